@@ -0,0 +1,209 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/gen"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/generator"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const lintUsage = `usage: go-mcp lint [flags] <descriptor set | schema directory>...
+
+Checks the input schema and name of every tool against the restrictions of
+the selected LLM providers and prints each offending schema path.
+
+A descriptor set, e.g. from "buf build -o", yields the tools of every
+service in it, rendered as protoc-gen-go-mcp generates them with the
+plugin options of -opt, including those of a config file. A directory is
+read for the <tool>.input.json files of the emit_schemas plugin option.
+
+Exits with 1 if a tool violates a restriction.
+
+flags:
+`
+
+// lintTool is a tool to check and where it came from.
+type lintTool struct {
+	source string
+	tool   runtime.Tool
+}
+
+func lint(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("lint", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, lintUsage)
+		flags.PrintDefaults()
+	}
+	providers := flags.String("providers", "openai,gemini,claude", fmt.Sprintf("Comma-separated providers to check against: %v.", gen.Providers))
+	var pluginParams generator.ListFlag
+	flags.Var(&pluginParams, "opt", "Plugin options to render the tools of descriptor sets with, as given to protoc-gen-go-mcp, e.g. \"openai_strict,config=mcp.yaml\". Can be repeated.")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	var selected []gen.Provider
+	for _, name := range strings.Split(*providers, ",") {
+		p, err := gen.ParseProvider(name)
+		if err != nil {
+			fmt.Fprintln(stderr, "go-mcp lint:", err)
+			return 2
+		}
+		selected = append(selected, p)
+	}
+	var pluginFlags flag.FlagSet
+	plugin := generator.DefineFlags(&pluginFlags)
+	if err := generator.NewParams(&pluginFlags).SetParameter(strings.Join(pluginParams, ",")); err != nil {
+		fmt.Fprintln(stderr, "go-mcp lint: -opt:", err)
+		return 2
+	}
+	opts, err := plugin.Options()
+	if err != nil {
+		fmt.Fprintln(stderr, "go-mcp lint:", err)
+		return 2
+	}
+
+	var tools []lintTool
+	for _, path := range flags.Args() {
+		info, err := os.Stat(path)
+		if err != nil {
+			fmt.Fprintln(stderr, "go-mcp lint:", err)
+			return 2
+		}
+		var loaded []lintTool
+		if info.IsDir() {
+			loaded, err = loadSchemaDir(path)
+		} else {
+			loaded, err = loadDescriptorSet(path, opts)
+		}
+		if err != nil {
+			fmt.Fprintf(stderr, "go-mcp lint: %s: %v\n", path, err)
+			return 2
+		}
+		tools = append(tools, loaded...)
+	}
+
+	problems := 0
+	for _, t := range tools {
+		for _, p := range selected {
+			err := gen.ValidateTool(p, t.tool)
+			var conformance *gen.ConformanceError
+			switch {
+			case err == nil:
+			case errors.As(err, &conformance):
+				for _, v := range conformance.Violations {
+					fmt.Fprintf(stdout, "%s: %s: %s: %s\n", t.source, t.tool.Name, p, v)
+					problems++
+				}
+			default:
+				fmt.Fprintf(stderr, "go-mcp lint: %s: %s: %v\n", t.source, t.tool.Name, err)
+				return 2
+			}
+		}
+	}
+	fmt.Fprintf(stderr, "%d tools checked against %s, %d problems\n", len(tools), *providers, problems)
+	if problems > 0 {
+		return 1
+	}
+	return 0
+}
+
+// loadDescriptorSet renders the tools of every service in a binary
+// FileDescriptorSet the way the plugin generates them with opts.
+func loadDescriptorSet(path string, opts generator.Options) ([]lintTool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	fds := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, fds); err != nil {
+		return nil, fmt.Errorf("not a binary FileDescriptorSet: %w", err)
+	}
+	files, err := protodesc.NewFiles(fds)
+	if err != nil {
+		return nil, err
+	}
+
+	var tools []lintTool
+	for _, fdp := range fds.GetFile() {
+		fd, err := files.FindFileByPath(fdp.GetName())
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < fd.Services().Len(); i++ {
+			methods := fd.Services().Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				md := methods.Get(j)
+				if !opts.GeneratesTool(md) {
+					continue
+				}
+				if _, err := gen.DeclaredExtraProperties(md); err != nil {
+					return nil, err
+				}
+				tool, err := opts.Tool(md, fd.SourceLocations().ByDescriptor(md).LeadingComments)
+				if err != nil {
+					return nil, err
+				}
+				tools = append(tools, lintTool{source: fdp.GetName(), tool: tool})
+			}
+		}
+	}
+	return tools, nil
+}
+
+// loadSchemaDir reads the <tool>.input.json files written by the
+// emit_schemas plugin option.
+func loadSchemaDir(dir string) ([]lintTool, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.input.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, errors.New("no *.input.json schema files")
+	}
+	sort.Strings(paths)
+	tools := make([]lintTool, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		tools = append(tools, lintTool{
+			source: path,
+			tool: runtime.Tool{
+				Name:           strings.TrimSuffix(filepath.Base(path), ".input.json"),
+				RawInputSchema: data,
+			},
+		})
+	}
+	return tools, nil
+}
@@ -0,0 +1,215 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	_ "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// writeDescriptorSet writes the registered file at path and its imports as
+// a binary FileDescriptorSet, like "buf build -o" would.
+func writeDescriptorSet(t *testing.T, path string) string {
+	t.Helper()
+	fd, err := protoregistry.GlobalFiles.FindFileByPath(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fds := &descriptorpb.FileDescriptorSet{}
+	var add func(protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+		if !slices.ContainsFunc(fds.File, func(f *descriptorpb.FileDescriptorProto) bool { return f.GetName() == fd.Path() }) {
+			fds.File = append(fds.File, protodesc.ToFileDescriptorProto(fd))
+		}
+	}
+	add(fd)
+	data, err := proto.Marshal(fds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := filepath.Join(t.TempDir(), "image.binpb")
+	if err := os.WriteFile(out, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+// writeSchemaDir writes each schema as <tool>.input.json, like the
+// emit_schemas plugin option.
+func writeSchemaDir(t *testing.T, schemas map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for tool, schema := range schemas {
+		if err := os.WriteFile(filepath.Join(dir, tool+".input.json"), []byte(schema), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestLint(t *testing.T) {
+	image := writeDescriptorSet(t, "testdata/test_service.proto")
+	notImage := filepath.Join(t.TempDir(), "image.binpb")
+	if err := os.WriteFile(notImage, []byte("not a descriptor set"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	badMappings := filepath.Join(t.TempDir(), "bad.json")
+	if err := os.WriteFile(badMappings, []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	mappings := filepath.Join(t.TempDir(), "mappings.json")
+	if err := os.WriteFile(mappings, []byte(`{"google.protobuf.Any": {"type": "string"}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	config := filepath.Join(t.TempDir(), "mcp.yaml")
+	configYAML := "options:\n  openai_strict: true\n  schema_mappings: " + mappings + "\n"
+	if err := os.WriteFile(config, []byte(configYAML), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		args func(t *testing.T) []string
+		code int
+		// stdout lists substrings of the reported problems; nil means none.
+		stdout []string
+		stderr string
+	}{
+		{
+			name:   "no paths",
+			args:   func(*testing.T) []string { return nil },
+			code:   2,
+			stderr: "usage: go-mcp lint",
+		},
+		{
+			name:   "unknown provider",
+			args:   func(*testing.T) []string { return []string{"-providers", "openai,nope", image} },
+			code:   2,
+			stderr: "nope",
+		},
+		{
+			name:   "missing path",
+			args:   func(t *testing.T) []string { return []string{filepath.Join(t.TempDir(), "missing")} },
+			code:   2,
+			stderr: "go-mcp lint:",
+		},
+		{
+			name:   "invalid schema_mappings",
+			args:   func(*testing.T) []string { return []string{"-opt", "schema_mappings=" + badMappings, image} },
+			code:   2,
+			stderr: badMappings,
+		},
+		{
+			name:   "unknown plugin option",
+			args:   func(*testing.T) []string { return []string{"-opt", "openai_strct", image} },
+			code:   2,
+			stderr: `unknown option "openai_strct", did you mean "openai_strict"?`,
+		},
+		{
+			name:   "not a descriptor set",
+			args:   func(*testing.T) []string { return []string{notImage} },
+			code:   2,
+			stderr: "not a binary FileDescriptorSet",
+		},
+		{
+			name:   "directory without schemas",
+			args:   func(t *testing.T) []string { return []string{t.TempDir()} },
+			code:   2,
+			stderr: "no *.input.json schema files",
+		},
+		{
+			name: "conforming schemas",
+			args: func(t *testing.T) []string {
+				return []string{writeSchemaDir(t, map[string]string{
+					"pkg_Service_Get": `{"type":"object","properties":{"id":{"type":"string"}}}`,
+				})}
+			},
+			code:   0,
+			stderr: "1 tools checked against openai,gemini,claude, 0 problems",
+		},
+		{
+			name: "violating schemas",
+			args: func(t *testing.T) []string {
+				return []string{"-providers", "openai", writeSchemaDir(t, map[string]string{
+					"pkg_Service_List": `{"type":"object","properties":{"ids":{"type":"array"}}}`,
+					"pkg_Service_Get":  `{"type":"object","properties":{"id":{"type":"string"}}}`,
+				})}
+			},
+			code:   1,
+			stdout: []string{"pkg_Service_List.input.json: pkg_Service_List: openai: ", `"items"`},
+			stderr: "2 tools checked against openai, 1 problems",
+		},
+		{
+			name:   "descriptor set",
+			args:   func(*testing.T) []string { return []string{"-providers", "claude", image} },
+			code:   1,
+			stdout: []string{"testdata/test_service.proto: testdata_TestService_ProcessWellKnownTypes: claude: ", `"@type"`},
+			stderr: "4 tools checked against claude, 1 problems",
+		},
+		{
+			name: "descriptor set with plugin options",
+			args: func(*testing.T) []string {
+				return []string{"-providers", "claude,openai-strict", "-opt", "openai_strict", "-opt", "schema_mappings=" + mappings, image}
+			},
+			code:   0,
+			stderr: "4 tools checked against claude,openai-strict, 0 problems",
+		},
+		{
+			name: "descriptor set with a config file",
+			args: func(*testing.T) []string {
+				return []string{"-providers", "claude,openai-strict", "-opt", "config=" + config, image}
+			},
+			code:   0,
+			stderr: "4 tools checked against claude,openai-strict, 0 problems",
+		},
+		{
+			name: "descriptor set with excluded tools",
+			args: func(*testing.T) []string {
+				return []string{"-providers", "claude", "-opt", "exclude=testdata.TestService.ProcessWellKnownTypes", image}
+			},
+			code:   0,
+			stderr: "3 tools checked against claude, 0 problems",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var stdout, stderr bytes.Buffer
+			g.Expect(lint(tc.args(t), &stdout, &stderr)).To(Equal(tc.code), "stdout: %s\nstderr: %s", &stdout, &stderr)
+			if tc.stdout == nil {
+				g.Expect(stdout.String()).To(BeEmpty())
+			}
+			for _, s := range tc.stdout {
+				g.Expect(stdout.String()).To(ContainSubstring(s))
+			}
+			g.Expect(stderr.String()).To(ContainSubstring(tc.stderr))
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command go-mcp is the companion tool of protoc-gen-go-mcp.
+//
+//	go-mcp lint [flags] <descriptor set | schema directory>...
+//
+// lint checks every tool schema against the restrictions of LLM providers.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+const usage = `usage: go-mcp <command> [arguments]
+
+commands:
+  lint    check tool schemas against LLM provider restrictions
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	switch os.Args[1] {
+	case "lint":
+		os.Exit(lint(os.Args[2:], os.Stdout, os.Stderr))
+	default:
+		fmt.Fprintf(os.Stderr, "go-mcp: unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+}
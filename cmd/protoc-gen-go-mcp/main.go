@@ -15,28 +15,87 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 
 	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/generator"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
 )
 
 func main() {
 	var flagSet flag.FlagSet
-	packageSuffix := flagSet.String(
-		"package_suffix",
-		"mcp",
-		"Generate files into a sub-package of the package containing the base .pb.go files using the given suffix. An empty suffix denotes to generate into the same package as the base pb.go files.",
-	)
+	runPlugin(generator.NewParams(&flagSet).Set, definePlugin(&flagSet))
+}
 
-	protogen.Options{
-		ParamFunc: flagSet.Set,
-	}.Run(func(gen *protogen.Plugin) error {
-		for _, f := range gen.Files {
-			if !f.Generate {
-				continue
-			}
-			generator.NewFileGenerator(f, gen).Generate(*packageSuffix)
+// definePlugin defines the plugin options on flagSet and returns the plugin,
+// which generates with the values they are set to.
+func definePlugin(flagSet *flag.FlagSet) func(req *pluginpb.CodeGeneratorRequest, emit func(*pluginpb.CodeGeneratorResponse) error) error {
+	flags := generator.DefineFlags(flagSet)
+	return func(req *pluginpb.CodeGeneratorRequest, emit func(*pluginpb.CodeGeneratorResponse) error) error {
+		opts, err := flags.Options()
+		if err != nil {
+			return err
+		}
+		opts.Warn = func(w generator.Warning) {
+			fmt.Fprintln(os.Stderr, "protoc-gen-go-mcp: warning:", w)
+		}
+		var entries []generator.PreviewEntry
+		if flags.Preview() {
+			opts.Preview = func(e generator.PreviewEntry) { entries = append(entries, e) }
+		}
+		if err := generator.GenerateStream(req, opts, emit); err != nil {
+			return err
+		}
+		if flags.Preview() {
+			return generator.WritePreview(os.Stderr, entries)
 		}
 		return nil
-	})
+	}
+}
+
+// runPlugin runs f as a protoc plugin, like protogen.Options.Run, but lets
+// it write the response piece by piece: f passes each part to emit, which
+// writes it to stdout right away. The parts concatenate to the encoding of
+// one CodeGeneratorResponse, as protoc expects. An error of f is reported
+// in a last part. The plugin parameters protoc does not handle itself are
+// passed to paramFunc.
+func runPlugin(paramFunc func(name, value string) error, f func(req *pluginpb.CodeGeneratorRequest, emit func(*pluginpb.CodeGeneratorResponse) error) error) {
+	if err := streamPlugin(paramFunc, f); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", filepath.Base(os.Args[0]), err)
+		os.Exit(1)
+	}
+}
+
+func streamPlugin(paramFunc func(name, value string) error, f func(req *pluginpb.CodeGeneratorRequest, emit func(*pluginpb.CodeGeneratorResponse) error) error) error {
+	if len(os.Args) > 1 {
+		return fmt.Errorf("unknown argument %q (this program should be run by protoc, not directly)", os.Args[1])
+	}
+	in, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return err
+	}
+	req := &pluginpb.CodeGeneratorRequest{}
+	if err := proto.Unmarshal(in, req); err != nil {
+		return err
+	}
+	emit := func(resp *pluginpb.CodeGeneratorResponse) error {
+		out, err := proto.Marshal(resp)
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(out)
+		return err
+	}
+	// Parse the parameters once; the generator ignores all but protoc's.
+	if _, err := (protogen.Options{ParamFunc: paramFunc}).New(&pluginpb.CodeGeneratorRequest{Parameter: req.Parameter}); err != nil {
+		return err
+	}
+	if err := f(req, emit); err != nil {
+		return emit(&pluginpb.CodeGeneratorResponse{Error: proto.String(err.Error())})
+	}
+	return nil
 }
@@ -0,0 +1,114 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	_ "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// unrecordedOptions are the plugin options runtime.GenerationInfo leaves
+// out on purpose.
+var unrecordedOptions = []string{
+	// Layout: where and how the files are written.
+	"build_tag",
+	"compress_schemas",
+	"file_suffix",
+	"package_name",
+	"package_suffix",
+	"same_package",
+	// Extra outputs next to the generated files.
+	"emit_schemas",
+	"fan_out",
+	"failover",
+	"fuzz_tests",
+	"golden_tests",
+	"instructions",
+	"mocks",
+	"stats_report",
+	"warnings_report",
+	// How the generator runs.
+	"config",
+	"preview",
+	"strict",
+	// Which tools a package serves and what they are called, which differ
+	// between packages on purpose.
+	"exclude",
+	"service",
+	"tool_prefix",
+}
+
+// TestGenerationInfoOptions fails for plugin options that are neither
+// recorded in the generation info nor listed in unrecordedOptions, so that a
+// new option is classified when it is added.
+func TestGenerationInfoOptions(t *testing.T) {
+	g := NewWithT(t)
+
+	var flagSet flag.FlagSet
+	plugin := definePlugin(&flagSet)
+	fd, err := protoregistry.GlobalFiles.FindFileByPath("testdata/test_service.proto")
+	g.Expect(err).ToNot(HaveOccurred())
+	var files []*descriptorpb.FileDescriptorProto
+	var add func(protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+		if !slices.ContainsFunc(files, func(f *descriptorpb.FileDescriptorProto) bool { return f.GetName() == fd.Path() }) {
+			files = append(files, protodesc.ToFileDescriptorProto(fd))
+		}
+	}
+	add(fd)
+
+	var content string
+	err = plugin(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{fd.Path()},
+		Parameter:      proto.String("paths=source_relative"),
+		ProtoFile:      files,
+	}, func(resp *pluginpb.CodeGeneratorResponse) error {
+		for _, f := range resp.File {
+			content += f.GetContent()
+		}
+		return nil
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	_, info, ok := strings.Cut(content, "_GenerationInfo = runtime.GenerationInfo{")
+	g.Expect(ok).To(BeTrue())
+	info, _, _ = strings.Cut(info, "\n}\n")
+	var recorded []string
+	for _, m := range regexp.MustCompile(`"([a-z_]+)=`).FindAllStringSubmatch(info, -1) {
+		recorded = append(recorded, m[1])
+	}
+	g.Expect(recorded).To(ContainElements("openai_strict", "comment_markdown"))
+
+	flagSet.VisitAll(func(f *flag.Flag) {
+		g.Expect(slices.Contains(recorded, f.Name) || slices.Contains(unrecordedOptions, f.Name)).To(BeTrue(),
+			"option %s is neither recorded in the generation info nor listed in unrecordedOptions", f.Name)
+		g.Expect(slices.Contains(recorded, f.Name) && slices.Contains(unrecordedOptions, f.Name)).To(BeFalse(),
+			"option %s is recorded in the generation info and listed in unrecordedOptions", f.Name)
+	})
+}
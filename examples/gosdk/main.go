@@ -21,15 +21,21 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
+	"net/http"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
 	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime/gosdk"
 	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
 	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
 )
 
 func main() {
+	addr := flag.String("http", "", "serve streamable HTTP on this address, with /livez and /readyz, instead of stdio")
+	flag.Parse()
+
 	// Create MCP server using the official go-sdk adapter.
 	// raw is the *mcp.Server for transport setup,
 	// s is the runtime.MCPServer for tool registration.
@@ -40,8 +46,23 @@ func main() {
 
 	srv := testServer{}
 
+	// Readiness follows the error rate of the backend calls.
+	health := runtime.NewHealthChecker(runtime.HealthOptions{})
+
 	// Register handlers - same generated code, different MCP library.
-	testdatamcp.RegisterTestServiceHandler(s, &srv)
+	testdatamcp.RegisterTestServiceHandler(s, &srv, runtime.WithHealthChecker(health))
+
+	if *addr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/mcp", mcp.NewStreamableHTTPHandler(func(*http.Request) *mcp.Server { return raw }, nil))
+		mux.Handle("/livez", health.LivenessHandler())
+		mux.Handle("/readyz", health.ReadinessHandler())
+		fmt.Printf("Serving on http://%s/mcp with modelcontextprotocol/go-sdk\n", *addr)
+		if err := http.ListenAndServe(*addr, mux); err != nil {
+			fmt.Printf("Server error: %v\n", err)
+		}
+		return
+	}
 
 	fmt.Println("Serving over stdio with modelcontextprotocol/go-sdk")
 
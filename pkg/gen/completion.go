@@ -0,0 +1,146 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"slices"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ArgumentCompletion describes how a top-level argument of a tool completes.
+// Exactly one of Values and ListMethod is set.
+type ArgumentCompletion struct {
+	// Argument is the argument name, the proto field name.
+	Argument string
+
+	// Values are the names of an enum argument.
+	Values []string
+
+	// ListMethod is the List RPC of the resource a
+	// google.api.resource_reference argument refers to.
+	ListMethod protoreflect.MethodDescriptor
+}
+
+// ArgumentCompletions returns the completable top-level arguments of the tool
+// for method, in field order. Enum fields complete to their value names.
+// String fields annotated with google.api.resource_reference complete to
+// resource names when the same service has a unary List RPC whose response
+// holds a repeated message of that google.api.resource type with a "name"
+// field. Oneof members, deprecated fields omitted from the schema and
+// (mcp.field).from_context fields are skipped.
+func ArgumentCompletions(method protoreflect.MethodDescriptor, opts SchemaOptions) []ArgumentCompletion {
+	if TakesNoArguments(method.Input()) {
+		return nil
+	}
+	var completions []ArgumentCompletion
+	fields := method.Input().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if oo := fd.ContainingOneof(); oo != nil && !oo.IsSynthetic() {
+			continue
+		}
+		if fd.IsMap() || fieldOptions(fd).GetFromContext() != "" {
+			continue
+		}
+		if opts.DeprecatedFields == DeprecatedFieldsOmit && fieldDeprecated(fd) {
+			continue
+		}
+		switch {
+		case fd.Kind() == protoreflect.EnumKind:
+			var values []string
+			for j := 0; j < fd.Enum().Values().Len(); j++ {
+				values = append(values, string(fd.Enum().Values().Get(j).Name()))
+			}
+			completions = append(completions, ArgumentCompletion{Argument: string(fd.Name()), Values: values})
+		case fd.Kind() == protoreflect.StringKind:
+			typ := resourceReference(fd)
+			if typ == "" {
+				continue
+			}
+			sd, ok := method.Parent().(protoreflect.ServiceDescriptor)
+			if !ok {
+				continue
+			}
+			if list := resourceListMethod(sd, typ); list != nil {
+				completions = append(completions, ArgumentCompletion{Argument: string(fd.Name()), ListMethod: list})
+			}
+		}
+	}
+	return completions
+}
+
+// resourceReference returns the google.api.resource_reference type of fd, or
+// "" if it has none.
+func resourceReference(fd protoreflect.FieldDescriptor) string {
+	opts := fd.Options()
+	if opts == nil || !proto.HasExtension(opts, annotations.E_ResourceReference) {
+		return ""
+	}
+	ref, _ := proto.GetExtension(opts, annotations.E_ResourceReference).(*annotations.ResourceReference)
+	return ref.GetType()
+}
+
+// resourceType returns the google.api.resource type of md, or "" if it has
+// none.
+func resourceType(md protoreflect.MessageDescriptor) string {
+	opts := md.Options()
+	if opts == nil || !proto.HasExtension(opts, annotations.E_Resource) {
+		return ""
+	}
+	res, _ := proto.GetExtension(opts, annotations.E_Resource).(*annotations.ResourceDescriptor)
+	return res.GetType()
+}
+
+// resourceListMethod returns the unary RPC of sd that lists resources of
+// type typ, or nil if there is none.
+func resourceListMethod(sd protoreflect.ServiceDescriptor, typ string) protoreflect.MethodDescriptor {
+	for i := 0; i < sd.Methods().Len(); i++ {
+		m := sd.Methods().Get(i)
+		if m.IsStreamingClient() || m.IsStreamingServer() {
+			continue
+		}
+		fields := m.Output().Fields()
+		for j := 0; j < fields.Len(); j++ {
+			fd := fields.Get(j)
+			if !fd.IsList() || fd.Message() == nil || resourceType(fd.Message()) != typ {
+				continue
+			}
+			if name := fd.Message().Fields().ByName("name"); name != nil && name.Kind() == protoreflect.StringKind {
+				return m
+			}
+		}
+	}
+	return nil
+}
+
+// ResourceCompletions returns the variables of uri, the resource template of
+// method, that complete like the arguments of its tool, in template order.
+// The resource shares those completers, under its URI template.
+func ResourceCompletions(method protoreflect.MethodDescriptor, uri string, opts SchemaOptions) []string {
+	completable := map[string]bool{}
+	for _, c := range ArgumentCompletions(method, opts) {
+		completable[c.Argument] = true
+	}
+	var shared []string
+	for _, m := range uriVariable.FindAllStringSubmatch(uri, -1) {
+		if completable[m[2]] && !slices.Contains(shared, m[2]) {
+			shared = append(shared, m[2])
+		}
+	}
+	return shared
+}
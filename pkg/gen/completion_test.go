@@ -0,0 +1,81 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestArgumentCompletions_Enum(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.EnumFieldsRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("EdgeCaseService")
+
+	completions := ArgumentCompletions(sd.Methods().ByName("EnumFields"), SchemaOptions{})
+	priorities := []string{"PRIORITY_UNSPECIFIED", "PRIORITY_LOW", "PRIORITY_MEDIUM", "PRIORITY_HIGH", "PRIORITY_CRITICAL"}
+	g.Expect(completions).To(Equal([]ArgumentCompletion{
+		{Argument: "priority", Values: priorities},
+		{Argument: "priorities", Values: priorities},
+	}))
+
+	g.Expect(ArgumentCompletions(sd.Methods().ByName("NoArguments"), SchemaOptions{})).To(BeEmpty())
+}
+
+func TestArgumentCompletions_ResourceReference(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("AnnotatedService")
+
+	completions := ArgumentCompletions(sd.Methods().ByName("ApplyConfig"), SchemaOptions{})
+	g.Expect(completions).To(HaveLen(1))
+	g.Expect(completions[0].Argument).To(Equal("base_config"))
+	g.Expect(completions[0].ListMethod.FullName()).To(Equal(protoreflect.FullName("testdata.AnnotatedService.ListConfigs")))
+}
+
+func TestRegisterService_Completions(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("AnnotatedService")
+
+	var listed proto.Message
+	handler := func(_ context.Context, method protoreflect.MethodDescriptor, req proto.Message) (proto.Message, error) {
+		g.Expect(method.Name()).To(Equal(protoreflect.Name("ListConfigs")))
+		listed = req
+		return &testdata.ListConfigsResponse{Configs: []*testdata.Config{
+			{Name: "configs/staging"},
+			{Name: "configs/prod"},
+			{Name: "configs/prod-eu"},
+		}}, nil
+	}
+
+	reg := runtime.NewCompletionRegistry()
+	RegisterService(&recordingServer{}, sd, handler, RegisterServiceOptions{
+		NamePrefix:  "ops",
+		Completions: reg,
+	})
+
+	got, err := reg.Complete(context.Background(), "ops_testdata_AnnotatedService_ApplyConfig", "base_config", "configs/p", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got.Values).To(Equal([]string{"configs/prod", "configs/prod-eu"}))
+	g.Expect(got.Total).To(Equal(2))
+
+	// The List RPC is asked for a full page of candidates.
+	pageSize := listed.ProtoReflect().Descriptor().Fields().ByName("page_size")
+	g.Expect(listed.ProtoReflect().Get(pageSize).Int()).To(BeEquivalentTo(runtime.MaxCompletionValues))
+}
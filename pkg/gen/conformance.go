@@ -0,0 +1,244 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+// Provider names an LLM provider whose restrictions on tool definitions
+// ValidateTool checks.
+type Provider string
+
+const (
+	// ProviderOpenAI is OpenAI function calling without strict mode.
+	ProviderOpenAI Provider = "openai"
+	// ProviderOpenAIStrict is OpenAI function calling with strict mode, see
+	// ValidateOpenAIStrict.
+	ProviderOpenAIStrict Provider = "openai-strict"
+	// ProviderGemini is the OpenAPI based "parameters" schema of Gemini
+	// function declarations.
+	ProviderGemini Provider = "gemini"
+	// ProviderClaude is Anthropic tool use.
+	ProviderClaude Provider = "claude"
+)
+
+// Providers lists every Provider.
+var Providers = []Provider{ProviderOpenAI, ProviderOpenAIStrict, ProviderGemini, ProviderClaude}
+
+// ParseProvider parses a provider name as accepted by the lint command.
+func ParseProvider(s string) (Provider, error) {
+	p := Provider(strings.ToLower(strings.TrimSpace(s)))
+	if !slices.Contains(Providers, p) {
+		return "", fmt.Errorf("unknown provider %q; want one of %v", s, Providers)
+	}
+	return p, nil
+}
+
+// SchemaViolation is a part of a tool definition a provider rejects.
+type SchemaViolation struct {
+	// Path is the JSON pointer of the offending subschema, such as
+	// "#/properties/labels", or empty for the tool name.
+	Path    string
+	Message string
+}
+
+func (v SchemaViolation) String() string {
+	if v.Path == "" {
+		return v.Message
+	}
+	return v.Path + ": " + v.Message
+}
+
+// ConformanceError lists every violation found by ValidateTool or
+// ValidateOpenAIStrict.
+type ConformanceError struct {
+	// Provider describes the checked restrictions, e.g. "OpenAI strict mode".
+	Provider   string
+	Violations []SchemaViolation
+}
+
+func (e *ConformanceError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = v.String()
+	}
+	return fmt.Sprintf("schema is not valid in %s: %s", e.Provider, strings.Join(msgs, "; "))
+}
+
+// violations collects the violations of one check.
+type violations struct {
+	provider string
+	list     []SchemaViolation
+}
+
+func (v *violations) report(path, msg string) {
+	v.list = append(v.list, SchemaViolation{Path: path, Message: msg})
+}
+
+func (v *violations) err() error {
+	if len(v.list) == 0 {
+		return nil
+	}
+	return &ConformanceError{Provider: v.provider, Violations: v.list}
+}
+
+var (
+	toolNameRE       = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+	geminiToolNameRE = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_.:-]{0,63}$`)
+	claudePropertyRE = regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,64}$`)
+)
+
+// geminiKeywords are the fields of the OpenAPI Schema object Gemini
+// function declarations accept.
+var geminiKeywords = map[string]bool{
+	"type": true, "format": true, "title": true, "description": true, "nullable": true,
+	"enum": true, "properties": true, "required": true, "propertyOrdering": true,
+	"items": true, "minItems": true, "maxItems": true,
+	"minProperties": true, "maxProperties": true,
+	"minLength": true, "maxLength": true, "pattern": true,
+	"minimum": true, "maximum": true, "anyOf": true, "example": true, "default": true,
+}
+
+// ValidateTool checks the name and input schema of tool against the
+// restrictions p places on tool definitions, and returns a
+// *ConformanceError listing every violation, or nil.
+func ValidateTool(p Provider, tool runtime.Tool) error {
+	var schema map[string]any
+	if err := json.Unmarshal(tool.RawInputSchema, &schema); err != nil {
+		return fmt.Errorf("input schema: %w", err)
+	}
+	var v violations
+	switch p {
+	case ProviderOpenAI:
+		v.provider = "OpenAI function calling"
+		checkToolName(&v, tool.Name, toolNameRE)
+		checkRootObject(&v, schema, "oneOf", "anyOf", "allOf", "enum", "not")
+		walkSchema("#", schema, func(path string, s map[string]any) {
+			if hasType(s, "array") && s["items"] == nil {
+				v.report(path, `arrays must declare "items"`)
+			}
+		})
+	case ProviderOpenAIStrict:
+		v.provider = "OpenAI strict mode"
+		checkToolName(&v, tool.Name, toolNameRE)
+		var strictErr *ConformanceError
+		if err := ValidateOpenAIStrict(tool.RawInputSchema); err != nil {
+			if !errors.As(err, &strictErr) {
+				return err
+			}
+			v.list = append(v.list, strictErr.Violations...)
+		}
+	case ProviderGemini:
+		v.provider = "Gemini function calling"
+		checkToolName(&v, tool.Name, geminiToolNameRE)
+		checkRootObject(&v, schema)
+		walkSchema("#", schema, func(path string, s map[string]any) {
+			for _, k := range sortedKeys(s) {
+				if !geminiKeywords[k] {
+					v.report(path, fmt.Sprintf("keyword %q is not supported", k))
+				}
+			}
+			switch t := s["type"].(type) {
+			case nil, string:
+			default:
+				v.report(path, fmt.Sprintf(`"type" must be a single type, got %v; use "nullable": true for null`, t))
+			}
+			if path != "#" && hasType(s, "object") {
+				if props, _ := s["properties"].(map[string]any); len(props) == 0 {
+					v.report(path, "objects must declare at least one property")
+				}
+			}
+		})
+	case ProviderClaude:
+		v.provider = "Claude tool use"
+		checkToolName(&v, tool.Name, toolNameRE)
+		checkRootObject(&v, schema, "oneOf", "anyOf", "allOf")
+		walkSchema("#", schema, func(path string, s map[string]any) {
+			props, _ := s["properties"].(map[string]any)
+			for _, name := range sortedKeys(props) {
+				if !claudePropertyRE.MatchString(name) {
+					v.report(path, fmt.Sprintf("property name %q must match %s", name, claudePropertyRE))
+				}
+			}
+		})
+	default:
+		return fmt.Errorf("unknown provider %q", p)
+	}
+	return v.err()
+}
+
+func checkToolName(v *violations, name string, re *regexp.Regexp) {
+	if !re.MatchString(name) {
+		v.report("", fmt.Sprintf("tool name %q must match %s", name, re))
+	}
+}
+
+// checkRootObject reports a root schema that is not an object or uses one
+// of the given keywords at the top level.
+func checkRootObject(v *violations, schema map[string]any, forbidden ...string) {
+	if schema["type"] != "object" {
+		v.report("#", `the root schema must have "type": "object"`)
+	}
+	for _, k := range forbidden {
+		if _, ok := schema[k]; ok {
+			v.report("#", fmt.Sprintf("the root schema must not use %q", k))
+		}
+	}
+}
+
+func hasType(s map[string]any, want string) bool {
+	switch t := s["type"].(type) {
+	case string:
+		return t == want
+	case []any:
+		return slices.Contains(t, any(want))
+	}
+	return false
+}
+
+// walkSchema calls fn for s and every subschema below it, in a stable
+// order, with its JSON pointer.
+func walkSchema(path string, s map[string]any, fn func(path string, s map[string]any)) {
+	fn(path, s)
+	for _, key := range []string{"properties", "$defs", "definitions"} {
+		m, _ := s[key].(map[string]any)
+		for _, name := range sortedKeys(m) {
+			if sub, ok := m[name].(map[string]any); ok {
+				walkSchema(path+"/"+key+"/"+pointerEscape(name), sub, fn)
+			}
+		}
+	}
+	for _, key := range []string{"items", "additionalProperties", "not"} {
+		if sub, ok := s[key].(map[string]any); ok {
+			walkSchema(path+"/"+key, sub, fn)
+		}
+	}
+	for _, key := range []string{"anyOf", "oneOf", "allOf"} {
+		list, _ := s[key].([]any)
+		for i, sub := range list {
+			if sub, ok := sub.(map[string]any); ok {
+				walkSchema(fmt.Sprintf("%s/%s/%d", path, key, i), sub, fn)
+			}
+		}
+	}
+}
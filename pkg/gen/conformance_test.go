@@ -0,0 +1,125 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func toolViolations(g Gomega, p Provider, name, schema string) []string {
+	err := ValidateTool(p, runtime.Tool{Name: name, RawInputSchema: json.RawMessage(schema)})
+	if err == nil {
+		return nil
+	}
+	var conformance *ConformanceError
+	g.Expect(errors.As(err, &conformance)).To(BeTrue(), err.Error())
+	var out []string
+	for _, v := range conformance.Violations {
+		out = append(out, v.String())
+	}
+	return out
+}
+
+func TestValidateTool(t *testing.T) {
+	const mapSchema = `{"type":"object","properties":{"labels":{"type":"object","additionalProperties":{"type":"string"}},"when":{"type":["string","null"]}}}`
+	tests := []struct {
+		name     string
+		provider Provider
+		tool     string
+		schema   string
+		want     []string
+	}{
+		{
+			name:     "openai accepts open schemas",
+			provider: ProviderOpenAI,
+			tool:     "svc_Method",
+			schema:   mapSchema,
+		},
+		{
+			name:     "openai arrays need items",
+			provider: ProviderOpenAI,
+			tool:     "svc_Method",
+			schema:   `{"type":"object","properties":{"tags":{"type":"array"}}}`,
+			want:     []string{`#/properties/tags: arrays must declare "items"`},
+		},
+		{
+			name:     "openai top-level union",
+			provider: ProviderOpenAI,
+			tool:     "svc.Method",
+			schema:   `{"type":"object","anyOf":[{"type":"object"}]}`,
+			want: []string{
+				`tool name "svc.Method" must match ^[a-zA-Z0-9_-]{1,64}$`,
+				`#: the root schema must not use "anyOf"`,
+			},
+		},
+		{
+			name:     "openai strict",
+			provider: ProviderOpenAIStrict,
+			tool:     "svc_Method",
+			schema:   `{"type":"object","properties":{},"required":[]}`,
+			want:     []string{`#: objects must set "additionalProperties": false`},
+		},
+		{
+			name:     "gemini",
+			provider: ProviderGemini,
+			tool:     "svc.Method",
+			schema:   mapSchema,
+			want: []string{
+				`#/properties/labels: keyword "additionalProperties" is not supported`,
+				`#/properties/labels: objects must declare at least one property`,
+				`#/properties/when: "type" must be a single type, got [string null]; use "nullable": true for null`,
+			},
+		},
+		{
+			name:     "claude",
+			provider: ProviderClaude,
+			tool:     "svc_Method",
+			schema:   `{"type":"object","properties":{"payload":{"type":"object","properties":{"@type":{"type":"string"}}}}}`,
+			want:     []string{`#/properties/payload: property name "@type" must match ^[a-zA-Z0-9_.-]{1,64}$`},
+		},
+		{
+			name:     "claude root must be an object",
+			provider: ProviderClaude,
+			tool:     "svc_Method",
+			schema:   `{"oneOf":[{"type":"object"}]}`,
+			want: []string{
+				`#: the root schema must have "type": "object"`,
+				`#: the root schema must not use "oneOf"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(toolViolations(g, tt.provider, tt.tool, tt.schema)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestParseProvider(t *testing.T) {
+	g := NewWithT(t)
+
+	p, err := ParseProvider(" Gemini ")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(p).To(Equal(ProviderGemini))
+
+	_, err = ParseProvider("mistral")
+	g.Expect(err).To(MatchError(ContainSubstring(`unknown provider "mistral"`)))
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// dedupeDescriptions removes the descriptions within every repeat of a
+// message schema in schema, keeping those of its first occurrence. The
+// description of a repeat itself belongs to the field it occurs in and
+// stays. Message schemas are told apart like in ShareDefinitions.
+func dedupeDescriptions(schema []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(schema))
+	dec.UseNumber()
+	v, err := decodeOrdered(dec)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	var walk func(node any, root bool) error
+	walk = func(node any, root bool) error {
+		switch n := node.(type) {
+		case []any:
+			for _, v := range n {
+				if err := walk(v, false); err != nil {
+					return err
+				}
+			}
+		case *orderedMap:
+			if _, ok := n.vals["properties"]; ok && !root {
+				b, err := json.Marshal(withoutDescription(n))
+				if err != nil {
+					return err
+				}
+				if seen[string(b)] {
+					stripDescriptions(n, true)
+					return nil
+				}
+				seen[string(b)] = true
+			}
+			for _, k := range n.keys {
+				switch {
+				case dataKeywords[k]:
+				case schemaMapKeywords[k]:
+					if m, ok := n.vals[k].(*orderedMap); ok {
+						for _, name := range m.keys {
+							if err := walk(m.vals[name], false); err != nil {
+								return err
+							}
+						}
+					}
+				default:
+					if err := walk(n.vals[k], false); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	}
+	if err := walk(v, true); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// stripDescriptions removes the descriptions of the schemas within node,
+// and of node itself unless it is the root.
+func stripDescriptions(node any, root bool) {
+	switch n := node.(type) {
+	case []any:
+		for _, v := range n {
+			stripDescriptions(v, false)
+		}
+	case *orderedMap:
+		if !root {
+			n.delete("description")
+		}
+		for _, k := range n.keys {
+			switch {
+			case dataKeywords[k]:
+			case schemaMapKeywords[k]:
+				if m, ok := n.vals[k].(*orderedMap); ok {
+					for _, name := range m.keys {
+						stripDescriptions(m.vals[name], false)
+					}
+				}
+			default:
+				stripDescriptions(n.vals[k], false)
+			}
+		}
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDedupeDescriptions(t *testing.T) {
+	g := NewWithT(t)
+	address := func(description string) string {
+		return `{"description":"` + description + `","properties":{"city":{"description":"City name.","type":"string"},"geo":{"description":"Coordinates.","properties":{"lat":{"description":"Latitude.","type":"number"}},"type":"object"}},"type":"object"}`
+	}
+
+	got, err := dedupeDescriptions([]byte(`{"description":"An order.","properties":{` +
+		`"billing":` + address("Billing address.") + `,` +
+		`"shipping":` + address("Shipping address.") + `,` +
+		`"stops":{"items":` + address("A stop.") + `,"type":"array"}` +
+		`},"type":"object"}`))
+	g.Expect(err).ToNot(HaveOccurred())
+	repeat := func(description string) string {
+		return `{"description":"` + description + `","properties":{"city":{"type":"string"},"geo":{"properties":{"lat":{"type":"number"}},"type":"object"}},"type":"object"}`
+	}
+	g.Expect(string(got)).To(Equal(`{"description":"An order.","properties":{` +
+		`"billing":` + address("Billing address.") + `,` +
+		`"shipping":` + repeat("Shipping address.") + `,` +
+		`"stops":{"items":` + repeat("A stop.") + `,"type":"array"}` +
+		`},"type":"object"}`))
+
+	// Different messages keep their descriptions, and so do data keywords.
+	doc := `{"properties":{"a":{"default":{"description":"x"},"properties":{"x":{"description":"X.","type":"string"}},"type":"object"},"b":{"properties":{"y":{"description":"Y.","type":"string"}},"type":"object"}},"type":"object"}`
+	got, err = dedupeDescriptions([]byte(doc))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(got)).To(Equal(doc))
+}
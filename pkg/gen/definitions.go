@@ -0,0 +1,285 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// minSharedDefinitionSize is the size in bytes of the smallest message
+// schema ShareDefinitions moves into a definition. Smaller ones take about as
+// much room as the "$ref" replacing them.
+const minSharedDefinitionSize = 128
+
+// schemaMapKeywords hold objects whose values are schemas.
+var schemaMapKeywords = map[string]bool{
+	"properties": true, "patternProperties": true, "dependentSchemas": true,
+	"$defs": true, "definitions": true,
+}
+
+// dataKeywords hold JSON values, not schemas.
+var dataKeywords = map[string]bool{
+	"enum": true, "const": true, "default": true, "examples": true, "required": true,
+}
+
+// ShareDefinitions moves the message schemas that occur more than once in
+// schemas, e.g. a nested message taken by several tools of a file, into
+// definitions, and replaces every occurrence with a "$ref" to
+// "#/$defs/<name>" ("#/definitions/<name>" for draft-07). The description
+// of an occurrence stays next to its "$ref". Definitions are named after the
+// property they first occur in.
+//
+// It returns the definitions as a JSON object, empty when nothing repeats,
+// and the rewritten schemas. These are not self-contained:
+// runtime.SchemaDefinitions adds the definitions each one refers to.
+func ShareDefinitions(schemas []json.RawMessage, draft SchemaDraft) (json.RawMessage, []json.RawMessage, error) {
+	s := &definitionSharer{
+		prefix: "#/" + draft.DefinitionsKeyword() + "/",
+		counts: map[string]int{},
+		hints:  map[string]string{},
+		names:  map[string]string{},
+		defs:   newOrderedMap(),
+	}
+	decoded := make([]any, len(schemas))
+	for i, schema := range schemas {
+		dec := json.NewDecoder(bytes.NewReader(schema))
+		dec.UseNumber()
+		v, err := decodeOrdered(dec)
+		if err != nil {
+			return nil, nil, err
+		}
+		decoded[i] = v
+		s.count(v, "", true)
+	}
+	used := map[string]bool{}
+	for _, key := range s.order {
+		if s.counts[key] < 2 {
+			continue
+		}
+		base := definitionName(s.hints[key])
+		name := base
+		for i := 2; used[name]; i++ {
+			name = base + strconv.Itoa(i)
+		}
+		used[name] = true
+		s.names[key] = name
+	}
+
+	shared := make([]json.RawMessage, len(schemas))
+	for i, v := range decoded {
+		b, err := json.Marshal(s.rewrite(v, true))
+		if err != nil {
+			return nil, nil, err
+		}
+		shared[i] = b
+	}
+	defs, err := json.Marshal(s.defs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return defs, shared, nil
+}
+
+type definitionSharer struct {
+	prefix string
+	// counts holds the occurrences of every message schema, by its JSON
+	// without description. order lists them as first seen and hints the
+	// property they were first seen in.
+	counts map[string]int
+	order  []string
+	hints  map[string]string
+	// names holds the definition names of the schemas that are shared.
+	names map[string]string
+	defs  *orderedMap
+}
+
+// key returns the identity of the message schema n, or false if n is the
+// root, not a message schema, or too small to share.
+func (s *definitionSharer) key(n *orderedMap, root bool) (string, bool) {
+	if _, ok := n.vals["properties"]; root || !ok {
+		return "", false
+	}
+	b, err := json.Marshal(withoutDescription(n))
+	if err != nil || len(b) < minSharedDefinitionSize {
+		return "", false
+	}
+	return string(b), true
+}
+
+// count counts the message schemas in node. Repeats of a schema are not
+// descended into, so a message only nested in a shared one is not shared
+// on its own.
+func (s *definitionSharer) count(node any, hint string, root bool) {
+	switch n := node.(type) {
+	case []any:
+		for _, v := range n {
+			s.count(v, hint, false)
+		}
+	case *orderedMap:
+		if key, ok := s.key(n, root); ok {
+			s.counts[key]++
+			if s.counts[key] > 1 {
+				return
+			}
+			s.order = append(s.order, key)
+			s.hints[key] = hint
+		}
+		for _, k := range n.keys {
+			switch {
+			case dataKeywords[k]:
+			case schemaMapKeywords[k]:
+				if m, ok := n.vals[k].(*orderedMap); ok {
+					for _, name := range m.keys {
+						s.count(m.vals[name], name, false)
+					}
+				}
+			default:
+				s.count(n.vals[k], hint, false)
+			}
+		}
+	}
+}
+
+// rewrite returns node with the shared message schemas replaced by refs,
+// adding them to the definitions.
+func (s *definitionSharer) rewrite(node any, root bool) any {
+	switch n := node.(type) {
+	case []any:
+		out := make([]any, len(n))
+		for i, v := range n {
+			out[i] = s.rewrite(v, false)
+		}
+		return out
+	case *orderedMap:
+		var name string
+		if key, ok := s.key(n, root); ok {
+			name = s.names[key]
+		}
+		if name != "" {
+			if _, ok := s.defs.vals[name]; ok {
+				return s.ref(name, n)
+			}
+		}
+		out := newOrderedMap()
+		for _, k := range n.keys {
+			v := n.vals[k]
+			switch {
+			case dataKeywords[k]:
+			case schemaMapKeywords[k]:
+				if m, ok := v.(*orderedMap); ok {
+					rewritten := newOrderedMap()
+					for _, prop := range m.keys {
+						rewritten.set(prop, s.rewrite(m.vals[prop], false))
+					}
+					v = rewritten
+				}
+			default:
+				v = s.rewrite(v, false)
+			}
+			out.set(k, v)
+		}
+		if name == "" {
+			return out
+		}
+		s.defs.set(name, withoutDescription(out))
+		return s.ref(name, n)
+	}
+	return node
+}
+
+// ref returns the "$ref" to definition name replacing n.
+func (s *definitionSharer) ref(name string, n *orderedMap) *orderedMap {
+	ref := newOrderedMap()
+	ref.set("$ref", s.prefix+name)
+	if description, ok := n.vals["description"]; ok {
+		ref.set("description", description)
+	}
+	return ref
+}
+
+func withoutDescription(n *orderedMap) *orderedMap {
+	out := newOrderedMap()
+	for _, k := range n.keys {
+		if k != "description" {
+			out.set(k, n.vals[k])
+		}
+	}
+	return out
+}
+
+// definitionName turns a property name like "created_at" into a definition
+// name like "CreatedAt".
+func definitionName(property string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range property {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			if upper {
+				r = unicode.ToUpper(r)
+			}
+			b.WriteRune(r)
+			upper = false
+		default:
+			upper = true
+		}
+	}
+	if b.Len() == 0 {
+		return "Message"
+	}
+	return b.String()
+}
+
+// decodeOrdered decodes the next JSON value of dec, keeping the order of
+// object keys in orderedMaps.
+func decodeOrdered(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case json.Delim('{'):
+		m := newOrderedMap()
+		for dec.More() {
+			k, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			v, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			m.set(k.(string), v)
+		}
+		_, err := dec.Token()
+		return m, err
+	case json.Delim('['):
+		a := []any{}
+		for dec.More() {
+			v, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			a = append(a, v)
+		}
+		_, err := dec.Token()
+		return a, err
+	}
+	return tok, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+// sharedItem is a message schema large enough to be shared.
+const sharedItem = `{"properties":{"id":{"type":"string"},"labels":{"additionalProperties":{"type":"string"},"propertyNames":{"type":"string"},"type":"object"},"name":{"type":"string"}},"type":"object"}`
+
+func TestShareDefinitions(t *testing.T) {
+	g := NewWithT(t)
+
+	get := `{"properties":{"item":` + sharedItem[:len(sharedItem)-1] + `,"description":"The item."}},"type":"object"}`
+	list := `{"properties":{"items":{"items":` + sharedItem + `,"type":"array"},"page":{"properties":{"size":{"type":"integer"}},"type":"object"}},"type":"object"}`
+	defs, shared, err := ShareDefinitions([]json.RawMessage{json.RawMessage(get), json.RawMessage(list)}, "")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(defs)).To(Equal(`{"Item":` + sharedItem + `}`))
+	g.Expect(string(shared[0])).To(Equal(`{"properties":{"item":{"$ref":"#/$defs/Item","description":"The item."}},"type":"object"}`))
+	// Small messages stay inline.
+	g.Expect(string(shared[1])).To(Equal(`{"properties":{"items":{"items":{"$ref":"#/$defs/Item"},"type":"array"},"page":{"properties":{"size":{"type":"integer"}},"type":"object"}},"type":"object"}`))
+
+	tool := runtime.NewSchemaDefinitions(string(defs)).Tool(runtime.Tool{
+		Name:            "svc_Get",
+		RawInputSchema:  shared[0],
+		RawOutputSchema: shared[1],
+	}).Loaded()
+	g.Expect(string(tool.RawInputSchema)).To(Equal(`{"$defs":{"Item":` + sharedItem + `},"properties":{"item":{"$ref":"#/$defs/Item","description":"The item."}},"type":"object"}`))
+	g.Expect(string(tool.RawOutputSchema)).To(HavePrefix(`{"$defs":{"Item":`))
+
+	// Compressed definitions work the same.
+	compressed, err := runtime.CompressDefinitions(defs)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(runtime.NewSchemaDefinitions(compressed).Tool(runtime.Tool{RawInputSchema: shared[0]}).Loaded()).To(Equal(runtime.Tool{RawInputSchema: tool.RawInputSchema}))
+}
+
+func TestShareDefinitionsNested(t *testing.T) {
+	g := NewWithT(t)
+
+	// An order wraps an item. The item only occurs inside the order, so
+	// it is not shared on its own.
+	order := `{"properties":{"item":` + sharedItem + `,"note":{"type":"string"}},"type":"object"}`
+	schema := `{"properties":{"order":` + order + `},"type":"object"}`
+	defs, shared, err := ShareDefinitions([]json.RawMessage{json.RawMessage(schema), json.RawMessage(schema)}, Draft07)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(defs)).To(Equal(`{"Order":` + order + `}`))
+	g.Expect(string(shared[0])).To(Equal(`{"properties":{"order":{"$ref":"#/definitions/Order"}},"type":"object"}`))
+
+	// A second message with the same name gets a numbered one, and a
+	// shared message inside another is attached transitively.
+	other := `{"properties":{"order":{"properties":{"item":` + sharedItem + `,"quantity":{"type":"integer"}},"type":"object"}},"type":"object"}`
+	defs, shared, err = ShareDefinitions([]json.RawMessage{json.RawMessage(schema), json.RawMessage(other), json.RawMessage(schema), json.RawMessage(other)}, Draft07)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(defs)).To(Equal(`{"Item":` + sharedItem + `,` +
+		`"Order":{"properties":{"item":{"$ref":"#/definitions/Item"},"note":{"type":"string"}},"type":"object"},` +
+		`"Order2":{"properties":{"item":{"$ref":"#/definitions/Item"},"quantity":{"type":"integer"}},"type":"object"}}`))
+	tool := runtime.NewSchemaDefinitions(string(defs)).Tool(runtime.Tool{RawInputSchema: shared[1]}).Loaded()
+	var got struct {
+		Definitions map[string]any `json:"definitions"`
+	}
+	g.Expect(json.Unmarshal(tool.RawInputSchema, &got)).To(Succeed())
+	g.Expect(got.Definitions).To(HaveKey("Item"))
+	g.Expect(got.Definitions).To(HaveKey("Order2"))
+	g.Expect(got.Definitions).ToNot(HaveKey("Order"))
+}
+
+func TestShareDefinitionsKeepsOrder(t *testing.T) {
+	g := NewWithT(t)
+
+	// Oneof wrappers list the discriminator first.
+	wrapper := `{"properties":{"which":{"enum":["a","b"],"type":"string"},"b":{"type":"string"},"a":{"type":"string"}},"required":["which"],"type":"object"}`
+	schema := `{"properties":{"kind":` + wrapper + `,"other":` + wrapper + `},"type":"object"}`
+	defs, shared, err := ShareDefinitions([]json.RawMessage{json.RawMessage(schema)}, "")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(defs)).To(Equal(`{"Kind":` + wrapper + `}`))
+	g.Expect(string(shared[0])).To(Equal(`{"properties":{"kind":{"$ref":"#/$defs/Kind"},"other":{"$ref":"#/$defs/Kind"}},"type":"object"}`))
+}
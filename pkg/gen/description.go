@@ -0,0 +1,262 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// TruncateDescription shortens desc to at most maxBytes bytes. It prefers to
+// cut at the last sentence boundary that fits, then at a word boundary, and
+// appends a note pointing at ref (typically the proto element's full name)
+// where the complete documentation lives. A maxBytes of zero or less, or a
+// description that already fits, returns desc unchanged.
+func TruncateDescription(desc string, maxBytes int, ref string) string {
+	if maxBytes <= 0 || len(desc) <= maxBytes {
+		return desc
+	}
+	note := fmt.Sprintf(" (Truncated; full docs on %s.)", ref)
+	if ref == "" || len(note) > maxBytes/2 {
+		// Not worth spending most of the budget on the pointer.
+		note = ""
+	}
+	budget := maxBytes - len(note)
+
+	if cut := lastSentenceEnd(desc, budget); cut > 0 {
+		return strings.TrimSpace(desc[:cut]) + note
+	}
+	const ellipsis = "…"
+	budget -= len(ellipsis)
+	if budget <= 0 {
+		return truncateRunes(desc, maxBytes)
+	}
+	head := truncateRunes(desc, budget)
+	if i := strings.LastIndexFunc(head, unicode.IsSpace); i > 0 {
+		head = head[:i]
+	}
+	return strings.TrimSpace(head) + ellipsis + note
+}
+
+// lastSentenceEnd returns the byte offset just past the last sentence
+// terminator in desc[:limit] that is followed by whitespace (or the end of
+// the string), or 0 if there is none.
+func lastSentenceEnd(desc string, limit int) int {
+	best := 0
+	for i := 0; i < limit && i < len(desc); i++ {
+		switch desc[i] {
+		case '.', '!', '?':
+			if i+1 == len(desc) || desc[i+1] == ' ' || desc[i+1] == '\n' {
+				best = i + 1
+			}
+		case '\n':
+			// A blank line ends a paragraph even without punctuation.
+			if i+1 < len(desc) && desc[i+1] == '\n' {
+				best = i
+			}
+		}
+	}
+	return best
+}
+
+// truncateRunes cuts s to at most n bytes without splitting a UTF-8 sequence.
+func truncateRunes(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+// FieldComments selects which comments attached to a field are rendered as
+// its description.
+type FieldComments string
+
+const (
+	FieldCommentsNone     FieldComments = ""
+	FieldCommentsLeading  FieldComments = "leading"
+	FieldCommentsTrailing FieldComments = "trailing"
+	FieldCommentsBoth     FieldComments = "both"
+)
+
+// ParseFieldComments parses the field_comments plugin option.
+func ParseFieldComments(s string) (FieldComments, error) {
+	switch c := FieldComments(s); c {
+	case FieldCommentsNone, FieldCommentsLeading, FieldCommentsTrailing, FieldCommentsBoth:
+		return c, nil
+	case "none":
+		return FieldCommentsNone, nil
+	default:
+		return "", fmt.Errorf("unsupported field_comments %q; want none, leading, trailing or both", s)
+	}
+}
+
+// MarkdownMode controls how markdown and HTML in comments is rewritten.
+type MarkdownMode string
+
+const (
+	// MarkdownKeep leaves comments as written.
+	MarkdownKeep MarkdownMode = ""
+	// MarkdownNormalize unwraps hard-wrapped paragraphs and replaces HTML
+	// tags and entities with plain text, keeping markdown syntax.
+	MarkdownNormalize MarkdownMode = "normalize"
+	// MarkdownStrip normalizes and additionally removes markdown syntax
+	// (emphasis, inline code, headings, code fences), rendering links as
+	// "text (url)".
+	MarkdownStrip MarkdownMode = "strip"
+)
+
+// ParseMarkdownMode parses the comment_markdown plugin option.
+func ParseMarkdownMode(s string) (MarkdownMode, error) {
+	switch m := MarkdownMode(s); m {
+	case MarkdownKeep, MarkdownNormalize, MarkdownStrip:
+		return m, nil
+	case "keep":
+		return MarkdownKeep, nil
+	default:
+		return "", fmt.Errorf("unsupported comment_markdown %q; want keep, normalize or strip", s)
+	}
+}
+
+// FormatComment turns a raw proto comment into a description: directive lines
+// are dropped (see CleanComment and SchemaOptions.CommentDirectives) and
+// markdown is rewritten according to SchemaOptions.Markdown.
+func FormatComment(comment string, opts SchemaOptions) string {
+	text := cleanComment(comment, opts.CommentDirectives)
+	switch opts.Markdown {
+	case MarkdownNormalize:
+		text = normalizeMarkdown(text)
+	case MarkdownStrip:
+		text = stripMarkdown(normalizeMarkdown(text))
+	}
+	return text
+}
+
+// fieldComment returns the formatted comments of fd selected by
+// SchemaOptions.FieldComments, or "" if none are selected or available.
+func fieldComment(fd protoreflect.FieldDescriptor, opts SchemaOptions) string {
+	if opts.FieldComments == FieldCommentsNone {
+		return ""
+	}
+	loc := fd.ParentFile().SourceLocations().ByDescriptor(fd)
+	var parts []string
+	if opts.FieldComments != FieldCommentsTrailing {
+		parts = append(parts, loc.LeadingComments)
+	}
+	if opts.FieldComments != FieldCommentsLeading {
+		parts = append(parts, loc.TrailingComments)
+	}
+	var out []string
+	for _, p := range parts {
+		if p = strings.TrimSpace(FormatComment(p, opts)); p != "" {
+			out = append(out, p)
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// joinDescription joins non-empty description parts with a space.
+func joinDescription(parts ...string) string {
+	var out []string
+	for _, p := range parts {
+		if p != "" {
+			out = append(out, p)
+		}
+	}
+	return strings.Join(out, " ")
+}
+
+var (
+	htmlBreak    = regexp.MustCompile(`(?i)<br\s*/?>`)
+	htmlTag      = regexp.MustCompile(`</?[a-zA-Z][^<>]*>`)
+	listItem     = regexp.MustCompile(`^([-*+]|\d+[.)])\s`)
+	mdImage      = regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`)
+	mdLink       = regexp.MustCompile(`\[([^\]]+)\]\(([^)]+)\)`)
+	mdStrong     = regexp.MustCompile(`(\*\*|__)(\S(?:.*?\S)?)(\*\*|__)`)
+	mdEmphasis   = regexp.MustCompile(`(^|[^\w*])\*(\S(?:[^*\n]*?\S)?)\*`)
+	mdInlineCode = regexp.MustCompile("`([^`\n]+)`")
+	mdHeading    = regexp.MustCompile(`(?m)^#{1,6}\s+`)
+	mdFence      = regexp.MustCompile("(?m)^```.*\n?")
+)
+
+// normalizeMarkdown unwraps hard-wrapped paragraph lines and replaces HTML
+// with plain text. Blank lines, list items, headings and fenced code blocks
+// keep their line structure.
+func normalizeMarkdown(text string) string {
+	// A <br> is an explicit line break; mark it so unwrapping keeps it.
+	const hardBreak = "\x00"
+	text = htmlBreak.ReplaceAllString(text, hardBreak+"\n")
+	text = htmlTag.ReplaceAllString(text, "")
+	text = html.UnescapeString(text)
+
+	var out []string
+	inFence, joinable := false, false
+	for _, line := range strings.Split(text, "\n") {
+		broken := strings.HasSuffix(line, hardBreak)
+		line = strings.TrimSuffix(line, hardBreak)
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "```"):
+			inFence = !inFence
+			out = append(out, line)
+			joinable = false
+		case inFence:
+			out = append(out, line)
+		case trimmed == "":
+			out = append(out, "")
+			joinable = false
+		case strings.HasPrefix(trimmed, "#"):
+			out = append(out, trimmed)
+			joinable = false
+		case listItem.MatchString(trimmed):
+			out = append(out, trimmed)
+			joinable = true
+		case joinable:
+			out[len(out)-1] += " " + trimmed
+		default:
+			out = append(out, trimmed)
+			joinable = true
+		}
+		if broken {
+			joinable = false
+		}
+	}
+	// Collapse runs of blank lines left behind by removed markup.
+	text = strings.Join(out, "\n")
+	for strings.Contains(text, "\n\n\n") {
+		text = strings.ReplaceAll(text, "\n\n\n", "\n\n")
+	}
+	return strings.TrimSpace(text)
+}
+
+// stripMarkdown removes markdown syntax, keeping the text it decorates.
+func stripMarkdown(text string) string {
+	text = mdFence.ReplaceAllString(text, "")
+	text = mdHeading.ReplaceAllString(text, "")
+	text = mdImage.ReplaceAllString(text, "$1")
+	text = mdLink.ReplaceAllString(text, "$1 ($2)")
+	text = mdInlineCode.ReplaceAllString(text, "$1")
+	text = mdStrong.ReplaceAllString(text, "$2")
+	text = mdEmphasis.ReplaceAllString(text, "$1$2")
+	return strings.TrimSpace(text)
+}
@@ -0,0 +1,250 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	. "github.com/onsi/gomega"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestTruncateDescription(t *testing.T) {
+	const long = "Creates an item. The item is stored durably. Replication happens asynchronously across all configured regions."
+
+	tests := []struct {
+		name     string
+		desc     string
+		maxBytes int
+		ref      string
+		want     string
+	}{
+		{"no limit", long, 0, "pkg.Svc.Create", long},
+		{"fits", "Short.", 10, "pkg.Svc.Create", "Short."},
+		{
+			"sentence boundary with note",
+			long, 84, "pkg.Svc.Create",
+			"Creates an item. (Truncated; full docs on pkg.Svc.Create.)",
+		},
+		{
+			"keeps as many sentences as fit",
+			long, 100, "x.Y",
+			"Creates an item. The item is stored durably. (Truncated; full docs on x.Y.)",
+		},
+		{
+			"word boundary when no sentence fits",
+			"A single very long sentence without any terminator whatsoever", 40, "",
+			"A single very long sentence without…",
+		},
+		{
+			"paragraph break counts as a boundary",
+			"Summary line\n\nDetails that go on and on and on and on.", 30, "",
+			"Summary line",
+		},
+		{"note dropped when it would dominate", long, 20, "a.very.long.package.Service.Method", "Creates an item."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got := TruncateDescription(tt.desc, tt.maxBytes, tt.ref)
+			g.Expect(got).To(Equal(tt.want))
+			if tt.maxBytes > 0 {
+				g.Expect(len(got)).To(BeNumerically("<=", tt.maxBytes))
+			}
+		})
+	}
+}
+
+func TestTruncateDescription_NeverSplitsRunes(t *testing.T) {
+	g := NewWithT(t)
+	desc := strings.Repeat("äöü", 20)
+	for n := 1; n < len(desc); n++ {
+		got := TruncateDescription(desc, n, "")
+		g.Expect(utf8.ValidString(got)).To(BeTrue(), "maxBytes=%d", n)
+		g.Expect(len(got)).To(BeNumerically("<=", n))
+	}
+}
+
+func TestToolForMethod_DescriptionBudget(t *testing.T) {
+	g := NewWithT(t)
+	method := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor().ParentFile().
+		Services().ByName("TestService").Methods().ByName("CreateItem")
+	comment := "Creates an item.\nIt supports many options, all of which are described at great length here,\nfar beyond what a model needs to pick the tool."
+
+	tool := ToolForMethodWithOptions(method, comment, SchemaOptions{MaxToolDescriptionBytes: 120})
+	g.Expect(tool.Description).To(Equal("Creates an item. (Truncated; full docs on testdata.TestService.CreateItem.)"))
+
+	tool = ToolForMethodWithOptions(method, comment, SchemaOptions{})
+	g.Expect(tool.Description).To(Equal(comment))
+}
+
+func TestFieldSchema_DescriptionBudget(t *testing.T) {
+	g := NewWithT(t)
+	md := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor()
+
+	timeout := FieldSchema(md.Fields().ByName("timeout"), SchemaOptions{ExamplesInDescription: true, MaxFieldDescriptionBytes: 15})
+	g.Expect(timeout["description"]).To(Equal("Examples:…"))
+
+	// Schema overrides are spliced in verbatim and keep their description.
+	override := FieldSchema(md.Fields().ByName("pipeline_yaml"), SchemaOptions{MaxFieldDescriptionBytes: 15})
+	g.Expect(override["description"]).To(HaveLen(73))
+}
+
+func TestFormatComment_Directives(t *testing.T) {
+	g := NewWithT(t)
+	comment := " Lists items.\n buf:lint:ignore RPC_REQUEST_STANDARD_NAME\n nolint:revive\n internal: do not document\n"
+
+	g.Expect(FormatComment(comment, SchemaOptions{})).To(Equal("Lists items.\ninternal: do not document\n"))
+	g.Expect(FormatComment(comment, SchemaOptions{CommentDirectives: []string{"internal:"}})).To(Equal("Lists items.\n"))
+}
+
+func TestFormatComment_Markdown(t *testing.T) {
+	comment := "# Overview\n" +
+		"Creates an **item** in the\n" +
+		"given `namespace`. See [the docs](https://example.com/items)<br>for details &amp; limits.\n" +
+		"\n" +
+		"- first *option*\n" +
+		"- second option that wraps\n" +
+		"  onto a second line\n" +
+		"\n" +
+		"```\n" +
+		"item := New()\n" +
+		"```\n"
+
+	tests := []struct {
+		mode MarkdownMode
+		want string
+	}{
+		{MarkdownKeep, strings.Join(strings.Split(strings.TrimSuffix(comment, "\n"), "\n"), "\n") + "\n"},
+		{MarkdownNormalize, "# Overview\n" +
+			"Creates an **item** in the given `namespace`. See [the docs](https://example.com/items)\n" +
+			"for details & limits.\n" +
+			"\n" +
+			"- first *option*\n" +
+			"- second option that wraps onto a second line\n" +
+			"\n" +
+			"```\n" +
+			"item := New()\n" +
+			"```"},
+		{MarkdownStrip, "Overview\n" +
+			"Creates an item in the given namespace. See the docs (https://example.com/items)\n" +
+			"for details & limits.\n" +
+			"\n" +
+			"- first option\n" +
+			"- second option that wraps onto a second line\n" +
+			"\n" +
+			"item := New()"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			g := NewWithT(t)
+			got := FormatComment(comment, SchemaOptions{Markdown: tt.mode})
+			if tt.mode == MarkdownKeep {
+				g.Expect(got).To(Equal(CleanComment(comment)))
+				return
+			}
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestStripMarkdown_LeavesIdentifiersAlone(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(stripMarkdown("Set resource_group_id and max_items; 2 * 3 * 4 = 24.")).
+		To(Equal("Set resource_group_id and max_items; 2 * 3 * 4 = 24."))
+}
+
+// commentedMessage builds a message whose single field carries the given
+// leading and trailing comments in its source info.
+func commentedMessage(t *testing.T, leading, trailing string) protoreflect.MessageDescriptor {
+	t.Helper()
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("commented_fixture.proto"),
+		Package: proto.String("fixture"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Commented"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("page_size"),
+				JsonName: proto.String("pageSize"),
+				Number:   proto.Int32(1),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum(),
+			}},
+		}},
+		SourceCodeInfo: &descriptorpb.SourceCodeInfo{
+			Location: []*descriptorpb.SourceCodeInfo_Location{{
+				// message_type[0].field[0]
+				Path:             []int32{4, 0, 2, 0},
+				Span:             []int32{1, 2, 20},
+				LeadingComments:  proto.String(leading),
+				TrailingComments: proto.String(trailing),
+			}},
+		},
+	}
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatalf("building fixture: %v", err)
+	}
+	return fd.Messages().ByName("Commented")
+}
+
+func TestFieldComments(t *testing.T) {
+	md := commentedMessage(t, " Maximum number of items.\n buf:lint:ignore FIELD_LOWER_SNAKE_CASE\n", " Defaults to 50.\n")
+
+	tests := []struct {
+		mode FieldComments
+		want any
+	}{
+		{FieldCommentsNone, nil},
+		{FieldCommentsLeading, "Maximum number of items."},
+		{FieldCommentsTrailing, "Defaults to 50."},
+		{FieldCommentsBoth, "Maximum number of items.\nDefaults to 50."},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			g := NewWithT(t)
+			schema := FieldSchema(md.Fields().ByName("page_size"), SchemaOptions{FieldComments: tt.mode})
+			if tt.want == nil {
+				g.Expect(schema).ToNot(HaveKey("description"))
+				return
+			}
+			g.Expect(schema).To(HaveKeyWithValue("description", tt.want))
+		})
+	}
+}
+
+func TestParseCommentOptions(t *testing.T) {
+	g := NewWithT(t)
+
+	fc, err := ParseFieldComments("none")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(fc).To(Equal(FieldCommentsNone))
+	_, err = ParseFieldComments("all")
+	g.Expect(err).To(MatchError(ContainSubstring(`unsupported field_comments "all"`)))
+
+	md, err := ParseMarkdownMode("keep")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(md).To(Equal(MarkdownKeep))
+	_, err = ParseMarkdownMode("html")
+	g.Expect(err).To(MatchError(ContainSubstring(`unsupported comment_markdown "html"`)))
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DescriptorError is an error about a proto element. It carries the element's
+// descriptor so that callers can report where in the .proto source it is
+// declared; Error returns the wrapped message unchanged.
+type DescriptorError struct {
+	Descriptor protoreflect.Descriptor
+	Err        error
+}
+
+func (e *DescriptorError) Error() string { return e.Err.Error() }
+
+func (e *DescriptorError) Unwrap() error { return e.Err }
+
+// errorOn attaches d to err unless err already names a more specific
+// element.
+func errorOn(d protoreflect.Descriptor, err error) error {
+	if err == nil {
+		return nil
+	}
+	var de *DescriptorError
+	if errors.As(err, &de) {
+		return err
+	}
+	return &DescriptorError{Descriptor: d, Err: err}
+}
+
+// Location returns where d is declared as "file.proto:line:column", or just
+// the file path when the file was compiled without source code info.
+func Location(d protoreflect.Descriptor) string {
+	file := d.ParentFile()
+	if file == nil {
+		return string(d.FullName())
+	}
+	loc := file.SourceLocations().ByDescriptor(d)
+	if loc.Path == nil {
+		return file.Path()
+	}
+	return fmt.Sprintf("%s:%d:%d", file.Path(), loc.StartLine+1, loc.StartColumn+1)
+}
+
+// ErrorAt prefixes err with the source location of the element it is about:
+// the descriptor of a DescriptorError in its chain, or d otherwise.
+func ErrorAt(d protoreflect.Descriptor, err error) error {
+	if err == nil {
+		return nil
+	}
+	var de *DescriptorError
+	if errors.As(err, &de) {
+		d = de.Descriptor
+	}
+	return fmt.Errorf("%s: %w", Location(d), err)
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestLocation(t *testing.T) {
+	g := NewWithT(t)
+	md := diagnosticFixture(t, true)
+	fd := md.Fields().ByName("name")
+
+	g.Expect(Location(md)).To(Equal("fixture.proto:3:1"))
+	g.Expect(Location(fd)).To(Equal("fixture.proto:4:3"))
+	g.Expect(Location(diagnosticFixture(t, false))).To(Equal("fixture.proto"))
+
+	// The innermost descriptor wins, and the message is kept as is.
+	err := errorOn(md, fmt.Errorf("message: %w", errorOn(fd, errors.New("bad field"))))
+	g.Expect(ErrorAt(md, err)).To(MatchError("fixture.proto:4:3: message: bad field"))
+	g.Expect(ErrorAt(md, errors.New("bad message"))).To(MatchError("fixture.proto:3:1: bad message"))
+	g.Expect(ErrorAt(md, nil)).ToNot(HaveOccurred())
+}
+
+// diagnosticFixture builds a message "fixture.M" with a string field "name",
+// declared at lines 3 and 4 of fixture.proto when withSource is set.
+func diagnosticFixture(t *testing.T, withSource bool) protoreflect.MessageDescriptor {
+	t.Helper()
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("fixture.proto"),
+		Package: proto.String("fixture"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("M"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("name"),
+				JsonName: proto.String("name"),
+				Number:   proto.Int32(1),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			}},
+		}},
+	}
+	if withSource {
+		fdp.SourceCodeInfo = &descriptorpb.SourceCodeInfo{Location: []*descriptorpb.SourceCodeInfo_Location{
+			{Path: []int32{4, 0}, Span: []int32{2, 0, 4, 1}},
+			{Path: []int32{4, 0, 2, 0}, Span: []int32{3, 2, 19}},
+		}}
+	}
+	file, err := protodesc.NewFile(fdp, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return file.Messages().Get(0)
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"maps"
+	"strings"
+)
+
+// translateSchema rewrites, in place, the keywords of node, a schema or a
+// part of one, that mean different things in draft-07 and 2020-12 to those
+// of d. Generated schemas mostly stay within the keywords both agree on;
+// this catches "deprecated" and whatever (mcp.field).schema,
+// (mcp.message).schema and schema_mappings fragments bring in:
+//
+//   - "$defs" and "definitions", and the "$ref"s into them
+//   - "prefixItems" and array-form "items" with "additionalItems"
+//   - "dependentRequired" and "dependentSchemas", and "dependencies"
+//   - "deprecated", which draft-07 lacks; the description keeps the
+//     deprecation note
+//
+// An unset d leaves node alone.
+func (d SchemaDraft) translateSchema(node any) {
+	if d == "" {
+		return
+	}
+	switch n := node.(type) {
+	case []any:
+		for _, v := range n {
+			d.translateSchema(v)
+		}
+	case map[string]any:
+		if d == Draft07 {
+			toDraft07(n)
+		} else {
+			toDraft202012(n)
+		}
+		for k, v := range n {
+			switch {
+			case dataKeywords[k]:
+			case schemaMapKeywords[k], k == "dependencies":
+				// The keys are names, so only the values are schemas.
+				if m, ok := v.(map[string]any); ok {
+					for _, s := range m {
+						d.translateSchema(s)
+					}
+				}
+			default:
+				d.translateSchema(v)
+			}
+		}
+	}
+}
+
+// toDraft07 rewrites the 2019-09 and later keywords of the schema n.
+func toDraft07(n map[string]any) {
+	moveDefinitions(n, "$defs", "definitions")
+	if ref, ok := n["$ref"].(string); ok {
+		n["$ref"] = strings.Replace(ref, "#/$defs/", "#/definitions/", 1)
+	}
+	if prefix, ok := n["prefixItems"]; ok {
+		if items, ok := n["items"]; ok {
+			n["additionalItems"] = items
+		}
+		n["items"] = prefix
+		delete(n, "prefixItems")
+	}
+	deps := map[string]any{}
+	for _, k := range []string{"dependentRequired", "dependentSchemas"} {
+		if m, ok := n[k].(map[string]any); ok {
+			maps.Copy(deps, m)
+			delete(n, k)
+		}
+	}
+	if len(deps) > 0 {
+		n["dependencies"] = deps
+	}
+	delete(n, "deprecated")
+}
+
+// toDraft202012 rewrites the draft-07 keywords of the schema n.
+func toDraft202012(n map[string]any) {
+	moveDefinitions(n, "definitions", "$defs")
+	if ref, ok := n["$ref"].(string); ok {
+		n["$ref"] = strings.Replace(ref, "#/definitions/", "#/$defs/", 1)
+	}
+	if prefix, ok := n["items"].([]any); ok {
+		n["prefixItems"] = prefix
+		delete(n, "items")
+		if additional, ok := n["additionalItems"]; ok {
+			n["items"] = additional
+			delete(n, "additionalItems")
+		}
+	}
+	if deps, ok := n["dependencies"].(map[string]any); ok {
+		required, schemas := map[string]any{}, map[string]any{}
+		for name, dep := range deps {
+			switch dep.(type) {
+			case []any, []string:
+				required[name] = dep
+			default:
+				schemas[name] = dep
+			}
+		}
+		if len(required) > 0 {
+			n["dependentRequired"] = required
+		}
+		if len(schemas) > 0 {
+			n["dependentSchemas"] = schemas
+		}
+		delete(n, "dependencies")
+	}
+}
+
+// moveDefinitions merges the definitions under the keyword from into those
+// under to.
+func moveDefinitions(n map[string]any, from, to string) {
+	defs, ok := n[from].(map[string]any)
+	if !ok {
+		return
+	}
+	if existing, ok := n[to].(map[string]any); ok {
+		maps.Copy(existing, defs)
+	} else {
+		n[to] = defs
+	}
+	delete(n, from)
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestToolForMethod_DraftKeywords(t *testing.T) {
+	// A hand-written mapping in the other dialect's keywords.
+	mappings, err := ParseMessageSchemas([]byte(`{"testdata.ApplyConfigResponse": {
+		"type": "object",
+		"properties": {
+			"ok": {"type": "boolean", "deprecated": true},
+			"point": {"type": "array", "prefixItems": [{"type": "number"}, {"type": "number"}], "items": false},
+			"range": {"$ref": "#/$defs/range"},
+			"retry": {"type": "object", "dependentRequired": {"max": ["min"]}}
+		},
+		"$defs": {"range": {"type": "object", "properties": {"min": {"type": "integer"}}}}
+	}}`))
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+	method := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor().ParentFile().
+		Services().ByName("AnnotatedService").Methods().ByName("ApplyConfig")
+
+	tests := []struct {
+		draft SchemaDraft
+		want  string
+	}{
+		{"", `{"$defs":{"range":{"properties":{"min":{"type":"integer"}},"type":"object"}},"properties":{"ok":{"deprecated":true,"type":"boolean"},"point":{"items":false,"prefixItems":[{"type":"number"},{"type":"number"}],"type":"array"},"range":{"$ref":"#/$defs/range"},"retry":{"dependentRequired":{"max":["min"]},"type":"object"}},"type":"object"}`},
+		{Draft07, `{"$schema":"http://json-schema.org/draft-07/schema#","definitions":{"range":{"properties":{"min":{"type":"integer"}},"type":"object"}},"properties":{"ok":{"type":"boolean"},"point":{"additionalItems":false,"items":[{"type":"number"},{"type":"number"}],"type":"array"},"range":{"$ref":"#/definitions/range"},"retry":{"dependencies":{"max":["min"]},"type":"object"}},"type":"object"}`},
+		{Draft202012, `{"$defs":{"range":{"properties":{"min":{"type":"integer"}},"type":"object"}},"$schema":"https://json-schema.org/draft/2020-12/schema","properties":{"ok":{"deprecated":true,"type":"boolean"},"point":{"items":false,"prefixItems":[{"type":"number"},{"type":"number"}],"type":"array"},"range":{"$ref":"#/$defs/range"},"retry":{"dependentRequired":{"max":["min"]},"type":"object"}},"type":"object"}`},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.draft), func(t *testing.T) {
+			g := NewWithT(t)
+			tool := ToolForMethodWithOptions(method, "", SchemaOptions{MessageSchemas: mappings, Draft: tt.draft})
+			g.Expect(tool.RawOutputSchema).To(MatchJSON(tt.want))
+		})
+	}
+}
+
+func TestSchemaDraft_TranslateToDraft202012(t *testing.T) {
+	g := NewWithT(t)
+	schema := map[string]any{
+		"definitions": map[string]any{"name": map[string]any{"type": "string"}},
+		"properties": map[string]any{
+			"name":  map[string]any{"$ref": "#/definitions/name"},
+			"pair":  map[string]any{"type": "array", "items": []any{map[string]any{"type": "string"}}, "additionalItems": false},
+			"login": map[string]any{"dependencies": map[string]any{"user": []any{"password"}, "sso": map[string]any{"required": []any{"issuer"}}}},
+		},
+	}
+	Draft202012.translateSchema(schema)
+	g.Expect(schema).To(Equal(map[string]any{
+		"$defs": map[string]any{"name": map[string]any{"type": "string"}},
+		"properties": map[string]any{
+			"name":  map[string]any{"$ref": "#/$defs/name"},
+			"pair":  map[string]any{"type": "array", "prefixItems": []any{map[string]any{"type": "string"}}, "items": false},
+			"login": map[string]any{"dependentRequired": map[string]any{"user": []any{"password"}}, "dependentSchemas": map[string]any{"sso": map[string]any{"required": []any{"issuer"}}}},
+		},
+	}))
+}
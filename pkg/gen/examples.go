@@ -0,0 +1,123 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ToolExample is an example call of a tool declared with
+// (mcp.method).example.
+type ToolExample struct {
+	Intent string
+	// Arguments is the compacted JSON object of the tool arguments, wrapped
+	// in SchemaOptions.WrapInput if set.
+	Arguments json.RawMessage
+}
+
+// ToolExamples returns the example calls declared for method. It fails on
+// arguments that are not a JSON object or name no argument of the tool, so
+// that examples do not drift from the request they show.
+func ToolExamples(method protoreflect.MethodDescriptor, opts SchemaOptions) ([]ToolExample, error) {
+	declared := methodOptions(method).GetExample()
+	if len(declared) == 0 {
+		return nil, nil
+	}
+	props, err := DeclaredExtraProperties(method)
+	if err != nil {
+		return nil, err
+	}
+	// Request fields are wrapped in SchemaOptions.WrapInput, the other
+	// arguments are not.
+	fieldArgs := map[string]bool{}
+	known := map[string]bool{}
+	fields := method.Input().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fieldOptions(fd).GetFromContext() != "" {
+			continue
+		}
+		fieldArgs[string(fd.Name())] = true
+		fieldArgs[fd.JSONName()] = true
+	}
+	for _, p := range props {
+		known[p.Name] = true
+	}
+	if DryRunSupported(method, opts) {
+		known[runtime.DryRunProperty] = true
+	}
+
+	examples := make([]ToolExample, 0, len(declared))
+	for i, ex := range declared {
+		var args map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(ex.GetArguments()), &args); err != nil || args == nil {
+			return nil, errorOn(method, fmt.Errorf("example %d on %q: arguments must be a JSON object", i+1, method.FullName()))
+		}
+		for name := range args {
+			if !fieldArgs[name] && !known[name] {
+				return nil, errorOn(method, fmt.Errorf("example %d on %q: %q is not an argument of the tool", i+1, method.FullName(), name))
+			}
+		}
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, []byte(ex.GetArguments())); err != nil {
+			return nil, errorOn(method, fmt.Errorf("example %d on %q: %w", i+1, method.FullName(), err))
+		}
+		arguments := json.RawMessage(compact.Bytes())
+		if opts.WrapInput != "" {
+			wrapped := map[string]any{}
+			request := map[string]json.RawMessage{}
+			for name, value := range args {
+				if fieldArgs[name] {
+					request[name] = value
+				} else {
+					wrapped[name] = value
+				}
+			}
+			wrapped[opts.WrapInput] = request
+			arguments, _ = json.Marshal(wrapped)
+		}
+		examples = append(examples, ToolExample{Intent: ex.GetIntent(), Arguments: arguments})
+	}
+	return examples, nil
+}
+
+// appendExamples appends a list of examples to the tool description desc.
+func appendExamples(desc string, examples []ToolExample) string {
+	if len(examples) == 0 {
+		return desc
+	}
+	desc = strings.TrimRightFunc(desc, unicode.IsSpace)
+	var b strings.Builder
+	b.WriteString(desc)
+	if desc != "" {
+		b.WriteString("\n\n")
+	}
+	b.WriteString("Examples:")
+	for _, ex := range examples {
+		b.WriteString("\n- ")
+		if ex.Intent != "" {
+			b.WriteString(ex.Intent + ": ")
+		}
+		b.Write(ex.Arguments)
+	}
+	return b.String()
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/mcpoptions"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// exampleFixture builds a method "fixture.Examples.Call" whose request has
+// the fields "name" and "page_size", with the extra property "zone" and the
+// given examples.
+func exampleFixture(t *testing.T, examples ...*mcpoptions.ToolExample) protoreflect.MethodDescriptor {
+	t.Helper()
+	methOpts := &descriptorpb.MethodOptions{}
+	proto.SetExtension(methOpts, mcpoptions.E_Method, &mcpoptions.MethodOptions{
+		ExtraProperty: []*mcpoptions.ExtraProperty{{Name: "zone"}},
+		Example:       examples,
+	})
+	field := func(name, jsonName string, number int32, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(jsonName),
+			Number:   proto.Int32(number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     typ.Enum(),
+		}
+	}
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("example_fixture.proto"),
+		Package: proto.String("fixture"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("ExamplesReq"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("name", "name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+				field("page_size", "pageSize", 2, descriptorpb.FieldDescriptorProto_TYPE_INT32),
+			},
+		}},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("Examples"),
+			Method: []*descriptorpb.MethodDescriptorProto{{
+				Name:       proto.String("Call"),
+				InputType:  proto.String(".fixture.ExamplesReq"),
+				OutputType: proto.String(".fixture.ExamplesReq"),
+				Options:    methOpts,
+			}},
+		}},
+	}
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatalf("building fixture: %v", err)
+	}
+	return fd.Services().Get(0).Methods().Get(0)
+}
+
+func TestToolExamples(t *testing.T) {
+	g := NewWithT(t)
+	method := exampleFixture(t,
+		&mcpoptions.ToolExample{Intent: "List the first page", Arguments: `{ "name": "a", "pageSize": 10 }`},
+		&mcpoptions.ToolExample{Arguments: `{"page_size": 5, "zone": "eu"}`},
+	)
+
+	examples, err := ToolExamples(method, SchemaOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(examples).To(HaveLen(2))
+	g.Expect(examples[0].Intent).To(Equal("List the first page"))
+	g.Expect(string(examples[0].Arguments)).To(Equal(`{"name":"a","pageSize":10}`))
+
+	tool := ToolForMethodWithOptions(method, "Lists things.\n", SchemaOptions{})
+	g.Expect(tool.Description).To(Equal("Lists things.\n\nExamples:\n" +
+		`- List the first page: {"name":"a","pageSize":10}` + "\n" +
+		`- {"page_size":5,"zone":"eu"}`))
+
+	// Request fields move into the wrapper, extra properties stay next to it.
+	examples, err = ToolExamples(method, SchemaOptions{WrapInput: "request"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(examples[1].Arguments)).To(Equal(`{"request":{"page_size":5},"zone":"eu"}`))
+}
+
+func TestToolExamples_Errors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		arguments string
+		err       string
+	}{
+		"not json":         {`{"name":`, "must be a JSON object"},
+		"not an object":    {`["a"]`, "must be a JSON object"},
+		"unknown argument": {`{"nmae":"a"}`, `"nmae" is not an argument of the tool`},
+		"dry run disabled": {`{"dry_run":true}`, `"dry_run" is not an argument of the tool`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := ToolExamples(exampleFixture(t, &mcpoptions.ToolExample{Arguments: tc.arguments}), SchemaOptions{})
+			g.Expect(err).To(MatchError(ContainSubstring(tc.err)))
+		})
+	}
+}
+
+func TestToolExamples_Annotated(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.GetConfigRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("AnnotatedService")
+
+	tool := ToolForMethodWithOptions(sd.Methods().ByName("GetConfig"), "Gets a config.", SchemaOptions{})
+	g.Expect(tool.Description).To(HaveSuffix("\n\nExamples:\n" + `- Get the config named prod: {"name":"configs/prod"}`))
+
+	tool = ToolForMethodWithOptions(sd.Methods().ByName("ListConfigs"), "Lists configs.", SchemaOptions{})
+	g.Expect(tool.Description).To(Equal("Lists configs."))
+}
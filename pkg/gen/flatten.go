@@ -0,0 +1,128 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import "slices"
+
+// flattenNested applies SchemaOptions.FlattenNested to a tool input schema in
+// place. Every top-level property that is an object of only non-object
+// properties is replaced by one "parent.child" property per child; a child is
+// required when both it and its parent are. Deeper objects, maps, oneof
+// wrappers and lists of objects are left nested. runtime.UnflattenArguments
+// reverses it.
+func flattenNested(schema map[string]any) {
+	props, ok := schema["properties"].(map[string]any)
+	if !ok {
+		return
+	}
+	required, _ := schema["required"].([]string)
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		nested, ok := props[name].(map[string]any)
+		if !ok || !flattenable(nested) {
+			continue
+		}
+		delete(props, name)
+		children := nested["properties"].(map[string]any)
+		childRequired := requiredNames(nested)
+		var flatRequired []string
+		for child, childSchema := range children {
+			flat := name + "." + child
+			props[flat] = childSchema
+			if childRequired[child] {
+				flatRequired = append(flatRequired, flat)
+			}
+		}
+		// The children take the place of their parent in "required".
+		if i := slices.Index(required, name); i >= 0 {
+			slices.Sort(flatRequired)
+			required = slices.Replace(required, i, i+1, flatRequired...)
+		}
+	}
+	if required != nil {
+		schema["required"] = required
+	}
+}
+
+// flattenable reports whether s is a closed or plain object schema with at
+// least one property and no object-valued ones.
+func flattenable(s map[string]any) bool {
+	if s["type"] != "object" {
+		return false
+	}
+	if _, ok := s["additionalProperties"].(map[string]any); ok {
+		return false
+	}
+	if s["additionalProperties"] == true {
+		return false
+	}
+	props, ok := s["properties"].(map[string]any)
+	if !ok || len(props) == 0 {
+		return false
+	}
+	for _, p := range props {
+		child, ok := p.(map[string]any)
+		if !ok || !flatLeaf(child) {
+			return false
+		}
+	}
+	return true
+}
+
+// flatLeaf reports whether s describes a value without named properties of
+// its own: a scalar, an enum, a recursion placeholder or a list of those.
+func flatLeaf(s map[string]any) bool {
+	for _, k := range []string{"properties", "additionalProperties", "$ref", "anyOf", "oneOf", "allOf"} {
+		if _, ok := s[k]; ok {
+			return false
+		}
+	}
+	switch t := s["type"].(type) {
+	case string:
+		if t == "object" {
+			return false
+		}
+	case []string:
+		if slices.Contains(t, "object") {
+			return false
+		}
+	}
+	if items, ok := s["items"].(map[string]any); ok {
+		return flatLeaf(items)
+	}
+	return true
+}
+
+// requiredNames returns the "required" entries of s as a set.
+func requiredNames(s map[string]any) map[string]bool {
+	names := map[string]bool{}
+	switch r := s["required"].(type) {
+	case []string:
+		for _, n := range r {
+			names[n] = true
+		}
+	case []any:
+		for _, n := range r {
+			if n, ok := n.(string); ok {
+				names[n] = true
+			}
+		}
+	}
+	return names
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+// flattenFixture returns
+//
+//	message Req {
+//	  string id = 1;
+//	  Spec spec = 2 [(google.api.field_behavior) = REQUIRED];
+//	  Deep deep = 3;
+//	  map<string, string> labels = 4;
+//	}
+//	message Spec {
+//	  string name = 1 [(google.api.field_behavior) = REQUIRED];
+//	  repeated string zones = 2;
+//	}
+//	message Deep { Spec spec = 1; }
+func flattenFixture(t *testing.T) protoreflect.MessageDescriptor {
+	t.Helper()
+	required := &descriptorpb.FieldOptions{}
+	proto.SetExtension(required, annotations.E_FieldBehavior, []annotations.FieldBehavior{annotations.FieldBehavior_REQUIRED})
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+		fd := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     typ.Enum(),
+		}
+		if typeName != "" {
+			fd.TypeName = proto.String(typeName)
+		}
+		return fd
+	}
+	message := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	str := descriptorpb.FieldDescriptorProto_TYPE_STRING
+
+	spec := field("spec", 2, message, ".fixture.Spec")
+	spec.Options = required
+	name := field("name", 1, str, "")
+	name.Options = required
+	zones := field("zones", 2, str, "")
+	zones.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	labels := field("labels", 4, message, ".fixture.Req.LabelsEntry")
+	labels.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("flatten_fixture.proto"),
+		Package:    proto.String("fixture"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/api/field_behavior.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name:  proto.String("Req"),
+			Field: []*descriptorpb.FieldDescriptorProto{field("id", 1, str, ""), spec, field("deep", 3, message, ".fixture.Deep"), labels},
+			NestedType: []*descriptorpb.DescriptorProto{{
+				Name:    proto.String("LabelsEntry"),
+				Field:   []*descriptorpb.FieldDescriptorProto{field("key", 1, str, ""), field("value", 2, str, "")},
+				Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+			}},
+		}, {
+			Name:  proto.String("Spec"),
+			Field: []*descriptorpb.FieldDescriptorProto{name, zones},
+		}, {
+			Name:  proto.String("Deep"),
+			Field: []*descriptorpb.FieldDescriptorProto{field("spec", 1, message, ".fixture.Spec")},
+		}},
+	}
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatalf("building fixture: %v", err)
+	}
+	return fd.Messages().ByName("Req")
+}
+
+func TestFlattenNested(t *testing.T) {
+	g := NewWithT(t)
+	md := flattenFixture(t)
+
+	var schema struct {
+		Properties map[string]map[string]any `json:"properties"`
+		Required   []string                  `json:"required"`
+	}
+	g.Expect(json.Unmarshal(marshalInputSchema(md, SchemaOptions{FlattenNested: true}), &schema)).To(Succeed())
+	g.Expect(schema.Properties).To(HaveLen(5))
+	g.Expect(schema.Properties).To(HaveKey("id"))
+	g.Expect(schema.Properties["spec.name"]).To(Equal(map[string]any{"type": "string"}))
+	g.Expect(schema.Properties["spec.zones"]["type"]).To(Equal("array"))
+	// Objects with object-valued fields, and maps, stay nested.
+	g.Expect(schema.Properties).To(HaveKey("deep"))
+	g.Expect(schema.Properties).To(HaveKey("labels"))
+	g.Expect(schema.Required).To(Equal([]string{"spec.name"}))
+
+	// The handler side reassembles what the model sends for the flat schema.
+	msg, err := runtime.UnmarshalArguments(md, map[string]any{
+		"id":         "a",
+		"spec.name":  "n",
+		"spec.zones": []any{"z"},
+	}, runtime.CodecOptions{FlattenNested: true})
+	g.Expect(err).ToNot(HaveOccurred())
+	out, err := protojson.Marshal(msg)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(out).To(MatchJSON(`{"id":"a","spec":{"name":"n","zones":["z"]}}`))
+
+	// Without the option nothing is flattened.
+	var nested map[string]any
+	g.Expect(json.Unmarshal(marshalInputSchema(md, SchemaOptions{}), &nested)).To(Succeed())
+	g.Expect(nested["properties"]).To(HaveKey("spec"))
+}
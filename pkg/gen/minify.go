@@ -0,0 +1,68 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import "strings"
+
+// minifySchema applies SchemaOptions.Minify to node, a schema or a part of
+// one, in place. strict keeps empty "required" arrays.
+func minifySchema(node any, strict bool) {
+	switch n := node.(type) {
+	case []any:
+		for _, v := range n {
+			minifySchema(v, strict)
+		}
+	case *orderedMap:
+		for _, v := range n.vals {
+			minifySchema(v, strict)
+		}
+	case map[string]any:
+		delete(n, "$comment")
+		if desc, ok := n["description"].(string); ok {
+			n["description"] = strings.Join(strings.Fields(desc), " ")
+		}
+		if !strict {
+			switch r := n["required"].(type) {
+			case []string:
+				if len(r) == 0 {
+					delete(n, "required")
+				}
+			case []any:
+				if len(r) == 0 {
+					delete(n, "required")
+				}
+			}
+		}
+		for k, v := range n {
+			switch {
+			case dataKeywords[k]:
+			case schemaMapKeywords[k]:
+				// The keys are names, so only the values are schemas.
+				switch m := v.(type) {
+				case map[string]any:
+					for _, s := range m {
+						minifySchema(s, strict)
+					}
+				case *orderedMap:
+					for _, s := range m.vals {
+						minifySchema(s, strict)
+					}
+				}
+			default:
+				minifySchema(v, strict)
+			}
+		}
+	}
+}
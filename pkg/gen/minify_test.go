@@ -0,0 +1,72 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestMinifySchema(t *testing.T) {
+	g := NewWithT(t)
+	var schema map[string]any
+	g.Expect(json.Unmarshal([]byte(`{
+		"$comment": "generated",
+		"type": "object",
+		"description": "Lists items.\n\n  Paginated.",
+		"required": [],
+		"properties": {
+			"$comment": {"type": "string", "$comment": "a field named $comment"},
+			"description": {"type": "string", "description": "a  field\nnamed description"},
+			"items": {"type": "array", "items": {"type": "object", "required": [], "properties": {}}}
+		},
+		"anyOf": [{"$comment": "x", "required": ["a"]}],
+		"default": {"$comment": "data", "required": []}
+	}`), &schema)).To(Succeed())
+
+	minifySchema(schema, false)
+	g.Expect(json.Marshal(schema)).To(MatchJSON(`{
+		"type": "object",
+		"description": "Lists items. Paginated.",
+		"properties": {
+			"$comment": {"type": "string"},
+			"description": {"type": "string", "description": "a field named description"},
+			"items": {"type": "array", "items": {"type": "object", "properties": {}}}
+		},
+		"anyOf": [{"required": ["a"]}],
+		"default": {"$comment": "data", "required": []}
+	}`))
+
+	// OpenAI strict mode requires "required", even when empty.
+	schema = map[string]any{"type": "object", "required": []string{}, "properties": map[string]any{}}
+	minifySchema(schema, true)
+	g.Expect(schema).To(HaveKey("required"))
+}
+
+func TestToolForMethod_Minify(t *testing.T) {
+	g := NewWithT(t)
+	mappings, err := ParseMessageSchemas([]byte(`{"testdata.ApplyConfigResponse": {"$comment": "hand-written", "type": "object", "required": [], "properties": {"ok": {"type": "boolean", "description": "Whether\n  it applied."}}}}`))
+	g.Expect(err).ToNot(HaveOccurred())
+	method := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor().ParentFile().
+		Services().ByName("AnnotatedService").Methods().ByName("ApplyConfig")
+
+	plain := ToolForMethodWithOptions(method, "", SchemaOptions{MessageSchemas: mappings})
+	minified := ToolForMethodWithOptions(method, "", SchemaOptions{MessageSchemas: mappings, Minify: true})
+	g.Expect(string(minified.RawOutputSchema)).To(Equal(`{"properties":{"ok":{"description":"Whether it applied.","type":"boolean"}},"type":"object"}`))
+	g.Expect(len(minified.RawInputSchema)).To(BeNumerically("<=", len(plain.RawInputSchema)))
+}
@@ -0,0 +1,627 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/mcpoptions"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// fieldOptions returns the (mcp.field) options of fd, or nil if unset.
+func fieldOptions(fd protoreflect.FieldDescriptor) *mcpoptions.FieldOptions {
+	opts := fd.Options()
+	if opts == nil || !proto.HasExtension(opts, mcpoptions.E_Field) {
+		return nil
+	}
+	o, _ := proto.GetExtension(opts, mcpoptions.E_Field).(*mcpoptions.FieldOptions)
+	return o
+}
+
+// messageOptions returns the (mcp.message) options of md, or nil if unset.
+func messageOptions(md protoreflect.MessageDescriptor) *mcpoptions.MessageOptions {
+	opts := md.Options()
+	if opts == nil || !proto.HasExtension(opts, mcpoptions.E_Message) {
+		return nil
+	}
+	o, _ := proto.GetExtension(opts, mcpoptions.E_Message).(*mcpoptions.MessageOptions)
+	return o
+}
+
+// methodOptions returns the (mcp.method) options of md, or nil if unset.
+func methodOptions(md protoreflect.MethodDescriptor) *mcpoptions.MethodOptions {
+	opts := md.Options()
+	if opts == nil || !proto.HasExtension(opts, mcpoptions.E_Method) {
+		return nil
+	}
+	o, _ := proto.GetExtension(opts, mcpoptions.E_Method).(*mcpoptions.MethodOptions)
+	return o
+}
+
+// serviceOptions returns the (mcp.service) options of sd, or nil if unset.
+func serviceOptions(sd protoreflect.ServiceDescriptor) *mcpoptions.ServiceOptions {
+	opts := sd.Options()
+	if opts == nil || !proto.HasExtension(opts, mcpoptions.E_Service) {
+		return nil
+	}
+	o, _ := proto.GetExtension(opts, mcpoptions.E_Service).(*mcpoptions.ServiceOptions)
+	return o
+}
+
+// DeclaredExtraProperties returns the extra properties declared for method in
+// proto: those of its service, then its own, a method-level property replacing
+// a service-level one of the same name. Each is stored in the context under
+// runtime.ExtraPropertyKey(context_key).
+func DeclaredExtraProperties(method protoreflect.MethodDescriptor) ([]runtime.ExtraProperty, error) {
+	declared := append([]*mcpoptions.ExtraProperty{}, serviceOptions(method.Parent().(protoreflect.ServiceDescriptor)).GetExtraProperty()...)
+	for _, p := range methodOptions(method).GetExtraProperty() {
+		declared = slices.DeleteFunc(declared, func(q *mcpoptions.ExtraProperty) bool { return q.GetName() == p.GetName() })
+		declared = append(declared, p)
+	}
+
+	props := make([]runtime.ExtraProperty, 0, len(declared))
+	for _, p := range declared {
+		name := p.GetName()
+		if name == "" {
+			return nil, errorOn(method, fmt.Errorf("extra_property on %q: name is required", method.FullName()))
+		}
+		if method.Input().Fields().ByName(protoreflect.Name(name)) != nil || method.Input().Fields().ByJSONName(name) != nil {
+			return nil, errorOn(method, fmt.Errorf("extra_property %q on %q: collides with a field of %q", name, method.FullName(), method.Input().FullName()))
+		}
+		key := p.GetContextKey()
+		if key == "" {
+			key = name
+		}
+		prop := runtime.ExtraProperty{
+			Name:        name,
+			Description: p.GetDescription(),
+			Required:    p.GetRequired(),
+			ContextKey:  runtime.ExtraPropertyKey(key),
+			Sensitive:   p.GetSensitive(),
+		}
+		if raw := p.GetSchema(); raw != "" {
+			if _, err := parseSchemaOverride(raw); err != nil {
+				return nil, errorOn(method, fmt.Errorf("extra_property %q on %q: %w", name, method.FullName(), err))
+			}
+			prop.Schema = json.RawMessage(raw)
+		}
+		props = append(props, prop)
+	}
+	return props, nil
+}
+
+// FieldExcluded reports whether fd is left out of input schemas: it is
+// annotated with (mcp.field).exclude or its full name matches one of
+// SchemaOptions.ExcludeFields.
+func FieldExcluded(fd protoreflect.FieldDescriptor, opts SchemaOptions) bool {
+	if fieldOptions(fd).GetExclude() {
+		return true
+	}
+	for _, pattern := range opts.ExcludeFields {
+		if ok, _ := path.Match(pattern, string(fd.FullName())); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ExcludedFields returns the full names of the excluded fields, see
+// FieldExcluded, of md and the messages its fields take, in the order they
+// are found. Tool handlers reject arguments setting them.
+func ExcludedFields(md protoreflect.MessageDescriptor, opts SchemaOptions) []string {
+	var excluded []string
+	seen := map[protoreflect.FullName]bool{}
+	var visit func(md protoreflect.MessageDescriptor)
+	visit = func(md protoreflect.MessageDescriptor) {
+		if seen[md.FullName()] || strings.HasPrefix(string(md.FullName()), "google.protobuf.") {
+			return
+		}
+		seen[md.FullName()] = true
+		for i := 0; i < md.Fields().Len(); i++ {
+			fd := md.Fields().Get(i)
+			if FieldExcluded(fd, opts) {
+				excluded = append(excluded, string(fd.FullName()))
+				continue
+			}
+			if fd.IsMap() {
+				fd = fd.MapValue()
+			}
+			if fd.Message() != nil {
+				visit(fd.Message())
+			}
+		}
+	}
+	visit(md)
+	return excluded
+}
+
+// DeclaredTags returns the tags declared for method in proto: those of its
+// service, then its own, without empty or repeated ones.
+func DeclaredTags(method protoreflect.MethodDescriptor) []string {
+	var tags []string
+	declared := append(slices.Clone(serviceOptions(method.Parent().(protoreflect.ServiceDescriptor)).GetTag()), methodOptions(method).GetTag()...)
+	for _, tag := range declared {
+		if tag != "" && !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// fieldTitle returns the "title" of fd: its (mcp.field).title, else a title
+// derived from the field name if SchemaOptions.Titles is set.
+func fieldTitle(fd protoreflect.FieldDescriptor, opts SchemaOptions) string {
+	if title := fieldOptions(fd).GetTitle(); title != "" {
+		return title
+	}
+	if !opts.Titles {
+		return ""
+	}
+	return TitleFromSnakeCase(string(fd.Name()))
+}
+
+// toolTitle returns the display title of the tool for md: its
+// (mcp.method).title, else a title derived from the method name if
+// SchemaOptions.Titles is set.
+func toolTitle(md protoreflect.MethodDescriptor, opts SchemaOptions) string {
+	if title := methodOptions(md).GetTitle(); title != "" {
+		return title
+	}
+	if !opts.Titles {
+		return ""
+	}
+	return TitleFromCamelCase(string(md.Name()))
+}
+
+// TitleFromSnakeCase turns a snake_case identifier into Title Case words:
+// "resource_group_id" becomes "Resource Group Id".
+func TitleFromSnakeCase(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool { return r == '_' })
+	for i, w := range words {
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+// TitleFromCamelCase splits a CamelCase identifier into words, keeping
+// acronyms together: "GetHTTPConfig" becomes "Get HTTP Config".
+func TitleFromCamelCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prevLower := !unicode.IsUpper(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || nextLower {
+				b.WriteByte(' ')
+			}
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// DeprecatedFields selects how fields marked [deprecated = true] appear in
+// generated schemas.
+type DeprecatedFields string
+
+const (
+	// DeprecatedFieldsKeep renders deprecated fields like any other field.
+	DeprecatedFieldsKeep DeprecatedFields = ""
+	// DeprecatedFieldsAnnotate sets "deprecated": true and prefixes the
+	// description with a note steering the model toward the replacement.
+	DeprecatedFieldsAnnotate DeprecatedFields = "annotate"
+	// DeprecatedFieldsOmit leaves deprecated fields out of the schema.
+	DeprecatedFieldsOmit DeprecatedFields = "omit"
+)
+
+// ParseDeprecatedFields parses the deprecated_fields plugin option.
+func ParseDeprecatedFields(s string) (DeprecatedFields, error) {
+	switch d := DeprecatedFields(s); d {
+	case DeprecatedFieldsKeep, DeprecatedFieldsAnnotate, DeprecatedFieldsOmit:
+		return d, nil
+	case "keep":
+		return DeprecatedFieldsKeep, nil
+	default:
+		return "", fmt.Errorf("unsupported deprecated_fields %q; want keep, annotate or omit", s)
+	}
+}
+
+// fieldDeprecated reports whether fd is marked [deprecated = true].
+func fieldDeprecated(fd protoreflect.FieldDescriptor) bool {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	return ok && opts.GetDeprecated()
+}
+
+// MethodDeprecated reports whether md is marked option deprecated = true.
+func MethodDeprecated(md protoreflect.MethodDescriptor) bool {
+	opts, ok := md.Options().(*descriptorpb.MethodOptions)
+	return ok && opts.GetDeprecated()
+}
+
+// DryRunSupported reports whether the tool for method gets the "dry_run"
+// argument: SchemaOptions.DryRun is set, the method is not marked
+// idempotency_level = NO_SIDE_EFFECTS, and its request has no field of that
+// name. A dry run sets the request's validate_only field if it has one, and
+// otherwise returns the decoded request without calling the method.
+func DryRunSupported(method protoreflect.MethodDescriptor, opts SchemaOptions) bool {
+	if !opts.DryRun {
+		return false
+	}
+	if !MethodHasSideEffects(method) {
+		return false
+	}
+	return method.Input().Fields().ByName(runtime.DryRunProperty) == nil
+}
+
+// MethodHasSideEffects reports whether method may change state, i.e. it is
+// not marked idempotency_level = NO_SIDE_EFFECTS.
+func MethodHasSideEffects(method protoreflect.MethodDescriptor) bool {
+	mo, ok := method.Options().(*descriptorpb.MethodOptions)
+	return !ok || mo.GetIdempotencyLevel() != descriptorpb.MethodOptions_NO_SIDE_EFFECTS
+}
+
+// deprecationNote is the description prefix of an annotated deprecated field.
+func deprecationNote(fd protoreflect.FieldDescriptor) string {
+	if note := fieldOptions(fd).GetDeprecationNote(); note != "" {
+		return "Deprecated: " + note
+	}
+	return "Deprecated: avoid setting this field."
+}
+
+// fieldDefault returns the (mcp.field).default of fd, or nil if unset. It
+// panics on a malformed default; generation rejects those up front via
+// CheckSchemaOverrides.
+func fieldDefault(fd protoreflect.FieldDescriptor) any {
+	v, err := runtime.FieldDefault(fd)
+	if err != nil {
+		panic(fmt.Sprintf("protoc-gen-go-mcp: (mcp.field).default on %q: %v", fd.FullName(), err))
+	}
+	return v
+}
+
+// checkFieldDefault verifies that the default of fd parses and that protojson
+// accepts it for the field, so a bad default fails generation instead of
+// every tool call that omits the field.
+func checkFieldDefault(md protoreflect.MessageDescriptor, fd protoreflect.FieldDescriptor) error {
+	v, err := runtime.FieldDefault(fd)
+	if err != nil || v == nil {
+		return err
+	}
+	if oo := fd.ContainingOneof(); oo != nil && !oo.IsSynthetic() {
+		return fmt.Errorf("not supported on members of oneof %q", oo.Name())
+	}
+	raw, err := json.Marshal(map[string]any{string(fd.Name()): v})
+	if err != nil {
+		return err
+	}
+	if err := protojson.Unmarshal(raw, dynamicpb.NewMessage(md)); err != nil {
+		return fmt.Errorf("not a valid value for the field: %w", err)
+	}
+	return nil
+}
+
+// checkFieldMax validates the (mcp.field).max of fd: it must be positive, sit
+// on a singular integer field, and not be below the field's default.
+func checkFieldMax(fd protoreflect.FieldDescriptor) error {
+	max := fieldOptions(fd).GetMax()
+	if max == 0 {
+		return nil
+	}
+	if max < 0 {
+		return fmt.Errorf("must be positive, got %d", max)
+	}
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+	default:
+		return fmt.Errorf("only supported on integer fields, not %s", fd.Kind())
+	}
+	if fd.IsList() {
+		return fmt.Errorf("not supported on repeated fields")
+	}
+	if raw := fieldOptions(fd).GetDefault(); raw != "" {
+		if def, err := strconv.ParseInt(raw, 10, 64); err == nil && def > max {
+			return fmt.Errorf("default %d exceeds max %d", def, max)
+		}
+	}
+	return nil
+}
+
+// checkFieldJSONString validates the (mcp.field).json_string of fd: it must
+// sit on a message field, or a map of messages, that is not a well-known type
+// and has no schema override.
+func checkFieldJSONString(fd protoreflect.FieldDescriptor) error {
+	if !fieldOptions(fd).GetJsonString() {
+		return nil
+	}
+	if fieldOptions(fd).GetSchema() != "" {
+		return fmt.Errorf("cannot be combined with (mcp.field).schema")
+	}
+	value := fd
+	if fd.IsMap() {
+		value = fd.MapValue()
+	}
+	if value.Message() == nil {
+		return fmt.Errorf("only supported on message fields, not %s", value.Kind())
+	}
+	if value.Message().ParentFile().Package() == "google.protobuf" {
+		return fmt.Errorf("not supported on well-known type %s", value.Message().FullName())
+	}
+	return nil
+}
+
+// checkFieldRelativeTime validates the (mcp.field).relative_time of fd: it
+// must sit on a singular or repeated Timestamp or Duration field.
+func checkFieldRelativeTime(fd protoreflect.FieldDescriptor) error {
+	if !fieldOptions(fd).GetRelativeTime() {
+		return nil
+	}
+	if fd.IsMap() {
+		return fmt.Errorf("not supported on map fields")
+	}
+	if fd.Message() != nil {
+		switch fd.Message().FullName() {
+		case "google.protobuf.Timestamp", "google.protobuf.Duration":
+			return nil
+		}
+	}
+	return fmt.Errorf("only supported on google.protobuf.Timestamp and Duration fields")
+}
+
+// checkFieldFile validates the (mcp.field).file of fd: it must sit on a
+// singular bytes or string field.
+func checkFieldFile(fd protoreflect.FieldDescriptor) error {
+	if !fieldOptions(fd).GetFile() {
+		return nil
+	}
+	if fd.Cardinality() == protoreflect.Repeated {
+		return fmt.Errorf("not supported on repeated or map fields")
+	}
+	if fd.Kind() != protoreflect.BytesKind && fd.Kind() != protoreflect.StringKind {
+		return fmt.Errorf("only supported on bytes and string fields, not %s", fd.Kind())
+	}
+	return nil
+}
+
+// ReturnsFiles reports whether responses of type md carry files that tool
+// results return as embedded resources, see runtime.NewToolResultFiles: md
+// is a google.api.HttpBody, or has a top-level field marked
+// (mcp.field).file or of that type.
+func ReturnsFiles(md protoreflect.MessageDescriptor) bool {
+	if md.FullName() == "google.api.HttpBody" {
+		return true
+	}
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if fd.Cardinality() == protoreflect.Repeated {
+			continue
+		}
+		if fieldOptions(fd).GetFile() || (fd.Message() != nil && fd.Message().FullName() == "google.api.HttpBody") {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSchemaOverride parses a literal schema fragment from an (mcp.field) or
+// (mcp.message) "schema" option. The fragment must be a JSON object.
+func parseSchemaOverride(raw string) (map[string]any, error) {
+	var frag map[string]any
+	if err := json.Unmarshal([]byte(raw), &frag); err != nil {
+		return nil, fmt.Errorf("schema override is not a JSON object: %w", err)
+	}
+	if frag == nil {
+		return nil, fmt.Errorf("schema override is not a JSON object: got null")
+	}
+	return frag, nil
+}
+
+// fieldSchemaOverride returns the parsed (mcp.field).schema of fd, if set.
+// CheckSchemaOverrides reports malformed fragments up front; reaching one here
+// panics, the same way a discriminator collision does.
+func fieldSchemaOverride(fd protoreflect.FieldDescriptor) (map[string]any, bool) {
+	raw := fieldOptions(fd).GetSchema()
+	if raw == "" {
+		return nil, false
+	}
+	frag, err := parseSchemaOverride(raw)
+	if err != nil {
+		panic(fmt.Sprintf("protoc-gen-go-mcp: (mcp.field).schema on %q: %v", fd.FullName(), err))
+	}
+	return frag, true
+}
+
+// messageSchemaOverride returns the schema that replaces md: the
+// SchemaOptions.MessageSchemas entry if there is one, else its
+// (mcp.message).schema.
+func messageSchemaOverride(md protoreflect.MessageDescriptor, opts SchemaOptions) (map[string]any, bool) {
+	if override, ok := registeredMessageSchema(md, opts); ok {
+		return override, true
+	}
+	raw := messageOptions(md).GetSchema()
+	if raw == "" {
+		return nil, false
+	}
+	frag, err := parseSchemaOverride(raw)
+	if err != nil {
+		panic(fmt.Sprintf("protoc-gen-go-mcp: (mcp.message).schema on %q: %v", md.FullName(), err))
+	}
+	return frag, true
+}
+
+// registeredMessageSchema returns a fresh copy of the SchemaOptions.MessageSchemas
+// entry for md, if any. Callers may mutate the result.
+func registeredMessageSchema(md protoreflect.MessageDescriptor, opts SchemaOptions) (map[string]any, bool) {
+	raw, ok := opts.MessageSchemas[md.FullName()]
+	if !ok {
+		return nil, false
+	}
+	frag, err := parseSchemaOverride(string(raw))
+	if err != nil {
+		panic(fmt.Sprintf("protoc-gen-go-mcp: MessageSchemas[%q]: %v", md.FullName(), err))
+	}
+	return frag, true
+}
+
+// ParseMessageSchemas parses a schema mapping document: a JSON object keyed by
+// fully-qualified message name whose values are JSON Schema objects, e.g.
+//
+//	{"company.common.Resource": {"type": "string", "description": "A resource name."}}
+//
+// The result is meant for SchemaOptions.MessageSchemas.
+func ParseMessageSchemas(data []byte) (map[protoreflect.FullName]json.RawMessage, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("schema mappings must be a JSON object keyed by message name: %w", err)
+	}
+	out := make(map[protoreflect.FullName]json.RawMessage, len(raw))
+	for name, frag := range raw {
+		fullName := protoreflect.FullName(strings.TrimPrefix(name, "."))
+		if !fullName.IsValid() {
+			return nil, fmt.Errorf("schema mappings: %q is not a valid message full name", name)
+		}
+		if _, err := parseSchemaOverride(string(frag)); err != nil {
+			return nil, fmt.Errorf("schema mappings: %q: %w", name, err)
+		}
+		out[fullName] = frag
+	}
+	return out, nil
+}
+
+// applyExamples sets the "examples" keyword of schema from (mcp.field).example
+// values. Text is kept verbatim when the schema renders as a JSON string and
+// parsed as a JSON literal otherwise, falling back to the text if it does not
+// parse.
+func applyExamples(schema map[string]any, examples []string, opts SchemaOptions) {
+	if len(examples) == 0 {
+		return
+	}
+	asString := schemaAllowsType(schema, "string")
+	values := make([]any, 0, len(examples))
+	for _, ex := range examples {
+		var v any
+		if asString || json.Unmarshal([]byte(ex), &v) != nil {
+			v = ex
+		}
+		values = append(values, v)
+	}
+	schema["examples"] = values
+
+	if opts.ExamplesInDescription {
+		quoted := make([]string, len(examples))
+		for i, ex := range examples {
+			quoted[i] = "`" + ex + "`"
+		}
+		note := "Examples: " + strings.Join(quoted, ", ") + "."
+		if desc, _ := schema["description"].(string); desc != "" {
+			note = desc + " " + note
+		}
+		schema["description"] = note
+	}
+}
+
+// schemaAllowsType reports whether schema's "type" is, or includes, typ.
+func schemaAllowsType(schema map[string]any, typ string) bool {
+	switch t := schema["type"].(type) {
+	case string:
+		return t == typ
+	case []string:
+		for _, s := range t {
+			if s == typ {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CheckSchemaOverrides validates every (mcp.field).schema and
+// (mcp.message).schema fragment, (mcp.field).default, (mcp.field).max,
+// (mcp.field).from_context, (mcp.field).json_string,
+// (mcp.field).relative_time and (mcp.field).file reachable from md, so the plugin can report a
+// malformed annotation as a normal error instead of panicking mid-generation.
+func CheckSchemaOverrides(md protoreflect.MessageDescriptor) error {
+	return checkSchemaOverrides(md, map[protoreflect.FullName]bool{})
+}
+
+func checkSchemaOverrides(md protoreflect.MessageDescriptor, visited map[protoreflect.FullName]bool) error {
+	if visited[md.FullName()] {
+		return nil
+	}
+	visited[md.FullName()] = true
+
+	if raw := messageOptions(md).GetSchema(); raw != "" {
+		if _, err := parseSchemaOverride(raw); err != nil {
+			return errorOn(md, fmt.Errorf("(mcp.message).schema on %q: %w", md.FullName(), err))
+		}
+		// The fragment replaces the whole message, so its fields never render.
+		return nil
+	}
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if err := checkFieldDefault(md, fd); err != nil {
+			return errorOn(fd, fmt.Errorf("(mcp.field).default on %q: %w", fd.FullName(), err))
+		}
+		if err := checkFieldMax(fd); err != nil {
+			return errorOn(fd, fmt.Errorf("(mcp.field).max on %q: %w", fd.FullName(), err))
+		}
+		if oo := fd.ContainingOneof(); oo != nil && !oo.IsSynthetic() && fieldOptions(fd).GetFromContext() != "" {
+			return errorOn(fd, fmt.Errorf("(mcp.field).from_context on %q: not supported on members of oneof %q", fd.FullName(), oo.Name()))
+		}
+		if err := checkFieldJSONString(fd); err != nil {
+			return errorOn(fd, fmt.Errorf("(mcp.field).json_string on %q: %w", fd.FullName(), err))
+		}
+		if err := checkFieldRelativeTime(fd); err != nil {
+			return errorOn(fd, fmt.Errorf("(mcp.field).relative_time on %q: %w", fd.FullName(), err))
+		}
+		if err := checkFieldFile(fd); err != nil {
+			return errorOn(fd, fmt.Errorf("(mcp.field).file on %q: %w", fd.FullName(), err))
+		}
+		if raw := fieldOptions(fd).GetSchema(); raw != "" {
+			if _, err := parseSchemaOverride(raw); err != nil {
+				return errorOn(fd, fmt.Errorf("(mcp.field).schema on %q: %w", fd.FullName(), err))
+			}
+			continue
+		}
+		if fieldOptions(fd).GetJsonString() {
+			// The message renders as a string, so its fields never do.
+			continue
+		}
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		if fd.Message() != nil {
+			if err := checkSchemaOverrides(fd.Message(), visited); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,846 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/mcpoptions"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// schemaJSON round-trips a schema through JSON so assertions see plain maps.
+func schemaJSON(g Gomega, schema map[string]any) map[string]any {
+	b, err := json.Marshal(schema)
+	g.Expect(err).ToNot(HaveOccurred())
+	var out map[string]any
+	g.Expect(json.Unmarshal(b, &out)).To(Succeed())
+	return out
+}
+
+func TestSchemaOverride_Field(t *testing.T) {
+	g := NewWithT(t)
+	md := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor()
+	props := schemaJSON(g, MessageSchema(md, SchemaOptions{}))["properties"].(map[string]any)
+
+	g.Expect(props["pipeline_yaml"]).To(Equal(map[string]any{
+		"type":             "string",
+		"contentMediaType": "application/yaml",
+		"description":      "A pipeline config as YAML with top-level input, pipeline and output keys.",
+	}))
+	// Un-annotated fields keep the generated schema.
+	g.Expect(props["name"]).To(HaveKeyWithValue("type", "string"))
+}
+
+func TestSchemaOverride_RepeatedFieldReplacesArray(t *testing.T) {
+	g := NewWithT(t)
+	fd := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor().Fields().ByName("labels")
+	schema := schemaJSON(g, FieldSchema(fd, SchemaOptions{}))
+
+	g.Expect(schema["type"]).To(Equal("array"))
+	g.Expect(schema["maxItems"]).To(BeEquivalentTo(8))
+	g.Expect(schema["items"]).To(HaveKeyWithValue("pattern", "^[a-z]+=[a-z]+$"))
+}
+
+func TestSchemaOverride_Message(t *testing.T) {
+	g := NewWithT(t)
+	md := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor()
+	props := schemaJSON(g, MessageSchema(md, SchemaOptions{}))["properties"].(map[string]any)
+
+	threshold := props["threshold"].(map[string]any)
+	g.Expect(threshold["required"]).To(Equal([]any{"value"}))
+	g.Expect(threshold["properties"]).To(HaveKeyWithValue("value", map[string]any{
+		"type": "number", "minimum": 0.0, "maximum": 1.0,
+	}))
+}
+
+func TestSchemaOverride_ToolSchemaStillValid(t *testing.T) {
+	g := NewWithT(t)
+	md := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor()
+	method := md.ParentFile().Services().ByName("AnnotatedService").Methods().ByName("ApplyConfig")
+	tool := ToolForMethod(method, "")
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+	g.Expect(schema["type"]).To(Equal("object"))
+	g.Expect(CheckSchemaOverrides(md)).To(Succeed())
+}
+
+// overrideFixture builds a message whose field carries the given
+// (mcp.field).schema, and a parent that references it.
+func overrideFixture(t *testing.T, fragment string) protoreflect.MessageDescriptor {
+	t.Helper()
+	fieldOpts := &descriptorpb.FieldOptions{}
+	proto.SetExtension(fieldOpts, mcpoptions.E_Field, &mcpoptions.FieldOptions{Schema: fragment})
+
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("override_fixture.proto"),
+		Package: proto.String("fixture"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Parent"),
+				Field: []*descriptorpb.FieldDescriptorProto{{
+					Name:     proto.String("child"),
+					JsonName: proto.String("child"),
+					Number:   proto.Int32(1),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+					TypeName: proto.String(".fixture.Child"),
+				}},
+			},
+			{
+				Name: proto.String("Child"),
+				Field: []*descriptorpb.FieldDescriptorProto{{
+					Name:     proto.String("doc"),
+					JsonName: proto.String("doc"),
+					Number:   proto.Int32(1),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+					Options:  fieldOpts,
+				}},
+			},
+		},
+	}
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatalf("building fixture: %v", err)
+	}
+	return fd.Messages().ByName("Parent")
+}
+
+func TestCheckSchemaOverrides_Malformed(t *testing.T) {
+	for name, fragment := range map[string]string{
+		"invalid JSON": `{"type": "string"`,
+		"not object":   `["string"]`,
+		"null":         `null`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+			md := overrideFixture(t, fragment)
+
+			err := CheckSchemaOverrides(md)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(ContainSubstring(`(mcp.field).schema on "fixture.Child.doc"`))
+			// Schema generation fails loudly rather than emitting a bad schema.
+			g.Expect(func() { MessageSchema(md, SchemaOptions{}) }).To(Panic())
+		})
+	}
+}
+
+func TestMessageSchemas_ReplacesMessageEverywhere(t *testing.T) {
+	g := NewWithT(t)
+	mappings, err := ParseMessageSchemas([]byte(`{
+		"testdata.Threshold": {"type": "string", "description": "A ratio like 0.5."},
+		".google.protobuf.Timestamp": {"type": "integer", "description": "Unix seconds."}
+	}`))
+	g.Expect(err).ToNot(HaveOccurred())
+	opts := SchemaOptions{MessageSchemas: mappings}
+
+	// The registry wins over the message's own (mcp.message).schema.
+	md := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor()
+	props := schemaJSON(g, MessageSchema(md, opts))["properties"].(map[string]any)
+	g.Expect(props["threshold"]).To(Equal(map[string]any{"type": "string", "description": "A ratio like 0.5."}))
+
+	// Well-known types can be remapped too.
+	wkt := (&testdata.ProcessWellKnownTypesRequest{}).ProtoReflect().Descriptor()
+	props = schemaJSON(g, MessageSchema(wkt, opts))["properties"].(map[string]any)
+	g.Expect(props["timestamp"]).To(Equal(map[string]any{"type": "integer", "description": "Unix seconds."}))
+}
+
+func TestMessageSchemas_ToolForMethodWithOptions(t *testing.T) {
+	g := NewWithT(t)
+	mappings, err := ParseMessageSchemas([]byte(`{"testdata.ApplyConfigResponse": {"type": "object", "properties": {"ok": {"type": "boolean"}}}}`))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	md := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor()
+	method := md.ParentFile().Services().ByName("AnnotatedService").Methods().ByName("ApplyConfig")
+	tool := ToolForMethodWithOptions(method, "", SchemaOptions{MessageSchemas: mappings})
+
+	g.Expect(string(tool.RawOutputSchema)).To(MatchJSON(`{"type":"object","properties":{"ok":{"type":"boolean"}}}`))
+	// Each use gets its own copy; forcing the top-level type must not leak.
+	g.Expect(string(mappings["testdata.ApplyConfigResponse"])).To(ContainSubstring(`"ok"`))
+}
+
+func TestParseMessageSchemas_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{"not an object", `[]`, "must be a JSON object keyed by message name"},
+		{"bad name", `{"not a name": {}}`, `"not a name" is not a valid message full name`},
+		{"fragment not object", `{"a.B": "string"}`, `"a.B": schema override is not a JSON object`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := ParseMessageSchemas([]byte(tt.doc))
+			g.Expect(err).To(MatchError(ContainSubstring(tt.want)))
+		})
+	}
+}
+
+func TestExamples(t *testing.T) {
+	g := NewWithT(t)
+	md := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor()
+	props := schemaJSON(g, MessageSchema(md, SchemaOptions{}))["properties"].(map[string]any)
+
+	// Duration renders as a string, so examples stay verbatim.
+	g.Expect(props["timeout"]).To(HaveKeyWithValue("examples", []any{"30s", "5m"}))
+	// Integers are parsed as JSON literals.
+	g.Expect(props["replicas"]).To(HaveKeyWithValue("examples", []any{3.0}))
+	g.Expect(props["replicas"]).ToNot(HaveKey("description"))
+}
+
+func TestExamples_InDescription(t *testing.T) {
+	g := NewWithT(t)
+	md := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor()
+	props := schemaJSON(g, MessageSchema(md, SchemaOptions{ExamplesInDescription: true}))["properties"].(map[string]any)
+
+	g.Expect(props["timeout"]).To(HaveKeyWithValue("description", "Examples: `30s`, `5m`."))
+	g.Expect(props["timeout"]).To(HaveKeyWithValue("examples", []any{"30s", "5m"}))
+}
+
+func TestApplyExamples(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   map[string]any
+		examples []string
+		want     []any
+	}{
+		{"string stays verbatim", map[string]any{"type": "string"}, []string{"true", "{}"}, []any{"true", "{}"}},
+		{"nullable string", map[string]any{"type": []string{"string", "null"}}, []string{"42"}, []any{"42"}},
+		{"boolean parsed", map[string]any{"type": "boolean"}, []string{"true"}, []any{true}},
+		{"object parsed", map[string]any{"type": "object"}, []string{`{"a":1}`}, []any{map[string]any{"a": 1.0}}},
+		{"unparseable falls back to text", map[string]any{"type": "number"}, []string{"about 3"}, []any{"about 3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			applyExamples(tt.schema, tt.examples, SchemaOptions{})
+			g.Expect(tt.schema["examples"]).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestTitles_AnnotatedOnly(t *testing.T) {
+	g := NewWithT(t)
+	md := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor()
+	props := schemaJSON(g, MessageSchema(md, SchemaOptions{}))["properties"].(map[string]any)
+
+	g.Expect(props["name"]).To(HaveKeyWithValue("title", "Pipeline name"))
+	g.Expect(props["replicas"]).ToNot(HaveKey("title"))
+
+	method := md.ParentFile().Services().ByName("AnnotatedService").Methods().ByName("ApplyConfig")
+	g.Expect(ToolForMethod(method, "").Title).To(Equal("Apply pipeline config"))
+
+	other := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor().ParentFile().
+		Services().ByName("TestService").Methods().ByName("CreateItem")
+	g.Expect(ToolForMethod(other, "").Title).To(BeEmpty())
+}
+
+func TestTitles_Derived(t *testing.T) {
+	g := NewWithT(t)
+	opts := SchemaOptions{Titles: true}
+
+	md := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor()
+	props := schemaJSON(g, MessageSchema(md, opts))["properties"].(map[string]any)
+	g.Expect(props["name"]).To(HaveKeyWithValue("title", "Name"))
+	// Repeated fields carry the title on the array, not the items.
+	g.Expect(props["tags"]).To(HaveKeyWithValue("title", "Tags"))
+	g.Expect(props["tags"].(map[string]any)["items"]).ToNot(HaveKey("title"))
+	// Oneof wrappers are titled after the oneof.
+	g.Expect(props["item_type"]).To(HaveKeyWithValue("title", "Item Type"))
+
+	method := md.ParentFile().Services().ByName("TestService").Methods().ByName("CreateItem")
+	g.Expect(ToolForMethodWithOptions(method, "", opts).Title).To(Equal("Create Item"))
+
+	// Annotations still win over derived titles.
+	annotated := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor()
+	props = schemaJSON(g, MessageSchema(annotated, opts))["properties"].(map[string]any)
+	g.Expect(props["name"]).To(HaveKeyWithValue("title", "Pipeline name"))
+	g.Expect(props["pipeline_yaml"]).ToNot(HaveKey("title"), "schema overrides are used verbatim")
+}
+
+func TestTitleFromName(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(TitleFromSnakeCase("resource_group_id")).To(Equal("Resource Group Id"))
+	g.Expect(TitleFromSnakeCase("_leading__double_")).To(Equal("Leading Double"))
+	g.Expect(TitleFromCamelCase("CreateItem")).To(Equal("Create Item"))
+	g.Expect(TitleFromCamelCase("GetHTTPConfig")).To(Equal("Get HTTP Config"))
+	g.Expect(TitleFromCamelCase("ListV2Items")).To(Equal("List V2 Items"))
+	g.Expect(TitleFromCamelCase("GetID")).To(Equal("Get ID"))
+}
+
+func TestDeprecatedFields(t *testing.T) {
+	md := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor()
+
+	t.Run("keep", func(t *testing.T) {
+		g := NewWithT(t)
+		props := schemaJSON(g, MessageSchema(md, SchemaOptions{}))["properties"].(map[string]any)
+		g.Expect(props["legacy_name"]).To(Equal(map[string]any{"type": "string"}))
+	})
+
+	t.Run("annotate", func(t *testing.T) {
+		g := NewWithT(t)
+		props := schemaJSON(g, MessageSchema(md, SchemaOptions{DeprecatedFields: DeprecatedFieldsAnnotate}))["properties"].(map[string]any)
+		g.Expect(props["legacy_name"]).To(Equal(map[string]any{
+			"type":        "string",
+			"deprecated":  true,
+			"description": "Deprecated: Use name instead.",
+		}))
+		g.Expect(props["old_owner"]).To(HaveKeyWithValue("description", "Deprecated: avoid setting this field."))
+		g.Expect(props["name"]).ToNot(HaveKey("deprecated"))
+	})
+
+	t.Run("omit", func(t *testing.T) {
+		g := NewWithT(t)
+		props := schemaJSON(g, MessageSchema(md, SchemaOptions{DeprecatedFields: DeprecatedFieldsOmit}))["properties"].(map[string]any)
+		g.Expect(props).ToNot(HaveKey("legacy_name"))
+		g.Expect(props).ToNot(HaveKey("old_owner"))
+		g.Expect(props).To(HaveKey("name"))
+	})
+}
+
+func TestExcludeFields(t *testing.T) {
+	apply := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor()
+	deep := (&testdata.DeepNestingRequest{}).ProtoReflect().Descriptor()
+
+	t.Run("annotated field", func(t *testing.T) {
+		g := NewWithT(t)
+		props := schemaJSON(g, MessageSchema(apply, SchemaOptions{}))["properties"].(map[string]any)
+		g.Expect(props).ToNot(HaveKey("internal_trace_id"))
+		g.Expect(props).To(HaveKey("name"))
+		g.Expect(ExcludedFields(apply, SchemaOptions{})).To(Equal([]string{"testdata.ApplyConfigRequest.internal_trace_id"}))
+	})
+
+	t.Run("pattern", func(t *testing.T) {
+		g := NewWithT(t)
+		opts := SchemaOptions{ExcludeFields: []string{"*.InnerMessage.id"}}
+		inner := schemaJSON(g, MessageSchema(deep, opts))["properties"].(map[string]any)["middle"].(map[string]any)["properties"].(map[string]any)["inner"].(map[string]any)
+		g.Expect(inner["properties"]).ToNot(HaveKey("id"))
+		g.Expect(inner["properties"]).To(HaveKey("tags"))
+		g.Expect(ExcludedFields(deep, opts)).To(Equal([]string{"testdata.InnerMessage.id"}))
+		g.Expect(ExcludedFields(deep, SchemaOptions{})).To(BeEmpty())
+	})
+
+	t.Run("output schemas keep excluded fields", func(t *testing.T) {
+		g := NewWithT(t)
+		props := schemaJSON(g, MessageSchema(apply, outputOptions(SchemaOptions{})))["properties"].(map[string]any)
+		g.Expect(props).To(HaveKey("internal_trace_id"))
+	})
+}
+
+func TestExcludeFromResult(t *testing.T) {
+	g := NewWithT(t)
+	md := (&testdata.ListConfigsResponse{}).ProtoReflect().Descriptor()
+	output := schemaJSON(g, MessageSchema(md, outputOptions(SchemaOptions{})))
+	config := output["properties"].(map[string]any)["configs"].(map[string]any)["items"].(map[string]any)
+	g.Expect(config["properties"]).ToNot(HaveKey("internal_id"))
+	g.Expect(config["properties"]).To(HaveKey("name"))
+
+	input := schemaJSON(g, MessageSchema(md, SchemaOptions{}))
+	config = input["properties"].(map[string]any)["configs"].(map[string]any)["items"].(map[string]any)
+	g.Expect(config["properties"]).To(HaveKey("internal_id"))
+}
+
+func TestMethodDeprecated(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("AnnotatedService")
+	g.Expect(MethodDeprecated(sd.Methods().ByName("LegacyApply"))).To(BeTrue())
+	g.Expect(MethodDeprecated(sd.Methods().ByName("ApplyConfig"))).To(BeFalse())
+
+	_, err := ParseDeprecatedFields("hide")
+	g.Expect(err).To(MatchError(ContainSubstring(`unsupported deprecated_fields "hide"`)))
+}
+
+// defaultFixture builds a message "fixture.Defaults" with a single field
+// "value" of type typ carrying (mcp.field).default = def, optionally marked
+// (google.api.field_behavior) = REQUIRED.
+func defaultFixture(t *testing.T, typ descriptorpb.FieldDescriptorProto_Type, def string, required bool) protoreflect.MessageDescriptor {
+	t.Helper()
+	fieldOpts := &descriptorpb.FieldOptions{}
+	proto.SetExtension(fieldOpts, mcpoptions.E_Field, &mcpoptions.FieldOptions{Default: def})
+	if required {
+		proto.SetExtension(fieldOpts, annotations.E_FieldBehavior, []annotations.FieldBehavior{annotations.FieldBehavior_REQUIRED})
+	}
+	return singleFieldFixture(t, typ, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, fieldOpts)
+}
+
+// singleFieldFixture builds a message "fixture.Defaults" with a single field
+// "value" of the given type and label, carrying fieldOpts.
+func singleFieldFixture(t *testing.T, typ descriptorpb.FieldDescriptorProto_Type, label descriptorpb.FieldDescriptorProto_Label, fieldOpts *descriptorpb.FieldOptions) protoreflect.MessageDescriptor {
+	t.Helper()
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("default_fixture_" + typ.String() + ".proto"),
+		Package: proto.String("fixture"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Defaults"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("value"),
+				JsonName: proto.String("value"),
+				Number:   proto.Int32(1),
+				Label:    label.Enum(),
+				Type:     typ.Enum(),
+				Options:  fieldOpts,
+			}},
+		}},
+	}
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatalf("building fixture: %v", err)
+	}
+	return fd.Messages().ByName("Defaults")
+}
+
+func TestFieldDefault_Schema(t *testing.T) {
+	g := NewWithT(t)
+	md := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor()
+	props := schemaJSON(g, MessageSchema(md, SchemaOptions{}))["properties"].(map[string]any)
+
+	g.Expect(props["log_level"]).To(HaveKeyWithValue("default", "info"))
+	g.Expect(props["max_retries"]).To(HaveKeyWithValue("default", float64(5)))
+	g.Expect(props["name"]).ToNot(HaveKey("default"))
+}
+
+func TestFieldDefault_NotRequired(t *testing.T) {
+	g := NewWithT(t)
+
+	md := defaultFixture(t, descriptorpb.FieldDescriptorProto_TYPE_STRING, "", true)
+	g.Expect(MessageSchema(md, SchemaOptions{})["required"]).To(Equal([]string{"value"}))
+
+	md = defaultFixture(t, descriptorpb.FieldDescriptorProto_TYPE_BOOL, "true", true)
+	g.Expect(MessageSchema(md, SchemaOptions{})["required"]).To(BeEmpty())
+}
+
+func TestCheckSchemaOverrides_InvalidDefault(t *testing.T) {
+	for name, tc := range map[string]struct {
+		typ descriptorpb.FieldDescriptorProto_Type
+		def string
+	}{
+		"not JSON":     {descriptorpb.FieldDescriptorProto_TYPE_INT32, "five"},
+		"wrong type":   {descriptorpb.FieldDescriptorProto_TYPE_BOOL, "1"},
+		"out of range": {descriptorpb.FieldDescriptorProto_TYPE_INT32, "1e12"},
+		"null":         {descriptorpb.FieldDescriptorProto_TYPE_BOOL, "null"},
+		"array":        {descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, "[1]"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := CheckSchemaOverrides(defaultFixture(t, tc.typ, tc.def, false))
+			g.Expect(err).To(MatchError(ContainSubstring(`(mcp.field).default on "fixture.Defaults.value"`)))
+		})
+	}
+
+	g := NewWithT(t)
+	g.Expect(CheckSchemaOverrides(defaultFixture(t, descriptorpb.FieldDescriptorProto_TYPE_INT32, "5", false))).To(Succeed())
+}
+
+func TestFieldMax_Schema(t *testing.T) {
+	g := NewWithT(t)
+	md := (&testdata.ListConfigsRequest{}).ProtoReflect().Descriptor()
+	props := schemaJSON(g, MessageSchema(md, SchemaOptions{}))["properties"].(map[string]any)
+
+	g.Expect(props["page_size"]).To(HaveKeyWithValue("maximum", float64(200)))
+	g.Expect(props["page_size"]).To(HaveKeyWithValue("default", float64(50)))
+	g.Expect(props["page_token"]).ToNot(HaveKey("maximum"))
+	g.Expect(CheckSchemaOverrides(md)).To(Succeed())
+}
+
+func TestFieldMax_Int64Schema(t *testing.T) {
+	g := NewWithT(t)
+	fieldOpts := &descriptorpb.FieldOptions{}
+	proto.SetExtension(fieldOpts, mcpoptions.E_Field, &mcpoptions.FieldOptions{Max: 1000})
+	md := singleFieldFixture(t, descriptorpb.FieldDescriptorProto_TYPE_INT64, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, fieldOpts)
+	schema := FieldSchema(md.Fields().ByName("value"), SchemaOptions{})
+
+	// The value is a JSON string, so the bound is only described.
+	g.Expect(schema).To(HaveKeyWithValue("type", "string"))
+	g.Expect(schema).ToNot(HaveKey("maximum"))
+	g.Expect(schema["description"]).To(ContainSubstring("At most 1000; larger values are capped."))
+	g.Expect(CheckSchemaOverrides(md)).To(Succeed())
+}
+
+func TestCheckSchemaOverrides_InvalidMax(t *testing.T) {
+	for name, tc := range map[string]struct {
+		typ   descriptorpb.FieldDescriptorProto_Type
+		label descriptorpb.FieldDescriptorProto_Label
+		opts  *mcpoptions.FieldOptions
+		want  string
+	}{
+		"negative": {descriptorpb.FieldDescriptorProto_TYPE_INT32, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL,
+			&mcpoptions.FieldOptions{Max: -1}, "must be positive"},
+		"not an integer": {descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL,
+			&mcpoptions.FieldOptions{Max: 10}, "only supported on integer fields"},
+		"repeated": {descriptorpb.FieldDescriptorProto_TYPE_INT64, descriptorpb.FieldDescriptorProto_LABEL_REPEATED,
+			&mcpoptions.FieldOptions{Max: 10}, "not supported on repeated fields"},
+		"default above max": {descriptorpb.FieldDescriptorProto_TYPE_UINT32, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL,
+			&mcpoptions.FieldOptions{Max: 10, Default: "20"}, "default 20 exceeds max 10"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+			fieldOpts := &descriptorpb.FieldOptions{}
+			proto.SetExtension(fieldOpts, mcpoptions.E_Field, tc.opts)
+			err := CheckSchemaOverrides(singleFieldFixture(t, tc.typ, tc.label, fieldOpts))
+			g.Expect(err).To(MatchError(ContainSubstring(`(mcp.field).max on "fixture.Defaults.value": ` + tc.want)))
+		})
+	}
+}
+
+// jsonStringFixture builds
+//
+//	message Parent {
+//	  Child child = 1 [(mcp.field).json_string = true];
+//	  repeated Child children = 2 [(mcp.field).json_string = true];
+//	}
+//	message Child { string doc = 1; }
+func jsonStringFixture(t *testing.T) protoreflect.MessageDescriptor {
+	t.Helper()
+	fieldOpts := &descriptorpb.FieldOptions{}
+	proto.SetExtension(fieldOpts, mcpoptions.E_Field, &mcpoptions.FieldOptions{JsonString: true})
+	child := func(name string, number int32, label descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    label.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: proto.String(".fixture.Child"),
+			Options:  fieldOpts,
+		}
+	}
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("json_string_fixture.proto"),
+		Package: proto.String("fixture"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Parent"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					child("child", 1, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL),
+					child("children", 2, descriptorpb.FieldDescriptorProto_LABEL_REPEATED),
+				},
+			},
+			{
+				Name: proto.String("Child"),
+				Field: []*descriptorpb.FieldDescriptorProto{{
+					Name:     proto.String("doc"),
+					JsonName: proto.String("doc"),
+					Number:   proto.Int32(1),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				}},
+			},
+		},
+	}
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatalf("building fixture: %v", err)
+	}
+	return fd.Messages().ByName("Parent")
+}
+
+func TestFieldJSONString(t *testing.T) {
+	g := NewWithT(t)
+	md := jsonStringFixture(t)
+	g.Expect(CheckSchemaOverrides(md)).To(Succeed())
+
+	props := schemaJSON(g, MessageSchema(md, SchemaOptions{}))["properties"].(map[string]any)
+	g.Expect(props["child"]).To(Equal(map[string]any{
+		"type":        "string",
+		"description": "JSON-encoded Child. Provide a JSON object as a string.",
+	}))
+	g.Expect(props["children"]).To(HaveKeyWithValue("items", props["child"]))
+
+	// The handler parses the strings back, and results carry them again.
+	msg, err := runtime.UnmarshalArguments(md, map[string]any{
+		"child":    `{"doc":"a"}`,
+		"children": []any{`{"doc":"b"}`},
+	}, runtime.CodecOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	out, err := runtime.EncodeMessage(msg)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(out).To(MatchJSON(`{"child":"{\"doc\":\"a\"}","children":["{\"doc\":\"b\"}"]}`))
+}
+
+func TestCheckSchemaOverrides_InvalidJSONString(t *testing.T) {
+	g := NewWithT(t)
+	fieldOpts := &descriptorpb.FieldOptions{}
+	proto.SetExtension(fieldOpts, mcpoptions.E_Field, &mcpoptions.FieldOptions{JsonString: true})
+	err := CheckSchemaOverrides(singleFieldFixture(t, descriptorpb.FieldDescriptorProto_TYPE_STRING, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, fieldOpts))
+	g.Expect(err).To(MatchError(ContainSubstring("(mcp.field).json_string on \"fixture.Defaults.value\": only supported on message fields, not string")))
+}
+
+// relativeTimeFixture builds
+//
+//	message Window {
+//	  google.protobuf.Timestamp start = 1 [(mcp.field).relative_time = true];
+//	  google.protobuf.Timestamp end = 2;
+//	}
+func relativeTimeFixture(t *testing.T) protoreflect.MessageDescriptor {
+	t.Helper()
+	fieldOpts := &descriptorpb.FieldOptions{}
+	proto.SetExtension(fieldOpts, mcpoptions.E_Field, &mcpoptions.FieldOptions{RelativeTime: true})
+	timestamp := func(name string, number int32) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: proto.String(".google.protobuf.Timestamp"),
+		}
+	}
+	start := timestamp("start", 1)
+	start.Options = fieldOpts
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("relative_time_fixture.proto"),
+		Package:    proto.String("fixture"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/timestamp.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name:  proto.String("Window"),
+			Field: []*descriptorpb.FieldDescriptorProto{start, timestamp("end", 2)},
+		}},
+	}
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatalf("building fixture: %v", err)
+	}
+	return fd.Messages().ByName("Window")
+}
+
+func TestFieldRelativeTime(t *testing.T) {
+	g := NewWithT(t)
+	md := relativeTimeFixture(t)
+	g.Expect(CheckSchemaOverrides(md)).To(Succeed())
+
+	props := schemaJSON(g, MessageSchema(md, SchemaOptions{}))["properties"].(map[string]any)
+	g.Expect(props["start"]).ToNot(HaveKey("format"))
+	g.Expect(props["start"]).To(HaveKeyWithValue("description", ContainSubstring(`"now-2h"`)))
+	g.Expect(props["end"]).To(HaveKeyWithValue("format", "date-time"))
+
+	// The plugin option relaxes every Timestamp.
+	props = schemaJSON(g, MessageSchema(md, SchemaOptions{RelativeTimes: true}))["properties"].(map[string]any)
+	g.Expect(props["end"]).ToNot(HaveKey("format"))
+
+	// DecodeArguments normalizes the annotated field only.
+	args := map[string]any{"start": "2026-01-02", "end": "2026-01-02"}
+	g.Expect(runtime.DecodeArguments(md, args)).To(Succeed())
+	g.Expect(args).To(Equal(map[string]any{"start": "2026-01-02T00:00:00Z", "end": "2026-01-02"}))
+}
+
+func TestCheckSchemaOverrides_InvalidRelativeTime(t *testing.T) {
+	g := NewWithT(t)
+	fieldOpts := &descriptorpb.FieldOptions{}
+	proto.SetExtension(fieldOpts, mcpoptions.E_Field, &mcpoptions.FieldOptions{RelativeTime: true})
+	err := CheckSchemaOverrides(singleFieldFixture(t, descriptorpb.FieldDescriptorProto_TYPE_INT64, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, fieldOpts))
+	g.Expect(err).To(MatchError(ContainSubstring("only supported on google.protobuf.Timestamp and Duration fields")))
+}
+
+func TestCheckSchemaOverrides_InvalidFile(t *testing.T) {
+	g := NewWithT(t)
+	fieldOpts := &descriptorpb.FieldOptions{}
+	proto.SetExtension(fieldOpts, mcpoptions.E_Field, &mcpoptions.FieldOptions{File: true})
+	err := CheckSchemaOverrides(singleFieldFixture(t, descriptorpb.FieldDescriptorProto_TYPE_INT64, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, fieldOpts))
+	g.Expect(err).To(MatchError(ContainSubstring("(mcp.field).file on \"fixture.Defaults.value\": only supported on bytes and string fields, not int64")))
+	err = CheckSchemaOverrides(singleFieldFixture(t, descriptorpb.FieldDescriptorProto_TYPE_BYTES, descriptorpb.FieldDescriptorProto_LABEL_REPEATED, fieldOpts))
+	g.Expect(err).To(MatchError(ContainSubstring("not supported on repeated or map fields")))
+	g.Expect(CheckSchemaOverrides(singleFieldFixture(t, descriptorpb.FieldDescriptorProto_TYPE_BYTES, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, fieldOpts))).To(Succeed())
+}
+
+func TestReturnsFiles(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("AnnotatedService")
+	g.Expect(ReturnsFiles(sd.Methods().ByName("ExportConfig").Output())).To(BeTrue())
+	g.Expect(ReturnsFiles(sd.Methods().ByName("GetConfig").Output())).To(BeFalse())
+	g.Expect(ReturnsFiles((&httpbody.HttpBody{}).ProtoReflect().Descriptor())).To(BeTrue())
+}
+
+func TestFromContext_DroppedFromInputSchema(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("AnnotatedService")
+	tool := ToolForMethodWithOptions(sd.Methods().ByName("ApplyConfig"), "", SchemaOptions{})
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+	g.Expect(schema["properties"]).ToNot(HaveKey("organization_id"))
+	g.Expect(schema["required"]).ToNot(ContainElement("organization_id"))
+
+	// MessageSchema still describes the whole message.
+	props := MessageSchema(sd.Methods().ByName("ApplyConfig").Input(), SchemaOptions{})["properties"]
+	g.Expect(props).To(HaveKey("organization_id"))
+}
+
+// extraPropertyFixture builds a service "fixture.Svc" whose method "Call"
+// takes a request with a single field "name", carrying the given
+// (mcp.service) and (mcp.method) extra properties.
+func extraPropertyFixture(t *testing.T, service, method []*mcpoptions.ExtraProperty) protoreflect.MethodDescriptor {
+	t.Helper()
+	svcOpts := &descriptorpb.ServiceOptions{}
+	proto.SetExtension(svcOpts, mcpoptions.E_Service, &mcpoptions.ServiceOptions{ExtraProperty: service})
+	methOpts := &descriptorpb.MethodOptions{}
+	proto.SetExtension(methOpts, mcpoptions.E_Method, &mcpoptions.MethodOptions{ExtraProperty: method})
+
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("extra_property_fixture.proto"),
+		Package: proto.String("fixture"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Req"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("name"),
+				JsonName: proto.String("name"),
+				Number:   proto.Int32(1),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			}},
+		}},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name:    proto.String("Svc"),
+			Options: svcOpts,
+			Method: []*descriptorpb.MethodDescriptorProto{{
+				Name:       proto.String("Call"),
+				InputType:  proto.String(".fixture.Req"),
+				OutputType: proto.String(".fixture.Req"),
+				Options:    methOpts,
+			}},
+		}},
+	}
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatalf("building fixture: %v", err)
+	}
+	return fd.Services().Get(0).Methods().Get(0)
+}
+
+func TestDeclaredExtraProperties(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("AnnotatedService")
+
+	props, err := DeclaredExtraProperties(sd.Methods().ByName("ApplyConfig"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(props).To(HaveLen(3))
+	g.Expect(props[0]).To(Equal(runtime.ExtraProperty{
+		Name:        "cluster_id",
+		Description: "Cluster to apply the config to.",
+		Required:    true,
+		ContextKey:  runtime.ExtraPropertyKey("cluster_id"),
+	}))
+	g.Expect(props[1].Sensitive).To(BeTrue())
+	g.Expect(props[2].ContextKey).To(Equal(runtime.ExtraPropertyKey("deploy_region")))
+	g.Expect(string(props[2].Schema)).To(Equal(`{"type":"string","enum":["eu","us"]}`))
+
+	// Service-level properties apply to every method.
+	props, err = DeclaredExtraProperties(sd.Methods().ByName("LegacyApply"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(props).To(HaveLen(2))
+
+	// And they are baked into the tool schema.
+	tool := ToolForMethodWithOptions(sd.Methods().ByName("ApplyConfig"), "", SchemaOptions{})
+	var schema map[string]any
+	g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+	g.Expect(schema["properties"]).To(HaveKeyWithValue("region", map[string]any{"type": "string", "enum": []any{"eu", "us"}}))
+	g.Expect(schema["properties"]).To(HaveKeyWithValue("api_token", HaveKeyWithValue("writeOnly", true)))
+	g.Expect(schema["required"]).To(ConsistOf("cluster_id"))
+}
+
+func TestDeclaredExtraProperties_MethodReplacesService(t *testing.T) {
+	g := NewWithT(t)
+	method := extraPropertyFixture(t,
+		[]*mcpoptions.ExtraProperty{{Name: "token", Required: true}, {Name: "zone"}},
+		[]*mcpoptions.ExtraProperty{{Name: "token", Description: "Method token"}},
+	)
+	props, err := DeclaredExtraProperties(method)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(props).To(HaveLen(2))
+	g.Expect(props[0].Name).To(Equal("zone"))
+	g.Expect(props[1]).To(Equal(runtime.ExtraProperty{Name: "token", Description: "Method token", ContextKey: runtime.ExtraPropertyKey("token")}))
+}
+
+func TestDeclaredExtraProperties_Errors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		prop *mcpoptions.ExtraProperty
+		err  string
+	}{
+		"missing name":   {&mcpoptions.ExtraProperty{}, "name is required"},
+		"field clash":    {&mcpoptions.ExtraProperty{Name: "name"}, `collides with a field of "fixture.Req"`},
+		"invalid schema": {&mcpoptions.ExtraProperty{Name: "x", Schema: "[]"}, "not a JSON object"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := DeclaredExtraProperties(extraPropertyFixture(t, nil, []*mcpoptions.ExtraProperty{tc.prop}))
+			g.Expect(err).To(MatchError(ContainSubstring(tc.err)))
+		})
+	}
+}
+
+func TestWrapInput(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("AnnotatedService")
+	tool := ToolForMethodWithOptions(sd.Methods().ByName("ApplyConfig"), "", SchemaOptions{WrapInput: "request"})
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+	g.Expect(schema["type"]).To(Equal("object"))
+	// Declared extra properties sit next to the wrapper, not inside it.
+	g.Expect(schema["properties"]).To(HaveKey("cluster_id"))
+	g.Expect(schema["required"]).To(ConsistOf("request", "cluster_id"))
+
+	inner := schema["properties"].(map[string]any)["request"].(map[string]any)
+	g.Expect(inner["type"]).To(Equal("object"))
+	g.Expect(inner["properties"]).To(HaveKey("pipeline_yaml"))
+	g.Expect(inner["properties"]).ToNot(HaveKey("cluster_id"))
+
+	// The output schema is never wrapped.
+	var output map[string]any
+	g.Expect(json.Unmarshal(tool.RawOutputSchema, &output)).To(Succeed())
+	g.Expect(output["properties"]).To(HaveKey("applied"))
+}
+
+func TestDryRunSupported(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("AnnotatedService")
+	apply := sd.Methods().ByName("ApplyConfig")
+	list := sd.Methods().ByName("ListConfigs")
+
+	g.Expect(DryRunSupported(apply, SchemaOptions{})).To(BeFalse())
+	g.Expect(DryRunSupported(apply, SchemaOptions{DryRun: true})).To(BeTrue())
+	// NO_SIDE_EFFECTS methods have nothing to dry-run.
+	g.Expect(DryRunSupported(list, SchemaOptions{DryRun: true})).To(BeFalse())
+	g.Expect(MethodHasSideEffects(apply)).To(BeTrue())
+	g.Expect(MethodHasSideEffects(list)).To(BeFalse())
+
+	var schema map[string]any
+	tool := ToolForMethodWithOptions(apply, "", SchemaOptions{DryRun: true})
+	g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+	g.Expect(schema["properties"]).To(HaveKeyWithValue("dry_run", HaveKeyWithValue("type", "boolean")))
+	g.Expect(schema["required"]).ToNot(ContainElement("dry_run"))
+
+	tool = ToolForMethodWithOptions(list, "", SchemaOptions{DryRun: true})
+	g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+	g.Expect(schema["properties"]).ToNot(HaveKey("dry_run"))
+}
@@ -0,0 +1,173 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/mcpoptions"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// toolReference is the prompt template placeholder of the annotated method's
+// tool; toolReferencePrefix starts a reference to another RPC's tool.
+const (
+	toolReference       = "tool"
+	toolReferencePrefix = "tool:"
+)
+
+// DeclaredPrompt is a prompt declared with (mcp.service).prompt or
+// (mcp.method).prompt.
+type DeclaredPrompt struct {
+	Prompt   runtime.Prompt
+	Template string
+
+	// Method is the annotated RPC, or nil for a service-level prompt.
+	Method protoreflect.MethodDescriptor
+
+	// Tools maps the tool references in Template, "tool" or "tool:Method",
+	// to the RPC whose tool they name.
+	Tools map[string]protoreflect.MethodDescriptor
+}
+
+// DeclaredPrompts returns the prompts declared on sd and its RPCs, service
+// prompts first. included reports whether an RPC gets a tool: prompts on
+// other RPCs are skipped and references to their tools are errors, as are
+// duplicate names and unknown template placeholders.
+func DeclaredPrompts(sd protoreflect.ServiceDescriptor, included func(protoreflect.MethodDescriptor) bool) ([]DeclaredPrompt, error) {
+	var prompts []DeclaredPrompt
+	seen := map[string]bool{}
+	add := func(p *mcpoptions.Prompt, method protoreflect.MethodDescriptor) error {
+		prompt, err := declaredPrompt(p, sd, method, included)
+		if err != nil {
+			return err
+		}
+		if seen[prompt.Prompt.Name] {
+			where := protoreflect.Descriptor(sd)
+			if method != nil {
+				where = method
+			}
+			return errorOn(where, fmt.Errorf("%s: duplicate prompt %q", where.FullName(), prompt.Prompt.Name))
+		}
+		seen[prompt.Prompt.Name] = true
+		prompts = append(prompts, prompt)
+		return nil
+	}
+	for _, p := range serviceOptions(sd).GetPrompt() {
+		if err := add(p, nil); err != nil {
+			return nil, err
+		}
+	}
+	for i := 0; i < sd.Methods().Len(); i++ {
+		method := sd.Methods().Get(i)
+		if !included(method) {
+			continue
+		}
+		for _, p := range methodOptions(method).GetPrompt() {
+			if err := add(p, method); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return prompts, nil
+}
+
+// declaredPrompt converts and checks a prompt declared on sd, or on method
+// when it is not nil.
+func declaredPrompt(p *mcpoptions.Prompt, sd protoreflect.ServiceDescriptor, method protoreflect.MethodDescriptor, included func(protoreflect.MethodDescriptor) bool) (DeclaredPrompt, error) {
+	where := protoreflect.Descriptor(sd)
+	if method != nil {
+		where = method
+	}
+	if p.GetName() == "" {
+		return DeclaredPrompt{}, errorOn(where, fmt.Errorf("%s: prompt without a name", where.FullName()))
+	}
+	errorf := func(format string, args ...any) error {
+		return errorOn(where, fmt.Errorf("%s: prompt %q: %s", where.FullName(), p.GetName(), fmt.Sprintf(format, args...)))
+	}
+
+	prompt := DeclaredPrompt{
+		Prompt: runtime.Prompt{
+			Name:        p.GetName(),
+			Title:       p.GetTitle(),
+			Description: p.GetDescription(),
+		},
+		Template: p.GetTemplate(),
+		Method:   method,
+		Tools:    map[string]protoreflect.MethodDescriptor{},
+	}
+	arguments := map[string]bool{}
+	for _, arg := range p.GetArgument() {
+		switch {
+		case arg.GetName() == "":
+			return DeclaredPrompt{}, errorf("argument without a name")
+		case arguments[arg.GetName()]:
+			return DeclaredPrompt{}, errorf("duplicate argument %q", arg.GetName())
+		case arg.GetName() == toolReference || strings.HasPrefix(arg.GetName(), toolReferencePrefix):
+			return DeclaredPrompt{}, errorf("argument %q collides with a tool reference", arg.GetName())
+		}
+		arguments[arg.GetName()] = true
+		prompt.Prompt.Arguments = append(prompt.Prompt.Arguments, runtime.PromptArgument{
+			Name:        arg.GetName(),
+			Description: arg.GetDescription(),
+			Required:    arg.GetRequired(),
+		})
+	}
+
+	placeholders, err := runtime.PromptTemplatePlaceholders(p.GetTemplate())
+	if err != nil {
+		return DeclaredPrompt{}, errorf("%v", err)
+	}
+	for _, name := range placeholders {
+		switch {
+		case arguments[name]:
+		case name == toolReference:
+			if method == nil {
+				return DeclaredPrompt{}, errorf("{{tool}} is only available in method prompts; use {{tool:Method}}")
+			}
+			prompt.Tools[name] = method
+		case strings.HasPrefix(name, toolReferencePrefix):
+			target := sd.Methods().ByName(protoreflect.Name(strings.TrimPrefix(name, toolReferencePrefix)))
+			if target == nil || !included(target) {
+				return DeclaredPrompt{}, errorf("{{%s}} does not name an RPC of %s with a tool", name, sd.Name())
+			}
+			prompt.Tools[name] = target
+		default:
+			return DeclaredPrompt{}, errorf("placeholder {{%s}} is not an argument", name)
+		}
+	}
+	return prompt, nil
+}
+
+// SharedCompletions returns the arguments of a method prompt that are named
+// like a completable argument of the method's tool, see ArgumentCompletions.
+func SharedCompletions(prompt DeclaredPrompt, opts SchemaOptions) []string {
+	if prompt.Method == nil {
+		return nil
+	}
+	completable := map[string]bool{}
+	for _, c := range ArgumentCompletions(prompt.Method, opts) {
+		completable[c.Argument] = true
+	}
+	var shared []string
+	for _, arg := range prompt.Prompt.Arguments {
+		if completable[arg.Name] {
+			shared = append(shared, arg.Name)
+		}
+	}
+	return shared
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/mcpoptions"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func annotatedService() protoreflect.ServiceDescriptor {
+	return (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("AnnotatedService")
+}
+
+func allMethods(protoreflect.MethodDescriptor) bool { return true }
+
+func TestDeclaredPrompts(t *testing.T) {
+	g := NewWithT(t)
+	sd := annotatedService()
+
+	prompts, err := DeclaredPrompts(sd, allMethods)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(prompts).To(HaveLen(2))
+
+	rollout := prompts[0]
+	g.Expect(rollout.Prompt.Name).To(Equal("rollout"))
+	g.Expect(rollout.Prompt.Title).To(Equal("Roll out a config"))
+	g.Expect(rollout.Prompt.Arguments).To(Equal([]runtime.PromptArgument{
+		{Name: "name", Description: "Name of the pipeline to roll out.", Required: true},
+	}))
+	g.Expect(rollout.Method).To(BeNil())
+	g.Expect(rollout.Tools).To(HaveLen(2))
+	g.Expect(rollout.Tools["tool:ListConfigs"]).To(Equal(sd.Methods().ByName("ListConfigs")))
+	g.Expect(SharedCompletions(rollout, SchemaOptions{})).To(BeEmpty())
+
+	applyFrom := prompts[1]
+	g.Expect(applyFrom.Prompt.Name).To(Equal("apply_from"))
+	g.Expect(applyFrom.Method).To(Equal(sd.Methods().ByName("ApplyConfig")))
+	g.Expect(applyFrom.Tools).To(Equal(map[string]protoreflect.MethodDescriptor{"tool": sd.Methods().ByName("ApplyConfig")}))
+	g.Expect(SharedCompletions(applyFrom, SchemaOptions{})).To(Equal([]string{"base_config"}))
+
+	// A reference to an RPC without a tool is rejected.
+	_, err = DeclaredPrompts(sd, func(md protoreflect.MethodDescriptor) bool { return md.Name() != "ListConfigs" })
+	g.Expect(err).To(MatchError(ContainSubstring("{{tool:ListConfigs}} does not name an RPC of AnnotatedService with a tool")))
+}
+
+func TestDeclaredPrompt_Invalid(t *testing.T) {
+	sd := annotatedService()
+	method := sd.Methods().ByName("ApplyConfig")
+	arg := func(name string) *mcpoptions.PromptArgument {
+		return &mcpoptions.PromptArgument{Name: name}
+	}
+
+	tests := []struct {
+		name   string
+		prompt *mcpoptions.Prompt
+		method protoreflect.MethodDescriptor
+		err    string
+	}{
+		{"no name", &mcpoptions.Prompt{}, method, "prompt without a name"},
+		{"argument without name", &mcpoptions.Prompt{Name: "p", Argument: []*mcpoptions.PromptArgument{{}}}, method, "argument without a name"},
+		{"duplicate argument", &mcpoptions.Prompt{Name: "p", Argument: []*mcpoptions.PromptArgument{arg("a"), arg("a")}}, method, `duplicate argument "a"`},
+		{"argument named tool", &mcpoptions.Prompt{Name: "p", Argument: []*mcpoptions.PromptArgument{arg("tool")}}, method, "collides with a tool reference"},
+		{"unknown placeholder", &mcpoptions.Prompt{Name: "p", Template: "{{who}}"}, method, "placeholder {{who}} is not an argument"},
+		{"tool in service prompt", &mcpoptions.Prompt{Name: "p", Template: "{{tool}}"}, nil, "only available in method prompts"},
+		{"unknown RPC", &mcpoptions.Prompt{Name: "p", Template: "{{tool:Nope}}"}, nil, "does not name an RPC"},
+		{"bad template", &mcpoptions.Prompt{Name: "p", Template: "{{open"}, nil, "unterminated placeholder"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := declaredPrompt(tt.prompt, sd, tt.method, allMethods)
+			g.Expect(err).To(MatchError(ContainSubstring(tt.err)))
+		})
+	}
+}
+
+// promptServer records tools and prompts.
+type promptServer struct {
+	recordingServer
+	prompts map[string]runtime.PromptHandler
+}
+
+func (p *promptServer) AddPrompt(prompt runtime.Prompt, handler runtime.PromptHandler) {
+	if p.prompts == nil {
+		p.prompts = map[string]runtime.PromptHandler{}
+	}
+	p.prompts[prompt.Name] = handler
+}
+
+func TestRegisterService_Prompts(t *testing.T) {
+	g := NewWithT(t)
+	handler := func(context.Context, protoreflect.MethodDescriptor, proto.Message) (proto.Message, error) {
+		return &testdata.ListConfigsResponse{Configs: []*testdata.Config{{Name: "configs/prod"}}}, nil
+	}
+	srv := &promptServer{}
+	completions := runtime.NewCompletionRegistry()
+	RegisterService(srv, annotatedService(), handler, RegisterServiceOptions{
+		NamePrefix:  "ops",
+		Completions: completions,
+	})
+	g.Expect(srv.prompts).To(HaveLen(2))
+
+	result, err := srv.prompts["ops_rollout"](context.Background(), &runtime.GetPromptRequest{Arguments: map[string]string{"name": "ingest"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Messages).To(Equal([]runtime.PromptMessage{{
+		Role: "user",
+		Text: "List the configs with ops_testdata_AnnotatedService_ListConfigs, then apply pipeline ingest with ops_testdata_AnnotatedService_ApplyConfig.",
+	}}))
+
+	// The method prompt shares the base_config completions of its tool.
+	got, err := completions.Complete(context.Background(), "ops_apply_from", "base_config", "", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got.Values).To(Equal([]string{"configs/prod"}))
+}
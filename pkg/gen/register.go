@@ -18,7 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
+	"slices"
 
 	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -32,6 +32,11 @@ import (
 // This is the interface users implement to handle tool calls dynamically.
 type Handler func(ctx context.Context, method protoreflect.MethodDescriptor, req proto.Message) (proto.Message, error)
 
+// WatchHandler handles a server-streaming RPC exposed as a watched resource.
+// It calls update with every message of the stream and returns when the
+// stream ends or ctx is cancelled.
+type WatchHandler func(ctx context.Context, method protoreflect.MethodDescriptor, req proto.Message, update runtime.ResourceUpdate) error
+
 // NewMessage creates a new empty proto message for the given descriptor.
 // Users must provide this because protoreflect descriptors alone can't instantiate messages.
 type NewMessage func(descriptor protoreflect.MessageDescriptor) proto.Message
@@ -51,6 +56,69 @@ type RegisterServiceOptions struct {
 	// ExtraProperties adds additional properties to all tool schemas.
 	ExtraProperties []runtime.ExtraProperty
 
+	// ContextFields supplies the values of (mcp.field).from_context fields.
+	ContextFields []runtime.ContextField
+
+	// ForwardedHeaders adds a "headers" argument whose allowed entries are
+	// sent as outgoing gRPC metadata; see runtime.WithForwardedHeaders.
+	ForwardedHeaders []string
+
+	// Elicitation asks the user for missing required arguments instead of
+	// failing the call; see runtime.WithElicitation.
+	Elicitation bool
+
+	// Completions receives the argument completers of the registered tools,
+	// and of their resource templates; see ArgumentCompletions and
+	// ResourceCompletions. Resource references complete by calling the List
+	// RPC through handler.
+	Completions *runtime.CompletionRegistry
+
+	// WatchHandler runs the server-streaming RPCs that have a resource URI,
+	// which are registered as watched resources; see ResourceURI. Without
+	// it they are skipped.
+	WatchHandler WatchHandler
+
+	// Subscriptions receives the watchers of the watched resources; see
+	// runtime.WithSubscriptions.
+	Subscriptions *runtime.SubscriptionRegistry
+
+	// WorkerPools runs the tools of the given names, as registered, with
+	// bounded concurrency; see runtime.WithWorkerPool.
+	WorkerPools map[string]*runtime.WorkerPool
+
+	// ServiceWorkerPool runs every tool of the service with bounded
+	// concurrency; see runtime.WithServiceWorkerPool.
+	ServiceWorkerPool *runtime.WorkerPool
+
+	// CallTracker tracks the calls in flight for graceful shutdown; see
+	// runtime.WithCallTracker.
+	CallTracker *runtime.CallTracker
+
+	// ArgumentLimits bounds the arguments of every tool call; see
+	// runtime.WithArgumentLimits.
+	ArgumentLimits runtime.ArgumentLimits
+
+	// StringSanitizers clean up the string values of the arguments; see
+	// runtime.WithStringSanitizers.
+	StringSanitizers []runtime.StringSanitizer
+
+	// Tenant resolves and propagates the tenant of every call, resource read
+	// and completion, filling the from_context fields of its ContextField;
+	// see runtime.WithTenant.
+	Tenant *runtime.Tenant
+
+	// UsageRecorder receives the usage of every tool call; see
+	// runtime.WithUsageRecorder.
+	UsageRecorder runtime.UsageRecorder
+
+	// DeadlineBudget bounds the time every call may take as a whole; see
+	// runtime.WithDeadlineBudget.
+	DeadlineBudget runtime.DeadlineBudget
+
+	// DuplicateCalls suppresses repeated identical calls of the tools of
+	// methods with side effects; see runtime.WithDuplicateCallSuppression.
+	DuplicateCalls *runtime.DuplicateCallCache
+
 	// NewMessage creates new proto message instances from descriptors.
 	// If nil, defaults to DynamicNewMessage (uses dynamicpb).
 	NewMessage NewMessage
@@ -58,26 +126,67 @@ type RegisterServiceOptions struct {
 	// CommentProvider optionally returns the leading comment for an RPC method.
 	// If nil, the tool description will be empty.
 	CommentProvider func(method protoreflect.MethodDescriptor) string
+
+	// SchemaOverride rewrites the input schema of every tool as it is
+	// registered; see runtime.WithSchemaOverride.
+	SchemaOverride runtime.SchemaOverride
+
+	// Tags registers only the tools, and their resources, carrying at least
+	// one of these tags; see runtime.WithTags.
+	Tags []string
+
+	// ExcludeDeprecatedMethods skips RPCs marked option deprecated = true.
+	ExcludeDeprecatedMethods bool
+
+	// SchemaOptions controls how the tool input and output schemas are generated.
+	SchemaOptions SchemaOptions
 }
 
 // RegisterService dynamically registers all unary RPCs from a protobuf service
 // descriptor as MCP tools. This is the reflection-based alternative to the
-// static code generation approach.
+// static code generation approach. Prompts and resources declared in proto are
+// registered as well when s supports them.
 //
 // Unlike the generated code, this works at runtime with any service descriptor,
 // making it suitable for proxy/gateway scenarios where you don't have the
 // generated types at compile time.
 func RegisterService(s runtime.MCPServer, sd protoreflect.ServiceDescriptor, handler Handler, opts RegisterServiceOptions) {
+	registerService(s, sd, handler, opts)
+}
+
+// registration lists what registerService registered, other than watched
+// resources.
+type registration struct {
+	tools     []string
+	prompts   []string
+	resources []string
+}
+
+// registerService implements RegisterService.
+func registerService(s runtime.MCPServer, sd protoreflect.ServiceDescriptor, handler Handler, opts RegisterServiceOptions) registration {
 	if opts.NewMessage == nil {
 		opts.NewMessage = DynamicNewMessage
 	}
-	schemaOpts := SchemaOptions{}
+	schemaOpts := opts.SchemaOptions
+	contextFields := append(slices.Clone(opts.ContextFields), opts.Tenant.ContextFields()...)
+
+	// Streaming and excluded deprecated methods get no tool.
+	included := func(method protoreflect.MethodDescriptor) bool {
+		if method.IsStreamingClient() || method.IsStreamingServer() {
+			return false
+		}
+		return !opts.ExcludeDeprecatedMethods || !MethodDeprecated(method)
+	}
+	toolNames := map[protoreflect.FullName]string{}
+	var registered registration
 
 	for i := 0; i < sd.Methods().Len(); i++ {
 		method := sd.Methods().Get(i)
-
-		// Skip streaming methods
-		if method.IsStreamingClient() || method.IsStreamingServer() {
+		if opts.WatchHandler != nil && method.IsStreamingServer() && !method.IsStreamingClient() {
+			registerWatch(s, method, opts)
+			continue
+		}
+		if !included(method) {
 			continue
 		}
 
@@ -86,18 +195,15 @@ func RegisterService(s runtime.MCPServer, sd protoreflect.ServiceDescriptor, han
 			comment = opts.CommentProvider(method)
 		}
 
-		// Generate tool schema
-		toolName := MangleHeadIfTooLong(
-			strings.ReplaceAll(string(method.FullName()), ".", "_"),
-			64,
-		)
+		tool := ToolForMethodWithOptions(method, comment, schemaOpts)
 
-		tool := runtime.Tool{
-			Name:            toolName,
-			Description:     CleanComment(comment),
-			RawInputSchema:  marshalTopLevelSchema(method.Input(), schemaOpts),
-			RawOutputSchema: marshalTopLevelSchema(method.Output(), schemaOpts),
+		// Extra properties declared in proto are already in the schema; they
+		// are extracted together with the registered ones.
+		declared, err := DeclaredExtraProperties(method)
+		if err != nil {
+			panic(fmt.Sprintf("protoc-gen-go-mcp: %v", err))
 		}
+		extraProperties := append(declared, opts.ExtraProperties...)
 
 		// Apply name prefix and extra properties
 		if opts.NamePrefix != "" {
@@ -106,21 +212,103 @@ func RegisterService(s runtime.MCPServer, sd protoreflect.ServiceDescriptor, han
 		if len(opts.ExtraProperties) > 0 {
 			tool = runtime.AddExtraPropertiesToTool(tool, opts.ExtraProperties)
 		}
+		tool = runtime.AddHeadersToTool(tool, opts.ForwardedHeaders)
+		if opts.SchemaOverride != nil {
+			if schema := opts.SchemaOverride(tool.Name, tool.RawInputSchema); schema != nil {
+				tool.RawInputSchema = schema
+			}
+		}
+		toolNames[method.FullName()] = tool.Name
 
 		// Capture loop variable
 		md := method
 		newMsg := opts.NewMessage
+		for _, c := range ArgumentCompletions(method, schemaOpts) {
+			if c.ListMethod == nil {
+				opts.Completions.Add(tool.Name, c.Argument, runtime.EnumCompleter(c.Values...))
+				continue
+			}
+			if !included(c.ListMethod) {
+				continue
+			}
+			list := c.ListMethod
+			opts.Completions.Add(tool.Name, c.Argument, opts.Tenant.PropagateCompleter(runtime.ListCompleter(
+				func() proto.Message { return newMsg(list.Input()) },
+				func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return handler(ctx, list, req)
+				},
+			)))
+		}
+		dryRunSupported := DryRunSupported(method, schemaOpts)
+		excludedFields := ExcludedFields(method.Input(), schemaOpts)
+
+		var toolHandler runtime.ToolHandler = func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+			// Stop the backend call when the client's _meta timeout runs out.
+			ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+			defer cancel()
 
-		s.AddTool(tool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 			message := request.Arguments
+			if message == nil {
+				// A call may come without arguments; the steps below write
+				// to them.
+				message = map[string]any{}
+			}
 
 			// Extract extra properties into context and remove them from
 			// the arguments map so they don't leak into proto unmarshaling.
-			for _, prop := range opts.ExtraProperties {
-				if propVal, ok := message[prop.Name]; ok {
-					ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-					delete(message, prop.Name)
+			ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+
+			if opts.Elicitation {
+				// Ask the user for required arguments the model left out.
+				elicited, err := runtime.ElicitMissingArguments(ctx, tool, message, extraProperties)
+				if err != nil {
+					return runtime.NewToolResultError(err.Error()), nil
 				}
+				message = elicited
+			}
+
+			// Strip the "headers" argument into outgoing metadata.
+			ctx, err = runtime.ExtractHeaders(ctx, md.Input(), message, opts.ForwardedHeaders)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+
+			// Strip the "dry_run" argument; see DryRunSupported.
+			dryRun := false
+			if dryRunSupported {
+				dryRun, err = runtime.ExtractDryRun(message)
+				if err != nil {
+					return runtime.NewToolResultError(err.Error()), nil
+				}
+			}
+
+			if TakesNoArguments(md.Input()) {
+				// Nothing to decode; ignore whatever the model sent.
+				message = map[string]any{}
+			} else {
+				// The request fields may be nested under SchemaOptions.WrapInput.
+				message, err = runtime.UnwrapArguments(message, schemaOpts.WrapInput)
+				if err != nil {
+					return runtime.NewToolResultError(err.Error()), nil
+				}
+				if schemaOpts.FlattenNested {
+					if err := runtime.UnflattenArguments(message); err != nil {
+						return runtime.NewToolResultError(err.Error()), nil
+					}
+				}
+				// Excluded fields are not in the schema; refuse them.
+				if err := runtime.RejectFields(md.Input(), message, excludedFields); err != nil {
+					return runtime.NewToolResultError(err.Error()), nil
+				}
+			}
+
+			// Fill (mcp.field).from_context fields from ctx; the model never
+			// sets them.
+			if err := runtime.PopulateFromContext(ctx, md.Input(), message, contextFields); err != nil {
+				return nil, err
 			}
 
 			// Rewrite oneof discriminated wrappers and recursion placeholders
@@ -128,6 +316,17 @@ func RegisterService(s runtime.MCPServer, sd protoreflect.ServiceDescriptor, han
 			if err := runtime.DecodeArguments(md.Input(), message); err != nil {
 				return runtime.NewToolResultError(err.Error()), nil
 			}
+			if err := runtime.SanitizeStrings(md.Input(), message, opts.StringSanitizers); err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			if schemaOpts.RelativeTimes {
+				if err := runtime.NormalizeTimes(md.Input(), message); err != nil {
+					return runtime.NewToolResultError(err.Error()), nil
+				}
+			}
+			if err := runtime.FillIdempotencyKey(ctx, md.Input(), message); err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
 
 			// Marshal to JSON, then unmarshal into proto
 			marshaled, err := json.Marshal(message)
@@ -143,6 +342,12 @@ func RegisterService(s runtime.MCPServer, sd protoreflect.ServiceDescriptor, han
 				return nil, err
 			}
 
+			// A backend with validate_only performs the dry run itself;
+			// otherwise return the decoded request without calling it.
+			if dryRun && !runtime.SetValidateOnly(req) {
+				return runtime.DryRunResult(req)
+			}
+
 			// Call handler
 			resp, err := handler(ctx, md, req)
 			if err != nil {
@@ -155,6 +360,106 @@ func RegisterService(s runtime.MCPServer, sd protoreflect.ServiceDescriptor, han
 			}
 
 			return runtime.NewToolResultJSON(structured), nil
-		})
+		}
+		if pool := opts.ServiceWorkerPool; pool != nil {
+			toolHandler = pool.RunService(toolHandler)
+		}
+		if pool := opts.WorkerPools[tool.Name]; pool != nil {
+			toolHandler = pool.Run(toolHandler)
+		}
+		toolHandler = runtime.RecordUsage(tool.Name, opts.UsageRecorder, toolHandler)
+		if MethodHasSideEffects(method) {
+			toolHandler = opts.DuplicateCalls.Suppress(tool.Name, toolHandler)
+		}
+		toolHandler = opts.Tenant.Propagate(toolHandler)
+		toolHandler = opts.ArgumentLimits.Limit(toolHandler)
+		toolHandler = opts.DeadlineBudget.Bound(toolHandler)
+		toolHandler = opts.CallTracker.Track(toolHandler)
+		// Methods the tags filter out get neither a tool nor a resource.
+		if !runtime.MatchesTags(tool, opts.Tags) {
+			continue
+		}
+		s.AddTool(tool, toolHandler)
+		registered.tools = append(registered.tools, tool.Name)
+
+		uri, err := ResourceURI(method, schemaOpts)
+		if err != nil {
+			panic(fmt.Sprintf("protoc-gen-go-mcp: %v", err))
+		}
+		if uri != "" {
+			resource := runtime.Resource{
+				URI:         uri,
+				Name:        tool.Name,
+				Title:       tool.Title,
+				Description: tool.Description,
+				MIMEType:    "application/json",
+			}
+			// Reads call the tool, so they share its arguments pipeline and
+			// middleware.
+			runtime.AddResource(s, resource, runtime.ToolResource(uri, md.Input(), schemaOpts.WrapInput, toolHandler))
+			registered.resources = append(registered.resources, uri)
+			opts.Completions.Inherit(uri, tool.Name, ResourceCompletions(method, uri, schemaOpts)...)
+		}
 	}
+
+	prompts, err := DeclaredPrompts(sd, included)
+	if err != nil {
+		panic(fmt.Sprintf("protoc-gen-go-mcp: %v", err))
+	}
+	for _, p := range prompts {
+		prompt := p.Prompt
+		if opts.NamePrefix != "" {
+			prompt.Name = opts.NamePrefix + "_" + prompt.Name
+		}
+		tools := map[string]string{}
+		for ref, method := range p.Tools {
+			tools[ref] = toolNames[method.FullName()]
+		}
+		runtime.AddPrompt(s, prompt, runtime.TemplatePromptHandler(prompt, p.Template, tools))
+		registered.prompts = append(registered.prompts, prompt.Name)
+		if p.Method != nil {
+			opts.Completions.Inherit(prompt.Name, toolNames[p.Method.FullName()], SharedCompletions(p, schemaOpts)...)
+		}
+	}
+	return registered
+}
+
+// registerWatch registers the server-streaming method as a watched resource
+// if it has a resource URI.
+func registerWatch(s runtime.MCPServer, method protoreflect.MethodDescriptor, opts RegisterServiceOptions) {
+	if opts.ExcludeDeprecatedMethods && MethodDeprecated(method) {
+		return
+	}
+	uri, err := ResourceURI(method, opts.SchemaOptions)
+	if err != nil {
+		panic(fmt.Sprintf("protoc-gen-go-mcp: %v", err))
+	}
+	if uri == "" {
+		return
+	}
+	comment := ""
+	if opts.CommentProvider != nil {
+		comment = opts.CommentProvider(method)
+	}
+	tool := ToolForMethodWithOptions(method, comment, opts.SchemaOptions)
+	if opts.NamePrefix != "" {
+		tool.Name = opts.NamePrefix + "_" + tool.Name
+	}
+	resource := runtime.Resource{
+		URI:         uri,
+		Name:        tool.Name,
+		Title:       tool.Title,
+		Description: tool.Description,
+		MIMEType:    "application/json",
+	}
+	runtime.AddWatchedResource(s, resource, opts.Tenant.Subscriptions(opts.Subscriptions), opts.Tenant.PropagateWatch(resource.URI, func(ctx context.Context, uri string, update runtime.ResourceUpdate) error {
+		req := opts.NewMessage(method.Input())
+		if req == nil {
+			return fmt.Errorf("NewMessage returned nil for %s", method.Input().FullName())
+		}
+		if err := runtime.SetURIVariables(req, resource.URI, uri); err != nil {
+			return err
+		}
+		return opts.WatchHandler(ctx, method, req, update)
+	}))
 }
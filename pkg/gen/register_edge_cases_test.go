@@ -264,8 +264,8 @@ func TestRegisterService_EdgeCaseService_AllMethods(t *testing.T) {
 	err = json.Unmarshal(resultBytes, &resp)
 	g.Expect(err).ToNot(HaveOccurred())
 
-	// EdgeCaseService has 9 unary RPCs
-	g.Expect(resp.Result.Tools).To(HaveLen(9))
+	// EdgeCaseService has 10 unary RPCs
+	g.Expect(resp.Result.Tools).To(HaveLen(10))
 
 	expectedTools := []string{
 		"testdata_EdgeCaseService_DeepNesting",
@@ -277,6 +277,7 @@ func TestRegisterService_EdgeCaseService_AllMethods(t *testing.T) {
 		"testdata_EdgeCaseService_NumericValidation",
 		"testdata_EdgeCaseService_RecursiveTree",
 		"testdata_EdgeCaseService_OneofRecursive",
+		"testdata_EdgeCaseService_NoArguments",
 	}
 	toolNames := make([]string, 0)
 	for _, tool := range resp.Result.Tools {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
@@ -367,3 +368,371 @@ func TestDynamicNewMessage(t *testing.T) {
 	g.Expect(msg).ToNot(BeNil())
 	g.Expect(string(msg.ProtoReflect().Descriptor().FullName())).To(Equal("testdata.GetItemRequest"))
 }
+
+func TestRegisterService_ExcludeDeprecatedMethods(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("AnnotatedService")
+	handler := func(ctx context.Context, method protoreflect.MethodDescriptor, req proto.Message) (proto.Message, error) {
+		return newTestMessage(method.Output()), nil
+	}
+
+	all := &recordingServer{}
+	RegisterService(all, sd, handler, RegisterServiceOptions{})
+	g.Expect(all.tools).To(HaveLen(5))
+
+	current := &recordingServer{}
+	RegisterService(current, sd, handler, RegisterServiceOptions{ExcludeDeprecatedMethods: true})
+	g.Expect(current.tools).To(HaveLen(4))
+	for _, tool := range current.tools {
+		g.Expect(tool.Name).ToNot(Equal("testdata_AnnotatedService_LegacyApply"))
+	}
+}
+
+func TestRegisterService_DeclaredExtraProperties(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("AnnotatedService")
+
+	type tenantKey struct{}
+	var clusterID, region any
+	var req *dynamicpb.Message
+	handler := func(ctx context.Context, method protoreflect.MethodDescriptor, r proto.Message) (proto.Message, error) {
+		clusterID = ctx.Value(runtime.ExtraPropertyKey("cluster_id"))
+		region = ctx.Value(runtime.ExtraPropertyKey("deploy_region"))
+		req = r.(*dynamicpb.Message)
+		return dynamicpb.NewMessage(method.Output()), nil
+	}
+
+	srv := &recordingServer{}
+	RegisterService(srv, sd, handler, RegisterServiceOptions{
+		ContextFields: []runtime.ContextField{{Name: "tenant", ContextKey: tenantKey{}}},
+	})
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal(srv.tools[0].RawInputSchema, &schema)).To(Succeed())
+	g.Expect(schema["properties"]).To(HaveKey("cluster_id"))
+	g.Expect(schema["properties"]).To(HaveKey("region"))
+	g.Expect(schema["required"]).To(ConsistOf("cluster_id"))
+
+	ctx := context.WithValue(context.Background(), tenantKey{}, "org-1")
+	result, err := srv.handlers["testdata_AnnotatedService_ApplyConfig"](ctx, &runtime.CallToolRequest{
+		Arguments: map[string]any{"name": "p", "cluster_id": "c-1", "region": "eu"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeFalse())
+	g.Expect(clusterID).To(Equal("c-1"))
+	g.Expect(region).To(Equal("eu"))
+	g.Expect(req.Get(req.Descriptor().Fields().ByName("name")).String()).To(Equal("p"))
+}
+
+func TestRegisterService_WrapInput(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("TestService")
+
+	var got *testdata.CreateItemRequest
+	handler := func(ctx context.Context, method protoreflect.MethodDescriptor, req proto.Message) (proto.Message, error) {
+		if r, ok := req.(*testdata.CreateItemRequest); ok {
+			got = r
+		}
+		return newTestMessage(method.Output()), nil
+	}
+
+	srv := &recordingServer{}
+	RegisterService(srv, sd, handler, RegisterServiceOptions{
+		NewMessage:    newTestMessage,
+		SchemaOptions: SchemaOptions{WrapInput: "request"},
+	})
+
+	call := srv.handlers["testdata_TestService_CreateItem"]
+	result, err := call(context.Background(), &runtime.CallToolRequest{
+		Arguments: map[string]any{"request": map[string]any{"name": "Widget"}},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeFalse())
+	g.Expect(got.GetName()).To(Equal("Widget"))
+
+	// Fields sent at the top level are a model-readable error.
+	result, err = call(context.Background(), &runtime.CallToolRequest{
+		Arguments: map[string]any{"name": "Widget"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeTrue())
+	g.Expect(result.Text).To(ContainSubstring(`missing argument "request"`))
+}
+
+func TestRegisterService_EmptyInput(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.NoArgumentsResponse{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("EdgeCaseService")
+
+	var calls int
+	handler := func(ctx context.Context, method protoreflect.MethodDescriptor, req proto.Message) (proto.Message, error) {
+		calls++
+		return newTestMessage(method.Output()), nil
+	}
+
+	srv := &recordingServer{}
+	RegisterService(srv, sd, handler, RegisterServiceOptions{
+		NewMessage:    newTestMessage,
+		SchemaOptions: SchemaOptions{WrapInput: "request"},
+	})
+
+	// Missing and invented arguments are both ignored.
+	call := srv.handlers["testdata_EdgeCaseService_NoArguments"]
+	for _, args := range []map[string]any{nil, {"verbose": true, "request": "x"}} {
+		result, err := call(context.Background(), &runtime.CallToolRequest{Arguments: args})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.IsError).To(BeFalse(), "%v", args)
+	}
+	g.Expect(calls).To(Equal(2))
+}
+
+func TestRegisterService_DryRun(t *testing.T) {
+	g := NewWithT(t)
+	file := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor().ParentFile()
+
+	type tenantKey struct{}
+	var got proto.Message
+	handler := func(ctx context.Context, method protoreflect.MethodDescriptor, req proto.Message) (proto.Message, error) {
+		got = req
+		return newTestMessage(method.Output()), nil
+	}
+
+	// ApplyConfigRequest has validate_only, so the dry run reaches the backend.
+	srv := &recordingServer{}
+	RegisterService(srv, file.Services().ByName("AnnotatedService"), handler, RegisterServiceOptions{
+		NewMessage:    newTestMessage,
+		SchemaOptions: SchemaOptions{DryRun: true},
+		ContextFields: []runtime.ContextField{{Name: "tenant", ContextKey: tenantKey{}}},
+	})
+	ctx := context.WithValue(context.Background(), tenantKey{}, "org-1")
+	result, err := srv.handlers["testdata_AnnotatedService_ApplyConfig"](ctx, &runtime.CallToolRequest{
+		Arguments: map[string]any{"cluster_id": "c1", "name": "p", "dry_run": true},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeFalse(), result.Text)
+	validateOnly := got.ProtoReflect().Descriptor().Fields().ByName("validate_only")
+	g.Expect(got.ProtoReflect().Get(validateOnly).Bool()).To(BeTrue())
+
+	// CreateItemRequest has none, so the decoded request comes back unsent.
+	got = nil
+	sd := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("TestService")
+	srv = &recordingServer{}
+	RegisterService(srv, sd, handler, RegisterServiceOptions{
+		NewMessage:    newTestMessage,
+		SchemaOptions: SchemaOptions{DryRun: true},
+	})
+	result, err = srv.handlers["testdata_TestService_CreateItem"](context.Background(), &runtime.CallToolRequest{
+		Arguments: map[string]any{"name": "Widget", "dry_run": true},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeFalse())
+	g.Expect(result.Text).To(ContainSubstring(`"name":"Widget"`))
+	g.Expect(got).To(BeNil())
+
+	// dry_run must be a boolean.
+	result, err = srv.handlers["testdata_TestService_CreateItem"](context.Background(), &runtime.CallToolRequest{
+		Arguments: map[string]any{"name": "Widget", "dry_run": "yes"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeTrue())
+}
+
+func TestRegisterService_Tags(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("AnnotatedService")
+	handler := func(ctx context.Context, method protoreflect.MethodDescriptor, req proto.Message) (proto.Message, error) {
+		return dynamicpb.NewMessage(method.Output()), nil
+	}
+
+	all := &recordingServer{}
+	RegisterService(all, sd, handler, RegisterServiceOptions{})
+	g.Expect(all.tools).ToNot(BeEmpty())
+	for _, tool := range all.tools {
+		g.Expect(tool.Tags).To(ContainElement("configs"))
+	}
+
+	deploy := &recordingServer{}
+	RegisterService(deploy, sd, handler, RegisterServiceOptions{Tags: []string{"deploy"}})
+	g.Expect(deploy.tools).To(HaveLen(1))
+	g.Expect(deploy.tools[0].Name).To(Equal("testdata_AnnotatedService_ApplyConfig"))
+	g.Expect(deploy.tools[0].Tags).To(Equal([]string{"configs", "deploy"}))
+
+	// The resources of the methods filtered out are not registered either.
+	resources := &resourceServer{}
+	RegisterService(resources, sd, handler, RegisterServiceOptions{
+		SchemaOptions: SchemaOptions{Resources: true},
+		Tags:          []string{"deploy"},
+	})
+	g.Expect(resources.tools).To(HaveLen(1))
+	g.Expect(resources.resources).To(BeEmpty())
+}
+
+func TestRegisterService_SchemaOverride(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("TestService")
+	handler := func(ctx context.Context, method protoreflect.MethodDescriptor, req proto.Message) (proto.Message, error) {
+		return newTestMessage(method.Output()), nil
+	}
+
+	s := &recordingServer{}
+	RegisterService(s, sd, handler, RegisterServiceOptions{
+		NewMessage: newTestMessage,
+		NamePrefix: "local",
+		SchemaOverride: func(name string, schema json.RawMessage) json.RawMessage {
+			if name != "local_testdata_TestService_CreateItem" {
+				return nil
+			}
+			return json.RawMessage(`{"type":"object","properties":{"name":{"type":"string","enum":["a","b"]}}}`)
+		},
+	})
+	for _, tool := range s.tools {
+		if tool.Name == "local_testdata_TestService_CreateItem" {
+			g.Expect(string(tool.RawInputSchema)).To(ContainSubstring(`"enum":["a","b"]`))
+		} else {
+			g.Expect(string(tool.RawInputSchema)).ToNot(ContainSubstring(`"enum":["a","b"]`))
+		}
+	}
+}
+
+func TestRegisterService_ExcludedFields(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("TestService")
+	called := false
+	handler := func(ctx context.Context, method protoreflect.MethodDescriptor, req proto.Message) (proto.Message, error) {
+		called = true
+		return newTestMessage(method.Output()), nil
+	}
+
+	s := &recordingServer{}
+	RegisterService(s, sd, handler, RegisterServiceOptions{
+		NewMessage:    newTestMessage,
+		SchemaOptions: SchemaOptions{ExcludeFields: []string{"testdata.CreateItemRequest.thumbnail"}},
+	})
+	for _, tool := range s.tools {
+		g.Expect(string(tool.RawInputSchema)).ToNot(ContainSubstring(`"thumbnail"`))
+	}
+
+	result, err := s.handlers["testdata_TestService_CreateItem"](context.Background(), &runtime.CallToolRequest{
+		Arguments: map[string]any{"name": "a", "thumbnail": "AAAA"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeTrue())
+	g.Expect(result.Text).To(ContainSubstring(`argument "thumbnail" is not accepted by this tool`))
+	g.Expect(called).To(BeFalse())
+}
+
+func TestRegisterService_ArgumentLimits(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("TestService")
+	called := false
+	handler := func(ctx context.Context, method protoreflect.MethodDescriptor, req proto.Message) (proto.Message, error) {
+		called = true
+		return newTestMessage(method.Output()), nil
+	}
+
+	s := &recordingServer{}
+	RegisterService(s, sd, handler, RegisterServiceOptions{
+		NewMessage:     newTestMessage,
+		ArgumentLimits: runtime.ArgumentLimits{MaxArrayLength: 1},
+	})
+	result, err := s.handlers["testdata_TestService_CreateItem"](context.Background(), &runtime.CallToolRequest{
+		Arguments: map[string]any{"name": "a", "tags": []any{"x", "y"}},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeTrue())
+	g.Expect(result.Text).To(ContainSubstring(`argument "tags" has 2 elements, over the limit of 1`))
+	g.Expect(called).To(BeFalse())
+}
+
+func TestRegisterService_StringSanitizers(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("TestService")
+	var got string
+	handler := func(ctx context.Context, method protoreflect.MethodDescriptor, req proto.Message) (proto.Message, error) {
+		got = req.ProtoReflect().Get(method.Input().Fields().ByName("name")).String()
+		return newTestMessage(method.Output()), nil
+	}
+
+	s := &recordingServer{}
+	RegisterService(s, sd, handler, RegisterServiceOptions{
+		NewMessage:       newTestMessage,
+		StringSanitizers: []runtime.StringSanitizer{runtime.StripControlCharacters},
+	})
+	result, err := s.handlers["testdata_TestService_CreateItem"](context.Background(), &runtime.CallToolRequest{
+		Arguments: map[string]any{"name": "a\x00b"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeFalse())
+	g.Expect(got).To(Equal("ab"))
+}
+
+func TestRegisterService_Tenant(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("AnnotatedService")
+	var org string
+	handler := func(ctx context.Context, method protoreflect.MethodDescriptor, req proto.Message) (proto.Message, error) {
+		org = req.ProtoReflect().Get(method.Input().Fields().ByName("organization_id")).String()
+		return newTestMessage(method.Output()), nil
+	}
+
+	s := &recordingServer{}
+	RegisterService(s, sd, handler, RegisterServiceOptions{
+		NewMessage: newTestMessage,
+		Tenant: &runtime.Tenant{
+			Resolve:      runtime.TenantFromArgument("org"),
+			ContextField: "tenant",
+		},
+	})
+	result, err := s.handlers["testdata_AnnotatedService_ApplyConfig"](context.Background(), &runtime.CallToolRequest{
+		Arguments: map[string]any{"org": "org-1", "name": "p"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeFalse(), result.Text)
+	g.Expect(org).To(Equal("org-1"))
+}
+
+func TestRegisterService_UsageRecorder(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("TestService")
+	handler := func(ctx context.Context, method protoreflect.MethodDescriptor, req proto.Message) (proto.Message, error) {
+		return newTestMessage(method.Output()), nil
+	}
+
+	var recorded []runtime.Usage
+	s := &recordingServer{}
+	RegisterService(s, sd, handler, RegisterServiceOptions{
+		NewMessage: newTestMessage,
+		UsageRecorder: runtime.UsageRecorderFunc(func(_ context.Context, usage runtime.Usage) {
+			recorded = append(recorded, usage)
+		}),
+	})
+	_, err := s.handlers["testdata_TestService_GetItem"](context.Background(), &runtime.CallToolRequest{
+		Arguments: map[string]any{"id": "a"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(recorded).To(HaveLen(1))
+	g.Expect(recorded[0].Tool).To(Equal("testdata_TestService_GetItem"))
+	g.Expect(recorded[0].BytesIn).To(Equal(len(`{"id":"a"}`)))
+	g.Expect(recorded[0].BytesOut).To(BeNumerically(">", 0))
+}
+
+func TestRegisterService_DeadlineBudget(t *testing.T) {
+	g := NewWithT(t)
+	sd := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor().ParentFile().Services().ByName("TestService")
+	var remaining time.Duration
+	handler := func(ctx context.Context, method protoreflect.MethodDescriptor, req proto.Message) (proto.Message, error) {
+		deadline, _ := ctx.Deadline()
+		remaining = time.Until(deadline)
+		return newTestMessage(method.Output()), nil
+	}
+
+	s := &recordingServer{}
+	RegisterService(s, sd, handler, RegisterServiceOptions{
+		NewMessage:     newTestMessage,
+		DeadlineBudget: runtime.DeadlineBudget{Timeout: time.Minute, Reserve: 10 * time.Second},
+	})
+	_, err := s.handlers["testdata_TestService_GetItem"](context.Background(), &runtime.CallToolRequest{
+		Arguments: map[string]any{"id": "a"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(remaining).To(BeNumerically("~", 50*time.Second, time.Second))
+}
@@ -0,0 +1,334 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// DescriptorSource returns the current descriptors of the API a dynamic
+// server exposes, e.g. by reading the output of `buf build` or by fetching a
+// module from a schema registry.
+type DescriptorSource func(ctx context.Context) (*descriptorpb.FileDescriptorSet, error)
+
+// FileDescriptorSource reads a binary FileDescriptorSet from path.
+func FileDescriptorSource(path string) DescriptorSource {
+	return func(context.Context) (*descriptorpb.FileDescriptorSet, error) {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		fds := &descriptorpb.FileDescriptorSet{}
+		if err := proto.Unmarshal(b, fds); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		return fds, nil
+	}
+}
+
+// Reloader registers the services of a FileDescriptorSet with
+// RegisterService and swaps the tool set whenever a new version of the
+// descriptors is loaded, so API updates roll out without restarting agent
+// sessions. Each version is registered in full before any of it reaches the
+// server. Then its tools are added as one change (see runtime.ToolAdder),
+// replacing those of methods that are still there, the tools, prompts and
+// resources of removed methods are removed, and the completers in
+// RegisterServiceOptions.Completions are switched to the new version's.
+// Clients never see a tool missing during a swap, but may list the stale
+// ones until they are removed: with mark3labs that is a second
+// tools/list_changed notification, go-sdk coalesces them into one. With
+// mark3labs, create the server with server.WithToolCapabilities(true);
+// mcp-go v0.37 cannot remove resource templates.
+//
+// Watched resources are registered by the first load only. A Reloader is
+// safe for concurrent use.
+type Reloader struct {
+	s        runtime.MCPServer
+	handler  Handler
+	opts     RegisterServiceOptions
+	services []protoreflect.FullName
+
+	mu         sync.Mutex
+	digest     [sha256.Size]byte
+	registered registration
+	loaded     bool
+}
+
+// NewReloader returns a Reloader that registers the named services on s, or
+// every service of the descriptors if none are named. Nothing is registered
+// before the first Load.
+func NewReloader(s runtime.MCPServer, handler Handler, opts RegisterServiceOptions, services ...protoreflect.FullName) *Reloader {
+	return &Reloader{s: s, handler: handler, opts: opts, services: services}
+}
+
+// Load registers the services of fds, unless they are the ones loaded last,
+// and reports whether it did. The files of fds may come in any order;
+// imports missing from it are resolved from protoregistry.GlobalFiles. On
+// error the previous tool set stays in place.
+func (r *Reloader) Load(fds *descriptorpb.FileDescriptorSet) (bool, error) {
+	encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(fds)
+	if err != nil {
+		return false, err
+	}
+	digest := sha256.Sum256(encoded)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.loaded && digest == r.digest {
+		return false, nil
+	}
+	services, err := r.resolveServices(fds)
+	if err != nil {
+		return false, err
+	}
+
+	// Register into a throwaway server first: invalid options panic, and a
+	// half-registered tool set must not reach clients.
+	if err := r.check(services); err != nil {
+		return false, err
+	}
+
+	// Build the new version on the side, then apply it: the tools are added
+	// as one change, the stale ones removed, and the completers re-pointed.
+	opts := r.opts
+	if r.loaded {
+		opts.WatchHandler = nil
+	}
+	if r.opts.Completions != nil {
+		opts.Completions = runtime.NewCompletionRegistry()
+	}
+	staged := &staging{}
+	var registered registration
+	for _, sd := range services {
+		reg := registerService(staged, sd, r.handler, opts)
+		registered.tools = append(registered.tools, reg.tools...)
+		registered.prompts = append(registered.prompts, reg.prompts...)
+		registered.resources = append(registered.resources, reg.resources...)
+	}
+	staged.apply(r.s)
+	previous := r.registered
+	if stale := removed(previous.tools, registered.tools); len(stale) > 0 {
+		runtime.RemoveTools(r.s, stale...)
+	}
+	if stale := removed(previous.prompts, registered.prompts); len(stale) > 0 {
+		runtime.RemovePrompts(r.s, stale...)
+	}
+	if stale := removed(previous.resources, registered.resources); len(stale) > 0 {
+		runtime.RemoveResources(r.s, stale...)
+	}
+	r.opts.Completions.Replace(opts.Completions, removed(
+		slices.Concat(previous.tools, previous.prompts, previous.resources),
+		slices.Concat(registered.tools, registered.prompts, registered.resources))...)
+	r.digest, r.registered, r.loaded = digest, registered, true
+	return true, nil
+}
+
+// staging collects what registerService adds, for Load to apply to the
+// server once the whole version is registered.
+type staging struct {
+	tools []runtime.ServerTool
+	// rest adds the prompts and resources, in registration order.
+	rest []func(s runtime.MCPServer)
+}
+
+func (st *staging) AddTool(tool runtime.Tool, handler runtime.ToolHandler) {
+	st.tools = append(st.tools, runtime.ServerTool{Tool: tool, Handler: handler})
+}
+
+func (st *staging) AddPrompt(prompt runtime.Prompt, handler runtime.PromptHandler) {
+	st.rest = append(st.rest, func(s runtime.MCPServer) { runtime.AddPrompt(s, prompt, handler) })
+}
+
+func (st *staging) AddResource(resource runtime.Resource, handler runtime.ResourceHandler) {
+	st.rest = append(st.rest, func(s runtime.MCPServer) { runtime.AddResource(s, resource, handler) })
+}
+
+// apply adds the staged tools to s as one change, then the prompts and
+// resources.
+func (st *staging) apply(s runtime.MCPServer) {
+	runtime.AddTools(s, st.tools...)
+	for _, add := range st.rest {
+		add(s)
+	}
+}
+
+// removed returns the names of previous that are not in current.
+func removed(previous, current []string) []string {
+	var stale []string
+	for _, name := range previous {
+		if !slices.Contains(current, name) {
+			stale = append(stale, name)
+		}
+	}
+	return stale
+}
+
+// Tools returns the names of the tools registered by the last load.
+func (r *Reloader) Tools() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.registered.tools)
+}
+
+// Watch loads the descriptors from source right away and then every
+// interval, until ctx is done. Errors are passed to onError, which may be
+// nil, and leave the previous tool set in place.
+func (r *Reloader) Watch(ctx context.Context, source DescriptorSource, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		fds, err := source(ctx)
+		if err == nil {
+			_, err = r.Load(fds)
+		}
+		if err != nil && onError != nil && ctx.Err() == nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// resolveServices builds the files of fds and returns the services to
+// register.
+func (r *Reloader) resolveServices(fds *descriptorpb.FileDescriptorSet) ([]protoreflect.ServiceDescriptor, error) {
+	sorted, err := dependencyOrder(fds.GetFile())
+	if err != nil {
+		return nil, err
+	}
+	files := new(protoregistry.Files)
+	resolver := fallbackResolver{files}
+	var services []protoreflect.ServiceDescriptor
+	for _, fdp := range sorted {
+		if _, err := files.FindFileByPath(fdp.GetName()); err == nil {
+			continue
+		}
+		fd, err := protodesc.NewFile(fdp, resolver)
+		if err != nil {
+			return nil, err
+		}
+		if err := files.RegisterFile(fd); err != nil {
+			return nil, err
+		}
+		for i := 0; i < fd.Services().Len(); i++ {
+			sd := fd.Services().Get(i)
+			if len(r.services) == 0 || slices.Contains(r.services, sd.FullName()) {
+				services = append(services, sd)
+			}
+		}
+	}
+	for _, name := range r.services {
+		if !slices.ContainsFunc(services, func(sd protoreflect.ServiceDescriptor) bool { return sd.FullName() == name }) {
+			return nil, fmt.Errorf("service %s not found in the descriptors", name)
+		}
+	}
+	return services, nil
+}
+
+// dependencyOrder sorts files so that each comes after the files it
+// imports. Imports that are not among files are left to the resolver.
+func dependencyOrder(files []*descriptorpb.FileDescriptorProto) ([]*descriptorpb.FileDescriptorProto, error) {
+	byName := make(map[string]*descriptorpb.FileDescriptorProto, len(files))
+	for _, f := range files {
+		byName[f.GetName()] = f
+	}
+	sorted := make([]*descriptorpb.FileDescriptorProto, 0, len(files))
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := map[string]int{}
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("import cycle at %s", name)
+		case done:
+			return nil
+		}
+		f, ok := byName[name]
+		if !ok {
+			return nil
+		}
+		state[name] = visiting
+		for _, dep := range f.GetDependency() {
+			if err := visit(dep); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+		state[name] = done
+		sorted = append(sorted, f)
+		return nil
+	}
+	for _, f := range files {
+		if err := visit(f.GetName()); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
+
+// check registers services on a server that only collects tools, and turns
+// a panic over invalid options into an error.
+func (r *Reloader) check(services []protoreflect.ServiceDescriptor) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("%v", p)
+		}
+	}()
+	opts := r.opts
+	opts.Completions, opts.Subscriptions, opts.WatchHandler = nil, nil, nil
+	discard := runtime.AddToolFunc(func(runtime.Tool, runtime.ToolHandler) {})
+	for _, sd := range services {
+		registerService(discard, sd, r.handler, opts)
+	}
+	return nil
+}
+
+// fallbackResolver resolves from files, and from protoregistry.GlobalFiles
+// what files lacks.
+type fallbackResolver struct {
+	files *protoregistry.Files
+}
+
+func (f fallbackResolver) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	if fd, err := f.files.FindFileByPath(path); err == nil {
+		return fd, nil
+	}
+	return protoregistry.GlobalFiles.FindFileByPath(path)
+}
+
+func (f fallbackResolver) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	if d, err := f.files.FindDescriptorByName(name); err == nil {
+		return d, nil
+	}
+	return protoregistry.GlobalFiles.FindDescriptorByName(name)
+}
@@ -0,0 +1,327 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/mcpoptions"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// toolSet is an MCPServer that keeps the tools registered on it.
+type toolSet struct {
+	mu    sync.Mutex
+	tools map[string]runtime.Tool
+}
+
+func (s *toolSet) AddTool(tool runtime.Tool, _ runtime.ToolHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tools[tool.Name] = tool
+}
+
+func (s *toolSet) RemoveTools(names ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, name := range names {
+		delete(s.tools, name)
+	}
+}
+
+func (s *toolSet) names() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var names []string
+	for name := range s.tools {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// changeLog is a toolSet that adds tools in batches and records each
+// change made to it.
+type changeLog struct {
+	toolSet
+	changes []string
+}
+
+func (s *changeLog) AddTools(tools ...runtime.ServerTool) {
+	var names []string
+	for _, t := range tools {
+		s.AddTool(t.Tool, t.Handler)
+		names = append(names, t.Tool.Name)
+	}
+	s.changes = append(s.changes, "add "+strings.Join(names, " "))
+}
+
+func (s *changeLog) RemoveTools(names ...string) {
+	s.toolSet.RemoveTools(names...)
+	s.changes = append(s.changes, "remove "+strings.Join(names, " "))
+}
+
+// catalog is a toolSet that also keeps the prompts and resources
+// registered on it.
+type catalog struct {
+	toolSet
+	prompts   map[string]bool
+	resources map[string]bool
+}
+
+func newCatalog() *catalog {
+	return &catalog{toolSet: toolSet{tools: map[string]runtime.Tool{}}, prompts: map[string]bool{}, resources: map[string]bool{}}
+}
+
+func (c *catalog) AddPrompt(prompt runtime.Prompt, _ runtime.PromptHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.prompts[prompt.Name] = true
+}
+
+func (c *catalog) RemovePrompts(names ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, name := range names {
+		delete(c.prompts, name)
+	}
+}
+
+func (c *catalog) AddResource(resource runtime.Resource, _ runtime.ResourceHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.resources[resource.URI] = true
+}
+
+func (c *catalog) RemoveResources(uris ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, uri := range uris {
+		delete(c.resources, uri)
+	}
+}
+
+// testServiceDescriptors returns test_service.proto and its dependencies in
+// dependency order, with edit applied to test_service.proto.
+func testServiceDescriptors(edit func(*descriptorpb.FileDescriptorProto)) *descriptorpb.FileDescriptorSet {
+	return descriptorsOf((&testdata.CreateItemRequest{}).ProtoReflect().Descriptor().ParentFile(), edit)
+}
+
+// descriptorsOf returns file and its dependencies in dependency order, with
+// edit applied to file.
+func descriptorsOf(file protoreflect.FileDescriptor, edit func(*descriptorpb.FileDescriptorProto)) *descriptorpb.FileDescriptorSet {
+	fds := &descriptorpb.FileDescriptorSet{}
+	seen := map[string]bool{}
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+		fdp := protodesc.ToFileDescriptorProto(fd)
+		if fd == file && edit != nil {
+			edit(fdp)
+		}
+		fds.File = append(fds.File, fdp)
+	}
+	add(file)
+	return fds
+}
+
+// withoutMethod removes the RPC name from TestService.
+func withoutMethod(name string) func(*descriptorpb.FileDescriptorProto) {
+	return func(fdp *descriptorpb.FileDescriptorProto) {
+		for _, sd := range fdp.Service {
+			for i, md := range sd.Method {
+				if md.GetName() == name {
+					sd.Method = append(sd.Method[:i], sd.Method[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestReloader(t *testing.T) {
+	all := []string{
+		"testdata_TestService_CreateItem",
+		"testdata_TestService_GetItem",
+		"testdata_TestService_ProcessWellKnownTypes",
+		"testdata_TestService_TestValidation",
+	}
+
+	t.Run("swaps the tool set", func(t *testing.T) {
+		g := NewWithT(t)
+		s := &toolSet{tools: map[string]runtime.Tool{}}
+		r := NewReloader(s, nil, RegisterServiceOptions{}, "testdata.TestService")
+
+		changed, err := r.Load(testServiceDescriptors(nil))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(changed).To(BeTrue())
+		g.Expect(s.names()).To(Equal(all))
+		g.Expect(r.Tools()).To(ConsistOf(all))
+
+		// The same descriptors again change nothing.
+		changed, err = r.Load(testServiceDescriptors(nil))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(changed).To(BeFalse())
+
+		changed, err = r.Load(testServiceDescriptors(withoutMethod("GetItem")))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(changed).To(BeTrue())
+		g.Expect(s.names()).To(Equal([]string{all[0], all[2], all[3]}))
+	})
+
+	t.Run("applies each version as one change", func(t *testing.T) {
+		g := NewWithT(t)
+		s := &changeLog{toolSet: toolSet{tools: map[string]runtime.Tool{}}}
+		r := NewReloader(s, nil, RegisterServiceOptions{}, "testdata.TestService")
+
+		_, err := r.Load(testServiceDescriptors(nil))
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = r.Load(testServiceDescriptors(withoutMethod("GetItem")))
+		g.Expect(err).ToNot(HaveOccurred())
+		// The new tools are all in place before the stale one goes.
+		g.Expect(s.changes).To(Equal([]string{
+			"add " + strings.Join(all, " "),
+			"add " + strings.Join([]string{all[0], all[2], all[3]}, " "),
+			"remove " + all[1],
+		}))
+	})
+
+	t.Run("accepts files in any order", func(t *testing.T) {
+		g := NewWithT(t)
+		s := &toolSet{tools: map[string]runtime.Tool{}}
+		r := NewReloader(s, nil, RegisterServiceOptions{}, "testdata.TestService")
+
+		// Move the files out of protoregistry.GlobalFiles' reach, so each
+		// import has to be built first.
+		fds := testServiceDescriptors(nil)
+		for _, fdp := range fds.File {
+			fdp.Name = proto.String("v2/" + fdp.GetName())
+			for i, dep := range fdp.Dependency {
+				fdp.Dependency[i] = "v2/" + dep
+			}
+		}
+		slices.Reverse(fds.File)
+		_, err := r.Load(fds)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(s.names()).To(Equal(all))
+	})
+
+	t.Run("swaps prompts, resources and completions", func(t *testing.T) {
+		g := NewWithT(t)
+		s := newCatalog()
+		completions := runtime.NewCompletionRegistry()
+		r := NewReloader(s, nil, RegisterServiceOptions{
+			SchemaOptions: SchemaOptions{Resources: true},
+			Completions:   completions,
+		}, "testdata.AnnotatedService")
+		file := annotatedService().ParentFile()
+
+		_, err := r.Load(descriptorsOf(file, nil))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(s.prompts).To(HaveKey("apply_from"))
+		g.Expect(s.resources).To(HaveKey("testdata://v1/{+name}"))
+		g.Expect(s.resources).To(HaveKey("configs://list"))
+		completer := func(ctx context.Context, value string, args map[string]string) ([]string, error) {
+			return []string{"configs/prod"}, nil
+		}
+		completions.Add("apply_from", "notes", completer)
+
+		// Drop GetConfig and the prompt of ApplyConfig.
+		_, err = r.Load(descriptorsOf(file, func(fdp *descriptorpb.FileDescriptorProto) {
+			withoutMethod("GetConfig")(fdp)
+			for _, md := range fdp.Service[0].Method {
+				if md.GetName() == "ApplyConfig" {
+					proto.ClearExtension(md.Options, mcpoptions.E_Method)
+				}
+			}
+		}))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(s.prompts).ToNot(HaveKey("apply_from"))
+		g.Expect(s.prompts).To(HaveKey("rollout"))
+		g.Expect(s.resources).ToNot(HaveKey("testdata://v1/{+name}"))
+		g.Expect(s.resources).To(HaveKey("configs://list"))
+		completion, err := completions.Complete(context.Background(), "apply_from", "notes", "", nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(completion.Values).To(BeEmpty())
+	})
+
+	t.Run("keeps the tool set on error", func(t *testing.T) {
+		g := NewWithT(t)
+		s := &toolSet{tools: map[string]runtime.Tool{}}
+		r := NewReloader(s, nil, RegisterServiceOptions{}, "testdata.TestService")
+		_, err := r.Load(testServiceDescriptors(nil))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = r.Load(testServiceDescriptors(func(fdp *descriptorpb.FileDescriptorProto) {
+			fdp.Service[0].Name = proto.String("RenamedService")
+		}))
+		g.Expect(err).To(MatchError("service testdata.TestService not found in the descriptors"))
+
+		_, err = r.Load(testServiceDescriptors(func(fdp *descriptorpb.FileDescriptorProto) {
+			fdp.Service[0].Method[0].InputType = proto.String(".testdata.Missing")
+		}))
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(s.names()).To(Equal(all))
+	})
+
+	t.Run("watches a descriptor file", func(t *testing.T) {
+		g := NewWithT(t)
+		path := filepath.Join(t.TempDir(), "api.binpb")
+		write := func(fds *descriptorpb.FileDescriptorSet) {
+			b, err := proto.Marshal(fds)
+			g.Expect(err).ToNot(HaveOccurred())
+			// Replace the file atomically so Watch never reads half of it.
+			g.Expect(os.WriteFile(path+".tmp", b, 0o644)).To(Succeed())
+			g.Expect(os.Rename(path+".tmp", path)).To(Succeed())
+		}
+		write(testServiceDescriptors(nil))
+
+		s := &toolSet{tools: map[string]runtime.Tool{}}
+		r := NewReloader(s, nil, RegisterServiceOptions{}, "testdata.TestService")
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			r.Watch(ctx, FileDescriptorSource(path), 10*time.Millisecond, func(err error) { t.Error(err) })
+			close(done)
+		}()
+		g.Eventually(s.names).Should(Equal(all))
+
+		write(testServiceDescriptors(withoutMethod("CreateItem")))
+		g.Eventually(s.names).Should(Equal(all[1:]))
+		cancel()
+		<-done
+	})
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// uriVariable matches a {name} or {+name} expression of a URI template.
+var uriVariable = regexp.MustCompile(`\{(\+?)([A-Za-z0-9_.]+)\}`)
+
+// httpVariable matches a {field} or {field=pattern} segment of a
+// google.api.http path.
+var httpVariable = regexp.MustCompile(`\{([A-Za-z0-9_.]+)(?:=([^}]*))?\}`)
+
+// ResourceURI returns the URI, or URI template, under which method is
+// exposed as an MCP resource, or "" if it is not. It is the
+// (mcp.method).resource_uri of the method, or with SchemaOptions.Resources
+// the URI derived from its google.api.http GET binding: the proto package as
+// scheme, then the path, where {field=pattern} becomes {+field} when the
+// pattern spans several segments. Every template variable must name a
+// singular scalar field of the request, possibly nested like {parent.id}.
+//
+// A server-streaming RPC is a watched resource: subscribing to it runs the
+// stream and every message it receives is an update; see
+// runtime.AddWatchedResource.
+func ResourceURI(method protoreflect.MethodDescriptor, opts SchemaOptions) (string, error) {
+	uri := methodOptions(method).GetResourceUri()
+	if uri == "" && opts.Resources {
+		uri = httpResourceURI(method)
+	}
+	if uri == "" {
+		return "", nil
+	}
+	errorf := func(format string, args ...any) error {
+		return errorOn(method, fmt.Errorf("%s: resource URI %q: %s", method.FullName(), uri, fmt.Sprintf(format, args...)))
+	}
+	if method.IsStreamingClient() {
+		return "", errorf("client-streaming RPCs cannot be read as resources")
+	}
+	u, err := url.Parse(uriVariable.ReplaceAllString(uri, "x"))
+	if err != nil || u.Scheme == "" {
+		return "", errorf("not an absolute URI")
+	}
+	for _, m := range uriVariable.FindAllStringSubmatch(uri, -1) {
+		if err := checkURIVariable(method.Input(), m[2]); err != nil {
+			return "", errorf("%v", err)
+		}
+	}
+	return uri, nil
+}
+
+// httpResourceURI derives a resource URI template from the google.api.http
+// GET binding of method, or returns "" if it has none.
+func httpResourceURI(method protoreflect.MethodDescriptor) string {
+	opts := method.Options()
+	if opts == nil || !proto.HasExtension(opts, annotations.E_Http) {
+		return ""
+	}
+	rule, _ := proto.GetExtension(opts, annotations.E_Http).(*annotations.HttpRule)
+	path := rule.GetGet()
+	if path == "" {
+		return ""
+	}
+	path = httpVariable.ReplaceAllStringFunc(path, func(v string) string {
+		m := httpVariable.FindStringSubmatch(v)
+		if strings.Contains(m[2], "/") || strings.Contains(m[2], "**") {
+			return "{+" + m[1] + "}"
+		}
+		return "{" + m[1] + "}"
+	})
+	scheme := uriScheme(string(method.ParentFile().Package()))
+	return scheme + "://" + strings.TrimPrefix(path, "/")
+}
+
+// uriScheme turns a proto package into a URI scheme. RFC 3986 allows only
+// letters, digits, "+", "-" and "." after a leading letter, so the package
+// is lowercased, every other character becomes "-" and leading ones are
+// dropped.
+func uriScheme(pkg string) string {
+	scheme := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '+', r == '-', r == '.':
+			return r
+		}
+		return '-'
+	}, strings.ToLower(pkg))
+	return strings.TrimLeft(scheme, "-")
+}
+
+// HTTPBinding returns the google.api.http binding of method, and whether it
+// has one. Additional bindings are ignored.
+func HTTPBinding(method protoreflect.MethodDescriptor) (runtime.HTTPBinding, bool) {
+	opts := method.Options()
+	if opts == nil || !proto.HasExtension(opts, annotations.E_Http) {
+		return runtime.HTTPBinding{}, false
+	}
+	rule, _ := proto.GetExtension(opts, annotations.E_Http).(*annotations.HttpRule)
+	binding := runtime.HTTPBinding{Body: rule.GetBody(), ResponseBody: rule.GetResponseBody()}
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		binding.Method, binding.Path = http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Put:
+		binding.Method, binding.Path = http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Post:
+		binding.Method, binding.Path = http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Delete:
+		binding.Method, binding.Path = http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Patch:
+		binding.Method, binding.Path = http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Custom:
+		binding.Method, binding.Path = pattern.Custom.GetKind(), pattern.Custom.GetPath()
+	}
+	return binding, binding.Path != ""
+}
+
+// checkURIVariable reports whether path names a singular scalar field of md.
+func checkURIVariable(md protoreflect.MessageDescriptor, path string) error {
+	segments := strings.Split(path, ".")
+	for i, segment := range segments {
+		fd := md.Fields().ByName(protoreflect.Name(segment))
+		if fd == nil {
+			return fmt.Errorf("variable {%s}: %s has no field %q", path, md.FullName(), segment)
+		}
+		if fd.IsList() || fd.IsMap() {
+			return fmt.Errorf("variable {%s}: field %q is not singular", path, segment)
+		}
+		if i == len(segments)-1 {
+			if fd.Message() != nil {
+				return fmt.Errorf("variable {%s}: field %q is a message", path, segment)
+			}
+			return nil
+		}
+		if fd.Message() == nil {
+			return fmt.Errorf("variable {%s}: field %q is not a message", path, segment)
+		}
+		md = fd.Message()
+	}
+	return nil
+}
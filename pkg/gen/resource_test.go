@@ -0,0 +1,306 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/mcpoptions"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestResourceURI(t *testing.T) {
+	g := NewWithT(t)
+	sd := annotatedService()
+
+	uri, err := ResourceURI(sd.Methods().ByName("ListConfigs"), SchemaOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(uri).To(Equal("configs://list"))
+
+	// HTTP bindings are only exposed when asked for.
+	uri, err = ResourceURI(sd.Methods().ByName("GetConfig"), SchemaOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(uri).To(BeEmpty())
+	uri, err = ResourceURI(sd.Methods().ByName("GetConfig"), SchemaOptions{Resources: true})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(uri).To(Equal("testdata://v1/{+name}"))
+
+	uri, err = ResourceURI(sd.Methods().ByName("ApplyConfig"), SchemaOptions{Resources: true})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(uri).To(BeEmpty())
+}
+
+func TestHTTPBinding(t *testing.T) {
+	g := NewWithT(t)
+	sd := annotatedService()
+
+	binding, ok := HTTPBinding(sd.Methods().ByName("GetConfig"))
+	g.Expect(ok).To(BeTrue())
+	g.Expect(binding).To(Equal(runtime.HTTPBinding{Method: "GET", Path: "/v1/{name=configs/*}"}))
+
+	_, ok = HTTPBinding(sd.Methods().ByName("ApplyConfig"))
+	g.Expect(ok).To(BeFalse())
+}
+
+// resourceFixture builds a service "fixture.Svc" with a unary method "Get", a
+// server-streaming method "Watch" and a client-streaming method "Upload", all
+// annotated with uri. The request has a string "name", a repeated "tags", a
+// message "parent" with an "id" and an enum "kind".
+func resourceFixture(t *testing.T, uri string) protoreflect.ServiceDescriptor {
+	t.Helper()
+	methOpts := &descriptorpb.MethodOptions{}
+	proto.SetExtension(methOpts, mcpoptions.E_Method, &mcpoptions.MethodOptions{ResourceUri: uri})
+	field := func(name string, number int32, label descriptorpb.FieldDescriptorProto_Label, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    label.Enum(),
+			Type:     typ.Enum(),
+		}
+	}
+	parent := field("parent", 3, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE)
+	parent.TypeName = proto.String(".fixture.Parent")
+	kind := field("kind", 4, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, descriptorpb.FieldDescriptorProto_TYPE_ENUM)
+	kind.TypeName = proto.String(".fixture.Kind")
+
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("resource_fixture.proto"),
+		Package: proto.String("fixture"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Req"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("name", 1, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+				field("tags", 2, descriptorpb.FieldDescriptorProto_LABEL_REPEATED, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+				parent,
+				kind,
+			},
+		}, {
+			Name: proto.String("Parent"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("id", 1, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+			},
+		}},
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: proto.String("Kind"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("KIND_UNSPECIFIED"), Number: proto.Int32(0)},
+				{Name: proto.String("KIND_FILE"), Number: proto.Int32(1)},
+				{Name: proto.String("KIND_DIR"), Number: proto.Int32(2)},
+			},
+		}},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("Svc"),
+			Method: []*descriptorpb.MethodDescriptorProto{{
+				Name:       proto.String("Get"),
+				InputType:  proto.String(".fixture.Req"),
+				OutputType: proto.String(".fixture.Req"),
+				Options:    methOpts,
+			}, {
+				Name:            proto.String("Watch"),
+				InputType:       proto.String(".fixture.Req"),
+				OutputType:      proto.String(".fixture.Req"),
+				Options:         methOpts,
+				ServerStreaming: proto.Bool(true),
+			}, {
+				Name:            proto.String("Upload"),
+				InputType:       proto.String(".fixture.Req"),
+				OutputType:      proto.String(".fixture.Req"),
+				Options:         methOpts,
+				ClientStreaming: proto.Bool(true),
+			}},
+		}},
+	}
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatalf("building fixture: %v", err)
+	}
+	return fd.Services().Get(0)
+}
+
+func TestURIScheme(t *testing.T) {
+	g := NewWithT(t)
+	for pkg, want := range map[string]string{
+		"testdata":          "testdata",
+		"Acme.Billing.V1":   "acme.billing.v1",
+		"my_company.api.v1": "my-company.api.v1",
+		"_internal.v1":      "internal.v1",
+	} {
+		scheme := uriScheme(pkg)
+		g.Expect(scheme).To(Equal(want), pkg)
+		u, err := url.Parse(scheme + "://v1/configs")
+		g.Expect(err).ToNot(HaveOccurred(), pkg)
+		g.Expect(u.Scheme).To(Equal(want), pkg)
+	}
+}
+
+func TestResourceURI_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		uri  string
+		err  string
+	}{
+		{"relative", "items/{name}", "not an absolute URI"},
+		{"unknown field", "items://{nope}", `fixture.Req has no field "nope"`},
+		{"repeated field", "items://{tags}", `field "tags" is not singular`},
+		{"message field", "items://{parent}", `field "parent" is a message`},
+		{"through scalar", "items://{name.id}", `field "name" is not a message`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := ResourceURI(resourceFixture(t, tt.uri).Methods().ByName("Get"), SchemaOptions{})
+			g.Expect(err).To(MatchError(ContainSubstring(tt.err)))
+		})
+	}
+
+	g := NewWithT(t)
+	method := resourceFixture(t, "items://{parent.id}/{name}").Methods()
+	uri, err := ResourceURI(method.ByName("Get"), SchemaOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(uri).To(Equal("items://{parent.id}/{name}"))
+	uri, err = ResourceURI(method.ByName("Watch"), SchemaOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(uri).To(Equal("items://{parent.id}/{name}"))
+	_, err = ResourceURI(method.ByName("Upload"), SchemaOptions{})
+	g.Expect(err).To(MatchError(ContainSubstring("client-streaming RPCs cannot be read")))
+}
+
+// resourceServer records tools and resources.
+type resourceServer struct {
+	recordingServer
+	resources map[string]runtime.ResourceHandler
+}
+
+func (r *resourceServer) AddResource(resource runtime.Resource, handler runtime.ResourceHandler) {
+	if r.resources == nil {
+		r.resources = map[string]runtime.ResourceHandler{}
+	}
+	r.resources[resource.URI] = handler
+}
+
+func TestRegisterService_Resources(t *testing.T) {
+	g := NewWithT(t)
+	var got proto.Message
+	handler := func(_ context.Context, method protoreflect.MethodDescriptor, req proto.Message) (proto.Message, error) {
+		got = req
+		return &testdata.Config{Name: "configs/prod"}, nil
+	}
+	srv := &resourceServer{}
+	RegisterService(srv, annotatedService(), handler, RegisterServiceOptions{
+		SchemaOptions: SchemaOptions{Resources: true},
+	})
+	g.Expect(srv.resources).To(HaveKey("configs://list"))
+	g.Expect(srv.resources).To(HaveKey("testdata://v1/{+name}"))
+
+	result, err := srv.resources["testdata://v1/{+name}"](context.Background(), &runtime.ReadResourceRequest{URI: "testdata://v1/configs/prod"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got.ProtoReflect().Get(got.ProtoReflect().Descriptor().Fields().ByName("name")).String()).To(Equal("configs/prod"))
+	g.Expect(result.Contents).To(Equal([]runtime.ResourceContents{{
+		URI:      "testdata://v1/configs/prod",
+		MIMEType: "application/json",
+		Text:     `{"name":"configs/prod"}`,
+	}}))
+
+	_, err = srv.resources["testdata://v1/{+name}"](context.Background(), &runtime.ReadResourceRequest{URI: "other://x"})
+	g.Expect(err).To(MatchError(ContainSubstring("does not match")))
+
+	// Reads share the arguments pipeline of the tool.
+	srv = &resourceServer{}
+	RegisterService(srv, annotatedService(), handler, RegisterServiceOptions{
+		SchemaOptions: SchemaOptions{Resources: true},
+		StringSanitizers: []runtime.StringSanitizer{func(fd protoreflect.FieldDescriptor, value string) (string, error) {
+			return strings.ToUpper(value), nil
+		}},
+	})
+	_, err = srv.resources["testdata://v1/{+name}"](context.Background(), &runtime.ReadResourceRequest{URI: "testdata://v1/configs/prod"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got.ProtoReflect().Get(got.ProtoReflect().Descriptor().Fields().ByName("name")).String()).To(Equal("CONFIGS/PROD"))
+}
+
+func TestRegisterService_WatchedResources(t *testing.T) {
+	g := NewWithT(t)
+	handler := func(context.Context, protoreflect.MethodDescriptor, proto.Message) (proto.Message, error) {
+		return nil, nil
+	}
+	watch := func(ctx context.Context, method protoreflect.MethodDescriptor, req proto.Message, update runtime.ResourceUpdate) error {
+		name := req.ProtoReflect().Get(req.ProtoReflect().Descriptor().Fields().ByName("name")).String()
+		update(&testdata.Config{Name: name})
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	// Without a WatchHandler, streaming RPCs are skipped.
+	srv := &resourceServer{}
+	RegisterService(srv, annotatedService(), handler, RegisterServiceOptions{})
+	g.Expect(srv.resources).ToNot(HaveKey("configs://watch/{+name}"))
+
+	srv = &resourceServer{}
+	subscriptions := runtime.NewSubscriptionRegistry()
+	RegisterService(srv, annotatedService(), handler, RegisterServiceOptions{
+		WatchHandler:  watch,
+		Subscriptions: subscriptions,
+	})
+	g.Expect(srv.resources).To(HaveKey("configs://watch/{+name}"))
+
+	result, err := srv.resources["configs://watch/{+name}"](context.Background(), &runtime.ReadResourceRequest{URI: "configs://watch/configs/prod"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Contents[0].Text).To(Equal(`{"name":"configs/prod"}`))
+
+	updated := make(chan string, 1)
+	subscriptions.OnUpdate(func(_ context.Context, uri string) { updated <- uri })
+	g.Expect(subscriptions.Subscribe(context.Background(), "configs://watch/configs/dev", "session")).To(Succeed())
+	g.Eventually(updated).Should(Receive(Equal("configs://watch/configs/dev")))
+	subscriptions.Unsubscribe("configs://watch/configs/dev", "session")
+}
+
+func TestResourceCompletions(t *testing.T) {
+	g := NewWithT(t)
+	uri := "items://{kind}/{name}/{+kind}"
+	g.Expect(ResourceCompletions(resourceFixture(t, uri).Methods().ByName("Get"), uri, SchemaOptions{})).To(Equal([]string{"kind"}))
+	uri = "items://{parent.id}/{name}"
+	g.Expect(ResourceCompletions(resourceFixture(t, uri).Methods().ByName("Get"), uri, SchemaOptions{})).To(BeEmpty())
+}
+
+func TestRegisterService_ResourceCompletions(t *testing.T) {
+	g := NewWithT(t)
+	reg := runtime.NewCompletionRegistry()
+	handler := func(context.Context, protoreflect.MethodDescriptor, proto.Message) (proto.Message, error) {
+		return nil, nil
+	}
+	RegisterService(&resourceServer{}, resourceFixture(t, "items://{kind}/{name}"), handler, RegisterServiceOptions{
+		NamePrefix:  "ops",
+		Completions: reg,
+	})
+
+	// The resource template completes its variables like the tool's arguments.
+	got, err := reg.Complete(context.Background(), "items://{kind}/{name}", "kind", "kind_d", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got.Values).To(Equal([]string{"KIND_DIR"}))
+	got, err = reg.Complete(context.Background(), "items://{kind}/{name}", "name", "", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got.Values).To(BeEmpty())
+}
@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"slices"
 	"strings"
 
 	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
@@ -39,6 +40,162 @@ type SchemaOptions struct {
 	// (though it's not strictly enforced), so the default keeps total depth
 	// manageable while still giving LLMs useful field-level detail.
 	MaxRecursionDepth int
+
+	// MessageSchemas maps message full names to literal JSON Schema objects
+	// used verbatim wherever that message appears, including well-known types.
+	// It lets an organization centrally pin how shared types render across
+	// every generated tool, and takes precedence over (mcp.message).schema.
+	// Use ParseMessageSchemas to load and validate a mapping file.
+	MessageSchemas map[protoreflect.FullName]json.RawMessage
+
+	// MessageTransforms adjust the schema of every message as it is
+	// generated, in order, to encode house rules centrally; see
+	// MessageTransform.
+	MessageTransforms []MessageTransform
+
+	// ExcludeFields holds path.Match patterns of the full names of fields
+	// removed from input schemas like (mcp.field).exclude, e.g.
+	// "*.internal_*". "*" also matches dots.
+	ExcludeFields []string
+
+	// output is set for output schemas, which keep excluded fields and drop
+	// the ones excluded from results.
+	output bool
+
+	// ExamplesInDescription additionally appends (mcp.field).example values to
+	// the field description, for providers that drop the "examples" keyword.
+	ExamplesInDescription bool
+
+	// Titles derives a "title" keyword for every field from its name
+	// (resource_id -> "Resource Id") and a tool title from the method name
+	// (CreateItem -> "Create Item"), for clients that render form-style UIs.
+	// Titles set via (mcp.field).title and (mcp.method).title are emitted
+	// regardless.
+	Titles bool
+
+	// MaxToolDescriptionBytes caps tool descriptions; longer ones are cut at a
+	// sentence boundary by TruncateDescription. Zero means no limit.
+	MaxToolDescriptionBytes int
+
+	// MaxFieldDescriptionBytes caps the "description" of each field schema the
+	// same way. Zero means no limit.
+	MaxFieldDescriptionBytes int
+
+	// FieldComments selects which proto comments of a field become its schema
+	// "description". Comments are only available when the descriptors carry
+	// source info, as they do inside protoc plugins. The zero value includes
+	// none.
+	FieldComments FieldComments
+
+	// Markdown controls how markdown and HTML in comments is rewritten for
+	// tool and field descriptions. The zero value keeps it as written.
+	Markdown MarkdownMode
+
+	// CommentDirectives lists extra line prefixes, beyond
+	// DefaultCommentDirectives, whose comment lines are dropped.
+	CommentDirectives []string
+
+	// DeprecatedFields controls how fields marked [deprecated = true] render.
+	// The zero value renders them like any other field.
+	DeprecatedFields DeprecatedFields
+
+	// Draft selects the JSON Schema dialect the tool schemas declare and
+	// follow. The zero value emits no "$schema" keyword, which MCP treats as
+	// 2020-12, and leaves the keywords of schema fragments as written.
+	Draft SchemaDraft
+
+	// WrapInput nests the request schema under a single required top-level
+	// property of this name, leaving the top level for siblings such as extra
+	// properties and headers. Handlers unwrap it with runtime.UnwrapArguments.
+	// Empty keeps the request fields at the top level.
+	WrapInput string
+
+	// FlattenNested replaces each top-level message field whose own fields
+	// are all scalars, enums or lists of those with dotted "field.subfield"
+	// properties, for models that fill flat argument lists more reliably
+	// than nested objects. Handlers reassemble the objects with
+	// runtime.UnflattenArguments.
+	FlattenNested bool
+
+	// RelativeTimes lets the model write every google.protobuf.Timestamp
+	// and Duration field the way (mcp.field).relative_time allows, and
+	// describes those forms in place of the strict format. Handlers
+	// normalize them with runtime.NormalizeTimes.
+	RelativeTimes bool
+
+	// DryRun adds an optional "dry_run" boolean to the tools of methods that
+	// may have side effects; see DryRunSupported.
+	DryRun bool
+
+	// Resources also exposes RPCs with a google.api.http GET binding as MCP
+	// resources; see ResourceURI.
+	Resources bool
+
+	// OpenAIStrict renders tool input schemas in the subset of JSON Schema
+	// OpenAI accepts with strict function calling: objects are closed and
+	// list every property as required, optional fields are nullable, maps
+	// are lists of key/value entries, dynamic well-known types are
+	// JSON-encoded strings and unsupported keywords are dropped. Check the
+	// result with ValidateOpenAIStrict.
+	OpenAIStrict bool
+
+	// Minify drops what only helps humans reading the schemas, for a
+	// smaller tools/list payload: "$comment" keywords, empty "required"
+	// arrays outside of OpenAI strict mode, which requires them, and runs of
+	// whitespace in descriptions.
+	Minify bool
+
+	// DedupeDescriptions keeps the descriptions within a message schema
+	// only at its first occurrence in a tool schema, and elides them at the
+	// others, cutting the tokens of messages that occur many times.
+	DedupeDescriptions bool
+}
+
+// SchemaDraft identifies a JSON Schema dialect.
+type SchemaDraft string
+
+const (
+	// Draft07 targets JSON Schema draft-07, for validators and providers that
+	// predate 2019-09.
+	Draft07 SchemaDraft = "draft-07"
+	// Draft202012 targets JSON Schema 2020-12, the MCP default dialect.
+	Draft202012 SchemaDraft = "2020-12"
+)
+
+// ParseSchemaDraft parses a dialect name as accepted by the schema_draft
+// plugin option. The empty string selects the unset default.
+func ParseSchemaDraft(s string) (SchemaDraft, error) {
+	switch d := SchemaDraft(strings.TrimPrefix(s, "draft-")); d {
+	case "":
+		return "", nil
+	case "07", "7":
+		return Draft07, nil
+	case Draft202012:
+		return Draft202012, nil
+	default:
+		return "", fmt.Errorf("unsupported JSON Schema draft %q; want %q or %q", s, Draft07, Draft202012)
+	}
+}
+
+// URI returns the "$schema" meta-schema URI of the dialect, or "" if unset.
+func (d SchemaDraft) URI() string {
+	switch d {
+	case Draft07:
+		return "http://json-schema.org/draft-07/schema#"
+	case Draft202012:
+		return "https://json-schema.org/draft/2020-12/schema"
+	default:
+		return ""
+	}
+}
+
+// DefinitionsKeyword returns the keyword that holds reusable subschemas in
+// the dialect: "definitions" for draft-07, "$defs" otherwise.
+func (d SchemaDraft) DefinitionsKeyword() string {
+	if d == Draft07 {
+		return "definitions"
+	}
+	return "$defs"
 }
 
 // DiscriminatorKey is the property name of the oneof discriminator emitted in
@@ -65,6 +222,9 @@ const defaultMaxRecursionDepth = runtime.DefaultMaxRecursionDepth
 // expanded on the current recursion path. After MaxRecursionDepth expansions,
 // a JSON-string placeholder is emitted instead of a full schema.
 func messageSchema(md protoreflect.MessageDescriptor, opts SchemaOptions, seen map[protoreflect.FullName]int) map[string]any {
+	if override, ok := messageSchemaOverride(md, opts); ok {
+		return override
+	}
 	if seen == nil {
 		seen = make(map[protoreflect.FullName]int)
 	}
@@ -91,6 +251,15 @@ func messageSchema(md protoreflect.MessageDescriptor, opts SchemaOptions, seen m
 	for i := 0; i < md.Fields().Len(); i++ {
 		nestedFd := md.Fields().Get(i)
 		name := string(nestedFd.Name())
+		if opts.DeprecatedFields == DeprecatedFieldsOmit && fieldDeprecated(nestedFd) {
+			continue
+		}
+		if !opts.output && FieldExcluded(nestedFd, opts) {
+			continue
+		}
+		if opts.output && fieldOptions(nestedFd).GetExcludeFromResult() {
+			continue
+		}
 
 		if oneof := nestedFd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
 			// A member literally named "which" would collide with the
@@ -107,13 +276,17 @@ func messageSchema(md protoreflect.MessageDescriptor, opts SchemaOptions, seen m
 				oneofMembers[string(oneof.Name())] = members
 			}
 			memberSchema := fieldSchema(nestedFd, opts, seen)
-			memberSchema["description"] = fmt.Sprintf("The value when %s=%q.", DiscriminatorKey, name)
+			memberSchema["description"] = joinDescription(
+				fmt.Sprintf("The value when %s=%q.", DiscriminatorKey, name),
+				fieldComment(nestedFd, opts),
+			)
 			members.set(name, memberSchema)
 			continue
 		}
 
 		normalFields[name] = fieldSchema(nestedFd, opts, seen)
-		if IsFieldRequired(nestedFd) {
+		// A field with a default is filled in server-side when omitted.
+		if IsFieldRequired(nestedFd) && fieldDefault(nestedFd) == nil {
 			required = append(required, name)
 		}
 	}
@@ -144,7 +317,7 @@ func messageSchema(md protoreflect.MessageDescriptor, opts SchemaOptions, seen m
 			props.set(k, members.vals[k])
 		}
 
-		normalFields[name] = map[string]any{
+		wrapper := map[string]any{
 			"type": "object",
 			"description": fmt.Sprintf(
 				"Exactly one of the %q group. Set %q to the chosen field name, then set only that field.",
@@ -153,18 +326,34 @@ func messageSchema(md protoreflect.MessageDescriptor, opts SchemaOptions, seen m
 			"properties": props,
 			"required":   []string{DiscriminatorKey},
 		}
+		if opts.Titles {
+			wrapper["title"] = TitleFromSnakeCase(name)
+		}
+		normalFields[name] = wrapper
 		if oneofRequired(oo) {
 			required = append(required, name)
 		}
 	}
 
-	return map[string]any{
+	schema := map[string]any{
 		"type":       "object",
 		"properties": normalFields,
 		"required":   required,
 	}
+	for _, transform := range opts.MessageTransforms {
+		transform(md, schema)
+	}
+	return schema
 }
 
+// MessageTransform adjusts the schema generated for md in place, e.g. to
+// inject descriptions or drop subtrees. Its "properties" hold the field
+// schemas by proto field name, and "required" is a []string. It is not
+// called for messages with a schema override, well-known types, or
+// messages beyond the recursion depth. The handlers decode arguments with
+// protojson, so a renamed field must take its JSON name.
+type MessageTransform func(md protoreflect.MessageDescriptor, schema map[string]any)
+
 // oneofRequired reports whether a oneof carries (buf.validate.oneof).required.
 func oneofRequired(oo protoreflect.OneofDescriptor) bool {
 	opts := oo.Options()
@@ -192,6 +381,14 @@ func (o *orderedMap) set(k string, v any) {
 	o.vals[k] = v
 }
 
+func (o *orderedMap) delete(k string) {
+	if _, ok := o.vals[k]; !ok {
+		return
+	}
+	delete(o.vals, k)
+	o.keys = slices.DeleteFunc(o.keys, func(key string) bool { return key == k })
+}
+
 func (o *orderedMap) MarshalJSON() ([]byte, error) {
 	var b strings.Builder
 	b.WriteByte('{')
@@ -222,6 +419,47 @@ func FieldSchema(fd protoreflect.FieldDescriptor, opts SchemaOptions) map[string
 
 // fieldSchema is the internal implementation that threads the seen set for cycle detection.
 func fieldSchema(fd protoreflect.FieldDescriptor, opts SchemaOptions, seen map[protoreflect.FullName]int) map[string]any {
+	// An (mcp.field).schema fragment replaces everything below, including the
+	// array/object wrapping of repeated and map fields.
+	if override, ok := fieldSchemaOverride(fd); ok {
+		return override
+	}
+	schema := fieldValueSchema(fd, opts, seen)
+	if comment := fieldComment(fd, opts); comment != "" {
+		existing, _ := schema["description"].(string)
+		schema["description"] = joinDescription(comment, existing)
+	}
+	if opts.DeprecatedFields == DeprecatedFieldsAnnotate && fieldDeprecated(fd) {
+		schema["deprecated"] = true
+		existing, _ := schema["description"].(string)
+		schema["description"] = joinDescription(deprecationNote(fd), existing)
+	}
+	// A tighter buf.validate bound still wins; values above max are clamped.
+	// 64-bit integers are JSON strings, which "maximum" does not constrain,
+	// so their bound goes in the description.
+	if max := fieldOptions(fd).GetMax(); max > 0 {
+		if KindToType(fd.Kind()) == "string" {
+			existing, _ := schema["description"].(string)
+			schema["description"] = joinDescription(existing, fmt.Sprintf("At most %d; larger values are capped.", max))
+		} else if cur, ok := schema["maximum"].(int); !ok || int64(cur) > max {
+			schema["maximum"] = int(max)
+		}
+	}
+	if desc, ok := schema["description"].(string); ok {
+		schema["description"] = TruncateDescription(desc, opts.MaxFieldDescriptionBytes, string(fd.FullName()))
+	}
+	if title := fieldTitle(fd, opts); title != "" {
+		schema["title"] = title
+	}
+	if def := fieldDefault(fd); def != nil {
+		schema["default"] = def
+	}
+	return schema
+}
+
+// fieldValueSchema renders the schema of a field's value, wrapping repeated
+// fields in an array and map fields in an object.
+func fieldValueSchema(fd protoreflect.FieldDescriptor, opts SchemaOptions, seen map[protoreflect.FullName]int) map[string]any {
 	if fd.IsMap() {
 		return mapFieldSchema(fd, opts, seen)
 	}
@@ -230,7 +468,11 @@ func fieldSchema(fd protoreflect.FieldDescriptor, opts SchemaOptions, seen map[p
 
 	switch fd.Kind() {
 	case protoreflect.MessageKind:
-		schema = messageFieldSchema(fd, opts, seen)
+		if fieldOptions(fd).GetJsonString() {
+			schema = jsonStringSchema(fd.Message())
+		} else {
+			schema = messageFieldSchema(fd, opts, seen)
+		}
 	case protoreflect.EnumKind:
 		schema = enumFieldSchema(fd)
 	default:
@@ -241,6 +483,7 @@ func fieldSchema(fd protoreflect.FieldDescriptor, opts SchemaOptions, seen map[p
 	for key, value := range constraints {
 		schema[key] = value
 	}
+	applyExamples(schema, fieldOptions(fd).GetExample(), opts)
 
 	if fd.IsList() {
 		return map[string]any{
@@ -251,6 +494,16 @@ func fieldSchema(fd protoreflect.FieldDescriptor, opts SchemaOptions, seen map[p
 	return schema
 }
 
+// jsonStringSchema is the schema of a message field annotated with
+// (mcp.field).json_string. It reads like a recursion placeholder, which the
+// runtime parses back the same way.
+func jsonStringSchema(md protoreflect.MessageDescriptor) map[string]any {
+	return map[string]any{
+		"type":        "string",
+		"description": fmt.Sprintf("JSON-encoded %s. Provide a JSON object as a string.", md.Name()),
+	}
+}
+
 func mapFieldSchema(fd protoreflect.FieldDescriptor, opts SchemaOptions, seen map[protoreflect.FullName]int) map[string]any {
 	keyType := fd.MapKey().Kind()
 	keyConstraints := map[string]any{"type": "string"}
@@ -264,20 +517,71 @@ func mapFieldSchema(fd protoreflect.FieldDescriptor, opts SchemaOptions, seen ma
 		keyConstraints["pattern"] = "^-?(0|[1-9]\\d*)$"
 	}
 
+	var value map[string]any
+	if fieldOptions(fd).GetJsonString() {
+		value = jsonStringSchema(fd.MapValue().Message())
+	} else {
+		value = fieldSchema(fd.MapValue(), opts, seen)
+	}
+	applyExamples(value, fieldOptions(fd).GetExample(), opts)
+
+	if opts.OpenAIStrict {
+		// Strict mode cannot describe an open-ended object; the runtime folds
+		// this entry list back into a map.
+		return map[string]any{
+			"type": "array",
+			"items": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"key":   keyConstraints,
+					"value": value,
+				},
+				"required": []string{"key", "value"},
+			},
+		}
+	}
 	return map[string]any{
 		"type":                 "object",
 		"propertyNames":        keyConstraints,
-		"additionalProperties": fieldSchema(fd.MapValue(), opts, seen),
+		"additionalProperties": value,
 	}
 }
 
 func messageFieldSchema(fd protoreflect.FieldDescriptor, opts SchemaOptions, seen map[protoreflect.FullName]int) map[string]any {
+	if override, ok := registeredMessageSchema(fd.Message(), opts); ok {
+		return override
+	}
 	fullName := string(fd.Message().FullName())
+	if opts.OpenAIStrict && runtime.IsDynamicWellKnownType(fd.Message()) {
+		// Strict mode cannot describe arbitrary JSON; the runtime parses
+		// the string back.
+		return map[string]any{
+			"type":        "string",
+			"description": fmt.Sprintf("JSON-encoded %s.", fullName),
+		}
+	}
 	switch fullName {
 	case "google.protobuf.Timestamp":
+		if opts.RelativeTimes || fieldOptions(fd).GetRelativeTime() {
+			return map[string]any{
+				"type":        []string{"string", "null"},
+				"description": `An RFC 3339 timestamp such as "2026-01-02T15:04:05Z", or without a time zone for UTC, a date, "now", or now plus or minus a duration such as "now-2h" or "now+15m".`,
+			}
+		}
 		return map[string]any{"type": []string{"string", "null"}, "format": "date-time"}
 	case "google.protobuf.Duration":
+		if opts.RelativeTimes || fieldOptions(fd).GetRelativeTime() {
+			return map[string]any{
+				"type":        []string{"string", "null"},
+				"description": `A duration such as "90s", "15m", "1h30m" or "2d".`,
+			}
+		}
 		return map[string]any{"type": []string{"string", "null"}, "pattern": `^-?[0-9]+(\.[0-9]+)?s$`}
+	// Models alternate between sending dynamic values natively and
+	// JSON-encoded as strings. The runtime parses such strings back, but
+	// only the untyped Value schema says so: the shapes stay plain so that
+	// clients keep recognizing them, and unions are avoided throughout, so a
+	// typed schema can't admit the string without contradicting itself.
 	case "google.protobuf.Struct":
 		return map[string]any{
 			"type":                 "object",
@@ -285,7 +589,7 @@ func messageFieldSchema(fd protoreflect.FieldDescriptor, opts SchemaOptions, see
 		}
 	case "google.protobuf.Value":
 		return map[string]any{
-			"description": "represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object).",
+			"description": "represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object). Arrays and objects JSON-encoded as a string are accepted too.",
 		}
 	case "google.protobuf.ListValue":
 		return map[string]any{
@@ -492,10 +796,20 @@ func ExtractValidateConstraints(fd protoreflect.FieldDescriptor) map[string]any
 	return constraints
 }
 
-// CleanComment removes tool-specific comment prefixes (buf:lint, @ignore-comment).
+// DefaultCommentDirectives are the line prefixes CleanComment drops: linter
+// and tooling directives that are never meant for end users.
+var DefaultCommentDirectives = []string{"buf:lint:", "@ignore-comment", "protolint:", "api-linter:", "nolint:"}
+
+// CleanComment removes tool-specific comment lines (buf:lint, @ignore-comment
+// and the rest of DefaultCommentDirectives).
 func CleanComment(comment string) string {
+	return cleanComment(comment, nil)
+}
+
+// cleanComment is CleanComment with additional directive prefixes.
+func cleanComment(comment string, extraDirectives []string) string {
 	var cleanedLines []string
-	strippedPrefixes := []string{"buf:lint:", "@ignore-comment"}
+	strippedPrefixes := append(append([]string(nil), DefaultCommentDirectives...), extraDirectives...)
 outer:
 	for _, line := range strings.Split(comment, "\n") {
 		trimmed := strings.TrimSpace(line)
@@ -544,27 +858,140 @@ func MangleHeadIfTooLong(name string, maxLen int) string {
 // ToolForMethod generates the MCP tool definition for a given RPC method
 // descriptor (input and output JSON schemas plus name and description).
 func ToolForMethod(method protoreflect.MethodDescriptor, comment string) runtime.Tool {
+	return ToolForMethodWithOptions(method, comment, SchemaOptions{})
+}
+
+// ToolForMethodWithOptions is ToolForMethod with explicit schema options.
+func ToolForMethodWithOptions(method protoreflect.MethodDescriptor, comment string, opts SchemaOptions) runtime.Tool {
 	toolName := MangleHeadIfTooLong(strings.ReplaceAll(string(method.FullName()), ".", "_"), 64)
-	description := CleanComment(comment)
+	description := TruncateDescription(FormatComment(comment, opts), opts.MaxToolDescriptionBytes, string(method.FullName()))
+	// Examples are appended whole, after truncating the comment. The plugin
+	// reports malformed ones up front; reaching one here panics.
+	examples, err := ToolExamples(method, opts)
+	if err != nil {
+		panic(fmt.Sprintf("protoc-gen-go-mcp: %v", err))
+	}
+	description = appendExamples(description, examples)
 
-	return runtime.Tool{
+	tool := runtime.Tool{
 		Name:            toolName,
 		Description:     description,
-		RawInputSchema:  marshalTopLevelSchema(method.Input(), SchemaOptions{}),
-		RawOutputSchema: marshalTopLevelSchema(method.Output(), SchemaOptions{}),
+		RawInputSchema:  marshalInputSchema(method.Input(), opts),
+		RawOutputSchema: marshalTopLevelSchema(method.Output(), outputOptions(opts)),
+		Title:           toolTitle(method, opts),
+		Tags:            DeclaredTags(method),
+	}
+	// Bake in the extra properties declared in proto. The plugin reports
+	// malformed declarations up front; reaching one here panics.
+	declared, err := DeclaredExtraProperties(method)
+	if err != nil {
+		panic(fmt.Sprintf("protoc-gen-go-mcp: %v", err))
+	}
+	tool = runtime.AddExtraPropertiesToTool(tool, declared)
+	if DryRunSupported(method, opts) {
+		tool = runtime.AddDryRunToTool(tool)
 	}
+	return tool
 }
 
 // marshalTopLevelSchema generates and marshals a JSON schema for a top-level
-// message (RPC input or output). It forces the top-level "type" to plain
-// "object" so the schema satisfies MCP's requirement even when the underlying
-// MessageSchema would emit a nullable type.
+// message (RPC input or output).
 func marshalTopLevelSchema(md protoreflect.MessageDescriptor, opts SchemaOptions) json.RawMessage {
+	return marshalSchema(MessageSchema(md, opts), opts)
+}
+
+// TakesNoArguments reports whether md is google.protobuf.Empty. Tools for
+// methods taking it get an explicitly empty, closed input schema, and their
+// handlers ignore any arguments the model sends anyway.
+func TakesNoArguments(md protoreflect.MessageDescriptor) bool {
+	return md.FullName() == "google.protobuf.Empty"
+}
+
+// marshalInputSchema is marshalTopLevelSchema for a tool's arguments. Fields
+// annotated with (mcp.field).from_context are filled in server-side, so they
+// are dropped from the schema entirely. An idempotency key stays, but is
+// optional.
+func marshalInputSchema(md protoreflect.MessageDescriptor, opts SchemaOptions) json.RawMessage {
+	if TakesNoArguments(md) {
+		// "additionalProperties": false keeps the model from inventing
+		// parameters and makes the schema valid under strict tool calling;
+		// extra properties added later follow the strict rules too. There is
+		// nothing to wrap, so WrapInput does not apply.
+		return marshalSchema(map[string]any{
+			"properties":           map[string]any{},
+			"required":             []string{},
+			"additionalProperties": false,
+		}, opts)
+	}
 	schema := MessageSchema(md, opts)
+	if props, ok := schema["properties"].(map[string]any); ok {
+		required, _ := schema["required"].([]string)
+		for i := 0; i < md.Fields().Len(); i++ {
+			fd := md.Fields().Get(i)
+			if fieldOptions(fd).GetFromContext() == "" {
+				continue
+			}
+			delete(props, string(fd.Name()))
+			required = slices.DeleteFunc(required, func(name string) bool { return name == string(fd.Name()) })
+		}
+		// The handler derives an idempotency key the model leaves out.
+		if fd := runtime.IdempotencyKeyField(md); fd != nil {
+			if prop, ok := props[string(fd.Name())].(map[string]any); ok {
+				existing, _ := prop["description"].(string)
+				prop["description"] = joinDescription(existing, "Leave unset to derive it from the other arguments, so that retries of this call reuse it.")
+				required = slices.DeleteFunc(required, func(name string) bool { return name == string(fd.Name()) })
+			}
+		}
+		if required != nil {
+			schema["required"] = required
+		}
+	}
+	if opts.FlattenNested {
+		flattenNested(schema)
+	}
+	if opts.WrapInput != "" {
+		schema["type"] = "object"
+		schema = map[string]any{
+			"properties": map[string]any{opts.WrapInput: schema},
+			"required":   []string{opts.WrapInput},
+		}
+	}
+	if opts.OpenAIStrict {
+		strictSchema(schema)
+	}
+	return marshalSchema(schema, opts)
+}
+
+// outputOptions returns opts for rendering output schemas, which describe
+// what EncodeMessage returns and so never use the strict input shapes. They
+// keep excluded fields and drop the ones excluded from results.
+func outputOptions(opts SchemaOptions) SchemaOptions {
+	opts.OpenAIStrict = false
+	opts.output = true
+	return opts
+}
+
+// marshalSchema marshals a top-level message schema. It forces "type" to plain
+// "object" so the schema satisfies MCP's requirement even when the underlying
+// MessageSchema would emit a nullable type, and declares and follows the
+// selected draft.
+func marshalSchema(schema map[string]any, opts SchemaOptions) json.RawMessage {
 	schema["type"] = "object"
+	if uri := opts.Draft.URI(); uri != "" {
+		opts.Draft.translateSchema(schema)
+		schema["$schema"] = uri
+	}
+	if opts.Minify {
+		minifySchema(schema, opts.OpenAIStrict)
+	}
 	marshaled, err := json.Marshal(schema)
 	if err != nil {
 		panic(err)
 	}
+	if opts.DedupeDescriptions {
+		if marshaled, err = dedupeDescriptions(marshaled); err != nil {
+			panic(err)
+		}
+	}
 	return json.RawMessage(marshaled)
 }
@@ -148,6 +148,9 @@ func TestMessageFieldSchema_WellKnownTypes_Standard(t *testing.T) {
 		{"struct_field", func(g Gomega, s map[string]any) {
 			g.Expect(s["type"]).To(Equal("object"))
 			g.Expect(s["additionalProperties"]).To(Equal(true))
+			// The object type doesn't admit a string, so the schema doesn't
+			// claim one is accepted.
+			g.Expect(s).ToNot(HaveKey("description"))
 		}},
 		{"value_field", func(g Gomega, s map[string]any) {
 			g.Expect(s).To(HaveKey("description"))
@@ -730,3 +733,88 @@ func TestSchemaRoundTripAllMessages(t *testing.T) {
 		})
 	}
 }
+
+func TestParseSchemaDraft(t *testing.T) {
+	tests := []struct {
+		in   string
+		want SchemaDraft
+	}{
+		{"", ""},
+		{"draft-07", Draft07},
+		{"07", Draft07},
+		{"2020-12", Draft202012},
+		{"draft-2020-12", Draft202012},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := ParseSchemaDraft(tt.in)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+
+	_, err := ParseSchemaDraft("draft-04")
+	NewWithT(t).Expect(err).To(MatchError(ContainSubstring(`unsupported JSON Schema draft "draft-04"`)))
+}
+
+// TestToolSchemas_Draft verifies the selected dialect is declared on the tool
+// schemas and that validators accept every generated schema under it.
+func TestToolSchemas_Draft(t *testing.T) {
+	method := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor().ParentFile().
+		Services().ByName("TestService").Methods().ByName("CreateItem")
+
+	for _, draft := range []SchemaDraft{"", Draft07, Draft202012} {
+		t.Run(string(draft), func(t *testing.T) {
+			g := NewWithT(t)
+			tool := ToolForMethodWithOptions(method, "", SchemaOptions{Draft: draft})
+
+			var schema map[string]any
+			g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+			if draft == "" {
+				g.Expect(schema).ToNot(HaveKey("$schema"))
+			} else {
+				g.Expect(schema).To(HaveKeyWithValue("$schema", draft.URI()))
+			}
+
+			for _, msg := range allTestMessages() {
+				s := MessageSchema(msg.md, SchemaOptions{Draft: draft})
+				if uri := draft.URI(); uri != "" {
+					s["$schema"] = uri
+				}
+				_, err := compileJSONSchema(s)
+				g.Expect(err).ToNot(HaveOccurred(), "%s under %q", msg.name, draft)
+			}
+		})
+	}
+}
+
+func TestSchemaDraft_DefinitionsKeyword(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(Draft07.DefinitionsKeyword()).To(Equal("definitions"))
+	g.Expect(Draft202012.DefinitionsKeyword()).To(Equal("$defs"))
+	g.Expect(SchemaDraft("").DefinitionsKeyword()).To(Equal("$defs"))
+}
+
+// TestToolForMethod_EmptyInput verifies a method taking google.protobuf.Empty
+// gets a closed, explicitly empty input schema, and that extra properties
+// added to it follow the strict-mode rules.
+func TestToolForMethod_EmptyInput(t *testing.T) {
+	g := NewWithT(t)
+	method := (&testdata.NoArgumentsResponse{}).ProtoReflect().Descriptor().ParentFile().
+		Services().ByName("EdgeCaseService").Methods().ByName("NoArguments")
+	g.Expect(TakesNoArguments(method.Input())).To(BeTrue())
+
+	// WrapInput has nothing to wrap.
+	tool := ToolForMethodWithOptions(method, "", SchemaOptions{WrapInput: "request"})
+	g.Expect(tool.RawInputSchema).To(MatchJSON(`{"type":"object","properties":{},"required":[],"additionalProperties":false}`))
+
+	tool = runtime.AddExtraPropertiesToTool(tool, []runtime.ExtraProperty{
+		{Name: "cluster_id", Description: "Cluster", Required: true},
+		{Name: "trace", Description: "Trace"},
+	})
+	var schema map[string]any
+	g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+	g.Expect(schema["additionalProperties"]).To(BeFalse())
+	g.Expect(schema["required"]).To(ConsistOf("cluster_id", "trace"))
+}
@@ -0,0 +1,334 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// OpenAI limits for strict function calling schemas.
+const (
+	strictMaxProperties        = 5000
+	strictMaxNesting           = 10
+	strictMaxStringLength      = 120000
+	strictMaxEnumValues        = 1000
+	strictLargeEnumValues      = 250
+	strictLargeEnumStringBytes = 15000
+)
+
+// strictKeywords are the keywords OpenAI accepts in a strict schema.
+var strictKeywords = map[string]bool{
+	"$schema": true, "$ref": true, "$defs": true, "definitions": true,
+	"type": true, "title": true, "description": true, "enum": true, "const": true, "anyOf": true,
+	"properties": true, "required": true, "additionalProperties": true,
+	"items": true, "minItems": true, "maxItems": true,
+	"pattern": true, "format": true,
+	"minimum": true, "maximum": true, "exclusiveMinimum": true, "exclusiveMaximum": true, "multipleOf": true,
+}
+
+// strictFormats are the string formats OpenAI accepts in a strict schema.
+var strictFormats = map[string]bool{
+	"date-time": true, "time": true, "date": true, "duration": true,
+	"email": true, "hostname": true, "ipv4": true, "ipv6": true, "uuid": true,
+}
+
+var strictTypes = map[string]bool{
+	"string": true, "number": true, "integer": true, "boolean": true,
+	"object": true, "array": true, "null": true,
+}
+
+// strictSchema rewrites schema in place into the subset OpenAI accepts with
+// strict function calling: every object is closed and lists all its
+// properties as required, optional ones become nullable, and keywords
+// outside the subset are dropped. Defaults and constraints dropped here are
+// still applied by the runtime and the backend; the runtime also treats the
+// nulls a model sends for unset fields as omitted.
+func strictSchema(schema map[string]any) {
+	for k := range schema {
+		if !strictKeywords[k] {
+			delete(schema, k)
+		}
+	}
+	if format, ok := schema["format"].(string); ok && !strictFormats[format] {
+		delete(schema, "format")
+	}
+
+	var names []string
+	var props func(string) map[string]any
+	var setProp func(string, map[string]any)
+	switch p := schema["properties"].(type) {
+	case map[string]any:
+		for name := range p {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		props = func(name string) map[string]any { s, _ := p[name].(map[string]any); return s }
+		setProp = func(name string, s map[string]any) { p[name] = s }
+	case *orderedMap:
+		names = slices.Clone(p.keys)
+		props = func(name string) map[string]any { s, _ := p.vals[name].(map[string]any); return s }
+		setProp = func(name string, s map[string]any) { p.vals[name] = s }
+	}
+	if props != nil {
+		required := map[string]bool{}
+		switch r := schema["required"].(type) {
+		case []string:
+			for _, name := range r {
+				required[name] = true
+			}
+		case []any:
+			for _, name := range r {
+				if s, ok := name.(string); ok {
+					required[s] = true
+				}
+			}
+		}
+		for _, name := range names {
+			prop := props(name)
+			if prop == nil {
+				continue
+			}
+			strictSchema(prop)
+			if !required[name] {
+				setProp(name, nullable(prop))
+			}
+		}
+		schema["required"] = names
+		schema["additionalProperties"] = false
+	}
+
+	if items, ok := schema["items"].(map[string]any); ok {
+		strictSchema(items)
+	}
+	if anyOf, ok := schema["anyOf"].([]any); ok {
+		for _, s := range anyOf {
+			if s, ok := s.(map[string]any); ok {
+				strictSchema(s)
+			}
+		}
+	}
+	for _, key := range []string{"$defs", "definitions"} {
+		if defs, ok := schema[key].(map[string]any); ok {
+			for _, s := range defs {
+				if s, ok := s.(map[string]any); ok {
+					strictSchema(s)
+				}
+			}
+		}
+	}
+}
+
+// nullable widens s to also accept null, the strict-mode spelling of an
+// optional property.
+func nullable(s map[string]any) map[string]any {
+	switch t := s["type"].(type) {
+	case string:
+		if t != "null" {
+			s["type"] = []string{t, "null"}
+		}
+	case []string:
+		if !slices.Contains(t, "null") {
+			s["type"] = append(slices.Clip(t), "null")
+		}
+	case []any:
+		if !slices.Contains(t, any("null")) {
+			s["type"] = append(slices.Clip(t), "null")
+		}
+	default:
+		return map[string]any{"anyOf": []any{s, map[string]any{"type": "null"}}}
+	}
+	switch enum := s["enum"].(type) {
+	case []string:
+		values := make([]any, 0, len(enum)+1)
+		for _, v := range enum {
+			values = append(values, v)
+		}
+		s["enum"] = append(values, nil)
+	case []any:
+		if !slices.Contains(enum, nil) {
+			s["enum"] = append(slices.Clip(enum), nil)
+		}
+	}
+	return s
+}
+
+// ValidateOpenAIStrict checks a tool input schema against the restrictions
+// OpenAI places on strict function calling and structured outputs: the
+// supported keywords, types and string formats, closed objects listing every
+// property as required, and the limits on properties, nesting, enum values
+// and total string length. It returns a *ConformanceError listing every
+// violation, or nil.
+func ValidateOpenAIStrict(schema json.RawMessage) error {
+	var root any
+	if err := json.Unmarshal(schema, &root); err != nil {
+		return err
+	}
+	v := &strictValidator{violations: violations{provider: "OpenAI strict mode"}}
+	obj, ok := root.(map[string]any)
+	switch {
+	case !ok:
+		v.report("#", "the schema must be an object")
+	case obj["type"] != "object":
+		v.report("#", `the root schema must have "type": "object"`)
+	}
+	if ok {
+		v.validate("#", obj, 0)
+	}
+
+	if v.properties > strictMaxProperties {
+		v.report("#", fmt.Sprintf("%d object properties exceed the limit of %d", v.properties, strictMaxProperties))
+	}
+	if v.enumValues > strictMaxEnumValues {
+		v.report("#", fmt.Sprintf("%d enum values exceed the limit of %d", v.enumValues, strictMaxEnumValues))
+	}
+	if v.stringLength > strictMaxStringLength {
+		v.report("#", fmt.Sprintf("property names, definition names, enum and const values total %d characters, more than the limit of %d", v.stringLength, strictMaxStringLength))
+	}
+	return v.err()
+}
+
+type strictValidator struct {
+	violations
+	properties   int
+	enumValues   int
+	stringLength int
+}
+
+func (v *strictValidator) validate(path string, s map[string]any, depth int) {
+	for _, k := range sortedKeys(s) {
+		if !strictKeywords[k] {
+			v.report(path, fmt.Sprintf("keyword %q is not supported", k))
+		}
+	}
+	if format, ok := s["format"].(string); ok && !strictFormats[format] {
+		v.report(path, fmt.Sprintf("format %q is not supported", format))
+	}
+
+	var types []string
+	switch t := s["type"].(type) {
+	case string:
+		types = []string{t}
+	case []any:
+		for _, t := range t {
+			name, _ := t.(string)
+			types = append(types, name)
+		}
+	case nil:
+		_, hasAnyOf := s["anyOf"]
+		_, hasRef := s["$ref"]
+		_, hasEnum := s["enum"]
+		_, hasConst := s["const"]
+		if !hasAnyOf && !hasRef && !hasEnum && !hasConst {
+			v.report(path, `a schema without "type" accepts any JSON value, which is not supported; map the message to a typed schema with schema_mappings or (mcp.message).schema`)
+		}
+	default:
+		v.report(path, `"type" must be a string or an array of strings`)
+	}
+	for _, t := range types {
+		if !strictTypes[t] {
+			v.report(path, fmt.Sprintf("type %q is not supported", t))
+		}
+	}
+
+	if enum, ok := s["enum"].([]any); ok {
+		v.enumValues += len(enum)
+		length := 0
+		for _, e := range enum {
+			if e, ok := e.(string); ok {
+				length += len(e)
+			}
+		}
+		v.stringLength += length
+		if len(enum) > strictLargeEnumValues && length > strictLargeEnumStringBytes {
+			v.report(path, fmt.Sprintf("an enum of more than %d values may total at most %d characters, got %d", strictLargeEnumValues, strictLargeEnumStringBytes, length))
+		}
+	}
+	if c, ok := s["const"].(string); ok {
+		v.stringLength += len(c)
+	}
+
+	if slices.Contains(types, "object") {
+		if depth+1 > strictMaxNesting {
+			v.report(path, fmt.Sprintf("objects are nested more than %d levels deep", strictMaxNesting))
+		}
+		if s["additionalProperties"] != false {
+			v.report(path, `objects must set "additionalProperties": false`)
+		}
+		required := map[string]bool{}
+		if r, ok := s["required"].([]any); ok {
+			for _, name := range r {
+				if name, ok := name.(string); ok {
+					required[name] = true
+				}
+			}
+		}
+		props, _ := s["properties"].(map[string]any)
+		for _, name := range sortedKeys(props) {
+			v.properties++
+			v.stringLength += len(name)
+			if !required[name] {
+				v.report(path, fmt.Sprintf("property %q must be listed in \"required\"; make its type nullable to keep it optional", name))
+			}
+			if prop, ok := props[name].(map[string]any); ok {
+				v.validate(path+"/properties/"+pointerEscape(name), prop, depth+1)
+			}
+		}
+	}
+	if slices.Contains(types, "array") {
+		items, ok := s["items"].(map[string]any)
+		if ok {
+			v.validate(path+"/items", items, depth)
+		} else {
+			v.report(path, `arrays must declare "items"`)
+		}
+	}
+	if anyOf, ok := s["anyOf"].([]any); ok {
+		if path == "#" {
+			v.report(path, `the root schema must not be an "anyOf"`)
+		}
+		for i, sub := range anyOf {
+			if sub, ok := sub.(map[string]any); ok {
+				v.validate(fmt.Sprintf("%s/anyOf/%d", path, i), sub, depth)
+			}
+		}
+	}
+	for _, key := range []string{"$defs", "definitions"} {
+		defs, _ := s[key].(map[string]any)
+		for _, name := range sortedKeys(defs) {
+			v.stringLength += len(name)
+			if def, ok := defs[name].(map[string]any); ok {
+				v.validate(path+"/"+key+"/"+pointerEscape(name), def, depth)
+			}
+		}
+	}
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// pointerEscape escapes a JSON pointer reference token.
+func pointerEscape(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
@@ -0,0 +1,195 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func strictViolations(g Gomega, schema string) []string {
+	err := ValidateOpenAIStrict(json.RawMessage(schema))
+	if err == nil {
+		return nil
+	}
+	var strictErr *ConformanceError
+	g.Expect(errors.As(err, &strictErr)).To(BeTrue(), err.Error())
+	var out []string
+	for _, v := range strictErr.Violations {
+		out = append(out, v.String())
+	}
+	return out
+}
+
+func TestValidateOpenAIStrict(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   []string
+	}{
+		{
+			name:   "valid",
+			schema: `{"type":"object","properties":{"a":{"type":["string","null"],"format":"uuid"},"b":{"type":"array","items":{"type":"integer","minimum":0}}},"required":["a","b"],"additionalProperties":false}`,
+		},
+		{
+			name:   "root must be an object",
+			schema: `{"type":"array","items":{"type":"string"}}`,
+			want:   []string{`#: the root schema must have "type": "object"`},
+		},
+		{
+			name:   "open object",
+			schema: `{"type":"object","properties":{"a":{"type":"string"}},"required":["a"]}`,
+			want:   []string{`#: objects must set "additionalProperties": false`},
+		},
+		{
+			name:   "optional property",
+			schema: `{"type":"object","properties":{"a":{"type":"string"}},"additionalProperties":false}`,
+			want:   []string{`#: property "a" must be listed in "required"; make its type nullable to keep it optional`},
+		},
+		{
+			name:   "unsupported keywords and formats",
+			schema: `{"type":"object","properties":{"a/b":{"type":"string","minLength":1,"format":"byte"}},"required":["a/b"],"additionalProperties":false}`,
+			want: []string{
+				`#/properties/a~1b: keyword "minLength" is not supported`,
+				`#/properties/a~1b: format "byte" is not supported`,
+			},
+		},
+		{
+			name:   "untyped",
+			schema: `{"type":"object","properties":{"a":{"type":"array","items":{}}},"required":["a"],"additionalProperties":false}`,
+			want:   []string{`#/properties/a/items: a schema without "type" accepts any JSON value, which is not supported; map the message to a typed schema with schema_mappings or (mcp.message).schema`},
+		},
+		{
+			name:   "root anyOf",
+			schema: `{"type":"object","anyOf":[{"type":"object","additionalProperties":false}],"additionalProperties":false}`,
+			want:   []string{`#: the root schema must not be an "anyOf"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(strictViolations(g, tt.schema)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestValidateOpenAIStrictLimits(t *testing.T) {
+	g := NewWithT(t)
+
+	// 11 nested objects.
+	schema := `{"type":"object","properties":{},"required":[],"additionalProperties":false}`
+	for i := 0; i < 10; i++ {
+		schema = `{"type":"object","properties":{"n":` + schema + `},"required":["n"],"additionalProperties":false}`
+	}
+	g.Expect(strictViolations(g, schema)).To(ConsistOf(
+		`#/properties/n/properties/n/properties/n/properties/n/properties/n/properties/n/properties/n/properties/n/properties/n/properties/n: objects are nested more than 10 levels deep`,
+	))
+
+	values := make([]string, 300)
+	for i := range values {
+		values[i] = `"` + strings.Repeat("x", 60) + `"`
+	}
+	enum := `{"type":"object","properties":{"e":{"type":"string","enum":[` + strings.Join(values, ",") + `]}},"required":["e"],"additionalProperties":false}`
+	g.Expect(strictViolations(g, enum)).To(ConsistOf(
+		`#/properties/e: an enum of more than 250 values may total at most 15000 characters, got 18000`,
+	))
+}
+
+func TestOpenAIStrictToolSchemas(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, svc := range []protoreflect.ServiceDescriptor{
+		testdata.File_testdata_edge_cases_proto.Services().ByName("EdgeCaseService"),
+		testdata.File_testdata_annotations_proto.Services().ByName("AnnotatedService"),
+	} {
+		for i := 0; i < svc.Methods().Len(); i++ {
+			md := svc.Methods().Get(i)
+			tool := ToolForMethodWithOptions(md, "", SchemaOptions{OpenAIStrict: true})
+			g.Expect(ValidateOpenAIStrict(tool.RawInputSchema)).To(Succeed(), string(md.FullName()))
+
+			// Output schemas describe EncodeMessage and never change.
+			g.Expect(tool.RawOutputSchema).To(MatchJSON(ToolForMethodWithOptions(md, "", SchemaOptions{}).RawOutputSchema))
+		}
+	}
+
+	// Free-form JSON inside google.protobuf.Any cannot be expressed.
+	md := testdata.File_testdata_test_service_proto.Services().ByName("TestService").Methods().ByName("ProcessWellKnownTypes")
+	tool := ToolForMethodWithOptions(md, "", SchemaOptions{OpenAIStrict: true})
+	g.Expect(strictViolations(g, string(tool.RawInputSchema))).To(ConsistOf(
+		ContainSubstring(`#/properties/payload/properties/value/anyOf/0: a schema without "type"`),
+	))
+}
+
+func TestOpenAIStrictSchemaShape(t *testing.T) {
+	g := NewWithT(t)
+
+	md := testdata.File_testdata_test_service_proto.Services().ByName("TestService").Methods().ByName("CreateItem")
+	tool := ToolForMethodWithOptions(md, "", SchemaOptions{OpenAIStrict: true})
+	// The discriminator stays the first property of a oneof wrapper.
+	g.Expect(string(tool.RawInputSchema)).To(ContainSubstring(`"properties":{"which":`))
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+	g.Expect(schema["required"]).To(ConsistOf("name", "description", "labels", "tags", "item_type", "thumbnail"))
+	props := schema["properties"].(map[string]any)
+	g.Expect(props["name"]).To(HaveKeyWithValue("type", "string"))
+	g.Expect(props["description"]).To(HaveKeyWithValue("type", ConsistOf("string", "null")))
+	g.Expect(props["thumbnail"]).ToNot(HaveKey("contentEncoding"))
+	g.Expect(props["thumbnail"]).ToNot(HaveKey("format"))
+	g.Expect(props["labels"]).To(Equal(map[string]any{
+		"type": []any{"array", "null"},
+		"items": map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"key":   map[string]any{"type": "string"},
+				"value": map[string]any{"type": "string"},
+			},
+			"required":             []any{"key", "value"},
+			"additionalProperties": false,
+		},
+	}))
+}
+
+func TestOpenAIStrictArgumentsDecode(t *testing.T) {
+	g := NewWithT(t)
+
+	// What a model answering the strict schema of CreateItem sends: every
+	// property, null for the unset ones, and the map as an entry list.
+	var args map[string]any
+	g.Expect(json.Unmarshal([]byte(`{
+		"name": "n",
+		"description": null,
+		"labels": [{"key": "env", "value": "prod"}],
+		"tags": null,
+		"item_type": {"which": "product", "product": {"price": 1.5, "quantity": null}, "service": null},
+		"thumbnail": null
+	}`), &args)).To(Succeed())
+
+	msg, err := runtime.UnmarshalArguments((&testdata.CreateItemRequest{}).ProtoReflect().Descriptor(), args, runtime.CodecOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(proto.Equal(msg, &testdata.CreateItemRequest{
+		Name:     "n",
+		Labels:   map[string]string{"env": "prod"},
+		ItemType: &testdata.CreateItemRequest_Product{Product: &testdata.ProductDetails{Price: 1.5}},
+	})).To(BeTrue(), "%v", msg)
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// WarningKind classifies a Warning.
+type WarningKind string
+
+const (
+	// WarningStreaming is an RPC that gets no tool because it streams.
+	WarningStreaming WarningKind = "streaming"
+	// WarningAny is a google.protobuf.Any field, whose payload has no
+	// schema.
+	WarningAny WarningKind = "any"
+	// WarningTruncated is a description cut by TruncateDescription.
+	WarningTruncated WarningKind = "truncated"
+	// WarningToolName is a tool name shortened by MangleHeadIfTooLong.
+	WarningToolName WarningKind = "tool_name"
+	// WarningRecursion is a field of a recursive message, rendered as a
+	// JSON string beyond SchemaOptions.MaxRecursionDepth levels.
+	WarningRecursion WarningKind = "recursion"
+)
+
+// Warning is something about a proto element that the generated tools do
+// not faithfully represent.
+type Warning struct {
+	Kind       WarningKind
+	Descriptor protoreflect.Descriptor
+	Message    string
+}
+
+// SchemaWarnings reports what the tool for method cannot faithfully
+// represent with opts: google.protobuf.Any fields, and tool and field
+// descriptions cut by TruncateDescription, and the fields that make
+// messages recursive.
+func SchemaWarnings(method protoreflect.MethodDescriptor, comment string, opts SchemaOptions) []Warning {
+	var warnings []Warning
+	if max := opts.MaxToolDescriptionBytes; max > 0 {
+		if desc := FormatComment(comment, opts); len(desc) > max {
+			warnings = append(warnings, Warning{WarningTruncated, method, fmt.Sprintf("tool description of %d bytes truncated to max_tool_description_bytes=%d", len(desc), max)})
+		}
+	}
+	seen := map[protoreflect.FullName]bool{}
+	warnings = append(warnings, messageWarnings(method.Input(), opts, true, seen, map[protoreflect.FullName]bool{})...)
+	warnings = append(warnings, messageWarnings(method.Output(), outputOptions(opts), false, seen, map[protoreflect.FullName]bool{})...)
+	return warnings
+}
+
+// messageWarnings is SchemaWarnings for the fields of md and the messages
+// they reach. input leaves out the fields filled in from the context. path
+// holds the messages being walked, so that a field back to one of them
+// closes a cycle.
+func messageWarnings(md protoreflect.MessageDescriptor, opts SchemaOptions, input bool, seen, path map[protoreflect.FullName]bool) []Warning {
+	if seen[md.FullName()] || strings.HasPrefix(string(md.FullName()), "google.protobuf.") {
+		return nil
+	}
+	seen[md.FullName()] = true
+	if _, ok := messageSchemaOverride(md, opts); ok {
+		return nil
+	}
+	path[md.FullName()] = true
+	defer delete(path, md.FullName())
+	maxDepth := opts.MaxRecursionDepth
+	if maxDepth <= 0 {
+		maxDepth = defaultMaxRecursionDepth
+	}
+	var warnings []Warning
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if opts.DeprecatedFields == DeprecatedFieldsOmit && fieldDeprecated(fd) {
+			continue
+		}
+		if input && fieldOptions(fd).GetFromContext() != "" || fieldOptions(fd).GetSchema() != "" {
+			continue
+		}
+		// Oneof members get a description of their own, which is not cut.
+		oo := fd.ContainingOneof()
+		if max := opts.MaxFieldDescriptionBytes; max > 0 && (oo == nil || oo.IsSynthetic()) {
+			desc := fieldComment(fd, opts)
+			if opts.DeprecatedFields == DeprecatedFieldsAnnotate && fieldDeprecated(fd) {
+				desc = joinDescription(deprecationNote(fd), desc)
+			}
+			if len(desc) > max {
+				warnings = append(warnings, Warning{WarningTruncated, fd, fmt.Sprintf("field description of %d bytes truncated to max_field_description_bytes=%d", len(desc), max)})
+			}
+		}
+		value := fd
+		if fd.IsMap() {
+			value = fd.MapValue()
+		}
+		if value.Message() == nil || fieldOptions(fd).GetJsonString() {
+			continue
+		}
+		if value.Message().FullName() == "google.protobuf.Any" {
+			if _, ok := messageSchemaOverride(value.Message(), opts); !ok {
+				warnings = append(warnings, Warning{WarningAny, fd, "google.protobuf.Any has no schema for its payload, so the model sees a free-form value; map it with schema_mappings"})
+			}
+			continue
+		}
+		if path[value.Message().FullName()] {
+			warnings = append(warnings, Warning{WarningRecursion, fd, fmt.Sprintf("makes %s recursive, so beyond %d levels the model sees a JSON string instead of a schema", value.Message().FullName(), maxDepth)})
+			continue
+		}
+		warnings = append(warnings, messageWarnings(value.Message(), opts, input, seen, path)...)
+	}
+	return warnings
+}
@@ -0,0 +1,260 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/gen"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the YAML file of the config plugin option, for option sets too
+// long for opt= strings:
+//
+//	options:
+//	  openai_strict: true
+//	  comment_directives: [TODO:, FIXME:]
+//	services:
+//	  example.v1.ClusterService:
+//	    methods:
+//	      DeleteCluster:
+//	        exclude: true
+//	      GetCluster:
+//	        name: get_cluster
+//	        description: Returns the cluster of the given name.
+type Config struct {
+	// Options sets plugin options by name, as opt= would. Lists are joined
+	// with commas.
+	Options map[string]any `yaml:"options"`
+
+	// Services overrides the generation of services, by full name.
+	Services map[protoreflect.FullName]ServiceConfig `yaml:"services"`
+}
+
+// ServiceConfig overrides the generation of a service.
+type ServiceConfig struct {
+	// Exclude generates nothing for the service.
+	Exclude bool `yaml:"exclude"`
+	// OpenAIStrict overrides the openai_strict option for the service.
+	OpenAIStrict *bool `yaml:"openai_strict"`
+	// ToolPrefix overrides the tool_prefix option for the service.
+	ToolPrefix string `yaml:"tool_prefix"`
+
+	// Methods overrides the generation of methods, by name.
+	Methods map[protoreflect.Name]MethodConfig `yaml:"methods"`
+}
+
+// MethodConfig overrides the generation of a method.
+type MethodConfig struct {
+	// Exclude generates no tool or resource for the method.
+	Exclude bool `yaml:"exclude"`
+	// OpenAIStrict overrides the openai_strict option for the method.
+	OpenAIStrict *bool `yaml:"openai_strict"`
+
+	// Name, Title and Description replace those of the tool.
+	Name        string `yaml:"name"`
+	Title       string `yaml:"title"`
+	Description string `yaml:"description"`
+}
+
+// toolNameRE matches the tool names MCP clients accept.
+var toolNameRE = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// ParseConfig parses a config file. Unknown keys are errors, so that typos
+// do not go unnoticed.
+func ParseConfig(data []byte) (*Config, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	var c Config
+	if err := dec.Decode(&c); err != nil {
+		return nil, err
+	}
+	for svc, sc := range c.Services {
+		if sc.ToolPrefix != "" && !toolPrefixRE.MatchString(sc.ToolPrefix) {
+			return nil, fmt.Errorf("services.%s: tool_prefix %q must match %s", svc, sc.ToolPrefix, toolPrefixRE)
+		}
+		for name, mc := range sc.Methods {
+			if mc.Name != "" && !toolNameRE.MatchString(mc.Name) {
+				return nil, fmt.Errorf("services.%s.methods.%s: tool name %q must match %s", svc, name, mc.Name, toolNameRE)
+			}
+		}
+	}
+	return &c, nil
+}
+
+// SetServiceOption sets a service plugin option,
+// "<service>:<option>=<value>" such as
+// "acme.v1.AdminService:disabled=true". The options are disabled (or
+// exclude), openai_strict and tool_prefix, as in ServiceConfig.
+func (c *Config) SetServiceOption(value string) error {
+	service, option, ok := strings.Cut(value, ":")
+	if !ok || service == "" {
+		return fmt.Errorf("service option %q must be <service>:<option>=<value>", value)
+	}
+	name, v, _ := strings.Cut(option, "=")
+	if c.Services == nil {
+		c.Services = map[protoreflect.FullName]ServiceConfig{}
+	}
+	sc := c.Services[protoreflect.FullName(service)]
+	switch name {
+	case "disabled", "exclude":
+		b, err := parseBool(v)
+		if err != nil {
+			return fmt.Errorf("service %s: %s: %w", service, name, err)
+		}
+		sc.Exclude = b
+	case "openai_strict":
+		b, err := parseBool(v)
+		if err != nil {
+			return fmt.Errorf("service %s: %s: %w", service, name, err)
+		}
+		sc.OpenAIStrict = &b
+	case "tool_prefix":
+		if !toolPrefixRE.MatchString(v) {
+			return fmt.Errorf("service %s: tool_prefix %q must match %s", service, v, toolPrefixRE)
+		}
+		sc.ToolPrefix = v
+	default:
+		return fmt.Errorf("service %s: unknown option %q; want disabled, openai_strict or tool_prefix", service, name)
+	}
+	c.Services[protoreflect.FullName(service)] = sc
+	return nil
+}
+
+// parseBool parses a boolean option value, where no value is true.
+func parseBool(v string) (bool, error) {
+	if v == "" {
+		return true, nil
+	}
+	return strconv.ParseBool(v)
+}
+
+// OptionValues returns Options as the string values of the plugin options.
+func (c *Config) OptionValues() (map[string]string, error) {
+	values := map[string]string{}
+	for _, name := range slices.Sorted(maps.Keys(c.Options)) {
+		value, err := optionValue(c.Options[name])
+		if err != nil {
+			return nil, fmt.Errorf("options.%s: %w", name, err)
+		}
+		values[name] = value
+	}
+	return values, nil
+}
+
+func optionValue(v any) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case []any:
+		parts := make([]string, len(v))
+		for i, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return "", fmt.Errorf("list elements must be strings, got %v", e)
+			}
+			parts[i] = s
+		}
+		return strings.Join(parts, ","), nil
+	default:
+		return "", fmt.Errorf("unsupported value %v", v)
+	}
+}
+
+// check returns an error for every service or method of c that is not
+// declared by files, the files of a request including their imports.
+func (c *Config) check(files []*descriptorpb.FileDescriptorProto) error {
+	if c == nil {
+		return nil
+	}
+	services := map[protoreflect.FullName]*descriptorpb.ServiceDescriptorProto{}
+	for _, f := range files {
+		for _, svc := range f.GetService() {
+			services[protoreflect.FullName(f.GetPackage()).Append(protoreflect.Name(svc.GetName()))] = svc
+		}
+	}
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(c.Services)) {
+		svc, ok := services[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("config: unknown service %s", name))
+			continue
+		}
+		for _, meth := range slices.Sorted(maps.Keys(c.Services[name].Methods)) {
+			if !slices.ContainsFunc(svc.GetMethod(), func(m *descriptorpb.MethodDescriptorProto) bool { return m.GetName() == string(meth) }) {
+				errs = append(errs, fmt.Errorf("config: service %s has no method %s", name, meth))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// method returns the configuration of the service and of method.
+func (c *Config) method(method protoreflect.MethodDescriptor) (ServiceConfig, MethodConfig) {
+	if c == nil {
+		return ServiceConfig{}, MethodConfig{}
+	}
+	sc := c.Services[method.Parent().FullName()]
+	return sc, sc.Methods[method.Name()]
+}
+
+// excludes reports whether method is excluded by c.
+func (c *Config) excludes(method protoreflect.MethodDescriptor) bool {
+	sc, mc := c.method(method)
+	return sc.Exclude || mc.Exclude
+}
+
+// schemaOptions returns opts with the overrides of c for method.
+func (c *Config) schemaOptions(method protoreflect.MethodDescriptor, opts gen.SchemaOptions) gen.SchemaOptions {
+	sc, mc := c.method(method)
+	for _, strict := range []*bool{sc.OpenAIStrict, mc.OpenAIStrict} {
+		if strict != nil {
+			opts.OpenAIStrict = *strict
+		}
+	}
+	return opts
+}
+
+// applyTool replaces the name, title and description of the tool of
+// method with those c sets.
+func (c *Config) applyTool(method protoreflect.MethodDescriptor, tool *runtime.Tool) {
+	_, mc := c.method(method)
+	if mc.Name != "" {
+		tool.Name = mc.Name
+	}
+	if mc.Title != "" {
+		tool.Title = mc.Title
+	}
+	if mc.Description != "" {
+		tool.Description = mc.Description
+	}
+}
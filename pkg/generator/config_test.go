@@ -0,0 +1,84 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	c, err := ParseConfig([]byte(`
+options:
+  openai_strict: true
+  max_tool_description_bytes: 512
+  schema_draft: draft-07
+  comment_directives: [TODO:, FIXME:]
+  wrap_input:
+services:
+  testdata.TestService:
+    openai_strict: false
+    methods:
+      GetItem:
+        name: get_item
+        description: Returns an item.
+`))
+	g.Expect(err).ToNot(HaveOccurred())
+	values, err := c.OptionValues()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(values).To(Equal(map[string]string{
+		"openai_strict":              "true",
+		"max_tool_description_bytes": "512",
+		"schema_draft":               "draft-07",
+		"comment_directives":         "TODO:,FIXME:",
+		"wrap_input":                 "",
+	}))
+	svc := c.Services["testdata.TestService"]
+	g.Expect(*svc.OpenAIStrict).To(BeFalse())
+	g.Expect(svc.Methods["GetItem"].Name).To(Equal("get_item"))
+
+	// Typos are errors.
+	_, err = ParseConfig([]byte("services:\n  testdata.TestService:\n    exlude: true\n"))
+	g.Expect(err).To(MatchError(ContainSubstring("field exlude not found")))
+
+	_, err = ParseConfig([]byte("services:\n  a.B:\n    methods:\n      C:\n        name: get item\n"))
+	g.Expect(err).To(MatchError(ContainSubstring(`services.a.B.methods.C: tool name "get item" must match`)))
+
+	c, err = ParseConfig([]byte("options:\n  comment_directives: [1, 2]\n"))
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = c.OptionValues()
+	g.Expect(err).To(MatchError(ContainSubstring("options.comment_directives: list elements must be strings")))
+}
+
+func TestSetServiceOption(t *testing.T) {
+	g := NewWithT(t)
+
+	var c Config
+	g.Expect(c.SetServiceOption("acme.v1.AdminService:disabled=true")).To(Succeed())
+	g.Expect(c.SetServiceOption("acme.v1.PublicService:openai_strict")).To(Succeed())
+	g.Expect(c.SetServiceOption("acme.v1.PublicService:tool_prefix=pub_")).To(Succeed())
+	g.Expect(c.Services["acme.v1.AdminService"].Exclude).To(BeTrue())
+	public := c.Services["acme.v1.PublicService"]
+	g.Expect(*public.OpenAIStrict).To(BeTrue())
+	g.Expect(public.ToolPrefix).To(Equal("pub_"))
+
+	g.Expect(c.SetServiceOption("disabled=true")).To(MatchError(`service option "disabled=true" must be <service>:<option>=<value>`))
+	g.Expect(c.SetServiceOption("acme.v1.AdminService:disabled=maybe")).To(MatchError(ContainSubstring("service acme.v1.AdminService: disabled: ")))
+	g.Expect(c.SetServiceOption("acme.v1.AdminService:titles=true")).To(MatchError(`service acme.v1.AdminService: unknown option "titles"; want disabled, openai_strict or tool_prefix`))
+	g.Expect(c.SetServiceOption("acme.v1.AdminService:tool_prefix=a.b")).To(MatchError(ContainSubstring(`tool_prefix "a.b" must match`)))
+}
@@ -0,0 +1,487 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/gen"
+)
+
+// Flags are the options of the protoc-gen-go-mcp plugin, defined on a
+// flag.FlagSet. The plugin sets them from its parameters with Params, and so
+// does go-mcp lint, so both turn the same parameters and config file into
+// the same Options.
+type Flags struct {
+	flagSet *flag.FlagSet
+
+	packageSuffix            *string
+	packageName              *string
+	samePackage              *bool
+	fileSuffix               *string
+	schemaMappings           *string
+	schemaPatches            *string
+	schemaDraft              *string
+	examplesInDescription    *bool
+	titles                   *bool
+	maxToolDescriptionBytes  *int
+	maxFieldDescriptionBytes *int
+	fieldComments            *string
+	commentMarkdown          *string
+	commentDirectives        ListFlag
+	excludeDeprecatedMethods *bool
+	deprecatedFields         *string
+	wrapInput                *string
+	flattenNested            *bool
+	relativeTimes            *bool
+	dryRun                   *bool
+	resources                *bool
+	openAIStrict             *bool
+	dedupeDescriptions       *bool
+	minifySchemas            *bool
+	emitSchemas              *string
+	warningsReport           *string
+	strict                   *bool
+	statsReport              *string
+	instructions             *string
+	preview                  *bool
+	goldenTests              *bool
+	mocks                    *bool
+	fuzzTests                *bool
+	fanOut                   *bool
+	failover                 *bool
+	compressSchemas          *bool
+	sharedDefinitions        *bool
+	buildTag                 *string
+	exclude                  ListFlag
+	excludeFields            ListFlag
+	toolPrefix               ListFlag
+	serviceOptions           ListFlag
+	configPath               *string
+}
+
+// DefineFlags defines the plugin options on flagSet.
+func DefineFlags(flagSet *flag.FlagSet) *Flags {
+	f := &Flags{flagSet: flagSet}
+
+	f.packageSuffix = flagSet.String(
+		"package_suffix",
+		"mcp",
+		"Generate files into a sub-package of the package containing the base .pb.go files using the given suffix. An empty suffix denotes to generate into the same package as the base pb.go files.",
+	)
+
+	f.packageName = flagSet.String(
+		"package_name",
+		"",
+		"Name of the package to generate files into, overriding package_suffix. {package} stands for the package of the base .pb.go files, e.g. \"{package}mcp\" or \"mcpconnect\". The files go into a directory of that name next to the base .pb.go files.",
+	)
+
+	f.samePackage = flagSet.Bool(
+		"same_package",
+		false,
+		"Same as package_suffix=\"\", generating files into the package of the base .pb.go files, but with the <Service>Server and <Service>Client interfaces named <Service>MCPServer and <Service>MCPClient, so they do not clash with protoc-gen-go-grpc output. Overrides package_suffix.",
+	)
+
+	f.fileSuffix = flagSet.String(
+		"file_suffix",
+		GeneratedFilenameExtension,
+		"Suffix of the generated file names, e.g. \"_mcp.pb.go\". Mock and test files replace its .go with .mock.go, _test.go and _fuzz_test.go.",
+	)
+
+	f.schemaMappings = flagSet.String(
+		"schema_mappings",
+		"",
+		"Path to a JSON file mapping fully-qualified message names to JSON Schema objects that replace the generated schema of those messages everywhere they appear.",
+	)
+
+	f.schemaPatches = flagSet.String(
+		"schema_patches",
+		"",
+		"Path to a directory of JSON Merge Patch (RFC 7396) files applied to the generated schemas: <tool>.input.json patches the input schema of the tool of that name and <tool>.output.json its output schema, named like the files of emit_schemas.",
+	)
+
+	f.schemaDraft = flagSet.String(
+		"schema_draft",
+		"",
+		"JSON Schema dialect the tool schemas declare via \"$schema\": \"draft-07\" or \"2020-12\". Empty omits \"$schema\".",
+	)
+
+	f.examplesInDescription = flagSet.Bool(
+		"examples_in_description",
+		false,
+		"Also append (mcp.field).example values to field descriptions, for LLM providers that ignore the \"examples\" keyword.",
+	)
+
+	f.titles = flagSet.Bool(
+		"titles",
+		false,
+		"Emit human-readable titles for tools and fields, derived from their names unless set with (mcp.method).title or (mcp.field).title.",
+	)
+
+	f.maxToolDescriptionBytes = flagSet.Int(
+		"max_tool_description_bytes",
+		0,
+		"Truncate tool descriptions longer than this many bytes at a sentence boundary. 0 disables the limit.",
+	)
+	f.maxFieldDescriptionBytes = flagSet.Int(
+		"max_field_description_bytes",
+		0,
+		"Truncate field descriptions longer than this many bytes at a sentence boundary. 0 disables the limit.",
+	)
+
+	f.fieldComments = flagSet.String(
+		"field_comments",
+		"none",
+		"Which proto comments of a field become its schema description: none, leading, trailing or both.",
+	)
+	f.commentMarkdown = flagSet.String(
+		"comment_markdown",
+		"keep",
+		"How markdown/HTML in comments is rendered into descriptions: keep, normalize (unwrap lines, drop HTML) or strip (also remove markdown syntax).",
+	)
+	flagSet.Var(
+		&f.commentDirectives,
+		"comment_directives",
+		"Extra line prefixes, comma-separated or in repeated options, whose comment lines are dropped from descriptions, in addition to buf:lint:, @ignore-comment, protolint:, api-linter: and nolint:.",
+	)
+
+	f.excludeDeprecatedMethods = flagSet.Bool(
+		"exclude_deprecated_methods",
+		false,
+		"Do not generate tools for RPCs marked option deprecated = true.",
+	)
+	f.deprecatedFields = flagSet.String(
+		"deprecated_fields",
+		"keep",
+		"How fields marked [deprecated = true] appear in schemas: keep, annotate (deprecated: true plus a note, see (mcp.field).deprecation_note) or omit.",
+	)
+
+	f.wrapInput = flagSet.String(
+		"wrap_input",
+		"",
+		"Nest each tool's request fields under a single top-level property of this name, e.g. \"request\", leaving the top level for extra properties and headers.",
+	)
+
+	f.flattenNested = flagSet.Bool(
+		"flatten_nested",
+		false,
+		"Replace each top-level message field whose own fields are all scalars, enums or lists of those with dotted properties such as \"spec.name\", for models that fill flat argument lists more reliably than nested objects. Handlers reassemble the nested request.",
+	)
+
+	f.relativeTimes = flagSet.Bool(
+		"relative_times",
+		false,
+		"Accept relative and zone-less values such as \"now-2h\", \"2026-01-02\" or \"1h30m\" in every google.protobuf.Timestamp and Duration field, normalized by the handlers. (mcp.field).relative_time does this for a single field.",
+	)
+
+	f.dryRun = flagSet.Bool(
+		"dry_run",
+		false,
+		"Add a dry_run boolean to tools of RPCs not marked idempotency_level = NO_SIDE_EFFECTS. A dry run sets the request's validate_only field if it has one, and otherwise returns the decoded request without calling the RPC.",
+	)
+
+	f.resources = flagSet.Bool(
+		"resources",
+		false,
+		"Also expose RPCs with a google.api.http GET binding as MCP resources, under a URI template derived from the HTTP path. (mcp.method).resource_uri exposes any unary RPC.",
+	)
+
+	f.openAIStrict = flagSet.Bool(
+		"openai_strict",
+		false,
+		"Render tool input schemas in the JSON Schema subset OpenAI accepts with strict function calling, and fail generation with the offending paths when a schema cannot be expressed in it.",
+	)
+
+	f.dedupeDescriptions = flagSet.Bool(
+		"dedupe_descriptions",
+		false,
+		"Keep the field descriptions of a message only where it first occurs in a tool schema, and elide them where it repeats, to cut the tokens of messages used many times. Not compatible with shared_definitions, which keeps them once in $defs.",
+	)
+
+	f.minifySchemas = flagSet.Bool(
+		"minify_schemas",
+		false,
+		"Drop $comment keywords, empty required arrays and runs of whitespace in descriptions from the schemas, trading readability for a smaller tools/list payload.",
+	)
+
+	f.emitSchemas = flagSet.String(
+		"emit_schemas",
+		"",
+		"Also write each tool's input and output schema as an indented <tool>.input.json / <tool>.output.json file into this output directory, for review and external validation.",
+	)
+
+	f.warningsReport = flagSet.String(
+		"warnings_report",
+		"",
+		"Also write the warnings about what the generated tools do not faithfully represent (skipped streaming RPCs, google.protobuf.Any fields, truncated descriptions and tool names) as a JSON report to this output path.",
+	)
+
+	f.strict = flagSet.Bool(
+		"strict",
+		false,
+		"Fail generation instead of warning about what the generated tools do not faithfully represent: streaming RPCs without a resource URI, google.protobuf.Any fields, recursive messages, and truncated descriptions and tool names. Exclude the RPCs or map the types to fix them.",
+	)
+
+	f.statsReport = flagSet.String(
+		"stats_report",
+		"",
+		"Also write a JSON report of the generated tools to this output path: counts, the description and schema sizes of every tool, its estimated tokens, and the largest tools, to keep the tool list within LLM context budgets.",
+	)
+
+	f.instructions = flagSet.String(
+		"instructions",
+		"",
+		"Also write MCP server instructions to this output path: the generated tools grouped by service, read-only tools first, the resources and prompts, and hints on the order to use them in. Embed the file and pass it as the server instructions.",
+	)
+
+	f.preview = flagSet.Bool(
+		"preview",
+		false,
+		"Print the tools, resources and prompts that would be generated, with the RPCs they come from and their schema sizes, to stderr instead of writing any files, to review the MCP surface of a proto change.",
+	)
+
+	f.goldenTests = flagSet.Bool(
+		"golden_tests",
+		false,
+		"Also generate a _test.go file per proto file that compares every tool's name, description and schemas with golden snapshots in the package's testdata directory. Run the tests with UPDATE_MCP_GOLDEN=1 to write the snapshots.",
+	)
+
+	f.mocks = flagSet.Bool(
+		"mocks",
+		false,
+		"Also generate a <Service>ServerMock with canned responses and call recording for every <Service>Server interface, in a .pb.mcp.mock.go file.",
+	)
+
+	f.fuzzTests = flagSet.Bool(
+		"fuzz_tests",
+		false,
+		"Also generate a _test.go file per proto file with Go fuzz tests that feed arbitrary JSON arguments to every tool handler, checking that malformed arguments never cause a panic.",
+	)
+
+	f.fanOut = flagSet.Bool(
+		"fan_out",
+		false,
+		"Also generate FanOutTo<Service>Clients and FanOutToConnect<Service>Clients functions, which register tools that run every call against a map of clients, such as the control plane of every region, and return the results by target.",
+	)
+
+	f.failover = flagSet.Bool(
+		"failover",
+		false,
+		"Also generate FailoverTo<Service>Clients and FailoverToConnect<Service>Clients functions, which forward every call to the first available of a list of clients in order of priority, failing over on connection errors and UNAVAILABLE.",
+	)
+
+	f.compressSchemas = flagSet.Bool(
+		"compress_schemas",
+		false,
+		"Embed the tool schemas of each file gzip-compressed instead of as string literals, and decompress them on the first tools/list. Shrinks binaries that link large APIs; the generated Tool vars then load their schemas with Loaded.",
+	)
+
+	f.sharedDefinitions = flagSet.Bool(
+		"shared_definitions",
+		false,
+		"Store message schemas that occur more than once in the tools of a file once, as $defs the tool schemas $ref, to shrink the generated file. Each listed tool still carries a copy of the definitions it uses.",
+	)
+
+	f.buildTag = flagSet.String(
+		"build_tag",
+		"",
+		"Build constraint expression, e.g. \"mcp\", written as a //go:build line at the top of every generated Go file, so that the MCP bindings only compile in builds that set the tag.",
+	)
+
+	flagSet.Var(
+		&f.exclude,
+		"exclude",
+		"Pattern of the full names of services or methods to generate no tools or resources for, e.g. \"foo.v1.Admin*\" or \"foo.v1.ClusterService.Delete*\". Can be repeated.",
+	)
+
+	flagSet.Var(
+		&f.excludeFields,
+		"exclude_fields",
+		"Pattern of the full names of request fields to leave out of tool input schemas like (mcp.field).exclude, e.g. \"*.internal_*\"; \"*\" also matches dots. Handlers reject calls setting them. Can be repeated.",
+	)
+
+	flagSet.Var(
+		&f.toolPrefix,
+		"tool_prefix",
+		"Prefix for the generated tool names, e.g. \"rp_\". Scope it to services with a pattern of their full names, as in \"foo.v1.Admin*:admin_\"; a scoped prefix wins over an unscoped one. Can be repeated.",
+	)
+
+	flagSet.Var(
+		&f.serviceOptions,
+		"service",
+		"Option scoped to one service, as <service>:<option>=<value>, e.g. \"acme.v1.AdminService:disabled=true\". The options are disabled, openai_strict and tool_prefix. Can be repeated, and overrides the config file.",
+	)
+
+	f.configPath = flagSet.String(
+		"config",
+		"",
+		"Path to a YAML file with plugin options under options, and per-service and per-method overrides (exclude, name, title, description, openai_strict) under services. opt= parameters take precedence over its options.",
+	)
+
+	return f
+}
+
+// Preview reports whether the preview option is set.
+func (f *Flags) Preview() bool {
+	return *f.preview
+}
+
+// Options reads the files the flags name, starting with the config file,
+// and returns the options they set. Warn and Preview are left to the
+// caller.
+func (f *Flags) Options() (Options, error) {
+	config, err := f.loadConfig()
+	if err != nil {
+		return Options{}, err
+	}
+	if len(f.serviceOptions) > 0 && config == nil {
+		config = &Config{}
+	}
+	for _, value := range f.serviceOptions {
+		if err := config.SetServiceOption(value); err != nil {
+			return Options{}, err
+		}
+	}
+	var toolPrefixes []ToolPrefix
+	for _, value := range f.toolPrefix {
+		tp, err := ParseToolPrefix(value)
+		if err != nil {
+			return Options{}, err
+		}
+		toolPrefixes = append(toolPrefixes, tp)
+	}
+	draft, err := gen.ParseSchemaDraft(*f.schemaDraft)
+	if err != nil {
+		return Options{}, err
+	}
+	fieldComments, err := gen.ParseFieldComments(*f.fieldComments)
+	if err != nil {
+		return Options{}, err
+	}
+	markdown, err := gen.ParseMarkdownMode(*f.commentMarkdown)
+	if err != nil {
+		return Options{}, err
+	}
+	deprecatedFields, err := gen.ParseDeprecatedFields(*f.deprecatedFields)
+	if err != nil {
+		return Options{}, err
+	}
+	schemaOpts := gen.SchemaOptions{
+		Draft:                 draft,
+		ExamplesInDescription: *f.examplesInDescription,
+		Titles:                *f.titles,
+
+		MaxToolDescriptionBytes:  *f.maxToolDescriptionBytes,
+		MaxFieldDescriptionBytes: *f.maxFieldDescriptionBytes,
+
+		FieldComments:     fieldComments,
+		Markdown:          markdown,
+		CommentDirectives: f.commentDirectives,
+		DeprecatedFields:  deprecatedFields,
+		ExcludeFields:     f.excludeFields,
+
+		WrapInput:     *f.wrapInput,
+		FlattenNested: *f.flattenNested,
+		RelativeTimes: *f.relativeTimes,
+		DryRun:        *f.dryRun,
+		Resources:     *f.resources,
+
+		OpenAIStrict: *f.openAIStrict,
+		Minify:       *f.minifySchemas,
+
+		DedupeDescriptions: *f.dedupeDescriptions,
+	}
+	if *f.schemaMappings != "" {
+		data, err := os.ReadFile(*f.schemaMappings)
+		if err != nil {
+			return Options{}, fmt.Errorf("reading schema_mappings: %w", err)
+		}
+		schemaOpts.MessageSchemas, err = gen.ParseMessageSchemas(data)
+		if err != nil {
+			return Options{}, fmt.Errorf("%s: %w", *f.schemaMappings, err)
+		}
+	}
+	var patches map[string]SchemaPatch
+	if *f.schemaPatches != "" {
+		patches, err = ReadSchemaPatches(*f.schemaPatches)
+		if err != nil {
+			return Options{}, fmt.Errorf("reading schema_patches: %w", err)
+		}
+	}
+	return Options{
+		PackageSuffix:            *f.packageSuffix,
+		PackageName:              *f.packageName,
+		FileSuffix:               *f.fileSuffix,
+		SamePackage:              *f.samePackage,
+		SchemaOptions:            schemaOpts,
+		ExcludeDeprecatedMethods: *f.excludeDeprecatedMethods,
+		EmitSchemasDir:           *f.emitSchemas,
+		GoldenTests:              *f.goldenTests,
+		Mocks:                    *f.mocks,
+		FuzzTests:                *f.fuzzTests,
+		FanOut:                   *f.fanOut,
+		Failover:                 *f.failover,
+		CompressSchemas:          *f.compressSchemas,
+		SharedDefinitions:        *f.sharedDefinitions,
+		BuildTag:                 *f.buildTag,
+		Config:                   config,
+		Exclude:                  f.exclude,
+		ToolPrefixes:             toolPrefixes,
+		SchemaPatches:            patches,
+		Strict:                   *f.strict,
+		WarningsReport:           *f.warningsReport,
+		StatsReport:              *f.statsReport,
+		Instructions:             *f.instructions,
+	}, nil
+}
+
+// loadConfig reads the config file, if any, and sets the options it lists
+// unless the parameters already set them.
+func (f *Flags) loadConfig() (*Config, error) {
+	path := *f.configPath
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config: %w", err)
+	}
+	config, err := ParseConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	values, err := config.OptionValues()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	set := map[string]bool{}
+	f.flagSet.Visit(func(fl *flag.Flag) { set[fl.Name] = true })
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		if f.flagSet.Lookup(name) == nil || name == "config" {
+			return nil, fmt.Errorf("%s: unknown option %q", path, name)
+		}
+		if set[name] {
+			continue
+		}
+		if err := f.flagSet.Set(name, values[name]); err != nil {
+			return nil, fmt.Errorf("%s: option %s: %w", path, name, err)
+		}
+	}
+	return config, nil
+}
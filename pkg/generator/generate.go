@@ -0,0 +1,424 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"go/build/constraint"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/gen"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// Options configures Generate. The fields mirror the plugin options of
+// protoc-gen-go-mcp.
+type Options struct {
+	// FilesToGenerate lists the proto paths to generate code for. Empty
+	// generates every file in the set that declares a service.
+	FilesToGenerate []string
+
+	// PackageSuffix is the sub-package the files are generated into, "mcp"
+	// in the plugin. Empty generates into the package of the .pb.go files.
+	PackageSuffix string
+	// PackageName, when set, overrides PackageSuffix, see
+	// FileGenerator.PackageName.
+	PackageName string
+	// SamePackage generates into the package of the .pb.go files, see
+	// FileGenerator.SamePackage.
+	SamePackage bool
+	// FileSuffix replaces the .pb.mcp.go suffix of the generated files.
+	FileSuffix string
+
+	// Paths is the protoc paths parameter, "import" or "source_relative".
+	Paths string
+	// Module is the protoc module parameter, stripped from output paths.
+	Module string
+	// ImportPaths maps proto paths to Go import paths, overriding their
+	// go_package like the protoc M<proto>=<path> parameters. A value may
+	// name the package after a semicolon, "example.com/foo;foopb".
+	ImportPaths map[string]string
+
+	SchemaOptions            gen.SchemaOptions
+	ExcludeDeprecatedMethods bool
+	EmitSchemasDir           string
+	GoldenTests              bool
+	Mocks                    bool
+	FuzzTests                bool
+	FanOut                   bool
+	Failover                 bool
+	CompressSchemas          bool
+	SharedDefinitions        bool
+	BuildTag                 string
+
+	// Exclude and ToolPrefixes are the exclude and tool_prefix options, see
+	// FileGenerator.
+	Exclude      []string
+	ToolPrefixes []ToolPrefix
+	// SchemaPatches is the schema_patches option, see
+	// FileGenerator.SchemaPatches.
+	SchemaPatches map[string]SchemaPatch
+	// ToolTransforms adjust the generated tools, see
+	// FileGenerator.ToolTransforms. Message schemas are adjusted with
+	// SchemaOptions.MessageTransforms.
+	ToolTransforms []ToolTransform
+
+	// Config holds the per-service and per-method overrides of a config
+	// file. Its Options are not applied; set the fields above instead.
+	Config *Config
+
+	// Warn, when set, receives the warnings of FileGenerator.Warn.
+	Warn func(Warning)
+	// Strict turns the warnings into errors, see FileGenerator.Strict.
+	Strict bool
+	// Preview, when set, receives the inventory of FileGenerator.Preview,
+	// and no files are written.
+	Preview func(PreviewEntry)
+	// WarningsReport, when set, is the output path of a JSON report of
+	// the warnings, for CI to check.
+	WarningsReport string
+	// StatsReport, when set, is the output path of a JSON report of the
+	// Stats of the generated tools.
+	StatsReport string
+	// Instructions, when set, is the output path of MCP server
+	// instructions summarizing the generated tools, see
+	// WriteInstructions.
+	Instructions string
+}
+
+// GeneratesTool reports whether opts generate a tool for method: it is
+// unary, and neither excluded nor an excluded deprecated RPC.
+func (opts Options) GeneratesTool(method protoreflect.MethodDescriptor) bool {
+	return opts.fileGenerator().generatesTool(method)
+}
+
+// Tool returns the tool opts generate for method, whose leading comment is
+// comment, as the generated code registers it.
+func (opts Options) Tool(method protoreflect.MethodDescriptor, comment string) (runtime.Tool, error) {
+	tool := gen.ToolForMethodWithOptions(method, comment, opts.Config.schemaOptions(method, opts.SchemaOptions))
+	err := opts.fileGenerator().finishTool(method, &tool)
+	return tool, err
+}
+
+// fileGenerator returns a FileGenerator with the options that decide which
+// tools are generated and how.
+func (opts Options) fileGenerator() *FileGenerator {
+	return &FileGenerator{
+		ExcludeDeprecatedMethods: opts.ExcludeDeprecatedMethods,
+		Config:                   opts.Config,
+		Exclude:                  opts.Exclude,
+		ToolPrefixes:             opts.ToolPrefixes,
+		SchemaPatches:            opts.SchemaPatches,
+		ToolTransforms:           opts.ToolTransforms,
+	}
+}
+
+// Generate runs the generator on a FileDescriptorSet in memory, without
+// the protoc plugin protocol, and returns the content of every generated
+// file by output path. The set must contain the files to generate and all
+// their dependencies, in any order.
+func Generate(fds *descriptorpb.FileDescriptorSet, opts Options) (map[string][]byte, error) {
+	files, err := sortFiles(fds.GetFile())
+	if err != nil {
+		return nil, err
+	}
+	toGenerate := opts.FilesToGenerate
+	if len(toGenerate) == 0 {
+		for _, f := range files {
+			if len(f.GetService()) > 0 {
+				toGenerate = append(toGenerate, f.GetName())
+			}
+		}
+	}
+
+	var params []string
+	if opts.Paths != "" {
+		params = append(params, "paths="+opts.Paths)
+	}
+	if opts.Module != "" {
+		params = append(params, "module="+opts.Module)
+	}
+	for _, name := range slices.Sorted(maps.Keys(opts.ImportPaths)) {
+		params = append(params, "M"+name+"="+opts.ImportPaths[name])
+	}
+	out := map[string][]byte{}
+	err = GenerateStream(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: toGenerate,
+		Parameter:      proto.String(strings.Join(params, ",")),
+		ProtoFile:      files,
+	}, opts, func(resp *pluginpb.CodeGeneratorResponse) error {
+		for _, f := range resp.File {
+			out[f.GetName()] = []byte(f.GetContent())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GenerateStream runs the generator on a protoc plugin request one file to
+// generate at a time, passing the response with the files generated for it
+// to emit before moving on. Only the descriptors a file depends on and its
+// output are held in memory at once, so peak memory stays flat however many
+// files the request has. The responses can be written one after the other
+// as the response of a protoc plugin, whose encoding they concatenate to.
+// The reports of Options come in a last response.
+//
+// The files of req must follow their dependencies, as protoc sends them.
+// Parameters other than protoc's own (paths, module, M) are ignored; opts
+// carries the options of the generator.
+func GenerateStream(req *pluginpb.CodeGeneratorRequest, opts Options, emit func(*pluginpb.CodeGeneratorResponse) error) error {
+	newPlugin := func(toGenerate []string, files []*descriptorpb.FileDescriptorProto) (*protogen.Plugin, error) {
+		return protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+			FileToGenerate:  toGenerate,
+			Parameter:       req.Parameter,
+			ProtoFile:       files,
+			CompilerVersion: req.CompilerVersion,
+		})
+	}
+	// The options are checked against every file of the request, on the
+	// descriptors as sent: linking them all would hold them at once.
+	g := newGeneration(opts)
+	if err := g.check(req.ProtoFile); err != nil {
+		return err
+	}
+
+	byName := make(map[string]*descriptorpb.FileDescriptorProto, len(req.ProtoFile))
+	for _, f := range req.ProtoFile {
+		byName[f.GetName()] = f
+	}
+	for _, name := range req.FileToGenerate {
+		plugin, err := newPlugin([]string{name}, dependencies(req.ProtoFile, byName, name))
+		if err != nil {
+			return err
+		}
+		g.generate(plugin)
+		if err := respond(plugin, emit); err != nil {
+			return err
+		}
+	}
+
+	plugin, err := newPlugin(nil, nil)
+	if err != nil {
+		return err
+	}
+	g.writeReports(plugin)
+	return respond(plugin, emit)
+}
+
+// respond passes the response of plugin to emit, or returns its error.
+func respond(plugin *protogen.Plugin, emit func(*pluginpb.CodeGeneratorResponse) error) error {
+	resp := plugin.Response()
+	if resp.Error != nil {
+		return errors.New(resp.GetError())
+	}
+	return emit(resp)
+}
+
+// dependencies returns the file called name and the files it depends on,
+// transitively, in the order of files.
+func dependencies(files []*descriptorpb.FileDescriptorProto, byName map[string]*descriptorpb.FileDescriptorProto, name string) []*descriptorpb.FileDescriptorProto {
+	needed := map[string]bool{}
+	var visit func(name string)
+	visit = func(name string) {
+		if needed[name] {
+			return
+		}
+		needed[name] = true
+		for _, dep := range byName[name].GetDependency() {
+			visit(dep)
+		}
+	}
+	visit(name)
+	var deps []*descriptorpb.FileDescriptorProto
+	for _, f := range files {
+		if needed[f.GetName()] {
+			deps = append(deps, f)
+		}
+	}
+	return deps
+}
+
+// GenerateFiles generates every file of the plugin marked for generation.
+// Errors are reported through the plugin's response.
+func GenerateFiles(plugin *protogen.Plugin, opts Options) {
+	g := newGeneration(opts)
+	if err := g.check(plugin.Request.ProtoFile); err != nil {
+		plugin.Error(err)
+		return
+	}
+	g.generate(plugin)
+	g.writeReports(plugin)
+}
+
+// generation is the state of a run of the generator that spans the files
+// it generates: the tool names taken, the warnings and the inventory of the
+// reports.
+type generation struct {
+	opts      Options
+	toolNames map[string]string
+	warned    map[string]bool
+	entries   []PreviewEntry
+	warnings  []Warning
+}
+
+func newGeneration(opts Options) *generation {
+	return &generation{
+		opts:      opts,
+		toolNames: map[string]string{},
+		warned:    map[string]bool{},
+		warnings:  []Warning{},
+	}
+}
+
+// check validates the options against files, those of the request.
+func (g *generation) check(files []*descriptorpb.FileDescriptorProto) error {
+	opts := g.opts
+	if opts.BuildTag != "" {
+		if _, err := constraint.Parse("//go:build " + opts.BuildTag); err != nil {
+			return fmt.Errorf("build_tag %q: %w", opts.BuildTag, err)
+		}
+	}
+	for _, pattern := range opts.Exclude {
+		if err := checkPattern(pattern); err != nil {
+			return fmt.Errorf("exclude: %w", err)
+		}
+	}
+	for _, pattern := range opts.SchemaOptions.ExcludeFields {
+		if err := checkPattern(pattern); err != nil {
+			return fmt.Errorf("exclude_fields: %w", err)
+		}
+	}
+	return opts.Config.check(files)
+}
+
+func (g *generation) warn(w Warning) {
+	g.warnings = append(g.warnings, w)
+	if g.opts.Warn != nil {
+		g.opts.Warn(w)
+	}
+}
+
+// generate generates the files of plugin marked for generation.
+func (g *generation) generate(plugin *protogen.Plugin) {
+	opts := g.opts
+	for _, f := range plugin.Files {
+		if !f.Generate {
+			continue
+		}
+		fg := NewFileGenerator(f, plugin)
+		fg.SchemaOptions = opts.SchemaOptions
+		fg.ExcludeDeprecatedMethods = opts.ExcludeDeprecatedMethods
+		fg.EmitSchemasDir = opts.EmitSchemasDir
+		fg.GoldenTests = opts.GoldenTests
+		fg.Mocks = opts.Mocks
+		fg.FuzzTests = opts.FuzzTests
+		fg.FanOut = opts.FanOut
+		fg.Failover = opts.Failover
+		fg.CompressSchemas = opts.CompressSchemas
+		fg.SharedDefinitions = opts.SharedDefinitions
+		fg.BuildTag = opts.BuildTag
+		fg.PackageName = opts.PackageName
+		fg.FileSuffix = opts.FileSuffix
+		fg.SamePackage = opts.SamePackage
+		fg.Config = opts.Config
+		fg.Exclude = opts.Exclude
+		fg.ToolPrefixes = opts.ToolPrefixes
+		fg.SchemaPatches = opts.SchemaPatches
+		fg.ToolTransforms = opts.ToolTransforms
+		fg.Warn = g.warn
+		fg.Strict = opts.Strict
+		fg.Preview = opts.Preview
+		if (opts.StatsReport != "" || opts.Instructions != "") && opts.Preview == nil {
+			fg.listed = func(e PreviewEntry) { g.entries = append(g.entries, e) }
+		}
+		fg.toolNames = g.toolNames
+		fg.warned = g.warned
+		fg.Generate(opts.PackageSuffix)
+	}
+}
+
+// writeReports adds the reports of the options to plugin.
+func (g *generation) writeReports(plugin *protogen.Plugin) {
+	opts := g.opts
+	if opts.WarningsReport != "" && opts.Preview == nil {
+		if err := writeWarningsReport(plugin, opts.WarningsReport, g.warnings); err != nil {
+			plugin.Error(err)
+		}
+	}
+	if opts.StatsReport != "" && opts.Preview == nil {
+		if err := writeStatsReport(plugin, opts.StatsReport, NewStats(g.entries)); err != nil {
+			plugin.Error(err)
+		}
+	}
+	if opts.Instructions != "" && opts.Preview == nil {
+		if err := writeInstructions(plugin, opts.Instructions, g.entries); err != nil {
+			plugin.Error(err)
+		}
+	}
+}
+
+// sortFiles orders files so that every file follows its dependencies, as
+// protogen requires.
+func sortFiles(files []*descriptorpb.FileDescriptorProto) ([]*descriptorpb.FileDescriptorProto, error) {
+	byName := make(map[string]*descriptorpb.FileDescriptorProto, len(files))
+	for _, f := range files {
+		byName[f.GetName()] = f
+	}
+	sorted := make([]*descriptorpb.FileDescriptorProto, 0, len(files))
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := map[string]int{}
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("import cycle at %s", name)
+		case done:
+			return nil
+		}
+		f, ok := byName[name]
+		if !ok {
+			return fmt.Errorf("missing dependency %s", name)
+		}
+		state[name] = visiting
+		for _, dep := range f.GetDependency() {
+			if err := visit(dep); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+		state[name] = done
+		sorted = append(sorted, f)
+		return nil
+	}
+	for _, f := range files {
+		if err := visit(f.GetName()); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"maps"
+	"regexp"
+	"runtime/debug"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Version is the protoc-gen-go-mcp version recorded in generated files.
+// Release builds set it with -ldflags -X. Otherwise it is the module version
+// of a go install of a tagged version, and "devel" for every other build so
+// that local builds do not churn generated files.
+var Version = ""
+
+const modulePath = "github.com/redpanda-data/protoc-gen-go-mcp"
+
+// pseudoVersion matches the versions Go stamps into builds of untagged
+// commits.
+var pseudoVersion = regexp.MustCompile(`-(0\.)?\d{14}-[0-9a-f]{12}`)
+
+// version returns the version recorded in generated files.
+func version() string {
+	if Version != "" {
+		return Version
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "devel"
+	}
+	v := info.Main.Version
+	if info.Main.Path != modulePath {
+		v = ""
+		for _, dep := range info.Deps {
+			if dep.Path == modulePath {
+				v = dep.Version
+			}
+		}
+	}
+	if !strings.HasPrefix(v, "v") || strings.Contains(v, "+") || pseudoVersion.MatchString(v) {
+		return "devel"
+	}
+	return v
+}
+
+// generationOptions returns the options that affect the arguments and
+// results of the generated tools as sorted name=value pairs named after the
+// plugin options, for runtime.GenerationInfo. Layout options such as
+// package_suffix or build_tag, and options that only change how schemas are
+// embedded, are left out: packages differing in them serve the same tools.
+// So are the options of cosmeticOptions. TestGenerationInfoOptions in
+// cmd/protoc-gen-go-mcp fails for plugin options in none of these groups.
+func (g *FileGenerator) generationOptions() []string {
+	o := g.SchemaOptions
+	deprecatedFields := string(o.DeprecatedFields)
+	if deprecatedFields == "" {
+		deprecatedFields = "keep"
+	}
+	var mappings []string
+	for name := range o.MessageSchemas {
+		mappings = append(mappings, string(name))
+	}
+	slices.Sort(mappings)
+	patches := slices.Sorted(maps.Keys(g.SchemaPatches))
+	return []string{
+		"deprecated_fields=" + deprecatedFields,
+		"dry_run=" + strconv.FormatBool(o.DryRun),
+		"exclude_deprecated_methods=" + strconv.FormatBool(g.ExcludeDeprecatedMethods),
+		"exclude_fields=" + strings.Join(o.ExcludeFields, ","),
+		"flatten_nested=" + strconv.FormatBool(o.FlattenNested),
+		"openai_strict=" + strconv.FormatBool(o.OpenAIStrict),
+		"relative_times=" + strconv.FormatBool(o.RelativeTimes),
+		"resources=" + strconv.FormatBool(o.Resources),
+		"schema_draft=" + string(o.Draft),
+		"schema_mappings=" + strings.Join(mappings, ","),
+		"schema_patches=" + strings.Join(patches, ","),
+		"wrap_input=" + o.WrapInput,
+	}
+}
+
+// cosmeticOptions returns, like generationOptions, the options that only
+// change the descriptions of the tools and the formatting of their schemas.
+func (g *FileGenerator) cosmeticOptions() []string {
+	o := g.SchemaOptions
+	fieldComments := string(o.FieldComments)
+	if fieldComments == "" {
+		fieldComments = "none"
+	}
+	markdown := string(o.Markdown)
+	if markdown == "" {
+		markdown = "keep"
+	}
+	return []string{
+		"comment_directives=" + strings.Join(o.CommentDirectives, ","),
+		"comment_markdown=" + markdown,
+		"dedupe_descriptions=" + strconv.FormatBool(o.DedupeDescriptions),
+		"examples_in_description=" + strconv.FormatBool(o.ExamplesInDescription),
+		"field_comments=" + fieldComments,
+		"max_field_description_bytes=" + strconv.Itoa(o.MaxFieldDescriptionBytes),
+		"max_tool_description_bytes=" + strconv.Itoa(o.MaxToolDescriptionBytes),
+		"minify_schemas=" + strconv.FormatBool(o.Minify),
+		"shared_definitions=" + strconv.FormatBool(g.SharedDefinitions),
+		"titles=" + strconv.FormatBool(o.Titles),
+	}
+}
@@ -15,10 +15,16 @@
 package generator
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"go/token"
 	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"text/template"
 
 	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/gen"
@@ -37,6 +43,118 @@ type FileGenerator struct {
 	gen *protogen.Plugin
 
 	gf *protogen.GeneratedFile
+
+	// SchemaOptions is passed to the gen package for every tool schema.
+	SchemaOptions gen.SchemaOptions
+
+	// ExcludeDeprecatedMethods skips RPCs marked option deprecated = true.
+	ExcludeDeprecatedMethods bool
+
+	// EmitSchemasDir, when set, is the output directory the input and
+	// output schema of every tool are also written to as indented JSON.
+	EmitSchemasDir string
+
+	// GoldenTests also generates a _test.go file comparing every tool with
+	// a golden snapshot in the testdata directory of the package.
+	GoldenTests bool
+
+	// Mocks also generates a <Service>ServerMock for every <Service>Server
+	// interface, in a .pb.mcp.mock.go file (.mock.go in place of the .go
+	// of FileSuffix).
+	Mocks bool
+
+	// FuzzTests also generates a _test.go file fuzzing the argument
+	// decoding of every tool.
+	FuzzTests bool
+
+	// FanOut also generates FanOutTo<Service>Clients functions, which run
+	// every call against a set of clients; see runtime.FanOut.
+	FanOut bool
+	// Failover also generates FailoverTo<Service>Clients functions, which
+	// send every call to the first available of a list of clients; see
+	// runtime.Failover.
+	Failover bool
+
+	// SharedDefinitions moves message schemas that occur more than once in
+	// the tools of a file into shared definitions the schemas "$ref". The
+	// Tool vars then load their schemas with the definitions they use
+	// through runtime.SchemaDefinitions.
+	SharedDefinitions bool
+
+	// CompressSchemas embeds the tool schemas of a file gzip-compressed
+	// instead of as string literals. The Tool vars then load their schemas
+	// through runtime.CompressedSchemas on the first tools/list.
+	CompressSchemas bool
+
+	// BuildTag, when set, is the build constraint expression written as a
+	// //go:build line at the top of every generated Go file, so that the
+	// bindings only compile with e.g. -tags mcp.
+	BuildTag string
+
+	// PackageName, when set, names the package the files are generated
+	// into instead of the package suffix. "{package}" in it stands for the
+	// package of the .pb.go files, so "{package}mcp" is package_suffix=mcp.
+	// The files go into a directory of that name next to the .pb.go files.
+	PackageName string
+
+	// FileSuffix, when set, replaces GeneratedFilenameExtension. The mock
+	// and test files derive their suffixes from it.
+	FileSuffix string
+
+	// SamePackage is an empty package suffix, whatever the one passed to
+	// Generate, with the <Service>Server and <Service>Client interfaces
+	// named <Service>MCPServer and <Service>MCPClient, as
+	// protoc-gen-go-grpc declares the former in that package.
+	SamePackage bool
+
+	// Config holds the per-service and per-method overrides of the config
+	// plugin option, if any.
+	Config *Config
+
+	// Exclude holds path.Match patterns of the full names of services and
+	// methods to generate no tools or resources for, e.g. "foo.v1.Admin*".
+	Exclude []string
+
+	// ToolPrefixes prefix the tool names of the services they match. A
+	// prefix scoped to services wins over an unscoped one.
+	ToolPrefixes []ToolPrefix
+
+	// SchemaPatches, by final tool name, are applied to the schemas of the
+	// tools before they are embedded, see ReadSchemaPatches.
+	SchemaPatches map[string]SchemaPatch
+
+	// ToolTransforms adjust every generated tool, in order; see
+	// ToolTransform. Message schemas are adjusted with
+	// SchemaOptions.MessageTransforms.
+	ToolTransforms []ToolTransform
+
+	// Warn, when set, is called with what the generated code does not
+	// faithfully represent: RPCs that get no tool although they are not
+	// excluded, such as streaming ones, google.protobuf.Any fields, and
+	// truncated descriptions and tool names.
+	Warn func(Warning)
+
+	// Strict turns the warnings into errors, for teams that would rather
+	// fix their protos than ship partially functional tools.
+	Strict bool
+
+	// Preview, when set, is called with the tools, resources and prompts
+	// the file would get, and no files are written, so API owners can
+	// review the MCP surface of a proto change.
+	Preview func(PreviewEntry)
+
+	// toolNames maps the tool names generated so far to their RPCs and
+	// locations, to report collisions. GenerateFiles shares it between
+	// files; it holds no descriptors, so it does not keep them alive.
+	toolNames map[string]string
+	// warned holds the kinds and elements warned about, as messages are
+	// shared between RPCs. GenerateFiles shares it between files.
+	warned map[string]bool
+	// strictErrors holds the warnings of Strict mode.
+	strictErrors []error
+	// listed, when set, is called with the inventory of the file, like
+	// Preview but without skipping files, for the stats report.
+	listed func(PreviewEntry)
 }
 
 func NewFileGenerator(f *protogen.File, gen *protogen.Plugin) *FileGenerator {
@@ -45,6 +163,241 @@ func NewFileGenerator(f *protogen.File, gen *protogen.Plugin) *FileGenerator {
 	return &FileGenerator{f: f, gen: gen}
 }
 
+// mockTemplate renders the <Service>ServerMock types of Mocks. Package
+// qualified identifiers come from serverParams so the generated file imports
+// exactly what it uses.
+const mockTemplate = `// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
+// source: {{ .SourcePath }}
+
+package {{ .GoPackage }}
+
+{{- range .Services }}
+{{- $svc := .Name }}
+
+// {{$svc}}ServerMock is a {{$svc}}{{$.Infix}}Server for testing MCP wiring and
+// interceptors without a backend. A method calls its <Method>Func when set,
+// and otherwise returns <Method>Err or <Method>Response, defaulting to an empty
+// response. Streaming methods send <Method>Responses instead. <Method>Calls
+// returns the requests a method got.
+type {{$svc}}ServerMock struct {
+  {{- range .Methods }}
+  {{- if .Streaming }}
+  {{.Name}}Func func(req *{{.RequestType}}, stream {{$.ServerStream}}[{{.ResponseType}}]) error
+  {{.Name}}Responses []*{{.ResponseType}}
+  {{- else }}
+  {{.Name}}Func func(ctx {{$.Context}}, req *{{.RequestType}}) (*{{.ResponseType}}, error)
+  {{.Name}}Response *{{.ResponseType}}
+  {{- end }}
+  {{.Name}}Err error
+  {{- end }}
+
+  mu {{$.Mutex}}
+  {{- range .Methods }}
+  calls{{.Name}} []*{{.RequestType}}
+  {{- end }}
+}
+
+var _ {{$svc}}{{$.Infix}}Server = (*{{$svc}}ServerMock)(nil)
+{{- range .Methods }}
+{{- if .Streaming }}
+
+func (m *{{$svc}}ServerMock) {{.Name}}(req *{{.RequestType}}, stream {{$.ServerStream}}[{{.ResponseType}}]) error {
+  m.mu.Lock()
+  m.calls{{.Name}} = append(m.calls{{.Name}}, req)
+  m.mu.Unlock()
+  if m.{{.Name}}Func != nil {
+    return m.{{.Name}}Func(req, stream)
+  }
+  for _, resp := range m.{{.Name}}Responses {
+    if err := stream.Send(resp); err != nil {
+      return err
+    }
+  }
+  return m.{{.Name}}Err
+}
+{{- else }}
+
+func (m *{{$svc}}ServerMock) {{.Name}}(ctx {{$.Context}}, req *{{.RequestType}}) (*{{.ResponseType}}, error) {
+  m.mu.Lock()
+  m.calls{{.Name}} = append(m.calls{{.Name}}, req)
+  m.mu.Unlock()
+  if m.{{.Name}}Func != nil {
+    return m.{{.Name}}Func(ctx, req)
+  }
+  if m.{{.Name}}Err != nil {
+    return nil, m.{{.Name}}Err
+  }
+  if m.{{.Name}}Response != nil {
+    return m.{{.Name}}Response, nil
+  }
+  return &{{.ResponseType}}{}, nil
+}
+{{- end }}
+
+// {{.Name}}Calls returns the requests {{.Name}} was called with, in order.
+func (m *{{$svc}}ServerMock) {{.Name}}Calls() []*{{.RequestType}} {
+  m.mu.Lock()
+  defer m.mu.Unlock()
+  return append([]*{{.RequestType}}(nil), m.calls{{.Name}}...)
+}
+{{- end }}
+{{- end }}
+`
+
+// fuzzTestTemplate renders the fuzz tests of FuzzTests. Every service is
+// registered with a stub server that fails each call, so fuzzing exercises
+// the argument decoding of the real handlers and stops at the backend.
+const fuzzTestTemplate = `// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
+// source: {{ .SourcePath }}
+
+package {{ .GoPackage }}
+
+import (
+  "context"
+  "encoding/json"
+  "errors"
+  "testing"
+
+  "github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+{{- range .Services }}
+{{- $svc := .Name }}
+
+// fuzz{{$svc}}Server fails every call, so fuzzing stops at the backend.
+type fuzz{{$svc}}Server struct{}
+{{- range .Methods }}
+{{- if .Streaming }}
+
+func (fuzz{{$svc}}Server) {{.Name}}(*{{.RequestType}}, {{$.ServerStream}}[{{.ResponseType}}]) error {
+  return errors.New("not implemented")
+}
+{{- else }}
+
+func (fuzz{{$svc}}Server) {{.Name}}(context.Context, *{{.RequestType}}) (*{{.ResponseType}}, error) {
+  return nil, errors.New("not implemented")
+}
+{{- end }}
+{{- end }}
+{{- if .Seeds }}
+
+// Fuzz{{$svc}}Arguments feeds arbitrary JSON arguments to every {{$svc}}
+// tool handler. Malformed arguments must be rejected with an error, never
+// with a panic.
+func Fuzz{{$svc}}Arguments(f *testing.F) {
+  var handlers []runtime.ToolHandler
+  Register{{$svc}}Handler(runtime.AddToolFunc(func(_ runtime.Tool, handler runtime.ToolHandler) {
+    handlers = append(handlers, handler)
+  }), fuzz{{$svc}}Server{})
+
+  {{- range .Seeds }}
+  f.Add({{ printf "%#q" . }})
+  {{- end }}
+
+  f.Fuzz(func(t *testing.T, data string) {
+    for _, handler := range handlers {
+      // Handlers may rewrite the arguments in place.
+      var args map[string]any
+      if err := json.Unmarshal([]byte(data), &args); err != nil {
+        return
+      }
+      _, _ = handler(context.Background(), &runtime.CallToolRequest{Arguments: args})
+    }
+  })
+}
+{{- end }}
+{{- end }}
+`
+
+// serverParams is the input of mockTemplate and fuzzTestTemplate.
+type serverParams struct {
+	SourcePath string
+	GoPackage  string
+	Infix      string
+	Services   []serverInterface
+
+	// Context and Mutex are only set for mockTemplate.
+	Context      string
+	Mutex        string
+	ServerStream string
+}
+
+// serverInterface is a generated <Service>Server interface.
+type serverInterface struct {
+	Name    string
+	Methods []serverMethod
+
+	// Seeds are the fuzz corpus of the service's tools.
+	Seeds []string
+}
+
+// procedure returns the Connect procedure of meth, "/<service>/<method>".
+func procedure(meth *protogen.Method) string {
+	return "/" + string(meth.Parent.Desc.FullName()) + "/" + string(meth.Desc.Name())
+}
+
+type serverMethod struct {
+	Name         string
+	RequestType  string
+	ResponseType string
+	Streaming    bool
+}
+
+// goldenTestTemplate renders the golden snapshot tests of GoldenTests.
+const goldenTestTemplate = `// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
+// source: {{ .SourcePath }}
+
+package {{ .GoPackage }}
+
+import (
+  "bytes"
+  "os"
+  "path/filepath"
+  "testing"
+
+  "github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+{{- range $key, $methods := .Services }}
+{{- if $methods }}
+
+// Test{{$key}}ToolsGolden compares the {{$key}} tools with their snapshots in
+// testdata/. Run with UPDATE_MCP_GOLDEN=1 to accept intended changes.
+func Test{{$key}}ToolsGolden(t *testing.T) {
+  for _, tool := range []runtime.Tool{
+    {{- range $methodName, $tool := $methods }}
+    {{$key}}_{{$methodName}}Tool,
+    {{- end }}
+  } {
+    t.Run(tool.Name, func(t *testing.T) {
+      got, err := runtime.ToolSnapshot(tool)
+      if err != nil {
+        t.Fatal(err)
+      }
+      path := filepath.Join("testdata", tool.Name+".golden.json")
+      if os.Getenv("UPDATE_MCP_GOLDEN") != "" {
+        if err := os.MkdirAll("testdata", 0o755); err != nil {
+          t.Fatal(err)
+        }
+        if err := os.WriteFile(path, got, 0o644); err != nil {
+          t.Fatal(err)
+        }
+        return
+      }
+      want, err := os.ReadFile(path)
+      if err != nil {
+        t.Fatalf("%v; run with UPDATE_MCP_GOLDEN=1 to create it", err)
+      }
+      if !bytes.Equal(got, want) {
+        t.Errorf("tool %s differs from %s; run with UPDATE_MCP_GOLDEN=1 if the change is intended\ngot:\n%s", tool.Name, path, got)
+      }
+    })
+  }
+}
+{{- end }}
+{{- end }}
+`
+
 const fileTemplate = `// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
 // source: {{ .SourcePath }}
 
@@ -62,22 +415,59 @@ import (
 
 var (
 {{- range $key, $val := .Tools }}
-  {{$key}}Tool = {{ printf "%#v" $val }}
+  {{$key}}Tool = {{ $.ToolExpr (toolLiteral $val) }}
+{{- end }}
+{{- range $key, $val := .ExtraProperties }}
+  {{$key}}ExtraProperties = {{ $val }}
 {{- end }}
 )
+{{- if .SchemasVar }}
+
+// {{.SchemasVar}} holds the input and output
+// schemas of the tools above, gzip-compressed. They are decompressed on the
+// first tools/list, or when a tool above is Loaded.
+var {{.SchemasVar}} = runtime.NewCompressedSchemas({{.CompressedSchemas}})
+{{- end }}
+{{- if .DefinitionsVar }}
+
+// {{.DefinitionsVar}} holds the schema definitions
+// the tools above refer to with "$ref".
+var {{.DefinitionsVar}} = runtime.NewSchemaDefinitions({{.Definitions}})
+{{- end }}
+
+// {{.GenerationInfoVar}} records the
+// generator version and options of the tools above, see
+// runtime.CheckGenerationInfo.
+var {{.GenerationInfoVar}} = runtime.GenerationInfo{
+  Source:  {{ printf "%q" .GenerationInfo.Source }},
+  Version: {{ printf "%q" .GenerationInfo.Version }},
+  Options: []string{
+    {{- range .GenerationInfo.Options }}
+    {{ printf "%q" . }},
+    {{- end }}
+  },
+  Cosmetic: []string{
+    {{- range .GenerationInfo.Cosmetic }}
+    {{ printf "%q" . }},
+    {{- end }}
+  },
+}
 
 {{- range $serviceName, $methods := .Services }}
-// {{$serviceName}}Server is compatible with the grpc-go server interface.
-type {{$serviceName}}Server interface {
+// {{$serviceName}}{{$.Infix}}Server is compatible with the grpc-go server interface.
+type {{$serviceName}}{{$.Infix}}Server interface {
   {{- range $methodName, $tool := $methods }}
   {{$methodName}}(ctx context.Context, req *{{$tool.RequestType}}) (*{{$tool.ResponseType}}, error)
   {{- end }}
+  {{- range $methodName, $watch := index $.Watches $serviceName }}
+  {{$methodName}}(req *{{$watch.RequestType}}, stream grpc.ServerStreamingServer[{{$watch.ResponseType}}]) error
+  {{- end }}
 }
 {{ end }}
 
 {{- range $key, $val := .Services }}
 // Register{{$key}}Handler registers standard MCP handlers for {{$key}}
-func Register{{$key}}Handler(s runtime.MCPServer, srv {{$key}}Server, opts ...runtime.Option) {
+func Register{{$key}}Handler(s runtime.MCPServer, srv {{$key}}{{$.Infix}}Server, opts ...runtime.Option) {
   config := runtime.NewConfig()
   for _, opt := range opts {
     opt(config)
@@ -86,17 +476,97 @@ func Register{{$key}}Handler(s runtime.MCPServer, srv {{$key}}Server, opts ...ru
   {{- range $tool_name, $tool_val := $val }}
   {{$tool_name}}Tool := {{$key}}_{{$tool_name}}Tool
   {{$tool_name}}Tool = runtime.ApplyConfig({{$tool_name}}Tool, config)
+  {{- range $tool_val.Completions }}
+  {{- if .ListMethod }}
+  config.Completions.Add({{$tool_name}}Tool.Name, {{ printf "%q" .Argument }}, config.Tenant.PropagateCompleter(runtime.ResourceCompleter(srv.{{.ListMethod}})))
+  {{- else }}
+  config.Completions.Add({{$tool_name}}Tool.Name, {{ printf "%q" .Argument }}, runtime.EnumCompleter({{.Values}}))
+  {{- end }}
+  {{- end }}
 
-  s.AddTool({{$tool_name}}Tool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+  {{$tool_name}}Handler := runtime.{{ if $tool_val.SideEffects }}ApplyMutatingHandlerConfig{{ else }}ApplyHandlerConfig{{ end }}({{$tool_name}}Tool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
     var req {{$tool_val.RequestType}}
 
+    // Stop the backend call when the client's _meta timeout runs out.
+    ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+    defer cancel()
+
     message := request.Arguments
+    if message == nil {
+      // A call may come without arguments; the steps below write to them.
+      message = map[string]any{}
+    }
+
+    // Fill the fields named in the "resources" argument from their resources.
+    if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
 
-    // Extract extra properties if configured
-    for _, prop := range config.ExtraProperties {
-      if propVal, ok := message[prop.Name]; ok {
-        ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+    // Move extra properties into ctx, converted to their typed values.
+    {{- if $tool_val.ExtraProperties }}
+    extraProperties := append({{$key}}_{{$tool_name}}ExtraProperties, config.ExtraProperties...)
+    {{- else }}
+    extraProperties := config.ExtraProperties
+    {{- end }}
+    ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+    if err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+
+    if config.Elicitation {
+      // Ask the user for required arguments the model left out.
+      elicited, err := runtime.ElicitMissingArguments(ctx, {{$tool_name}}Tool, message, extraProperties)
+      if err != nil {
+        return runtime.NewToolResultError(err.Error()), nil
       }
+      message = elicited
+    }
+
+    // Strip the "headers" argument into outgoing metadata.
+    ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+    if err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+
+    {{- if $tool_val.DryRun }}
+
+    // Strip the "dry_run" argument; it validates instead of executing.
+    dryRun, err := runtime.ExtractDryRun(message)
+    if err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+    {{- end }}
+
+    {{- if $tool_val.NoArguments }}
+
+    // google.protobuf.Empty takes no arguments; ignore whatever the model sent.
+    message = map[string]any{}
+    {{- else if $.WrapInput }}
+    // The request fields are nested under the wrap_input property.
+    message, err = runtime.UnwrapArguments(message, {{ printf "%q" $.WrapInput }})
+    if err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+    {{- end }}
+
+    {{- if $.FlattenNested }}
+
+    // Nested request fields arrive as dotted properties.
+    if err := runtime.UnflattenArguments(message); err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+    {{- end }}
+    {{- if $tool_val.ExcludedFields }}
+
+    // Excluded fields are not in the schema; refuse them.
+    if err := runtime.RejectFields(req.ProtoReflect().Descriptor(), message, {{ $tool_val.ExcludedFields }}); err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+    {{- end }}
+
+    // Fill (mcp.field).from_context fields from ctx; the model never sets them.
+    if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+      return nil, err
     }
 
     // Rewrite oneof discriminated wrappers and recursion placeholders into the
@@ -105,6 +575,27 @@ func Register{{$key}}Handler(s runtime.MCPServer, srv {{$key}}Server, opts ...ru
       return runtime.NewToolResultError(err.Error()), nil
     }
 
+    // Clean up string values with the runtime.WithStringSanitizers sanitizers.
+    if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+
+    {{- if $.RelativeTimes }}
+
+    // Read relative and zone-less times in every Timestamp and Duration field.
+    if err := runtime.NormalizeTimes(req.ProtoReflect().Descriptor(), message); err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+    {{- end }}
+    {{- if $tool_val.IdempotencyKey }}
+
+    // Derive the idempotency key the model left out, scoped to the session
+    // and tenant of the call.
+    if err := runtime.FillIdempotencyKey(ctx, req.ProtoReflect().Descriptor(), message); err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+    {{- end }}
+
     marshaled, err := json.Marshal(message)
     if err != nil {
       return nil, err
@@ -113,29 +604,86 @@ func Register{{$key}}Handler(s runtime.MCPServer, srv {{$key}}Server, opts ...ru
     if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
       return nil, err
     }
+    {{- if $tool_val.DryRun }}
+
+    // A backend with validate_only performs the dry run itself; otherwise
+    // return the decoded request without calling it.
+    if dryRun && !runtime.SetValidateOnly(&req) {
+      return runtime.DryRunResult(&req)
+    }
+    {{- end }}
 
+    done := runtime.StartBackendCall(ctx)
     resp, err := srv.{{$tool_name}}(ctx, &req)
+    done(resp, err)
     if err != nil {
       return runtime.HandleError(err)
     }
 
+    {{- if $tool_val.Files }}
+
+    return runtime.NewToolResultFiles({{$tool_name}}Tool.Name, resp)
+    {{- else }}
+
     structured, err := runtime.EncodeMessage(resp)
     if err != nil {
       return nil, err
     }
 
     return runtime.NewToolResultJSON(structured), nil
+    {{- end }}
   })
+  runtime.AddTool(s, config, {{$tool_name}}Tool, {{$tool_name}}Handler)
+  {{- if $tool_val.Resource.URI }}
+
+  if runtime.MatchesTags({{$tool_name}}Tool, config.Tags) {
+    // Reads call the tool, so they share its arguments pipeline and middleware.
+    runtime.AddResource(s, runtime.ApplyResourceConfig({{ printf "%#v" $tool_val.Resource }}, config), runtime.ToolResource({{ printf "%q" $tool_val.Resource.URI }}, (&{{$tool_val.RequestType}}{}).ProtoReflect().Descriptor(), {{ printf "%q" $.WrapInput }}, {{$tool_name}}Handler))
+    {{- if $tool_val.ResourceCompletions }}
+    config.Completions.Inherit({{ printf "%q" $tool_val.Resource.URI }}, {{$tool_name}}Tool.Name, {{$tool_val.ResourceCompletions}})
+    {{- end }}
+  }
+  {{- end }}
+  {{- end }}
+  {{- range $watch_name, $watch := index $.Watches $key }}
+
+  runtime.AddWatchedResource(s, runtime.ApplyResourceConfig({{ printf "%#v" $watch.Resource }}, config), config.Tenant.Subscriptions(config.Subscriptions), config.Tenant.PropagateWatch({{ printf "%q" $watch.Resource.URI }}, func(ctx context.Context, uri string, update runtime.ResourceUpdate) error {
+    var req {{$watch.RequestType}}
+    if err := runtime.SetURIVariables(&req, {{ printf "%q" $watch.Resource.URI }}, uri); err != nil {
+      return err
+    }
+    return srv.{{$watch_name}}(&req, runtime.NewServerStream[{{$watch.ResponseType}}](ctx, update))
+  }))
+  {{- end }}
+  {{- range (index $.Prompts $key) }}
+  {
+    prompt := runtime.ApplyPromptConfig({{ printf "%#v" .MCPPrompt }}, config)
+    {{- if .Tools }}
+    runtime.AddPrompt(s, prompt, runtime.TemplatePromptHandler(prompt, {{ printf "%q" .Template }}, map[string]string{
+      {{- range $ref, $method := .Tools }}
+      {{ printf "%q" $ref }}: {{$method}}Tool.Name,
+      {{- end }}
+    }))
+    {{- else }}
+    runtime.AddPrompt(s, prompt, runtime.TemplatePromptHandler(prompt, {{ printf "%q" .Template }}, nil))
+    {{- end }}
+    {{- if .SharedCompletions }}
+    config.Completions.Inherit(prompt.Name, {{.Method}}Tool.Name, {{.SharedCompletions}})
+    {{- end }}
+  }
   {{- end }}
 }
 {{- end }}
 
 {{- range $serviceName, $methods := .Services }}
-// {{$serviceName}}Client is compatible with the grpc-go client interface.
-type {{$serviceName}}Client interface {
+// {{$serviceName}}{{$.Infix}}Client is compatible with the grpc-go client interface.
+type {{$serviceName}}{{$.Infix}}Client interface {
   {{- range $methodName, $tool := $methods }}
   {{$methodName}}(ctx context.Context, req *{{$tool.RequestType}}, opts ...grpc.CallOption) (*{{$tool.ResponseType}}, error)
   {{- end }}
+  {{- range $methodName, $watch := index $.Watches $serviceName }}
+  {{$methodName}}(ctx context.Context, req *{{$watch.RequestType}}, opts ...grpc.CallOption) (grpc.ServerStreamingClient[{{$watch.ResponseType}}], error)
+  {{- end }}
 }
 {{ end }}
 
@@ -146,6 +694,9 @@ type Connect{{$serviceName}}Client interface {
   {{- range $methodName, $tool := $methods }}
   {{$methodName}}(ctx context.Context, req *connect.Request[{{$tool.RequestType}}]) (*connect.Response[{{$tool.ResponseType}}], error)
   {{- end }}
+  {{- range $methodName, $watch := index $.Watches $serviceName }}
+  {{$methodName}}(ctx context.Context, req *connect.Request[{{$watch.RequestType}}]) (*connect.ServerStreamForClient[{{$watch.ResponseType}}], error)
+  {{- end }}
 }
 {{ end }}
 
@@ -160,75 +711,130 @@ func ForwardToConnect{{$key}}Client(s runtime.MCPServer, client Connect{{$key}}C
   {{- range $tool_name, $tool_val := $val }}
   {{$tool_name}}Tool := {{$key}}_{{$tool_name}}Tool
   {{$tool_name}}Tool = runtime.ApplyConfig({{$tool_name}}Tool, config)
+  {{- range $tool_val.Completions }}
+  {{- if .ListMethod }}
+  config.Completions.Add({{$tool_name}}Tool.Name, {{ printf "%q" .Argument }}, config.Tenant.PropagateCompleter(runtime.ResourceCompleter(func(ctx context.Context, req *{{.ListRequestType}}) (*{{.ListResponseType}}, error) {
+    resp, err := client.{{.ListMethod}}(ctx, connect.NewRequest(req))
+    if err != nil {
+      return nil, err
+    }
+    return resp.Msg, nil
+  })))
+  {{- else }}
+  config.Completions.Add({{$tool_name}}Tool.Name, {{ printf "%q" .Argument }}, runtime.EnumCompleter({{.Values}}))
+  {{- end }}
+  {{- end }}
 
-  s.AddTool({{$tool_name}}Tool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+  {{$tool_name}}Handler := runtime.{{ if $tool_val.SideEffects }}ApplyMutatingHandlerConfig{{ else }}ApplyHandlerConfig{{ end }}({{$tool_name}}Tool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
     var req {{$tool_val.RequestType}}
 
-    message := request.Arguments
+    // Stop the backend call when the client's _meta timeout runs out.
+    ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+    defer cancel()
 
-    // Extract extra properties if configured
-    for _, prop := range config.ExtraProperties {
-      if propVal, ok := message[prop.Name]; ok {
-        ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-      }
+    message := request.Arguments
+    if message == nil {
+      // A call may come without arguments; the steps below write to them.
+      message = map[string]any{}
     }
 
-    if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+    // Fill the fields named in the "resources" argument from their resources.
+    if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
       return runtime.NewToolResultError(err.Error()), nil
     }
 
-    marshaled, err := json.Marshal(message)
+    // Move extra properties into ctx, converted to their typed values.
+    {{- if $tool_val.ExtraProperties }}
+    extraProperties := append({{$key}}_{{$tool_name}}ExtraProperties, config.ExtraProperties...)
+    {{- else }}
+    extraProperties := config.ExtraProperties
+    {{- end }}
+    ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
     if err != nil {
-      return nil, err
+      return runtime.NewToolResultError(err.Error()), nil
     }
 
-    if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-      return nil, err
+    if config.Elicitation {
+      // Ask the user for required arguments the model left out.
+      elicited, err := runtime.ElicitMissingArguments(ctx, {{$tool_name}}Tool, message, extraProperties)
+      if err != nil {
+        return runtime.NewToolResultError(err.Error()), nil
+      }
+      message = elicited
     }
 
-    resp, err := client.{{$tool_name}}(ctx, connect.NewRequest(&req))
+    // Strip the "headers" argument into outgoing metadata.
+    ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
     if err != nil {
-      return runtime.HandleError(err)
+      return runtime.NewToolResultError(err.Error()), nil
     }
 
-    structured, err := runtime.EncodeMessage(resp.Msg)
+    {{- if $tool_val.DryRun }}
+
+    // Strip the "dry_run" argument; it validates instead of executing.
+    dryRun, err := runtime.ExtractDryRun(message)
     if err != nil {
-      return nil, err
+      return runtime.NewToolResultError(err.Error()), nil
     }
-    return runtime.NewToolResultJSON(structured), nil
-  })
-  {{- end }}
-}
-{{- end }}
+    {{- end }}
 
-{{- range $key, $val := .Services }}
-// ForwardTo{{$key}}Client registers a gRPC client, to forward MCP calls to it.
-func ForwardTo{{$key}}Client(s runtime.MCPServer, client {{$key}}Client, opts ...runtime.Option) {
-  config := runtime.NewConfig()
-  for _, opt := range opts {
-    opt(config)
-  }
+    {{- if $tool_val.NoArguments }}
 
-  {{- range $tool_name, $tool_val := $val }}
-  {{$tool_name}}Tool := {{$key}}_{{$tool_name}}Tool
-  {{$tool_name}}Tool = runtime.ApplyConfig({{$tool_name}}Tool, config)
+    // google.protobuf.Empty takes no arguments; ignore whatever the model sent.
+    message = map[string]any{}
+    {{- else if $.WrapInput }}
+    // The request fields are nested under the wrap_input property.
+    message, err = runtime.UnwrapArguments(message, {{ printf "%q" $.WrapInput }})
+    if err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+    {{- end }}
 
-  s.AddTool({{$tool_name}}Tool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
-    var req {{$tool_val.RequestType}}
+    {{- if $.FlattenNested }}
 
-    message := request.Arguments
+    // Nested request fields arrive as dotted properties.
+    if err := runtime.UnflattenArguments(message); err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+    {{- end }}
+    {{- if $tool_val.ExcludedFields }}
 
-    // Extract extra properties if configured
-    for _, prop := range config.ExtraProperties {
-      if propVal, ok := message[prop.Name]; ok {
-        ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-      }
+    // Excluded fields are not in the schema; refuse them.
+    if err := runtime.RejectFields(req.ProtoReflect().Descriptor(), message, {{ $tool_val.ExcludedFields }}); err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+    {{- end }}
+
+    // Fill (mcp.field).from_context fields from ctx; the model never sets them.
+    if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+      return nil, err
     }
 
     if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
       return runtime.NewToolResultError(err.Error()), nil
     }
 
+    // Clean up string values with the runtime.WithStringSanitizers sanitizers.
+    if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+
+    {{- if $.RelativeTimes }}
+
+    // Read relative and zone-less times in every Timestamp and Duration field.
+    if err := runtime.NormalizeTimes(req.ProtoReflect().Descriptor(), message); err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+    {{- end }}
+    {{- if $tool_val.IdempotencyKey }}
+
+    // Derive the idempotency key the model left out, scoped to the session
+    // and tenant of the call.
+    if err := runtime.FillIdempotencyKey(ctx, req.ProtoReflect().Descriptor(), message); err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+    {{- end }}
+
     marshaled, err := json.Marshal(message)
     if err != nil {
       return nil, err
@@ -237,37 +843,1033 @@ func ForwardTo{{$key}}Client(s runtime.MCPServer, client {{$key}}Client, opts ..
     if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
       return nil, err
     }
+    {{- if $tool_val.DryRun }}
+
+    // A backend with validate_only performs the dry run itself; otherwise
+    // return the decoded request without calling it.
+    if dryRun && !runtime.SetValidateOnly(&req) {
+      return runtime.DryRunResult(&req)
+    }
+    {{- end }}
+
+    {{- if $tool_val.SideEffects }}
 
-    resp, err := client.{{$tool_name}}(ctx, &req)
+    creq := connect.NewRequest(&req)
+    runtime.SetOutgoingHeaders(ctx, creq.Header())
+    runtime.ApplyConnectHeaders(ctx, {{$tool_name}}Tool.Name, config, creq.Header())
+    done := runtime.StartBackendCall(ctx)
+    resp, err := client.{{$tool_name}}(ctx, creq)
+    done(resp, err)
+    {{- else }}
+
+    done := runtime.StartBackendCall(ctx)
+    resp, err := runtime.Hedge(ctx, config, func(ctx context.Context) (*connect.Response[{{$tool_val.ResponseType}}], error) {
+      creq := connect.NewRequest(&req)
+      runtime.SetOutgoingHeaders(ctx, creq.Header())
+      runtime.ApplyConnectHeaders(ctx, {{$tool_name}}Tool.Name, config, creq.Header())
+      return client.{{$tool_name}}(ctx, creq)
+    })
+    done(resp, err)
+    {{- end }}
     if err != nil {
       return runtime.HandleError(err)
     }
 
-    structured, err := runtime.EncodeMessage(resp)
+    {{- if $tool_val.Files }}
+
+    return runtime.NewToolResultFiles({{$tool_name}}Tool.Name, resp.Msg)
+    {{- else }}
+
+    structured, err := runtime.EncodeMessage(resp.Msg)
     if err != nil {
       return nil, err
     }
     return runtime.NewToolResultJSON(structured), nil
+    {{- end }}
   })
-  {{- end }}
-}
-{{- end }}
+  runtime.AddTool(s, config, {{$tool_name}}Tool, {{$tool_name}}Handler)
+  {{- if $tool_val.Resource.URI }}
 
+  if runtime.MatchesTags({{$tool_name}}Tool, config.Tags) {
+    // Reads call the tool, so they share its arguments pipeline and middleware.
+    runtime.AddResource(s, runtime.ApplyResourceConfig({{ printf "%#v" $tool_val.Resource }}, config), runtime.ToolResource({{ printf "%q" $tool_val.Resource.URI }}, (&{{$tool_val.RequestType}}{}).ProtoReflect().Descriptor(), {{ printf "%q" $.WrapInput }}, {{$tool_name}}Handler))
+    {{- if $tool_val.ResourceCompletions }}
+    config.Completions.Inherit({{ printf "%q" $tool_val.Resource.URI }}, {{$tool_name}}Tool.Name, {{$tool_val.ResourceCompletions}})
+    {{- end }}
+  }
+  {{- end }}
+  {{- end }}
+  {{- range $watch_name, $watch := index $.Watches $key }}
 
-`
-
-type TplParams struct {
-	PackageName string
-	SourcePath  string
-	GoPackage   string
-	Tools       map[string]runtime.Tool
-	Services    map[string]map[string]Tool
-}
+  runtime.AddWatchedResource(s, runtime.ApplyResourceConfig({{ printf "%#v" $watch.Resource }}, config), config.Tenant.Subscriptions(config.Subscriptions), config.Tenant.PropagateWatch({{ printf "%q" $watch.Resource.URI }}, func(ctx context.Context, uri string, update runtime.ResourceUpdate) error {
+    var req {{$watch.RequestType}}
+    if err := runtime.SetURIVariables(&req, {{ printf "%q" $watch.Resource.URI }}, uri); err != nil {
+      return err
+    }
+    stream, err := client.{{$watch_name}}(ctx, connect.NewRequest(&req))
+    if err != nil {
+      return err
+    }
+    defer stream.Close()
+    for stream.Receive() {
+      update(stream.Msg())
+    }
+    return stream.Err()
+  }))
+  {{- end }}
+  {{- range (index $.Prompts $key) }}
+  {
+    prompt := runtime.ApplyPromptConfig({{ printf "%#v" .MCPPrompt }}, config)
+    {{- if .Tools }}
+    runtime.AddPrompt(s, prompt, runtime.TemplatePromptHandler(prompt, {{ printf "%q" .Template }}, map[string]string{
+      {{- range $ref, $method := .Tools }}
+      {{ printf "%q" $ref }}: {{$method}}Tool.Name,
+      {{- end }}
+    }))
+    {{- else }}
+    runtime.AddPrompt(s, prompt, runtime.TemplatePromptHandler(prompt, {{ printf "%q" .Template }}, nil))
+    {{- end }}
+    {{- if .SharedCompletions }}
+    config.Completions.Inherit(prompt.Name, {{.Method}}Tool.Name, {{.SharedCompletions}})
+    {{- end }}
+  }
+  {{- end }}
+}
+
+// ForwardToConnect{{$key}}URL forwards MCP calls to the {{$key}} at baseURL
+// with a connectrpc client. Select its wire protocol and codec with
+// runtime.WithConnectProtocol and runtime.WithConnectCodec.
+func ForwardToConnect{{$key}}URL(s runtime.MCPServer, httpClient connect.HTTPClient, baseURL string, opts ...runtime.Option) {
+  config := runtime.NewConfig()
+  for _, opt := range opts {
+    opt(config)
+  }
+  clientOpts := runtime.ConnectClientOptions(config)
+  ForwardToConnect{{$key}}Client(s, connect{{$key}}Client{
+    {{- range $tool_name, $tool_val := $val }}
+    call{{$tool_name}}: connect.NewClient[{{$tool_val.RequestType}}, {{$tool_val.ResponseType}}](httpClient, runtime.ConnectProcedureURL(baseURL, {{ printf "%q" $tool_val.Procedure }}), clientOpts...),
+    {{- end }}
+    {{- range $watch_name, $watch := index $.Watches $key }}
+    call{{$watch_name}}: connect.NewClient[{{$watch.RequestType}}, {{$watch.ResponseType}}](httpClient, runtime.ConnectProcedureURL(baseURL, {{ printf "%q" $watch.Procedure }}), clientOpts...),
+    {{- end }}
+  }, opts...)
+}
+
+// connect{{$key}}Client is the Connect{{$key}}Client of
+// ForwardToConnect{{$key}}URL.
+type connect{{$key}}Client struct {
+  {{- range $tool_name, $tool_val := $val }}
+  call{{$tool_name}} *connect.Client[{{$tool_val.RequestType}}, {{$tool_val.ResponseType}}]
+  {{- end }}
+  {{- range $watch_name, $watch := index $.Watches $key }}
+  call{{$watch_name}} *connect.Client[{{$watch.RequestType}}, {{$watch.ResponseType}}]
+  {{- end }}
+}
+{{- range $tool_name, $tool_val := $val }}
+
+func (c connect{{$key}}Client) {{$tool_name}}(ctx context.Context, req *connect.Request[{{$tool_val.RequestType}}]) (*connect.Response[{{$tool_val.ResponseType}}], error) {
+  return c.call{{$tool_name}}.CallUnary(ctx, req)
+}
+{{- end }}
+{{- range $watch_name, $watch := index $.Watches $key }}
+
+func (c connect{{$key}}Client) {{$watch_name}}(ctx context.Context, req *connect.Request[{{$watch.RequestType}}]) (*connect.ServerStreamForClient[{{$watch.ResponseType}}], error) {
+  return c.call{{$watch_name}}.CallServerStream(ctx, req)
+}
+{{- end }}
+
+// Connect{{$key}}Handler is compatible with the connectrpc-go handler interface.
+type Connect{{$key}}Handler interface {
+  {{- range $tool_name, $tool_val := $val }}
+  {{$tool_name}}(ctx context.Context, req *connect.Request[{{$tool_val.RequestType}}]) (*connect.Response[{{$tool_val.ResponseType}}], error)
+  {{- end }}
+  {{- range $watch_name, $watch := index $.Watches $key }}
+  {{$watch_name}}(ctx context.Context, req *connect.Request[{{$watch.RequestType}}], stream *connect.ServerStream[{{$watch.ResponseType}}]) error
+  {{- end }}
+}
+
+// ForwardToConnect{{$key}}Handler forwards MCP calls to a connectrpc handler
+// implementation in the same process by calling its methods directly, without
+// serializing requests. Handler interceptors do not run. Watched resources
+// need a stream, which only a mounted handler can serve: forward to it with
+// ForwardToConnect{{$key}}URL and runtime.InProcessHTTPClient instead.
+func ForwardToConnect{{$key}}Handler(s runtime.MCPServer, handler Connect{{$key}}Handler, opts ...runtime.Option) {
+  ForwardToConnect{{$key}}Client(s, handler{{$key}}Client{handler}, opts...)
+}
+
+// handler{{$key}}Client is the Connect{{$key}}Client of
+// ForwardToConnect{{$key}}Handler.
+type handler{{$key}}Client struct {
+  Connect{{$key}}Handler
+}
+{{- range $watch_name, $watch := index $.Watches $key }}
+
+func (c handler{{$key}}Client) {{$watch_name}}(ctx context.Context, req *connect.Request[{{$watch.RequestType}}]) (*connect.ServerStreamForClient[{{$watch.ResponseType}}], error) {
+  return nil, runtime.ErrInProcessStream({{ printf "%q" $watch.Procedure }})
+}
+{{- end }}
+{{- end }}
+
+{{- range $key, $val := .Services }}
+// ForwardTo{{$key}}Client registers a gRPC client, to forward MCP calls to it.
+func ForwardTo{{$key}}Client(s runtime.MCPServer, client {{$key}}{{$.Infix}}Client, opts ...runtime.Option) {
+  config := runtime.NewConfig()
+  for _, opt := range opts {
+    opt(config)
+  }
+
+  {{- range $tool_name, $tool_val := $val }}
+  {{$tool_name}}Tool := {{$key}}_{{$tool_name}}Tool
+  {{$tool_name}}Tool = runtime.ApplyConfig({{$tool_name}}Tool, config)
+  {{- range $tool_val.Completions }}
+  {{- if .ListMethod }}
+  config.Completions.Add({{$tool_name}}Tool.Name, {{ printf "%q" .Argument }}, config.Tenant.PropagateCompleter(runtime.ResourceCompleter(func(ctx context.Context, req *{{.ListRequestType}}) (*{{.ListResponseType}}, error) {
+    return client.{{.ListMethod}}(ctx, req)
+  })))
+  {{- else }}
+  config.Completions.Add({{$tool_name}}Tool.Name, {{ printf "%q" .Argument }}, runtime.EnumCompleter({{.Values}}))
+  {{- end }}
+  {{- end }}
+
+  {{$tool_name}}Handler := runtime.{{ if $tool_val.SideEffects }}ApplyMutatingHandlerConfig{{ else }}ApplyHandlerConfig{{ end }}({{$tool_name}}Tool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+    var req {{$tool_val.RequestType}}
+
+    // Stop the backend call when the client's _meta timeout runs out.
+    ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+    defer cancel()
+
+    message := request.Arguments
+    if message == nil {
+      // A call may come without arguments; the steps below write to them.
+      message = map[string]any{}
+    }
+
+    // Fill the fields named in the "resources" argument from their resources.
+    if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+
+    // Move extra properties into ctx, converted to their typed values.
+    {{- if $tool_val.ExtraProperties }}
+    extraProperties := append({{$key}}_{{$tool_name}}ExtraProperties, config.ExtraProperties...)
+    {{- else }}
+    extraProperties := config.ExtraProperties
+    {{- end }}
+    ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+    if err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+
+    if config.Elicitation {
+      // Ask the user for required arguments the model left out.
+      elicited, err := runtime.ElicitMissingArguments(ctx, {{$tool_name}}Tool, message, extraProperties)
+      if err != nil {
+        return runtime.NewToolResultError(err.Error()), nil
+      }
+      message = elicited
+    }
+
+    // Strip the "headers" argument into outgoing metadata.
+    ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+    if err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+
+    {{- if $tool_val.DryRun }}
+
+    // Strip the "dry_run" argument; it validates instead of executing.
+    dryRun, err := runtime.ExtractDryRun(message)
+    if err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+    {{- end }}
+
+    {{- if $tool_val.NoArguments }}
+
+    // google.protobuf.Empty takes no arguments; ignore whatever the model sent.
+    message = map[string]any{}
+    {{- else if $.WrapInput }}
+    // The request fields are nested under the wrap_input property.
+    message, err = runtime.UnwrapArguments(message, {{ printf "%q" $.WrapInput }})
+    if err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+    {{- end }}
+
+    {{- if $.FlattenNested }}
+
+    // Nested request fields arrive as dotted properties.
+    if err := runtime.UnflattenArguments(message); err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+    {{- end }}
+    {{- if $tool_val.ExcludedFields }}
+
+    // Excluded fields are not in the schema; refuse them.
+    if err := runtime.RejectFields(req.ProtoReflect().Descriptor(), message, {{ $tool_val.ExcludedFields }}); err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+    {{- end }}
+
+    // Fill (mcp.field).from_context fields from ctx; the model never sets them.
+    if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+      return nil, err
+    }
+
+    if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+
+    // Clean up string values with the runtime.WithStringSanitizers sanitizers.
+    if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+
+    {{- if $.RelativeTimes }}
+
+    // Read relative and zone-less times in every Timestamp and Duration field.
+    if err := runtime.NormalizeTimes(req.ProtoReflect().Descriptor(), message); err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+    {{- end }}
+    {{- if $tool_val.IdempotencyKey }}
+
+    // Derive the idempotency key the model left out, scoped to the session
+    // and tenant of the call.
+    if err := runtime.FillIdempotencyKey(ctx, req.ProtoReflect().Descriptor(), message); err != nil {
+      return runtime.NewToolResultError(err.Error()), nil
+    }
+    {{- end }}
+
+    marshaled, err := json.Marshal(message)
+    if err != nil {
+      return nil, err
+    }
+
+    if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+      return nil, err
+    }
+    {{- if $tool_val.DryRun }}
+
+    // A backend with validate_only performs the dry run itself; otherwise
+    // return the decoded request without calling it.
+    if dryRun && !runtime.SetValidateOnly(&req) {
+      return runtime.DryRunResult(&req)
+    }
+    {{- end }}
+
+    done := runtime.StartBackendCall(ctx)
+    {{- if $tool_val.SideEffects }}
+    resp, err := client.{{$tool_name}}(ctx, &req, runtime.ApplyCallOptions(ctx, {{$tool_name}}Tool.Name, config)...)
+    {{- else }}
+    resp, err := runtime.Hedge(ctx, config, func(ctx context.Context) (*{{$tool_val.ResponseType}}, error) {
+      return client.{{$tool_name}}(ctx, &req, runtime.ApplyCallOptions(ctx, {{$tool_name}}Tool.Name, config)...)
+    })
+    {{- end }}
+    done(resp, err)
+    if err != nil {
+      return runtime.HandleError(err)
+    }
+
+    {{- if $tool_val.Files }}
+
+    return runtime.NewToolResultFiles({{$tool_name}}Tool.Name, resp)
+    {{- else }}
+
+    structured, err := runtime.EncodeMessage(resp)
+    if err != nil {
+      return nil, err
+    }
+    return runtime.NewToolResultJSON(structured), nil
+    {{- end }}
+  })
+  runtime.AddTool(s, config, {{$tool_name}}Tool, {{$tool_name}}Handler)
+  {{- if $tool_val.Resource.URI }}
+
+  if runtime.MatchesTags({{$tool_name}}Tool, config.Tags) {
+    // Reads call the tool, so they share its arguments pipeline and middleware.
+    runtime.AddResource(s, runtime.ApplyResourceConfig({{ printf "%#v" $tool_val.Resource }}, config), runtime.ToolResource({{ printf "%q" $tool_val.Resource.URI }}, (&{{$tool_val.RequestType}}{}).ProtoReflect().Descriptor(), {{ printf "%q" $.WrapInput }}, {{$tool_name}}Handler))
+    {{- if $tool_val.ResourceCompletions }}
+    config.Completions.Inherit({{ printf "%q" $tool_val.Resource.URI }}, {{$tool_name}}Tool.Name, {{$tool_val.ResourceCompletions}})
+    {{- end }}
+  }
+  {{- end }}
+  {{- end }}
+  {{- range $watch_name, $watch := index $.Watches $key }}
+
+  runtime.AddWatchedResource(s, runtime.ApplyResourceConfig({{ printf "%#v" $watch.Resource }}, config), config.Tenant.Subscriptions(config.Subscriptions), config.Tenant.PropagateWatch({{ printf "%q" $watch.Resource.URI }}, func(ctx context.Context, uri string, update runtime.ResourceUpdate) error {
+    var req {{$watch.RequestType}}
+    if err := runtime.SetURIVariables(&req, {{ printf "%q" $watch.Resource.URI }}, uri); err != nil {
+      return err
+    }
+    stream, err := client.{{$watch_name}}(ctx, &req)
+    if err != nil {
+      return err
+    }
+    return runtime.RecvAll(stream.Recv, update)
+  }))
+  {{- end }}
+  {{- range (index $.Prompts $key) }}
+  {
+    prompt := runtime.ApplyPromptConfig({{ printf "%#v" .MCPPrompt }}, config)
+    {{- if .Tools }}
+    runtime.AddPrompt(s, prompt, runtime.TemplatePromptHandler(prompt, {{ printf "%q" .Template }}, map[string]string{
+      {{- range $ref, $method := .Tools }}
+      {{ printf "%q" $ref }}: {{$method}}Tool.Name,
+      {{- end }}
+    }))
+    {{- else }}
+    runtime.AddPrompt(s, prompt, runtime.TemplatePromptHandler(prompt, {{ printf "%q" .Template }}, nil))
+    {{- end }}
+    {{- if .SharedCompletions }}
+    config.Completions.Inherit(prompt.Name, {{.Method}}Tool.Name, {{.SharedCompletions}})
+    {{- end }}
+  }
+  {{- end }}
+}
+{{- end }}
+
+{{- range $key, $val := .Services }}
+
+// ForwardTo{{$key}}Conn forwards MCP calls to the {{$key}} behind conn,
+// e.g. a *grpc.ClientConn with interceptors or a connection pool, without
+// building a typed gRPC client first.
+func ForwardTo{{$key}}Conn(s runtime.MCPServer, conn grpc.ClientConnInterface, opts ...runtime.Option) {
+  ForwardTo{{$key}}Client(s, conn{{$key}}{{$.Infix}}Client{conn}, opts...)
+}
+
+// conn{{$key}}{{$.Infix}}Client is the {{$key}}{{$.Infix}}Client of
+// ForwardTo{{$key}}Conn.
+type conn{{$key}}{{$.Infix}}Client struct {
+  conn grpc.ClientConnInterface
+}
+{{- range $methodName, $tool := $val }}
+
+func (c conn{{$key}}{{$.Infix}}Client) {{$methodName}}(ctx context.Context, req *{{$tool.RequestType}}, opts ...grpc.CallOption) (*{{$tool.ResponseType}}, error) {
+  resp := new({{$tool.ResponseType}})
+  if err := c.conn.Invoke(ctx, {{ printf "%q" $tool.Procedure }}, req, resp, opts...); err != nil {
+    return nil, err
+  }
+  return resp, nil
+}
+{{- end }}
+{{- range $methodName, $watch := index $.Watches $key }}
+
+func (c conn{{$key}}{{$.Infix}}Client) {{$methodName}}(ctx context.Context, req *{{$watch.RequestType}}, opts ...grpc.CallOption) (grpc.ServerStreamingClient[{{$watch.ResponseType}}], error) {
+  stream, err := c.conn.NewStream(ctx, &grpc.StreamDesc{StreamName: {{ printf "%q" $methodName }}, ServerStreams: true}, {{ printf "%q" $watch.Procedure }}, opts...)
+  if err != nil {
+    return nil, err
+  }
+  x := &grpc.GenericClientStream[{{$watch.RequestType}}, {{$watch.ResponseType}}]{ClientStream: stream}
+  if err := x.ClientStream.SendMsg(req); err != nil {
+    return nil, err
+  }
+  if err := x.ClientStream.CloseSend(); err != nil {
+    return nil, err
+  }
+  return x, nil
+}
+{{- end }}
+{{- end }}
+
+{{- range $key, $val := .Services }}
+{{- if $.HasHTTP $key }}
+
+// ForwardTo{{$key}}HTTP forwards MCP calls to the JSON gateway of {{$key}}
+// at baseURL, through the google.api.http bindings of its RPCs. RPCs without
+// a binding fail with UNIMPLEMENTED. See runtime.CallHTTP.
+func ForwardTo{{$key}}HTTP(s runtime.MCPServer, client runtime.HTTPClient, baseURL string, opts ...runtime.Option) {
+  ForwardTo{{$key}}Client(s, http{{$key}}{{$.Infix}}Client{client: client, baseURL: baseURL}, opts...)
+}
+
+// http{{$key}}{{$.Infix}}Client is the {{$key}}{{$.Infix}}Client of
+// ForwardTo{{$key}}HTTP.
+type http{{$key}}{{$.Infix}}Client struct {
+  client  runtime.HTTPClient
+  baseURL string
+}
+{{- range $methodName, $tool := $val }}
+
+{{- if $tool.HTTP.Path }}
+
+func (c http{{$key}}{{$.Infix}}Client) {{$methodName}}(ctx context.Context, req *{{$tool.RequestType}}, _ ...grpc.CallOption) (*{{$tool.ResponseType}}, error) {
+  var resp {{$tool.ResponseType}}
+  if err := runtime.CallHTTP(ctx, c.client, c.baseURL, {{ printf "%#v" $tool.HTTP }}, req, &resp); err != nil {
+    return nil, err
+  }
+  return &resp, nil
+}
+{{- else }}
+
+func (c http{{$key}}{{$.Infix}}Client) {{$methodName}}(context.Context, *{{$tool.RequestType}}, ...grpc.CallOption) (*{{$tool.ResponseType}}, error) {
+  return nil, runtime.ErrNoHTTPBinding({{ printf "%q" $tool.Procedure }})
+}
+{{- end }}
+{{- end }}
+{{- range $methodName, $watch := index $.Watches $key }}
+
+func (c http{{$key}}{{$.Infix}}Client) {{$methodName}}(context.Context, *{{$watch.RequestType}}, ...grpc.CallOption) (grpc.ServerStreamingClient[{{$watch.ResponseType}}], error) {
+  return nil, runtime.ErrNoHTTPBinding({{ printf "%q" $watch.Procedure }})
+}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- if $.FanOut }}
+{{- range $key, $val := .Services }}
+
+// FanOutTo{{$key}}Clients registers the {{$key}} tools so that every call
+// runs against each of clients, keyed by target name, and returns the results
+// by target. See runtime.FanOut.
+func FanOutTo{{$key}}Clients(s runtime.MCPServer, clients map[string]{{$key}}{{$.Infix}}Client, opts ...runtime.Option) {
+  runtime.FanOut(s, clients, func(s runtime.MCPServer, client {{$key}}{{$.Infix}}Client) {
+    ForwardTo{{$key}}Client(s, client, opts...)
+  }, opts...)
+}
+
+// FanOutToConnect{{$key}}Clients is FanOutTo{{$key}}Clients for connectrpc
+// clients.
+func FanOutToConnect{{$key}}Clients(s runtime.MCPServer, clients map[string]Connect{{$key}}Client, opts ...runtime.Option) {
+  runtime.FanOut(s, clients, func(s runtime.MCPServer, client Connect{{$key}}Client) {
+    ForwardToConnect{{$key}}Client(s, client, opts...)
+  }, opts...)
+}
+{{- end }}
+{{- end }}
+
+{{- if $.Failover }}
+{{- range $key, $methods := .Services }}
+
+// FailoverTo{{$key}}Clients registers the {{$key}} tools so that every call
+// goes to the first available of clients, in order of priority, failing over
+// on connection errors and UNAVAILABLE. See runtime.Failover.
+func FailoverTo{{$key}}Clients(s runtime.MCPServer, clients []{{$key}}{{$.Infix}}Client, failover runtime.FailoverOptions, opts ...runtime.Option) {
+  ForwardTo{{$key}}Client(s, failover{{$key}}{{$.Infix}}Client{runtime.NewFailover(clients, failover)}, opts...)
+}
+
+// failover{{$key}}{{$.Infix}}Client is a {{$key}}{{$.Infix}}Client that calls
+// the first available of its clients.
+type failover{{$key}}{{$.Infix}}Client struct {
+  failover *runtime.Failover[{{$key}}{{$.Infix}}Client]
+}
+{{- range $methodName, $tool := $methods }}
+
+func (c failover{{$key}}{{$.Infix}}Client) {{$methodName}}(ctx context.Context, req *{{$tool.RequestType}}, opts ...grpc.CallOption) (*{{$tool.ResponseType}}, error) {
+  return runtime.CallWithFailover(ctx, c.failover, func(ctx context.Context, client {{$key}}{{$.Infix}}Client) (*{{$tool.ResponseType}}, error) {
+    return client.{{$methodName}}(ctx, req, opts...)
+  })
+}
+{{- end }}
+{{- range $methodName, $watch := index $.Watches $key }}
+
+func (c failover{{$key}}{{$.Infix}}Client) {{$methodName}}(ctx context.Context, req *{{$watch.RequestType}}, opts ...grpc.CallOption) (grpc.ServerStreamingClient[{{$watch.ResponseType}}], error) {
+  return runtime.CallWithFailover(ctx, c.failover, func(ctx context.Context, client {{$key}}{{$.Infix}}Client) (grpc.ServerStreamingClient[{{$watch.ResponseType}}], error) {
+    return client.{{$methodName}}(ctx, req, opts...)
+  })
+}
+{{- end }}
+
+// FailoverToConnect{{$key}}Clients is FailoverTo{{$key}}Clients for
+// connectrpc clients.
+func FailoverToConnect{{$key}}Clients(s runtime.MCPServer, clients []Connect{{$key}}Client, failover runtime.FailoverOptions, opts ...runtime.Option) {
+  ForwardToConnect{{$key}}Client(s, failoverConnect{{$key}}Client{runtime.NewFailover(clients, failover)}, opts...)
+}
+
+// failoverConnect{{$key}}Client is a Connect{{$key}}Client that calls the
+// first available of its clients.
+type failoverConnect{{$key}}Client struct {
+  failover *runtime.Failover[Connect{{$key}}Client]
+}
+{{- range $methodName, $tool := $methods }}
+
+func (c failoverConnect{{$key}}Client) {{$methodName}}(ctx context.Context, req *connect.Request[{{$tool.RequestType}}]) (*connect.Response[{{$tool.ResponseType}}], error) {
+  return runtime.CallWithFailover(ctx, c.failover, func(ctx context.Context, client Connect{{$key}}Client) (*connect.Response[{{$tool.ResponseType}}], error) {
+    return client.{{$methodName}}(ctx, req)
+  })
+}
+{{- end }}
+{{- range $methodName, $watch := index $.Watches $key }}
+
+func (c failoverConnect{{$key}}Client) {{$methodName}}(ctx context.Context, req *connect.Request[{{$watch.RequestType}}]) (*connect.ServerStreamForClient[{{$watch.ResponseType}}], error) {
+  return runtime.CallWithFailover(ctx, c.failover, func(ctx context.Context, client Connect{{$key}}Client) (*connect.ServerStreamForClient[{{$watch.ResponseType}}], error) {
+    return client.{{$methodName}}(ctx, req)
+  })
+}
+{{- end }}
+{{- end }}
+{{- end }}
+
+
+`
+
+type TplParams struct {
+	PackageName     string
+	SourcePath      string
+	GoPackage       string
+	Tools           map[string]runtime.Tool
+	Services        map[string]map[string]Tool
+	ExtraProperties map[string]string
+	WrapInput       string
+	FlattenNested   bool
+	RelativeTimes   bool
+
+	// Infix goes between the service name and Server or Client in the
+	// generated interface names, "MCP" with SamePackage so that they do not
+	// clash with the protoc-gen-go-grpc ones.
+	Infix string
+
+	// FanOut generates the FanOutTo<Service>Clients functions.
+	FanOut bool
+	// Failover generates the FailoverTo<Service>Clients functions.
+	Failover bool
+
+	// SchemasVar is the runtime.CompressedSchemas var holding the tool
+	// schemas with CompressSchemas, and CompressedSchemas its data as a Go
+	// string literal. SchemasVar is empty otherwise.
+	SchemasVar        string
+	CompressedSchemas string
+
+	// DefinitionsVar is the runtime.SchemaDefinitions var holding the
+	// definitions shared with SharedDefinitions, and Definitions its data as
+	// a Go string literal. DefinitionsVar is empty otherwise.
+	DefinitionsVar string
+	Definitions    string
+
+	// GenerationInfoVar is the runtime.GenerationInfo var recording
+	// GenerationInfo.
+	GenerationInfoVar string
+	GenerationInfo    runtime.GenerationInfo
+
+	// Prompts holds the prompts declared in proto, per service.
+	Prompts map[string][]Prompt
+
+	// Watches holds the server-streaming RPCs exposed as watched resources,
+	// per service and method.
+	Watches map[string]map[string]Watch
+}
+
+// HasHTTP reports whether an RPC of service has a google.api.http binding,
+// so it gets a ForwardTo<Service>HTTP forwarder.
+func (p TplParams) HasHTTP(service string) bool {
+	for _, tool := range p.Services[service] {
+		if tool.HTTP.Path != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// ToolExpr returns the Go expression initializing a Tool var to the tool
+// literal lit, which loads compressed schemas and shared definitions.
+func (p TplParams) ToolExpr(lit string) string {
+	if p.SchemasVar != "" {
+		lit = p.SchemasVar + ".Tool(" + lit + ")"
+	}
+	if p.DefinitionsVar != "" {
+		lit = p.DefinitionsVar + ".Tool(" + lit + ")"
+	}
+	return lit
+}
+
+// Watch is a server-streaming RPC exposed as a watched resource; see
+// runtime.AddWatchedResource.
+type Watch struct {
+	RequestType  string
+	ResponseType string
+	Resource     runtime.Resource
+
+	// Procedure is the Connect procedure of the RPC, e.g.
+	// "/acme.v1.ClusterService/WatchCluster".
+	Procedure string
+}
+
+// ToolTransform adjusts the tool generated for method in place, e.g. its
+// description or schemas, when the generator is used as a library. It sees
+// the tool after the schema patches; an error fails generation.
+type ToolTransform func(method protoreflect.MethodDescriptor, tool *runtime.Tool) error
 
 type Tool struct {
 	RequestType  string
 	ResponseType string
 	MCPTool      runtime.Tool
+
+	// Procedure is the Connect procedure of the RPC, e.g.
+	// "/acme.v1.ClusterService/GetCluster".
+	Procedure string
+
+	// HTTP is the google.api.http binding of the RPC, which the
+	// ForwardTo<Service>HTTP forwarder calls; its Path is empty if there is
+	// none.
+	HTTP runtime.HTTPBinding
+
+	// ExtraProperties is the Go literal of the extra properties declared in
+	// proto for the method, or empty if there are none.
+	ExtraProperties string
+
+	// NoArguments is set for methods taking google.protobuf.Empty, whose
+	// handlers discard the arguments instead of decoding them.
+	NoArguments bool
+
+	// DryRun is set when the tool takes the "dry_run" argument.
+	DryRun bool
+
+	// ExcludedFields is the Go literal of the full names of the request
+	// fields left out of the input schema, see gen.ExcludedFields, or empty if
+	// there are none.
+	ExcludedFields string
+
+	// IdempotencyKey is set when the request has an idempotency key field,
+	// see runtime.IdempotencyKeyField, which the handler fills in.
+	IdempotencyKey bool
+
+	// SideEffects is set for methods not marked idempotency_level =
+	// NO_SIDE_EFFECTS, whose handlers go through runtime.ApplyMutatingHandlerConfig. The
+	// forwarders hedge the backend calls of the others; see runtime.Hedge.
+	SideEffects bool
+
+	// Completions are the argument completers added to config.Completions.
+	Completions []Completion
+
+	// Resource is set when the method is also read as an MCP resource.
+	Resource runtime.Resource
+
+	// ResourceCompletions is the quoted list of the Resource URI variables
+	// that share the tool's completions, or empty.
+	ResourceCompletions string
+
+	// Files is set when the response carries files, which the handler
+	// returns as embedded resources.
+	Files bool
+}
+
+// Prompt is a prompt declared in proto, see gen.DeclaredPrompts.
+type Prompt struct {
+	MCPPrompt runtime.Prompt
+	Template  string
+
+	// Tools maps the tool references in Template to the Go names of their
+	// RPCs.
+	Tools map[string]string
+
+	// Method is the Go name of the annotated RPC, empty for a service prompt.
+	Method string
+
+	// SharedCompletions is the quoted argument list of the completions the
+	// prompt shares with the tool of Method, or empty.
+	SharedCompletions string
+}
+
+// Completion is a completer of a tool argument, see gen.ArgumentCompletions.
+type Completion struct {
+	Argument string
+
+	// Values is the argument list of runtime.EnumCompleter for an enum.
+	Values string
+
+	// ListMethod is the Go name of the List RPC of a resource reference,
+	// with its request and response types.
+	ListMethod       string
+	ListRequestType  string
+	ListResponseType string
+}
+
+// extraPropertiesLiteral renders extra properties declared in proto as a Go
+// composite literal for the generated file.
+func extraPropertiesLiteral(props []runtime.ExtraProperty) string {
+	var b strings.Builder
+	b.WriteString("[]runtime.ExtraProperty{")
+	for _, p := range props {
+		fmt.Fprintf(&b, "\n    {Name: %q", p.Name)
+		if p.Description != "" {
+			fmt.Fprintf(&b, ", Description: %q", p.Description)
+		}
+		if p.Required {
+			b.WriteString(", Required: true")
+		}
+		fmt.Fprintf(&b, ", ContextKey: runtime.ExtraPropertyKey(%q)", p.ContextKey)
+		if p.Schema != nil {
+			fmt.Fprintf(&b, ", Schema: json.RawMessage(%q)", p.Schema)
+		}
+		if p.Sensitive {
+			b.WriteString(", Sensitive: true")
+		}
+		b.WriteString("},")
+	}
+	b.WriteString("\n  }")
+	return b.String()
+}
+
+// shareDefinitions moves the message schemas that repeat across tools into
+// shared definitions, rewriting the schemas in tools, and returns the
+// definitions.
+func (g *FileGenerator) shareDefinitions(tools map[string]runtime.Tool) (json.RawMessage, error) {
+	keys := make([]string, 0, len(tools))
+	for key := range tools {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var schemas []json.RawMessage
+	for _, key := range keys {
+		schemas = append(schemas, tools[key].RawInputSchema)
+		if tools[key].RawOutputSchema != nil {
+			schemas = append(schemas, tools[key].RawOutputSchema)
+		}
+	}
+	defs, shared, err := gen.ShareDefinitions(schemas, g.SchemaOptions.Draft)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		tool := tools[key]
+		tool.RawInputSchema, shared = shared[0], shared[1:]
+		if tool.RawOutputSchema != nil {
+			tool.RawOutputSchema, shared = shared[0], shared[1:]
+		}
+		tools[key] = tool
+	}
+	return defs, nil
+}
+
+// toolLiteral renders tool as a Go composite literal for the generated file.
+// Schemas are emitted as string literals rather than the byte-slice literals
+// %#v produces, which are several times larger and slow to compile.
+func toolLiteral(tool runtime.Tool) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "runtime.Tool{\n    Name: %q,\n    Description: %q,", tool.Name, tool.Description)
+	if tool.RawInputSchema != nil {
+		fmt.Fprintf(&b, "\n    RawInputSchema: json.RawMessage(%s),", stringLiteral(tool.RawInputSchema))
+	}
+	if tool.RawOutputSchema != nil {
+		fmt.Fprintf(&b, "\n    RawOutputSchema: json.RawMessage(%s),", stringLiteral(tool.RawOutputSchema))
+	}
+	if tool.Title != "" {
+		fmt.Fprintf(&b, "\n    Title: %q,", tool.Title)
+	}
+	if len(tool.Tags) > 0 {
+		fmt.Fprintf(&b, "\n    Tags: %#v,", tool.Tags)
+	}
+	b.WriteString("\n  }")
+	return b.String()
+}
+
+// stringLiteral quotes b as a raw string literal when it can be one, and as
+// an interpreted string literal otherwise.
+func stringLiteral(b []byte) string {
+	if strconv.CanBackquote(string(b)) {
+		return "`" + string(b) + "`"
+	}
+	return strconv.Quote(string(b))
+}
+
+// binaryLiteral quotes data as a Go string literal split into lines of 64
+// bytes, escaping everything but printable ASCII.
+func binaryLiteral(data string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(data); i++ {
+		if i > 0 && i%64 == 0 {
+			b.WriteString("\" +\n    \"")
+		}
+		switch c := data[i]; {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c >= 0x20 && c < 0x7f:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "\\x%02x", c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// generatesTool reports whether method gets a tool: it is unary and not an
+// excluded deprecated RPC.
+func (g *FileGenerator) generatesTool(method protoreflect.MethodDescriptor) bool {
+	if method.IsStreamingClient() || method.IsStreamingServer() || g.excludes(method) {
+		return false
+	}
+	return !g.ExcludeDeprecatedMethods || !gen.MethodDeprecated(method)
+}
+
+// excludes reports whether method is excluded by Exclude or Config.
+func (g *FileGenerator) excludes(method protoreflect.MethodDescriptor) bool {
+	for _, pattern := range g.Exclude {
+		if matchesName(pattern, string(method.Parent().FullName()), string(method.FullName())) {
+			return true
+		}
+	}
+	return g.Config.excludes(method)
+}
+
+// prefixTool prepends the tool prefix of the service of method to the name
+// of its tool: that of Config, else the first of ToolPrefixes scoped to the
+// service, else the first unscoped one.
+func (g *FileGenerator) prefixTool(method protoreflect.MethodDescriptor, tool *runtime.Tool) error {
+	sc, _ := g.Config.method(method)
+	prefix := sc.ToolPrefix
+	if prefix == "" {
+		prefix = g.toolPrefix(string(method.Parent().FullName()))
+	}
+	if prefix == "" {
+		return nil
+	}
+	tool.Name = prefix + tool.Name
+	if !toolNameRE.MatchString(tool.Name) {
+		return fmt.Errorf("%s: tool name %q with tool_prefix %q is longer than 64 characters", method.FullName(), tool.Name, prefix)
+	}
+	return nil
+}
+
+// finishTool applies the overrides of Config, the tool prefix,
+// SchemaPatches and ToolTransforms to the tool of method.
+func (g *FileGenerator) finishTool(method protoreflect.MethodDescriptor, tool *runtime.Tool) error {
+	g.Config.applyTool(method, tool)
+	if err := g.prefixTool(method, tool); err != nil {
+		return err
+	}
+	if err := g.patchTool(tool); err != nil {
+		return err
+	}
+	for _, transform := range g.ToolTransforms {
+		if err := transform(method, tool); err != nil {
+			return fmt.Errorf("%s: %w", method.FullName(), err)
+		}
+		if !toolNameRE.MatchString(tool.Name) {
+			return fmt.Errorf("%s: transformed tool name %q must match %s", method.FullName(), tool.Name, toolNameRE)
+		}
+	}
+	return nil
+}
+
+// claimToolName records name as the tool name of method, or returns an
+// error pointing at both RPCs if another one already has it.
+func (g *FileGenerator) claimToolName(method protoreflect.MethodDescriptor, name string) error {
+	if g.toolNames == nil {
+		g.toolNames = map[string]string{}
+	}
+	if prev, ok := g.toolNames[name]; ok {
+		return gen.ErrorAt(method, fmt.Errorf("%s: tool name %q collides with that of %s", method.FullName(), name, prev))
+	}
+	g.toolNames[name] = fmt.Sprintf("%s at %s", method.FullName(), gen.Location(method))
+	return nil
+}
+
+// toolPrefix returns the prefix of ToolPrefixes for service.
+func (g *FileGenerator) toolPrefix(service string) string {
+	var unscoped string
+	for _, tp := range g.ToolPrefixes {
+		if tp.Services == "" {
+			if unscoped == "" {
+				unscoped = tp.Prefix
+			}
+		} else if matchesName(tp.Services, service) {
+			return tp.Prefix
+		}
+	}
+	return unscoped
+}
+
+// watch returns the watched resource of meth if it is a server-streaming RPC
+// with a resource URI, see gen.ResourceURI.
+func (g *FileGenerator) watch(meth *protogen.Method) (Watch, bool, error) {
+	if !meth.Desc.IsStreamingServer() || meth.Desc.IsStreamingClient() {
+		return Watch{}, false, nil
+	}
+	if g.ExcludeDeprecatedMethods && gen.MethodDeprecated(meth.Desc) || g.excludes(meth.Desc) {
+		return Watch{}, false, nil
+	}
+	opts := g.Config.schemaOptions(meth.Desc, g.SchemaOptions)
+	uri, err := gen.ResourceURI(meth.Desc, opts)
+	if err != nil || uri == "" {
+		return Watch{}, false, err
+	}
+	tool := gen.ToolForMethodWithOptions(meth.Desc, string(meth.Comments.Leading), opts)
+	g.Config.applyTool(meth.Desc, &tool)
+	if err := g.prefixTool(meth.Desc, &tool); err != nil {
+		return Watch{}, false, err
+	}
+	return Watch{
+		RequestType:  g.gf.QualifiedGoIdent(meth.Input.GoIdent),
+		ResponseType: g.gf.QualifiedGoIdent(meth.Output.GoIdent),
+		Procedure:    procedure(meth),
+		Resource: runtime.Resource{
+			URI:         uri,
+			Name:        tool.Name,
+			Title:       tool.Title,
+			Description: tool.Description,
+			MIMEType:    "application/json",
+		},
+	}, true, nil
+}
+
+// prompts returns the prompts declared on svc and its RPCs.
+func (g *FileGenerator) prompts(svc *protogen.Service) ([]Prompt, error) {
+	declared, err := gen.DeclaredPrompts(svc.Desc, g.generatesTool)
+	if err != nil {
+		return nil, err
+	}
+	goName := func(md protoreflect.MethodDescriptor) string {
+		for _, m := range svc.Methods {
+			if m.Desc == md {
+				return m.GoName
+			}
+		}
+		return ""
+	}
+	var prompts []Prompt
+	for _, d := range declared {
+		p := Prompt{
+			MCPPrompt: d.Prompt,
+			Template:  d.Template,
+		}
+		if len(d.Tools) > 0 {
+			p.Tools = map[string]string{}
+			for ref, md := range d.Tools {
+				p.Tools[ref] = goName(md)
+			}
+		}
+		if d.Method != nil {
+			p.Method = goName(d.Method)
+			var quoted []string
+			for _, arg := range gen.SharedCompletions(d, g.SchemaOptions) {
+				quoted = append(quoted, strconv.Quote(arg))
+			}
+			p.SharedCompletions = strings.Join(quoted, ", ")
+		}
+		prompts = append(prompts, p)
+	}
+	return prompts, nil
+}
+
+// completions returns the argument completers of the tool for meth. A
+// resource reference whose List RPC is not generated is skipped.
+func (g *FileGenerator) completions(svc *protogen.Service, meth *protogen.Method) []Completion {
+	var completions []Completion
+	for _, c := range gen.ArgumentCompletions(meth.Desc, g.SchemaOptions) {
+		if c.ListMethod == nil {
+			quoted := make([]string, len(c.Values))
+			for i, v := range c.Values {
+				quoted[i] = strconv.Quote(v)
+			}
+			completions = append(completions, Completion{Argument: c.Argument, Values: strings.Join(quoted, ", ")})
+			continue
+		}
+		if !g.generatesTool(c.ListMethod) {
+			continue
+		}
+		for _, m := range svc.Methods {
+			if m.Desc == c.ListMethod {
+				completions = append(completions, Completion{
+					Argument:         c.Argument,
+					ListMethod:       m.GoName,
+					ListRequestType:  g.gf.QualifiedGoIdent(m.Input.GoIdent),
+					ListResponseType: g.gf.QualifiedGoIdent(m.Output.GoIdent),
+				})
+			}
+		}
+	}
+	return completions
 }
 
 // Delegate to gen package - kept for backward compatibility with tests in this package.
@@ -280,12 +1882,42 @@ var (
 
 // messageSchema delegates to the gen package.
 func (g *FileGenerator) messageSchema(md protoreflect.MessageDescriptor) map[string]any {
-	return gen.MessageSchema(md, gen.SchemaOptions{})
+	return gen.MessageSchema(md, g.SchemaOptions)
 }
 
 // getType delegates to the gen package.
 func (g *FileGenerator) getType(fd protoreflect.FieldDescriptor) map[string]any {
-	return gen.FieldSchema(fd, gen.SchemaOptions{})
+	return gen.FieldSchema(fd, g.SchemaOptions)
+}
+
+// emitSchemas writes the schemas of tool to <tool name>.input.json and
+// <tool name>.output.json under EmitSchemasDir, for review and external
+// validation.
+func (g *FileGenerator) emitSchemas(tool runtime.Tool) error {
+	if g.EmitSchemasDir == "" || g.Preview != nil {
+		return nil
+	}
+	for _, f := range []struct {
+		suffix string
+		schema []byte
+	}{
+		{".input.json", tool.RawInputSchema},
+		{".output.json", tool.RawOutputSchema},
+	} {
+		if len(f.schema) == 0 {
+			continue
+		}
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, f.schema, "", "  "); err != nil {
+			return err
+		}
+		buf.WriteByte('\n')
+		out := g.gen.NewGeneratedFile(path.Join(g.EmitSchemasDir, tool.Name+f.suffix), "")
+		if _, err := out.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (g *FileGenerator) Generate(packageSuffix string) {
@@ -293,13 +1925,41 @@ func (g *FileGenerator) Generate(packageSuffix string) {
 	if len(g.f.Services) == 0 {
 		return
 	}
+	if g.FileSuffix != "" && (!strings.HasSuffix(g.FileSuffix, ".go") || strings.HasSuffix(g.FileSuffix, "_test.go") || strings.Contains(g.FileSuffix, "/")) {
+		g.gen.Error(fmt.Errorf("file_suffix %q must end in .go, not _test.go, and contain no /", g.FileSuffix))
+		return
+	}
+	if g.SharedDefinitions && g.SchemaOptions.DedupeDescriptions {
+		// Shared definitions already carry the descriptions once.
+		g.gen.Error(errors.New("shared_definitions and dedupe_descriptions are mutually exclusive"))
+		return
+	}
 	goImportPath := file.GoImportPath
-	if packageSuffix != "" {
+	if g.SamePackage {
+		// same_package is package_suffix="" with the interfaces renamed.
+		if g.PackageName != "" {
+			g.gen.Error(errors.New("same_package and package_name are mutually exclusive"))
+			return
+		}
+		packageSuffix = ""
+	}
+	var packageName string
+	switch {
+	case g.PackageName != "":
+		packageName = strings.ReplaceAll(g.PackageName, "{package}", string(file.GoPackageName))
+		if !token.IsIdentifier(packageName) {
+			g.gen.Error(fmt.Errorf("package_name %q gives %q, which is not a valid Go identifier", g.PackageName, packageName))
+			return
+		}
+	case packageSuffix != "":
 		if !token.IsIdentifier(packageSuffix) {
 			g.gen.Error(fmt.Errorf("package_suffix %q is not a valid Go identifier", packageSuffix))
 			return
 		}
-		file.GoPackageName += protogen.GoPackageName(packageSuffix)
+		packageName = string(file.GoPackageName) + packageSuffix
+	}
+	if packageName != "" {
+		file.GoPackageName = protogen.GoPackageName(packageName)
 		generatedFilenamePrefixToSlash := filepath.ToSlash(file.GeneratedFilenamePrefix)
 		file.GeneratedFilenamePrefix = path.Join(
 			path.Dir(generatedFilenamePrefixToSlash),
@@ -312,16 +1972,16 @@ func (g *FileGenerator) Generate(packageSuffix string) {
 		))
 	}
 
-	g.gf = g.gen.NewGeneratedFile(
-		file.GeneratedFilenamePrefix+GeneratedFilenameExtension,
+	g.gf = g.newGoFile(
+		file.GeneratedFilenamePrefix+g.filenameSuffix()+".go",
 		goImportPath,
 	)
-	if packageSuffix != "" {
+	if packageName != "" {
 		g.gf.Import(file.GoImportPath)
 	}
 
 	fileTpl := fileTemplate
-	tpl, err := template.New("gen").Parse(fileTpl)
+	tpl, err := template.New("gen").Funcs(template.FuncMap{"toolLiteral": toolLiteral}).Parse(fileTpl)
 	if err != nil {
 		g.gen.Error(err)
 		return
@@ -329,36 +1989,382 @@ func (g *FileGenerator) Generate(packageSuffix string) {
 
 	services := map[string]map[string]Tool{}
 	tools := map[string]runtime.Tool{}
+	extraProperties := map[string]string{}
+	prompts := map[string][]Prompt{}
+	watches := map[string]map[string]Watch{}
 
 	for _, svc := range g.f.Services {
 		s := map[string]Tool{}
+		w := map[string]Watch{}
 		for _, meth := range svc.Methods {
-			if meth.Desc.IsStreamingClient() || meth.Desc.IsStreamingServer() {
+			watch, ok, err := g.watch(meth)
+			if err != nil {
+				g.gen.Error(gen.ErrorAt(meth.Desc, err))
+				return
+			}
+			if ok {
+				w[meth.GoName] = watch
+				continue
+			}
+			if !g.generatesTool(meth.Desc) {
+				g.warnSkipped(meth.Desc)
 				continue
 			}
 
+			for _, md := range []protoreflect.MessageDescriptor{meth.Desc.Input(), meth.Desc.Output()} {
+				if err := gen.CheckSchemaOverrides(md); err != nil {
+					g.gen.Error(gen.ErrorAt(meth.Desc, fmt.Errorf("%s: %w", meth.Desc.FullName(), err)))
+					return
+				}
+			}
+
+			declared, err := gen.DeclaredExtraProperties(meth.Desc)
+			if err != nil {
+				g.gen.Error(gen.ErrorAt(meth.Desc, err))
+				return
+			}
+
+			opts := g.Config.schemaOptions(meth.Desc, g.SchemaOptions)
+			if _, err := gen.ToolExamples(meth.Desc, opts); err != nil {
+				g.gen.Error(gen.ErrorAt(meth.Desc, err))
+				return
+			}
 			comment := string(meth.Comments.Leading)
-			tool := gen.ToolForMethod(meth.Desc, comment)
+			tool := gen.ToolForMethodWithOptions(meth.Desc, comment, opts)
+			g.warnTool(meth.Desc, tool, comment, opts)
+			if err := g.finishTool(meth.Desc, &tool); err != nil {
+				g.gen.Error(gen.ErrorAt(meth.Desc, err))
+				return
+			}
+			if err := g.claimToolName(meth.Desc, tool.Name); err != nil {
+				g.gen.Error(err)
+				return
+			}
+			if opts.OpenAIStrict {
+				if err := gen.ValidateOpenAIStrict(tool.RawInputSchema); err != nil {
+					g.gen.Error(gen.ErrorAt(meth.Desc, fmt.Errorf("%s: %w", meth.Desc.FullName(), err)))
+					return
+				}
+			}
 
-			s[meth.GoName] = Tool{
-				RequestType:  g.gf.QualifiedGoIdent(meth.Input.GoIdent),
-				ResponseType: g.gf.QualifiedGoIdent(meth.Output.GoIdent),
-				MCPTool:      tool,
+			t := Tool{
+				RequestType:    g.gf.QualifiedGoIdent(meth.Input.GoIdent),
+				ResponseType:   g.gf.QualifiedGoIdent(meth.Output.GoIdent),
+				MCPTool:        tool,
+				Procedure:      procedure(meth),
+				NoArguments:    gen.TakesNoArguments(meth.Desc.Input()),
+				DryRun:         gen.DryRunSupported(meth.Desc, opts),
+				SideEffects:    gen.MethodHasSideEffects(meth.Desc),
+				Files:          gen.ReturnsFiles(meth.Desc.Output()),
+				IdempotencyKey: runtime.IdempotencyKeyField(meth.Desc.Input()) != nil,
+			}
+			if excluded := gen.ExcludedFields(meth.Desc.Input(), opts); len(excluded) > 0 {
+				t.ExcludedFields = fmt.Sprintf("%#v", excluded)
+			}
+			t.HTTP, _ = gen.HTTPBinding(meth.Desc)
+			t.Completions = g.completions(svc, meth)
+			uri, err := gen.ResourceURI(meth.Desc, opts)
+			if err != nil {
+				g.gen.Error(gen.ErrorAt(meth.Desc, err))
+				return
 			}
+			if uri != "" {
+				t.Resource = runtime.Resource{
+					URI:         uri,
+					Name:        tool.Name,
+					Title:       tool.Title,
+					Description: tool.Description,
+					MIMEType:    "application/json",
+				}
+				var quoted []string
+				for _, v := range gen.ResourceCompletions(meth.Desc, uri, opts) {
+					quoted = append(quoted, strconv.Quote(v))
+				}
+				t.ResourceCompletions = strings.Join(quoted, ", ")
+			}
+			if len(declared) > 0 {
+				t.ExtraProperties = extraPropertiesLiteral(declared)
+				extraProperties[svc.GoName+"_"+meth.GoName] = t.ExtraProperties
+			}
+			s[meth.GoName] = t
 			tools[svc.GoName+"_"+meth.GoName] = tool
+			if err := g.emitSchemas(tool); err != nil {
+				g.gen.Error(gen.ErrorAt(meth.Desc, fmt.Errorf("%s: %w", meth.Desc.FullName(), err)))
+				return
+			}
 		}
 		services[string(svc.Desc.Name())] = s
+		watches[string(svc.Desc.Name())] = w
+
+		p, err := g.prompts(svc)
+		if err != nil {
+			g.gen.Error(gen.ErrorAt(svc.Desc, err))
+			return
+		}
+		prompts[string(svc.Desc.Name())] = p
+	}
+	if len(g.strictErrors) > 0 {
+		g.gen.Error(errors.Join(g.strictErrors...))
+		return
+	}
+	if g.listed != nil {
+		g.inventory(services, watches, prompts, g.listed)
+	}
+	if g.Preview != nil {
+		g.inventory(services, watches, prompts, g.Preview)
+		g.gf.Skip()
+		return
 	}
 
+	var definitionsVar, definitions string
+	if g.SharedDefinitions && len(tools) > 0 {
+		defs, err := g.shareDefinitions(tools)
+		if err != nil {
+			g.gen.Error(err)
+			return
+		}
+		if string(defs) != "{}" {
+			definitionsVar = "file" + strings.TrimPrefix(file.GoDescriptorIdent.GoName, "File") + "_mcpDefinitions"
+			definitions = stringLiteral(defs)
+			if g.CompressSchemas {
+				data, err := runtime.CompressDefinitions(defs)
+				if err != nil {
+					g.gen.Error(err)
+					return
+				}
+				definitions = binaryLiteral(data)
+			}
+		}
+	}
+
+	var schemasVar, compressed string
+	if g.CompressSchemas && len(tools) > 0 {
+		var list []runtime.Tool
+		for _, tool := range tools {
+			list = append(list, tool)
+		}
+		data, err := runtime.CompressSchemas(list)
+		if err != nil {
+			g.gen.Error(err)
+			return
+		}
+		for key, tool := range tools {
+			tool.RawInputSchema, tool.RawOutputSchema = nil, nil
+			tools[key] = tool
+		}
+		schemasVar = "file" + strings.TrimPrefix(file.GoDescriptorIdent.GoName, "File") + "_mcpSchemas"
+		compressed = binaryLiteral(data)
+	}
+
+	var infix string
+	if g.SamePackage {
+		infix = "MCP"
+	}
 	params := TplParams{
-		PackageName: string(g.f.Desc.Package()),
-		SourcePath:  g.f.Desc.Path(),
-		GoPackage:   string(g.f.GoPackageName),
-		Services:    services,
-		Tools:       tools,
+		PackageName:       string(g.f.Desc.Package()),
+		SourcePath:        g.f.Desc.Path(),
+		GoPackage:         string(g.f.GoPackageName),
+		Services:          services,
+		Tools:             tools,
+		ExtraProperties:   extraProperties,
+		WrapInput:         g.SchemaOptions.WrapInput,
+		FlattenNested:     g.SchemaOptions.FlattenNested,
+		RelativeTimes:     g.SchemaOptions.RelativeTimes,
+		Infix:             infix,
+		FanOut:            g.FanOut,
+		Failover:          g.Failover,
+		Prompts:           prompts,
+		SchemasVar:        schemasVar,
+		CompressedSchemas: compressed,
+		DefinitionsVar:    definitionsVar,
+		Definitions:       definitions,
+		Watches:           watches,
+		GenerationInfoVar: file.GoDescriptorIdent.GoName + "_GenerationInfo",
+		GenerationInfo: runtime.GenerationInfo{
+			Source:   g.f.Desc.Path(),
+			Version:  version(),
+			Options:  g.generationOptions(),
+			Cosmetic: g.cosmeticOptions(),
+		},
 	}
 	err = tpl.Execute(g.gf, params)
 	if err != nil {
 		g.gen.Error(err)
+		return
+	}
+	if g.GoldenTests && len(tools) > 0 {
+		g.generateGoldenTests(file.GeneratedFilenamePrefix, goImportPath, params)
+	}
+	if g.Mocks {
+		g.generateMocks(file.GeneratedFilenamePrefix, goImportPath, params)
+	}
+	if g.FuzzTests && len(tools) > 0 {
+		g.generateFuzzTests(file.GeneratedFilenamePrefix, goImportPath, params)
+	}
+}
+
+// filenameSuffix returns the suffix of the generated file names, without
+// the .go extension.
+func (g *FileGenerator) filenameSuffix() string {
+	if g.FileSuffix != "" {
+		return strings.TrimSuffix(g.FileSuffix, ".go")
+	}
+	return strings.TrimSuffix(GeneratedFilenameExtension, ".go")
+}
+
+// newGoFile creates a generated Go file, starting it with the //go:build
+// line of BuildTag if set.
+func (g *FileGenerator) newGoFile(filename string, goImportPath protogen.GoImportPath) *protogen.GeneratedFile {
+	f := g.gen.NewGeneratedFile(filename, goImportPath)
+	if g.BuildTag != "" {
+		f.P("//go:build ", g.BuildTag)
+		f.P()
+	}
+	return f
+}
+
+// generateMocks writes a <Service>ServerMock for every service with methods
+// in its <Service>Server interface.
+func (g *FileGenerator) generateMocks(prefix string, goImportPath protogen.GoImportPath, params TplParams) {
+	tpl, err := template.New("mock").Parse(mockTemplate)
+	if err != nil {
+		g.gen.Error(err)
+		return
+	}
+	f := g.newGoFile(prefix+g.filenameSuffix()+".mock.go", goImportPath)
+	sp := g.serverParams(f, params)
+	if len(sp.Services) == 0 {
+		f.Skip()
+		return
+	}
+	sp.Context = f.QualifiedGoIdent(protogen.GoIdent{GoName: "Context", GoImportPath: "context"})
+	sp.Mutex = f.QualifiedGoIdent(protogen.GoIdent{GoName: "Mutex", GoImportPath: "sync"})
+	if err := tpl.Execute(f, sp); err != nil {
+		g.gen.Error(err)
+	}
+}
+
+// generateFuzzTests writes fuzz tests for the argument decoding of every
+// tool next to the generated file.
+func (g *FileGenerator) generateFuzzTests(prefix string, goImportPath protogen.GoImportPath, params TplParams) {
+	tpl, err := template.New("fuzz").Parse(fuzzTestTemplate)
+	if err != nil {
+		g.gen.Error(err)
+		return
+	}
+	f := g.newGoFile(prefix+g.filenameSuffix()+"_fuzz_test.go", goImportPath)
+	sp := g.serverParams(f, params)
+	for i, svc := range sp.Services {
+		seeds := map[string]bool{}
+		for _, m := range svc.Methods {
+			tool, ok := params.Services[svc.Name][m.Name]
+			if !ok {
+				continue
+			}
+			for _, seed := range fuzzSeeds(tool.MCPTool.RawInputSchema) {
+				if !seeds[seed] {
+					seeds[seed] = true
+					sp.Services[i].Seeds = append(sp.Services[i].Seeds, seed)
+				}
+			}
+		}
+	}
+	if len(sp.Services) == 0 {
+		f.Skip()
+		return
+	}
+	if err := tpl.Execute(f, sp); err != nil {
+		g.gen.Error(err)
+	}
+}
+
+// serverParams collects the <Service>Server interfaces of params, with types
+// qualified for f.
+func (g *FileGenerator) serverParams(f *protogen.GeneratedFile, params TplParams) serverParams {
+	sp := serverParams{
+		SourcePath: params.SourcePath,
+		GoPackage:  params.GoPackage,
+		Infix:      params.Infix,
+	}
+	for _, svc := range g.f.Services {
+		name := string(svc.Desc.Name())
+		si := serverInterface{Name: name}
+		for _, meth := range svc.Methods {
+			_, tool := params.Services[name][meth.GoName]
+			_, watch := params.Watches[name][meth.GoName]
+			if !tool && !watch {
+				continue
+			}
+			// Qualifying an identifier imports its package, so only
+			// files with streaming methods import grpc.
+			if watch && sp.ServerStream == "" {
+				sp.ServerStream = f.QualifiedGoIdent(protogen.GoIdent{GoName: "ServerStreamingServer", GoImportPath: "google.golang.org/grpc"})
+			}
+			si.Methods = append(si.Methods, serverMethod{
+				Name:         meth.GoName,
+				RequestType:  f.QualifiedGoIdent(meth.Input.GoIdent),
+				ResponseType: f.QualifiedGoIdent(meth.Output.GoIdent),
+				Streaming:    watch,
+			})
+		}
+		if len(si.Methods) > 0 {
+			sp.Services = append(sp.Services, si)
+		}
+	}
+	return sp
+}
+
+// fuzzSeeds returns the seed corpus for a tool with the given input schema:
+// no arguments, every argument with a value of its type, and every argument
+// with a value of the wrong type.
+func fuzzSeeds(inputSchema json.RawMessage) []string {
+	var schema struct {
+		Properties map[string]struct {
+			Type any `json:"type"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(inputSchema, &schema); err != nil || len(schema.Properties) == 0 {
+		return []string{"{}"}
+	}
+	typed := map[string]any{}
+	wrong := map[string]any{}
+	for name, prop := range schema.Properties {
+		typ, _ := prop.Type.(string)
+		if types, ok := prop.Type.([]any); ok && len(types) > 0 {
+			typ, _ = types[0].(string)
+		}
+		switch typ {
+		case "string":
+			typed[name], wrong[name] = "", map[string]any{}
+		case "integer", "number":
+			typed[name], wrong[name] = 0, "NaN"
+		case "boolean":
+			typed[name], wrong[name] = true, "yes"
+		case "array":
+			typed[name], wrong[name] = []any{}, "[]"
+		default:
+			typed[name], wrong[name] = map[string]any{}, []any{nil}
+		}
+	}
+	seeds := []string{"{}"}
+	for _, args := range []map[string]any{typed, wrong} {
+		// json.Marshal sorts map keys, so seeds are stable.
+		b, _ := json.Marshal(args)
+		seeds = append(seeds, string(b))
+	}
+	return seeds
+}
+
+// generateGoldenTests writes the golden snapshot tests of the tools in params
+// next to the generated file.
+func (g *FileGenerator) generateGoldenTests(prefix string, goImportPath protogen.GoImportPath, params TplParams) {
+	tpl, err := template.New("golden").Parse(goldenTestTemplate)
+	if err != nil {
+		g.gen.Error(err)
+		return
+	}
+	f := g.newGoFile(prefix+g.filenameSuffix()+"_test.go", goImportPath)
+	if err := tpl.Execute(f, params); err != nil {
+		g.gen.Error(err)
 	}
 }
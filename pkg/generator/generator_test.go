@@ -16,11 +16,24 @@ package generator
 
 import (
 	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
 	"testing"
 
 	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/gen"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
 	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	testdatamcp "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
 )
 
 func TestGetTypeStandard(t *testing.T) {
@@ -162,3 +175,922 @@ func TestSchemaMarshaling(t *testing.T) {
 	err = json.Unmarshal(marshaled, &unmarshaled)
 	g.Expect(err).ToNot(HaveOccurred())
 }
+
+func TestGenerateWithMessageSchemas(t *testing.T) {
+	g := NewWithT(t)
+	mappings, err := gen.ParseMessageSchemas([]byte(`{"testdata.Threshold": {"type": "string", "description": "mapped-threshold"}}`))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	resp := runGenerator(g, []string{"testdata/annotations.proto"}, func(fg *FileGenerator) {
+		fg.SchemaOptions.MessageSchemas = mappings
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(resp.File).To(HaveLen(1))
+
+	// Schemas are embedded as string literals; look for the mapped one.
+	md := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor()
+	method := md.ParentFile().Services().ByName("AnnotatedService").Methods().ByName("ApplyConfig")
+	tool := gen.ToolForMethodWithOptions(method, "", gen.SchemaOptions{MessageSchemas: mappings})
+	g.Expect(string(tool.RawInputSchema)).To(ContainSubstring("mapped-threshold"))
+	g.Expect(resp.File[0].GetContent()).To(ContainSubstring("RawInputSchema:  json.RawMessage(`" + string(tool.RawInputSchema) + "`)"))
+	g.Expect(resp.File[0].GetContent()).ToNot(ContainSubstring("json.RawMessage{0x"))
+}
+
+func TestStringLiteral(t *testing.T) {
+	g := NewWithT(t)
+
+	schema := `{"description":"say \"hi\""}`
+	g.Expect(stringLiteral([]byte(schema))).To(Equal("`" + schema + "`"))
+	// Backquotes cannot appear in a raw string literal.
+	schema = "{\"description\":\"run `ls`\"}"
+	g.Expect(stringLiteral([]byte(schema))).To(Equal(strconv.Quote(schema)))
+}
+
+func TestGenerateExcludeDeprecatedMethods(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/annotations.proto"}, func(fg *FileGenerator) {
+		fg.ExcludeDeprecatedMethods = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(resp.File).To(HaveLen(1))
+	content := resp.File[0].GetContent()
+	g.Expect(content).To(ContainSubstring("AnnotatedService_ApplyConfigTool"))
+	g.Expect(content).ToNot(ContainSubstring("LegacyApply"))
+}
+
+func TestGenerateOpenAIStrict(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/edge_cases.proto"}, func(fg *FileGenerator) {
+		fg.SchemaOptions.OpenAIStrict = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(resp.File[0].GetContent()).To(ContainSubstring("EdgeCaseService_MapVariantsTool"))
+
+	// google.protobuf.Any carries free-form JSON.
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.SchemaOptions.OpenAIStrict = true
+	})
+	g.Expect(resp.GetError()).To(Equal(`testdata/test_service.proto:34:3: testdata.TestService.ProcessWellKnownTypes: schema is not valid in OpenAI strict mode: #/properties/payload/properties/value/anyOf/0: a schema without "type" accepts any JSON value, which is not supported; map the message to a typed schema with schema_mappings or (mcp.message).schema`))
+}
+
+func TestGenerateCompressSchemas(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.CompressSchemas = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	content := resp.File[0].GetContent()
+	g.Expect(content).ToNot(ContainSubstring("json.RawMessage("))
+	g.Expect(content).To(ContainSubstring("TestService_CreateItemTool = file_testdata_test_service_proto_mcpSchemas.Tool(runtime.Tool{"))
+
+	// The embedded data holds the schemas the tool would have had.
+	f, err := parser.ParseFile(token.NewFileSet(), "", content, 0)
+	g.Expect(err).ToNot(HaveOccurred())
+	var data string
+	ast.Inspect(f, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "NewCompressedSchemas" {
+				data = stringConstant(g, call.Args[0])
+			}
+		}
+		return true
+	})
+	want := testdatamcp.TestService_CreateItemTool
+	got := runtime.NewCompressedSchemas(data).Tool(runtime.Tool{Name: want.Name, Description: want.Description})
+	g.Expect(got.Loaded()).To(Equal(want))
+}
+
+// stringConstant evaluates a concatenation of string literals.
+func stringConstant(g *WithT, expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.BinaryExpr:
+		return stringConstant(g, e.X) + stringConstant(g, e.Y)
+	case *ast.BasicLit:
+		s, err := strconv.Unquote(e.Value)
+		g.Expect(err).ToNot(HaveOccurred())
+		return s
+	}
+	g.Expect(expr).To(BeNil(), "not a string constant")
+	return ""
+}
+
+func TestGenerateSharedDefinitions(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/edge_cases.proto"}, func(fg *FileGenerator) {
+		fg.SharedDefinitions = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	content := resp.File[0].GetContent()
+	g.Expect(content).To(ContainSubstring("var file_testdata_edge_cases_proto_mcpDefinitions = runtime.NewSchemaDefinitions(`{\"Inner\":"))
+	g.Expect(content).To(ContainSubstring("EdgeCaseService_DeepNestingTool = file_testdata_edge_cases_proto_mcpDefinitions.Tool(runtime.Tool{"))
+	g.Expect(content).To(ContainSubstring(`{"$ref":"#/$defs/Inner"}`))
+
+	resp = runGenerator(g, []string{"testdata/edge_cases.proto"}, func(fg *FileGenerator) {
+		fg.SharedDefinitions = true
+		fg.CompressSchemas = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	content = resp.File[0].GetContent()
+	g.Expect(content).To(ContainSubstring(`runtime.NewSchemaDefinitions("\x1f\x8b`))
+	g.Expect(content).To(ContainSubstring("EdgeCaseService_DeepNestingTool = file_testdata_edge_cases_proto_mcpDefinitions.Tool(file_testdata_edge_cases_proto_mcpSchemas.Tool(runtime.Tool{"))
+
+	// Nothing repeats in test_service.proto.
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.SharedDefinitions = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(resp.File[0].GetContent()).ToNot(ContainSubstring("mcpDefinitions"))
+
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.SharedDefinitions = true
+		fg.SchemaOptions.DedupeDescriptions = true
+	})
+	g.Expect(resp.GetError()).To(Equal("shared_definitions and dedupe_descriptions are mutually exclusive"))
+}
+
+func TestGenerateWrapInput(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/annotations.proto"}, func(fg *FileGenerator) {
+		fg.SchemaOptions.WrapInput = "request"
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(resp.File).To(HaveLen(1))
+	content := resp.File[0].GetContent()
+	g.Expect(content).To(ContainSubstring(`runtime.UnwrapArguments(message, "request")`))
+
+	// Without the option the handlers do not unwrap.
+	resp = runGenerator(g, []string{"testdata/annotations.proto"}, nil)
+	g.Expect(resp.File[0].GetContent()).ToNot(ContainSubstring("UnwrapArguments"))
+}
+
+func TestGenerateFlattenNested(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/annotations.proto"}, func(fg *FileGenerator) {
+		fg.SchemaOptions.FlattenNested = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(resp.File).To(HaveLen(1))
+	g.Expect(resp.File[0].GetContent()).To(ContainSubstring("runtime.UnflattenArguments(message)"))
+
+	// Without the option the handlers do not unflatten.
+	resp = runGenerator(g, []string{"testdata/annotations.proto"}, nil)
+	g.Expect(resp.File[0].GetContent()).ToNot(ContainSubstring("UnflattenArguments"))
+}
+
+func TestGenerateRelativeTimes(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.SchemaOptions.RelativeTimes = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(resp.File).To(HaveLen(1))
+	content := resp.File[0].GetContent()
+	g.Expect(content).To(ContainSubstring("runtime.NormalizeTimes(req.ProtoReflect().Descriptor(), message)"))
+	g.Expect(content).ToNot(ContainSubstring(`date-time`))
+
+	// Without the option the handlers leave times to protojson.
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, nil)
+	g.Expect(resp.File[0].GetContent()).ToNot(ContainSubstring("NormalizeTimes"))
+}
+
+func TestGenerateIdempotencyKey(t *testing.T) {
+	g := NewWithT(t)
+
+	// Give CreateItemRequest an AIP-155 request_id.
+	fds := testServiceFileDescriptorSet(g)
+	for _, f := range fds.File {
+		for _, m := range f.MessageType {
+			if m.GetName() == "CreateItemRequest" {
+				m.Field = append(m.Field, &descriptorpb.FieldDescriptorProto{
+					Name:     proto.String("request_id"),
+					JsonName: proto.String("requestId"),
+					Number:   proto.Int32(100),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				})
+			}
+		}
+	}
+	files, err := Generate(fds, Options{PackageSuffix: "mcp", Paths: "source_relative"})
+	g.Expect(err).ToNot(HaveOccurred())
+	content := string(files["testdata/testdatamcp/test_service.pb.mcp.go"])
+	// Only CreateItem fills a key, in each of its three handlers.
+	g.Expect(strings.Count(content, "runtime.FillIdempotencyKey(ctx, req.ProtoReflect().Descriptor(), message)")).To(Equal(3))
+}
+
+func TestGenerateDryRun(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/annotations.proto"}, func(fg *FileGenerator) {
+		fg.SchemaOptions.DryRun = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(resp.File).To(HaveLen(1))
+	content := resp.File[0].GetContent()
+	// ApplyConfig and LegacyApply take dry_run; ListConfigs and GetConfig have no
+	// side effects.
+	g.Expect(strings.Count(content, "runtime.ExtractDryRun(message)")).To(Equal(3 * 2))
+	g.Expect(content).To(ContainSubstring("runtime.SetValidateOnly(&req)"))
+
+	// Without the option the handlers never look for dry_run.
+	resp = runGenerator(g, []string{"testdata/annotations.proto"}, nil)
+	g.Expect(resp.File[0].GetContent()).ToNot(ContainSubstring("DryRun"))
+}
+
+func TestGenerateResources(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/annotations.proto"}, nil)
+	g.Expect(resp.GetError()).To(BeEmpty())
+	content := resp.File[0].GetContent()
+	// Only ListConfigs is annotated with a resource_uri.
+	g.Expect(strings.Count(content, "runtime.AddResource(s,")).To(Equal(3 * 1))
+	// Reads go through the tool handler of the method.
+	g.Expect(strings.Count(content, `runtime.ToolResource("configs://list", (&testdata.ListConfigsRequest{}).ProtoReflect().Descriptor(), "", ListConfigsHandler)`)).To(Equal(3))
+
+	// With resources=true the google.api.http GET of GetConfig is exposed too.
+	resp = runGenerator(g, []string{"testdata/annotations.proto"}, func(fg *FileGenerator) {
+		fg.SchemaOptions.Resources = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	content = resp.File[0].GetContent()
+	g.Expect(strings.Count(content, "runtime.AddResource(s,")).To(Equal(3 * 2))
+	g.Expect(content).To(ContainSubstring(`runtime.ToolResource("testdata://v1/{+name}", (&testdata.GetConfigRequest{}).ProtoReflect().Descriptor(), "", GetConfigHandler)`))
+	// Its template variable completes like the tool argument.
+	g.Expect(strings.Count(content, `config.Completions.Inherit("testdata://v1/{+name}", GetConfigTool.Name, "name")`)).To(Equal(3))
+	g.Expect(content).ToNot(ContainSubstring(`config.Completions.Inherit("configs://list"`))
+}
+
+func TestGenerateWatchedResources(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/annotations.proto"}, nil)
+	g.Expect(resp.GetError()).To(BeEmpty())
+	content := resp.File[0].GetContent()
+	g.Expect(strings.Count(content, "runtime.AddWatchedResource(s,")).To(Equal(3))
+	g.Expect(content).To(ContainSubstring("WatchConfig(req *testdata.GetConfigRequest, stream grpc.ServerStreamingServer[testdata.Config]) error"))
+	g.Expect(content).To(ContainSubstring("srv.WatchConfig(&req, runtime.NewServerStream[testdata.Config](ctx, update))"))
+	g.Expect(content).To(ContainSubstring("(*connect.ServerStreamForClient[testdata.Config], error)"))
+	g.Expect(content).To(ContainSubstring("runtime.RecvAll(stream.Recv, update)"))
+	// Streaming RPCs still get no tool.
+	g.Expect(content).ToNot(ContainSubstring("AnnotatedService_WatchConfigTool"))
+}
+
+func TestGeneratePrompts(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/annotations.proto"}, nil)
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(resp.File).To(HaveLen(1))
+	content := resp.File[0].GetContent()
+	// Two prompts in each of the three registration functions.
+	g.Expect(strings.Count(content, "runtime.AddPrompt(s, prompt,")).To(Equal(3 * 2))
+	g.Expect(content).To(ContainSubstring(`"tool:ListConfigs": ListConfigsTool.Name,`))
+	g.Expect(content).To(ContainSubstring(`config.Completions.Inherit(prompt.Name, ApplyConfigTool.Name, "base_config")`))
+
+	// Prompts of other files are not generated.
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, nil)
+	g.Expect(resp.File[0].GetContent()).ToNot(ContainSubstring("AddPrompt"))
+}
+
+func TestGenerateEmitSchemas(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/annotations.proto"}, func(fg *FileGenerator) {
+		fg.EmitSchemasDir = "schemas"
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	files := map[string]string{}
+	for _, f := range resp.File[1:] {
+		files[f.GetName()] = f.GetContent()
+	}
+	g.Expect(files).To(HaveKey("schemas/testdata_AnnotatedService_ListConfigs.input.json"))
+	g.Expect(files).To(HaveKey("schemas/testdata_AnnotatedService_ListConfigs.output.json"))
+	// Streaming RPCs have no tool and so no schema.
+	g.Expect(files).ToNot(HaveKey("schemas/testdata_AnnotatedService_WatchConfig.input.json"))
+
+	input := files["schemas/testdata_AnnotatedService_ListConfigs.input.json"]
+	g.Expect(input).To(HavePrefix("{\n  \""))
+	g.Expect(input).To(HaveSuffix("}\n"))
+	g.Expect(input).To(MatchJSON(testdatamcp.AnnotatedService_ListConfigsTool.RawInputSchema))
+
+	// Nothing is emitted by default.
+	resp = runGenerator(g, []string{"testdata/annotations.proto"}, nil)
+	g.Expect(resp.File).To(HaveLen(1))
+}
+
+func TestGenerateGoldenTests(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.GoldenTests = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(resp.File).To(HaveLen(2))
+	g.Expect(resp.File[1].GetName()).To(Equal("testdata/testdatamcp/test_service.pb.mcp_test.go"))
+	content := resp.File[1].GetContent()
+	g.Expect(content).To(ContainSubstring("package testdatamcp"))
+	g.Expect(content).To(ContainSubstring("func TestTestServiceToolsGolden(t *testing.T) {"))
+	g.Expect(content).To(ContainSubstring("\t\tTestService_CreateItemTool,\n"))
+	g.Expect(content).To(ContainSubstring(`filepath.Join("testdata", tool.Name+".golden.json")`))
+
+	// Nothing is emitted by default.
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, nil)
+	g.Expect(resp.File).To(HaveLen(1))
+}
+
+func TestGenerateHTTPForwarder(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/annotations.proto"}, nil)
+	g.Expect(resp.GetError()).To(BeEmpty())
+	content := resp.File[0].GetContent()
+	g.Expect(content).To(ContainSubstring("func ForwardToAnnotatedServiceHTTP(s runtime.MCPServer, client runtime.HTTPClient, baseURL string, opts ...runtime.Option) {"))
+	g.Expect(content).To(ContainSubstring(`runtime.CallHTTP(ctx, c.client, c.baseURL, runtime.HTTPBinding{Method: "GET", Path: "/v1/{name=configs/*}", Body: "", ResponseBody: ""}, req, &resp)`))
+	g.Expect(content).To(ContainSubstring(`return nil, runtime.ErrNoHTTPBinding("/testdata.AnnotatedService/ApplyConfig")`))
+
+	// Services without HTTP bindings get no HTTP forwarder.
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, nil)
+	g.Expect(resp.File[0].GetContent()).ToNot(ContainSubstring("HTTP("))
+}
+
+func TestGenerateConnForwarder(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/annotations.proto"}, nil)
+	g.Expect(resp.GetError()).To(BeEmpty())
+	content := resp.File[0].GetContent()
+	g.Expect(content).To(ContainSubstring("func ForwardToAnnotatedServiceConn(s runtime.MCPServer, conn grpc.ClientConnInterface, opts ...runtime.Option) {"))
+	g.Expect(content).To(ContainSubstring(`c.conn.Invoke(ctx, "/testdata.AnnotatedService/GetConfig", req, resp, opts...)`))
+	g.Expect(content).To(ContainSubstring(`c.conn.NewStream(ctx, &grpc.StreamDesc{StreamName: "WatchConfig", ServerStreams: true}, "/testdata.AnnotatedService/WatchConfig", opts...)`))
+}
+
+func TestGenerateConnectHandlerForwarder(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/annotations.proto"}, nil)
+	g.Expect(resp.GetError()).To(BeEmpty())
+	content := resp.File[0].GetContent()
+	g.Expect(content).To(ContainSubstring("func ForwardToConnectAnnotatedServiceHandler(s runtime.MCPServer, handler ConnectAnnotatedServiceHandler, opts ...runtime.Option) {"))
+	g.Expect(content).To(ContainSubstring("WatchConfig(ctx context.Context, req *connect.Request[testdata.GetConfigRequest], stream *connect.ServerStream[testdata.Config]) error"))
+	g.Expect(content).To(ContainSubstring(`return nil, runtime.ErrInProcessStream("/testdata.AnnotatedService/WatchConfig")`))
+}
+
+func TestGenerateFanOut(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.FanOut = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	content := resp.File[0].GetContent()
+	g.Expect(content).To(ContainSubstring("func FanOutToTestServiceClients(s runtime.MCPServer, clients map[string]TestServiceClient, opts ...runtime.Option) {"))
+	g.Expect(content).To(ContainSubstring("ForwardToTestServiceClient(s, client, opts...)"))
+	g.Expect(content).To(ContainSubstring("func FanOutToConnectTestServiceClients(s runtime.MCPServer, clients map[string]ConnectTestServiceClient, opts ...runtime.Option) {"))
+
+	// Nothing is emitted by default.
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, nil)
+	g.Expect(resp.File[0].GetContent()).ToNot(ContainSubstring("FanOut"))
+}
+
+func TestGenerateFailover(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.Failover = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	content := resp.File[0].GetContent()
+	g.Expect(content).To(ContainSubstring("func FailoverToTestServiceClients(s runtime.MCPServer, clients []TestServiceClient, failover runtime.FailoverOptions, opts ...runtime.Option) {"))
+	g.Expect(content).To(ContainSubstring("func (c failoverTestServiceClient) GetItem(ctx context.Context, req *testdata.GetItemRequest, opts ...grpc.CallOption) (*testdata.GetItemResponse, error) {"))
+	g.Expect(content).To(ContainSubstring("func FailoverToConnectTestServiceClients(s runtime.MCPServer, clients []ConnectTestServiceClient, failover runtime.FailoverOptions, opts ...runtime.Option) {"))
+	g.Expect(content).To(ContainSubstring("return runtime.CallWithFailover(ctx, c.failover, func(ctx context.Context, client ConnectTestServiceClient) (*connect.Response[testdata.GetItemResponse], error) {"))
+
+	// Nothing is emitted by default.
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, nil)
+	g.Expect(resp.File[0].GetContent()).ToNot(ContainSubstring("Failover"))
+}
+
+func TestGenerateMocks(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.Mocks = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(resp.File).To(HaveLen(2))
+	g.Expect(resp.File[1].GetName()).To(Equal("testdata/testdatamcp/test_service.pb.mcp.mock.go"))
+	content := resp.File[1].GetContent()
+	g.Expect(content).To(ContainSubstring("var _ TestServiceServer = (*TestServiceServerMock)(nil)"))
+	g.Expect(content).To(ContainSubstring("func (m *TestServiceServerMock) GetItemCalls() []*testdata.GetItemRequest {"))
+	// Without streaming methods grpc is not imported.
+	g.Expect(content).ToNot(ContainSubstring(`"google.golang.org/grpc"`))
+
+	// Nothing is emitted by default.
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, nil)
+	g.Expect(resp.File).To(HaveLen(1))
+}
+
+func TestGenerateFuzzTests(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.FuzzTests = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(resp.File).To(HaveLen(2))
+	g.Expect(resp.File[1].GetName()).To(Equal("testdata/testdatamcp/test_service.pb.mcp_fuzz_test.go"))
+	content := resp.File[1].GetContent()
+	g.Expect(content).To(ContainSubstring("func FuzzTestServiceArguments(f *testing.F) {"))
+	g.Expect(content).To(ContainSubstring("RegisterTestServiceHandler(runtime.AddToolFunc("))
+	g.Expect(content).To(ContainSubstring("func (fuzzTestServiceServer) GetItem(context.Context, *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {"))
+	// Seeds set every argument of a tool to a value of its type, then of
+	// the wrong type.
+	g.Expect(content).To(ContainSubstring("f.Add(`{\"id\":\"\"}`)"))
+	g.Expect(content).To(ContainSubstring("f.Add(`{\"id\":{}}`)"))
+
+	// Nothing is emitted by default.
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, nil)
+	g.Expect(resp.File).To(HaveLen(1))
+}
+
+func TestGenerateBuildTag(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.BuildTag = "mcp && !nomcp"
+		fg.Mocks = true
+		fg.FuzzTests = true
+		fg.GoldenTests = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(resp.File).To(HaveLen(4))
+	for _, f := range resp.File {
+		g.Expect(f.GetContent()).To(HavePrefix("//go:build mcp && !nomcp\n\n// Code generated by protoc-gen-mcp-go. DO NOT EDIT."), f.GetName())
+		// go/build only honors the constraint ahead of the package clause.
+		file, err := parser.ParseFile(token.NewFileSet(), f.GetName(), f.GetContent(), parser.PackageClauseOnly|parser.ParseComments)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(file.Comments[0].List[0].Text).To(Equal("//go:build mcp && !nomcp"))
+	}
+
+	// No constraint is written by default.
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, nil)
+	g.Expect(resp.File[0].GetContent()).To(HavePrefix("// Code generated"))
+}
+
+func TestGeneratePackageNameAndFileSuffix(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.PackageName = "mcpconnect"
+		fg.FileSuffix = "_mcp.pb.go"
+		fg.Mocks = true
+		fg.FuzzTests = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	var names []string
+	for _, f := range resp.File {
+		names = append(names, f.GetName())
+		g.Expect(f.GetContent()).To(ContainSubstring("\npackage mcpconnect\n"), f.GetName())
+	}
+	g.Expect(names).To(ConsistOf(
+		"testdata/mcpconnect/test_service_mcp.pb.go",
+		"testdata/mcpconnect/test_service_mcp.pb.mock.go",
+		"testdata/mcpconnect/test_service_mcp.pb_fuzz_test.go",
+	))
+
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.PackageName = "{package}v2mcp"
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(resp.File[0].GetName()).To(Equal("testdata/testdatav2mcp/test_service.pb.mcp.go"))
+	g.Expect(resp.File[0].GetContent()).To(ContainSubstring("\npackage testdatav2mcp\n"))
+
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.PackageName = "{package}-mcp"
+	})
+	g.Expect(resp.GetError()).To(ContainSubstring(`package_name "{package}-mcp" gives "testdata-mcp"`))
+
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.FileSuffix = "_mcp_test.go"
+	})
+	g.Expect(resp.GetError()).To(ContainSubstring(`file_suffix "_mcp_test.go"`))
+}
+
+func TestGenerateSamePackage(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.SamePackage = true
+		fg.Mocks = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(resp.File).To(HaveLen(2))
+	g.Expect(resp.File[0].GetName()).To(Equal("testdata/test_service.pb.mcp.go"))
+	content := resp.File[0].GetContent()
+	g.Expect(content).To(ContainSubstring("\npackage testdata\n"))
+	// Types of the package are not qualified.
+	g.Expect(content).To(ContainSubstring("var req GetItemRequest"))
+	// Only the Connect procedures, "/testdata.TestService/...", mention it.
+	g.Expect(content).ToNot(MatchRegexp(`[^/]testdata\.`))
+	// The interfaces do not clash with the protoc-gen-go-grpc ones.
+	g.Expect(content).To(ContainSubstring("type TestServiceMCPServer interface {"))
+	g.Expect(content).To(ContainSubstring("type TestServiceMCPClient interface {"))
+	g.Expect(content).To(ContainSubstring("func RegisterTestServiceHandler(s runtime.MCPServer, srv TestServiceMCPServer, opts ...runtime.Option) {"))
+	g.Expect(content).To(ContainSubstring("func ForwardToTestServiceClient(s runtime.MCPServer, client TestServiceMCPClient, opts ...runtime.Option) {"))
+	g.Expect(resp.File[1].GetContent()).To(ContainSubstring("var _ TestServiceMCPServer = (*TestServiceServerMock)(nil)"))
+
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.SamePackage = true
+		fg.PackageName = "mcpconnect"
+	})
+	g.Expect(resp.GetError()).To(ContainSubstring("mutually exclusive"))
+
+	// Apart from the interface names, same_package is package_suffix="".
+	fds := testServiceFileDescriptorSet(g)
+	same, err := Generate(fds, Options{PackageSuffix: "mcp", SamePackage: true, Paths: "source_relative"})
+	g.Expect(err).ToNot(HaveOccurred())
+	empty, err := Generate(fds, Options{Paths: "source_relative"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(same).To(HaveLen(1))
+	renamed := strings.NewReplacer("TestServiceMCPServer", "TestServiceServer", "TestServiceMCPClient", "TestServiceClient").Replace(string(same["testdata/test_service.pb.mcp.go"]))
+	g.Expect(renamed).To(Equal(string(empty["testdata/test_service.pb.mcp.go"])))
+}
+
+func TestGenerateGenerationInfo(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.SchemaOptions.DryRun = true
+		fg.SchemaOptions.CommentDirectives = []string{"TODO:", "FIXME:"}
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	content := resp.File[0].GetContent()
+	g.Expect(content).To(ContainSubstring("var File_testdata_test_service_proto_GenerationInfo = runtime.GenerationInfo{"))
+	g.Expect(content).To(ContainSubstring(`Source:  "testdata/test_service.proto",`))
+	// Builds other than releases do not record their pseudo-version.
+	g.Expect(content).To(ContainSubstring(`Version: "devel",`))
+	g.Expect(content).To(ContainSubstring(`"dry_run=true",`))
+	g.Expect(content).To(ContainSubstring(`"comment_directives=TODO:,FIXME:",`))
+	// Defaults are recorded under their plugin option values.
+	g.Expect(content).To(ContainSubstring(`"field_comments=none",`))
+
+	defer func(v string) { Version = v }(Version)
+	Version = "v1.2.3"
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, nil)
+	g.Expect(resp.File[0].GetContent()).To(ContainSubstring(`Version: "v1.2.3",`))
+}
+
+func TestGenerateConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	config, err := ParseConfig([]byte(`
+services:
+  testdata.TestService:
+    openai_strict: true
+    methods:
+      ProcessWellKnownTypes:
+        exclude: true
+      GetItem:
+        name: get_item
+        title: Get item
+        description: Returns an item.
+`))
+	g.Expect(err).ToNot(HaveOccurred())
+	// ProcessWellKnownTypes cannot be expressed in OpenAI strict mode, so
+	// the service only generates with it excluded.
+	resp := runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.Config = config
+		fg.Mocks = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	content := resp.File[0].GetContent()
+	g.Expect(content).ToNot(ContainSubstring("ProcessWellKnownTypes"))
+	g.Expect(resp.File[1].GetContent()).ToNot(ContainSubstring("ProcessWellKnownTypes"))
+	g.Expect(content).To(ContainSubstring(`Name:            "get_item",`))
+	g.Expect(content).To(ContainSubstring(`Title:           "Get item",`))
+	g.Expect(content).To(ContainSubstring(`Description:     "Returns an item.",`))
+	g.Expect(content).To(ContainSubstring(`Name:            "testdata_TestService_CreateItem",`))
+
+	delete(config.Services["testdata.TestService"].Methods, "ProcessWellKnownTypes")
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.Config = config
+	})
+	g.Expect(resp.GetError()).To(ContainSubstring("testdata.TestService.ProcessWellKnownTypes: schema is not valid in OpenAI strict mode"))
+}
+
+func TestGenerateExcludeAndToolPrefix(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.Exclude = []string{"testdata.TestService.Process*", "testdata.Other*"}
+		fg.ToolPrefixes = []ToolPrefix{{Prefix: "rp_"}, {Services: "testdata.Test*", Prefix: "test_"}}
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	content := resp.File[0].GetContent()
+	g.Expect(content).ToNot(ContainSubstring("ProcessWellKnownTypes"))
+	// The scoped prefix wins.
+	g.Expect(content).To(ContainSubstring(`Name:            "test_testdata_TestService_GetItem",`))
+
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.Exclude = []string{"testdata.TestService"}
+		fg.ToolPrefixes = []ToolPrefix{{Prefix: "rp_"}}
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	content = resp.File[0].GetContent()
+	g.Expect(content).ToNot(ContainSubstring("runtime.Tool{"))
+
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.ToolPrefixes = []ToolPrefix{{Prefix: "rp_"}}
+	})
+	g.Expect(resp.File[0].GetContent()).To(ContainSubstring(`Name:            "rp_testdata_TestService_GetItem",`))
+
+	// A prefix set for the service itself wins over patterns.
+	config := &Config{}
+	g.Expect(config.SetServiceOption("testdata.TestService:tool_prefix=svc_")).To(Succeed())
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.Config = config
+		fg.ToolPrefixes = []ToolPrefix{{Prefix: "rp_"}, {Services: "testdata.Test*", Prefix: "test_"}}
+	})
+	g.Expect(resp.File[0].GetContent()).To(ContainSubstring(`Name:            "svc_testdata_TestService_GetItem",`))
+
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.ToolPrefixes = []ToolPrefix{{Prefix: strings.Repeat("x", 40)}}
+	})
+	g.Expect(resp.GetError()).To(ContainSubstring("is longer than 64 characters"))
+}
+
+func TestGenerateExcludeFields(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.SchemaOptions.ExcludeFields = []string{"testdata.*.thumbnail"}
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	content := resp.File[0].GetContent()
+	g.Expect(content).To(ContainSubstring(`runtime.RejectFields(req.ProtoReflect().Descriptor(), message, []string{"testdata.CreateItemRequest.thumbnail"})`))
+	g.Expect(content).ToNot(ContainSubstring(`\"thumbnail\"`))
+	g.Expect(content).To(ContainSubstring(`"exclude_fields=testdata.*.thumbnail",`))
+
+	_, err := Generate(testServiceFileDescriptorSet(g), Options{
+		PackageSuffix: "mcp",
+		SchemaOptions: gen.SchemaOptions{ExcludeFields: []string{"["}},
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("exclude_fields:")))
+}
+
+// testServiceFileDescriptorSet returns testdata/test_service.proto and its
+// dependencies, without source code info. Dependents come first: Generate
+// orders the set itself.
+func testServiceFileDescriptorSet(g Gomega) *descriptorpb.FileDescriptorSet {
+	fd, err := protoregistry.GlobalFiles.FindFileByPath("testdata/test_service.proto")
+	g.Expect(err).ToNot(HaveOccurred())
+	fds := &descriptorpb.FileDescriptorSet{}
+	seen := map[string]bool{}
+	var add func(protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		fds.File = append(fds.File, protodesc.ToFileDescriptorProto(fd))
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+	}
+	add(fd)
+	return fds
+}
+
+func TestGenerateFromFileDescriptorSet(t *testing.T) {
+	g := NewWithT(t)
+
+	fds := testServiceFileDescriptorSet(g)
+	files, err := Generate(fds, Options{PackageSuffix: "mcp", Paths: "source_relative", Mocks: true})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(files).To(HaveLen(2))
+	g.Expect(string(files["testdata/testdatamcp/test_service.pb.mcp.go"])).To(ContainSubstring("func RegisterTestServiceHandler("))
+	g.Expect(files).To(HaveKey("testdata/testdatamcp/test_service.pb.mcp.mock.go"))
+
+	_, err = Generate(fds, Options{PackageSuffix: "not-an-identifier"})
+	g.Expect(err).To(MatchError(ContainSubstring("is not a valid Go identifier")))
+
+	_, err = Generate(fds, Options{PackageSuffix: "mcp", BuildTag: "mcp &&"})
+	g.Expect(err).To(MatchError(ContainSubstring(`build_tag "mcp &&"`)))
+
+	// paths=import places files under their full import path, and
+	// source_relative next to the proto source.
+	files, err = Generate(fds, Options{PackageSuffix: "mcp", Paths: "import"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(files).To(HaveKey("github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp/test_service.pb.mcp.go"))
+	files, err = Generate(fds, Options{PackageSuffix: "mcp", Paths: "source_relative", PackageName: "mcpconnect", FileSuffix: "_mcp.pb.go"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(files).To(HaveKey("testdata/mcpconnect/test_service_mcp.pb.go"))
+
+	// M parameters override go_package, and module strips the prefix from
+	// output paths.
+	files, err = Generate(fds, Options{
+		PackageSuffix: "mcp",
+		Paths:         "import",
+		Module:        "example.com/api",
+		ImportPaths:   map[string]string{"testdata/test_service.proto": "example.com/api/test/v1;testv1"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(files).To(HaveKey("test/v1/testv1mcp/test_service.pb.mcp.go"))
+	content := string(files["test/v1/testv1mcp/test_service.pb.mcp.go"])
+	g.Expect(content).To(ContainSubstring("package testv1mcp"))
+	g.Expect(content).To(ContainSubstring(`"example.com/api/test/v1"`))
+
+	// The warnings report lands next to the generated files.
+	files, err = Generate(fds, Options{PackageSuffix: "mcp", WarningsReport: "mcp-warnings.json"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(files["mcp-warnings.json"])).To(ContainSubstring(`{
+      "kind": "any",
+      "element": "testdata.ProcessWellKnownTypesRequest.payload",
+      "location": "testdata/test_service.proto",`))
+
+	// Config overrides must name services and methods of the set.
+	config, err := ParseConfig([]byte("services:\n  testdata.Nope: {}\n  testdata.TestService:\n    methods:\n      Nope: {}\n"))
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = Generate(fds, Options{Config: config})
+	g.Expect(err).To(MatchError("config: unknown service testdata.Nope\nconfig: service testdata.TestService has no method Nope"))
+
+	fds.File = fds.File[:1]
+	_, err = Generate(fds, Options{})
+	g.Expect(err).To(MatchError(ContainSubstring("missing dependency")))
+}
+
+// fileDescriptorSet returns the files at paths and their dependencies,
+// without source code info. Dependents come first.
+func fileDescriptorSet(g Gomega, paths ...string) *descriptorpb.FileDescriptorSet {
+	fds := &descriptorpb.FileDescriptorSet{}
+	seen := map[string]bool{}
+	var add func(protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		fds.File = append(fds.File, protodesc.ToFileDescriptorProto(fd))
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+	}
+	for _, path := range paths {
+		fd, err := protoregistry.GlobalFiles.FindFileByPath(path)
+		g.Expect(err).ToNot(HaveOccurred())
+		add(fd)
+	}
+	return fds
+}
+
+func TestGenerateStream(t *testing.T) {
+	g := NewWithT(t)
+
+	fds := fileDescriptorSet(g, "testdata/test_service.proto", "testdata/annotations.proto")
+	files, err := sortFiles(fds.File)
+	g.Expect(err).ToNot(HaveOccurred())
+	opts := Options{PackageSuffix: "mcp", WarningsReport: "mcp-warnings.json"}
+
+	// Each proto file is generated and emitted on its own, and the reports
+	// come last, once every file has been seen.
+	var emitted [][]string
+	streamed := map[string][]byte{}
+	err = GenerateStream(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{"testdata/test_service.proto", "testdata/annotations.proto"},
+		Parameter:      proto.String("paths=source_relative"),
+		ProtoFile:      files,
+	}, opts, func(resp *pluginpb.CodeGeneratorResponse) error {
+		var names []string
+		for _, f := range resp.File {
+			names = append(names, f.GetName())
+			streamed[f.GetName()] = []byte(f.GetContent())
+		}
+		emitted = append(emitted, names)
+		return nil
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(emitted).To(Equal([][]string{
+		{"testdata/testdatamcp/test_service.pb.mcp.go"},
+		{"testdata/testdatamcp/annotations.pb.mcp.go"},
+		{"mcp-warnings.json"},
+	}))
+
+	opts.Paths = "source_relative"
+	opts.FilesToGenerate = []string{"testdata/test_service.proto", "testdata/annotations.proto"}
+	all, err := Generate(fds, opts)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(streamed).To(Equal(all))
+
+	// A file that fails to generate is not emitted.
+	emitted = nil
+	err = GenerateStream(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{"testdata/test_service.proto"},
+		ProtoFile:      files,
+	}, Options{PackageSuffix: "not-an-identifier"}, func(*pluginpb.CodeGeneratorResponse) error {
+		emitted = append(emitted, nil)
+		return nil
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("is not a valid Go identifier")))
+	g.Expect(emitted).To(BeEmpty())
+}
+
+func TestGenerateToolNameCollision(t *testing.T) {
+	g := NewWithT(t)
+
+	config, err := ParseConfig([]byte("services:\n  testdata.TestService:\n    methods:\n      GetItem:\n        name: testdata_TestService_CreateItem\n"))
+	g.Expect(err).ToNot(HaveOccurred())
+	resp := runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.Config = config
+	})
+	g.Expect(resp.GetError()).To(Equal(`testdata/test_service.proto:31:3: testdata.TestService.GetItem: tool name "testdata_TestService_CreateItem" collides with that of testdata.TestService.CreateItem at testdata/test_service.proto:28:3`))
+}
+
+func TestGenerateWarnings(t *testing.T) {
+	g := NewWithT(t)
+
+	var warnings []string
+	resp := runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.SchemaOptions.MaxToolDescriptionBytes = 20
+		fg.SchemaOptions.MaxFieldDescriptionBytes = 30
+		fg.SchemaOptions.FieldComments = gen.FieldCommentsLeading
+		fg.Warn = func(w Warning) { warnings = append(warnings, w.String()) }
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(warnings).To(ConsistOf(
+		"testdata/test_service.proto:28:3: testdata.TestService.CreateItem: tool description of 30 bytes truncated to max_tool_description_bytes=20",
+		"testdata/test_service.proto:31:3: testdata.TestService.GetItem: tool description of 32 bytes truncated to max_tool_description_bytes=20",
+		"testdata/test_service.proto:34:3: testdata.TestService.ProcessWellKnownTypes: tool description of 31 bytes truncated to max_tool_description_bytes=20",
+		"testdata/test_service.proto:37:3: testdata.TestService.TestValidation: tool description of 31 bytes truncated to max_tool_description_bytes=20",
+		"testdata/test_service.proto:48:3: testdata.CreateItemRequest.labels: field description of 40 bytes truncated to max_field_description_bytes=30",
+		"testdata/test_service.proto:60:3: testdata.CreateItemRequest.thumbnail: field description of 63 bytes truncated to max_field_description_bytes=30",
+		"testdata/test_service.proto:97:3: testdata.ProcessWellKnownTypesRequest.metadata: field description of 58 bytes truncated to max_field_description_bytes=30",
+		"testdata/test_service.proto:99:3: testdata.ProcessWellKnownTypesRequest.payload: google.protobuf.Any has no schema for its payload, so the model sees a free-form value; map it with schema_mappings",
+	))
+
+	// Excluded RPCs are skipped on purpose and not reported.
+	warnings = nil
+	resp = runGenerator(g, []string{"testdata/annotations.proto"}, func(fg *FileGenerator) {
+		fg.Warn = func(w Warning) { warnings = append(warnings, w.String()) }
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(warnings).To(BeEmpty())
+}
+
+func TestGenerateStrict(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := runGenerator(g, []string{"testdata/edge_cases.proto"}, func(fg *FileGenerator) {
+		fg.Strict = true
+	})
+	g.Expect(resp.GetError()).To(Equal("testdata/edge_cases.proto:236:3: testdata.TreeNode.children: makes testdata.TreeNode recursive, so beyond 3 levels the model sees a JSON string instead of a schema (strict)"))
+
+	// Every warning is reported, and excluding the RPCs fixes them.
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.Strict = true
+		fg.SchemaOptions.MaxToolDescriptionBytes = 31
+	})
+	g.Expect(strings.Split(resp.GetError(), "\n")).To(ConsistOf(
+		"testdata/test_service.proto:31:3: testdata.TestService.GetItem: tool description of 32 bytes truncated to max_tool_description_bytes=31 (strict)",
+		"testdata/test_service.proto:99:3: testdata.ProcessWellKnownTypesRequest.payload: google.protobuf.Any has no schema for its payload, so the model sees a free-form value; map it with schema_mappings (strict)",
+	))
+	resp = runGenerator(g, []string{"testdata/test_service.proto"}, func(fg *FileGenerator) {
+		fg.Strict = true
+		fg.Exclude = []string{"testdata.TestService.ProcessWellKnownTypes"}
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(resp.File).ToNot(BeEmpty())
+}
+
+func TestGeneratePreview(t *testing.T) {
+	g := NewWithT(t)
+
+	var entries []PreviewEntry
+	resp := runGenerator(g, []string{"testdata/annotations.proto"}, func(fg *FileGenerator) {
+		fg.EmitSchemasDir = "schemas"
+		fg.Preview = func(e PreviewEntry) { entries = append(entries, e) }
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(resp.File).To(BeEmpty())
+	var buf strings.Builder
+	g.Expect(WritePreview(&buf, entries)).To(Succeed())
+	g.Expect(buf.String()).To(Equal(`KIND      NAME                                    SOURCE                                  INPUT SCHEMA  OUTPUT SCHEMA
+tool      testdata_AnnotatedService_ApplyConfig   testdata.AnnotatedService.ApplyConfig   1061 B        75 B
+tool      testdata_AnnotatedService_LegacyApply   testdata.AnnotatedService.LegacyApply   1015 B        75 B
+tool      testdata_AnnotatedService_ListConfigs   testdata.AnnotatedService.ListConfigs   321 B         189 B
+resource  configs://list                          testdata.AnnotatedService.ListConfigs   -             -
+tool      testdata_AnnotatedService_GetConfig     testdata.AnnotatedService.GetConfig     257 B         71 B
+tool      testdata_AnnotatedService_ExportConfig  testdata.AnnotatedService.ExportConfig  257 B         180 B
+resource  configs://watch/{+name}                 testdata.AnnotatedService.WatchConfig   -             -
+prompt    rollout                                 testdata.AnnotatedService               -             -
+prompt    apply_from                              testdata.AnnotatedService.ApplyConfig   -             -
+5 tools, 2 resources, 2 prompts; no files written
+`))
+}
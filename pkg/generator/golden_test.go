@@ -39,6 +39,7 @@ import (
 var goldenProtoFiles = []string{
 	"testdata/test_service.proto",
 	"testdata/edge_cases.proto",
+	"testdata/annotations.proto",
 }
 
 // TestGoldenGeneration re-runs the code generator in-process and compares
@@ -54,6 +55,26 @@ var goldenProtoFiles = []string{
 func TestGoldenGeneration(t *testing.T) {
 	g := NewWithT(t)
 
+	// The checked-in files are generated with mocks=true.
+	resp := runGenerator(g, goldenProtoFiles, func(fg *FileGenerator) {
+		fg.Mocks = true
+	})
+	g.Expect(resp.GetError()).To(BeEmpty())
+	g.Expect(resp.File).ToNot(BeEmpty(), "generator produced no output files")
+
+	// Compare each output file against its checked-in golden copy.
+	for _, rf := range resp.File {
+		goldenPath := testdataPath("gen/go/" + rf.GetName())
+		expected, err := os.ReadFile(goldenPath)
+		g.Expect(err).ToNot(HaveOccurred(), "reading golden file for %s", rf.GetName())
+		g.Expect(rf.GetContent()).To(Equal(string(expected)),
+			"generated output differs from checked-in %s\nRun: just generate", rf.GetName())
+	}
+}
+
+// runGenerator runs the plugin in-process over the given registered proto
+// paths. configure, if non-nil, adjusts each FileGenerator before it runs.
+func runGenerator(g Gomega, protoFiles []string, configure func(*FileGenerator)) *pluginpb.CodeGeneratorResponse {
 	// Load source_code_info from the buf-built descriptor set.
 	srcInfoByPath := loadSourceCodeInfo(g)
 
@@ -77,7 +98,7 @@ func TestGoldenGeneration(t *testing.T) {
 	}
 
 	var filesToGenerate []string
-	for _, path := range goldenProtoFiles {
+	for _, path := range protoFiles {
 		fd, err := protoregistry.GlobalFiles.FindFileByPath(path)
 		g.Expect(err).ToNot(HaveOccurred(), "proto %s not registered — missing blank import?", path)
 		if fd.Services().Len() == 0 {
@@ -104,21 +125,14 @@ func TestGoldenGeneration(t *testing.T) {
 		if !f.Generate {
 			continue
 		}
-		NewFileGenerator(f, plugin).Generate("mcp")
+		fg := NewFileGenerator(f, plugin)
+		if configure != nil {
+			configure(fg)
+		}
+		fg.Generate("mcp")
 	}
 
-	resp := plugin.Response()
-	g.Expect(resp.GetError()).To(BeEmpty())
-	g.Expect(resp.File).ToNot(BeEmpty(), "generator produced no output files")
-
-	// Compare each output file against its checked-in golden copy.
-	for _, rf := range resp.File {
-		goldenPath := testdataPath("gen/go/" + rf.GetName())
-		expected, err := os.ReadFile(goldenPath)
-		g.Expect(err).ToNot(HaveOccurred(), "reading golden file for %s", rf.GetName())
-		g.Expect(rf.GetContent()).To(Equal(string(expected)),
-			"generated output differs from checked-in %s\nRun: just generate", rf.GetName())
-	}
+	return plugin.Response()
 }
 
 // loadSourceCodeInfo reads the FileDescriptorSet produced by buf build
@@ -3,11 +3,22 @@ package generator
 import (
 	"context"
 	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"connectrpc.com/connect"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
 	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime/mark3labs"
 	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdataconnect"
 	testdatamcp "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
 )
 
@@ -63,3 +74,361 @@ func TestGeneratedHandlerE2E(t *testing.T) {
 	g.Expect(srv.lastCreateReq.Labels).To(HaveKeyWithValue("env", "prod"))
 	g.Expect(srv.lastCreateReq.Tags).To(ConsistOf("sale"))
 }
+
+// listConfigsServer records the ListConfigs requests of an AnnotatedService.
+type listConfigsServer struct {
+	testdatamcp.AnnotatedServiceServer
+	last *testdata.ListConfigsRequest
+}
+
+func (s *listConfigsServer) ListConfigs(_ context.Context, in *testdata.ListConfigsRequest) (*testdata.ListConfigsResponse, error) {
+	s.last = in
+	return &testdata.ListConfigsResponse{}, nil
+}
+
+// TestGeneratedHandlerNoArguments checks that a call without arguments, which
+// mcp-go passes on as nil, still gets the declared defaults.
+func TestGeneratedHandlerNoArguments(t *testing.T) {
+	g := NewWithT(t)
+	srv := &listConfigsServer{}
+	raw, adapter := mark3labs.NewServer("test", "1.0")
+	testdatamcp.RegisterAnnotatedServiceHandler(adapter, srv)
+
+	result := raw.HandleMessage(context.Background(), json.RawMessage(`{
+		"jsonrpc": "2.0",
+		"id": 1,
+		"method": "tools/call",
+		"params": {"name": "testdata_AnnotatedService_ListConfigs"}
+	}`))
+	g.Expect(result).ToNot(BeNil())
+	g.Expect(srv.last).ToNot(BeNil())
+	g.Expect(srv.last.PageSize).To(Equal(int32(50)))
+
+	// Handlers called directly with nil arguments do the same.
+	srv.last = nil
+	handlers := map[string]runtime.ToolHandler{}
+	testdatamcp.RegisterAnnotatedServiceHandler(runtime.AddToolFunc(func(tool runtime.Tool, handler runtime.ToolHandler) {
+		handlers[tool.Name] = handler
+	}), srv)
+	res, err := handlers["testdata_AnnotatedService_ListConfigs"](context.Background(), &runtime.CallToolRequest{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeFalse())
+	g.Expect(srv.last.PageSize).To(Equal(int32(50)))
+}
+
+// applyConfigServer records the ApplyConfig requests of an AnnotatedService.
+type applyConfigServer struct {
+	testdatamcp.AnnotatedServiceServer
+	last *testdata.ApplyConfigRequest
+}
+
+func (s *applyConfigServer) ApplyConfig(_ context.Context, in *testdata.ApplyConfigRequest) (*testdata.ApplyConfigResponse, error) {
+	s.last = in
+	return &testdata.ApplyConfigResponse{Applied: true}, nil
+}
+
+// TestGeneratedHandlerNoArgumentsFromContext checks that a call without
+// arguments still gets its from_context fields.
+func TestGeneratedHandlerNoArgumentsFromContext(t *testing.T) {
+	g := NewWithT(t)
+	type orgKey struct{}
+	srv := &applyConfigServer{}
+	handlers := map[string]runtime.ToolHandler{}
+	testdatamcp.RegisterAnnotatedServiceHandler(runtime.AddToolFunc(func(tool runtime.Tool, handler runtime.ToolHandler) {
+		handlers[tool.Name] = handler
+	}), srv, runtime.WithContextFields(runtime.ContextField{Name: "tenant", ContextKey: orgKey{}}))
+
+	ctx := context.WithValue(context.Background(), orgKey{}, "org-1")
+	res, err := handlers["testdata_AnnotatedService_ApplyConfig"](ctx, &runtime.CallToolRequest{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeFalse())
+	g.Expect(srv.last.OrganizationId).To(Equal("org-1"))
+}
+
+// callOptionsClient records the call options of GetItem.
+type callOptionsClient struct {
+	testdatamcp.TestServiceClient
+	opts []grpc.CallOption
+}
+
+func (c *callOptionsClient) GetItem(_ context.Context, in *testdata.GetItemRequest, opts ...grpc.CallOption) (*testdata.GetItemResponse, error) {
+	c.opts = opts
+	return &testdata.GetItemResponse{Item: &testdata.Item{Id: in.Id}}, nil
+}
+
+// TestForwardedCallOptions checks that WithCallOptions reaches the client
+// call of a ForwardTo tool, with the tool name as registered.
+func TestForwardedCallOptions(t *testing.T) {
+	g := NewWithT(t)
+	client := &callOptionsClient{}
+	handlers := map[string]runtime.ToolHandler{}
+	var names []string
+	testdatamcp.ForwardToTestServiceClient(runtime.AddToolFunc(func(tool runtime.Tool, handler runtime.ToolHandler) {
+		handlers[tool.Name] = handler
+	}), client,
+		runtime.WithNamePrefix("eu"),
+		runtime.WithCallOptions(func(_ context.Context, toolName string) []grpc.CallOption {
+			names = append(names, toolName)
+			return []grpc.CallOption{grpc.UseCompressor("gzip")}
+		}),
+		runtime.WithCallOptions(func(context.Context, string) []grpc.CallOption {
+			return []grpc.CallOption{grpc.WaitForReady(true)}
+		}),
+	)
+
+	result, err := handlers["eu_testdata_TestService_GetItem"](context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"id": "item-1"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeFalse())
+	g.Expect(names).To(Equal([]string{"eu_testdata_TestService_GetItem"}))
+	g.Expect(client.opts).To(Equal([]grpc.CallOption{grpc.UseCompressor("gzip"), grpc.WaitForReady(true)}))
+}
+
+// connectTestServer records the GetItem requests of a Connect backend.
+type connectTestServer struct {
+	testdataconnect.UnimplementedTestServiceHandler
+	protocol    string
+	contentType string
+	auth        string
+}
+
+func (s *connectTestServer) GetItem(_ context.Context, req *connect.Request[testdata.GetItemRequest]) (*connect.Response[testdata.GetItemResponse], error) {
+	s.protocol = req.Peer().Protocol
+	s.contentType = req.Header().Get("Content-Type")
+	s.auth = req.Header().Get("Authorization")
+	return connect.NewResponse(&testdata.GetItemResponse{Item: &testdata.Item{Id: req.Msg.Id, Name: "found"}}), nil
+}
+
+// TestForwardToConnectURL checks the protocol, codec and headers options of
+// a ForwardToConnect URL forwarder against a Connect backend.
+func TestForwardToConnectURL(t *testing.T) {
+	g := NewWithT(t)
+	backend := &connectTestServer{}
+	mux := http.NewServeMux()
+	mux.Handle(testdataconnect.NewTestServiceHandler(backend))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	handlers := map[string]runtime.ToolHandler{}
+	testdatamcp.ForwardToConnectTestServiceURL(runtime.AddToolFunc(func(tool runtime.Tool, handler runtime.ToolHandler) {
+		handlers[tool.Name] = handler
+	}), srv.Client(), srv.URL+"/",
+		runtime.WithConnectProtocol(runtime.ConnectProtocolGRPCWeb),
+		runtime.WithConnectCodec(runtime.ConnectCodecJSON),
+		runtime.WithConnectHeaders(func(_ context.Context, toolName string) http.Header {
+			return http.Header{"Authorization": {"Bearer " + toolName}}
+		}),
+	)
+
+	result, err := handlers["testdata_TestService_GetItem"](context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"id": "item-1"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeFalse(), result.Text)
+	g.Expect(result.Text).To(ContainSubstring(`"name":"found"`))
+	g.Expect(backend.protocol).To(Equal(connect.ProtocolGRPCWeb))
+	g.Expect(backend.contentType).To(Equal("application/grpc-web+json"))
+	g.Expect(backend.auth).To(Equal("Bearer testdata_TestService_GetItem"))
+}
+
+// TestForwardToConnectHandler checks that a ForwardToConnect Handler
+// forwarder calls the handler implementation directly.
+func TestForwardToConnectHandler(t *testing.T) {
+	g := NewWithT(t)
+	backend := &connectTestServer{}
+	// connect-go handlers, streaming methods included, implement the
+	// generated handler interfaces.
+	var _ testdatamcp.ConnectAnnotatedServiceHandler = testdataconnect.UnimplementedAnnotatedServiceHandler{}
+	var _ testdatamcp.ConnectEdgeCaseServiceHandler = testdataconnect.UnimplementedEdgeCaseServiceHandler{}
+
+	handlers := map[string]runtime.ToolHandler{}
+	testdatamcp.ForwardToConnectTestServiceHandler(runtime.AddToolFunc(func(tool runtime.Tool, handler runtime.ToolHandler) {
+		handlers[tool.Name] = handler
+	}), backend)
+
+	result, err := handlers["testdata_TestService_GetItem"](context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"id": "item-1"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeFalse(), result.Text)
+	g.Expect(result.Text).To(ContainSubstring(`"name":"found"`))
+	g.Expect(backend.protocol).To(BeEmpty())
+}
+
+// TestForwardToConnectInProcess checks that a ForwardToConnect URL
+// forwarder reaches a handler mounted in the same process, interceptors
+// included, through runtime.InProcessHTTPClient.
+func TestForwardToConnectInProcess(t *testing.T) {
+	g := NewWithT(t)
+	backend := &connectTestServer{}
+	var intercepted string
+	interceptor := connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			intercepted = req.Spec().Procedure
+			return next(ctx, req)
+		}
+	})
+	mux := http.NewServeMux()
+	mux.Handle(testdataconnect.NewTestServiceHandler(backend, connect.WithInterceptors(interceptor)))
+
+	handlers := map[string]runtime.ToolHandler{}
+	testdatamcp.ForwardToConnectTestServiceURL(runtime.AddToolFunc(func(tool runtime.Tool, handler runtime.ToolHandler) {
+		handlers[tool.Name] = handler
+	}), runtime.InProcessHTTPClient(mux), "http://in-process",
+		runtime.WithConnectProtocol(runtime.ConnectProtocolGRPCWeb),
+	)
+
+	result, err := handlers["testdata_TestService_GetItem"](context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"id": "item-1"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeFalse(), result.Text)
+	g.Expect(result.Text).To(ContainSubstring(`"name":"found"`))
+	g.Expect(backend.protocol).To(Equal(connect.ProtocolGRPCWeb))
+	g.Expect(intercepted).To(Equal(testdataconnect.TestServiceGetItemProcedure))
+}
+
+// grpcConfigServer serves AnnotatedService configs over gRPC.
+type grpcConfigServer struct {
+	testdata.UnimplementedAnnotatedServiceServer
+}
+
+func (grpcConfigServer) GetConfig(_ context.Context, in *testdata.GetConfigRequest) (*testdata.Config, error) {
+	return &testdata.Config{Name: in.Name}, nil
+}
+
+func (grpcConfigServer) WatchConfig(in *testdata.GetConfigRequest, stream grpc.ServerStreamingServer[testdata.Config]) error {
+	return stream.Send(&testdata.Config{Name: in.Name + "-watched"})
+}
+
+// TestForwardToConn checks that a ForwardTo Conn forwarder calls unary and
+// streaming RPCs through a bare grpc.ClientConnInterface.
+func TestForwardToConn(t *testing.T) {
+	g := NewWithT(t)
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	testdata.RegisterAnnotatedServiceServer(srv, grpcConfigServer{})
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	var intercepted []string
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			intercepted = append(intercepted, method)
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}),
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer conn.Close()
+
+	raw, adapter := mark3labs.NewServer("test", "1.0")
+	testdatamcp.ForwardToAnnotatedServiceConn(adapter, conn)
+
+	ctx := context.Background()
+	result, err := json.Marshal(raw.HandleMessage(ctx, json.RawMessage(`{
+		"jsonrpc": "2.0",
+		"id": 1,
+		"method": "tools/call",
+		"params": {"name": "testdata_AnnotatedService_GetConfig", "arguments": {"name": "configs/a"}}
+	}`)))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(result)).To(ContainSubstring(`configs/a`))
+	g.Expect(string(result)).ToNot(ContainSubstring(`"isError":true`))
+	g.Expect(intercepted).To(Equal([]string{"/testdata.AnnotatedService/GetConfig"}))
+
+	result, err = json.Marshal(raw.HandleMessage(ctx, json.RawMessage(`{
+		"jsonrpc": "2.0",
+		"id": 2,
+		"method": "resources/read",
+		"params": {"uri": "configs://watch/configs/b"}
+	}`)))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(result)).To(ContainSubstring(`configs/b-watched`))
+}
+
+// TestForwardToConnDiagnostics checks the call diagnostics a ForwardTo Conn
+// forwarder attaches to results.
+func TestForwardToConnDiagnostics(t *testing.T) {
+	g := NewWithT(t)
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	testdata.RegisterAnnotatedServiceServer(srv, grpcConfigServer{})
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer conn.Close()
+
+	handlers := map[string]runtime.ToolHandler{}
+	testdatamcp.ForwardToAnnotatedServiceConn(runtime.AddToolFunc(func(tool runtime.Tool, handler runtime.ToolHandler) {
+		handlers[tool.Name] = handler
+	}), conn, runtime.WithCallDiagnostics())
+
+	result, err := handlers["testdata_AnnotatedService_GetConfig"](context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"name": "configs/a"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeFalse(), result.Text)
+	diagnostics := result.Meta[runtime.DiagnosticsMetaKey]
+	g.Expect(diagnostics).To(HaveKeyWithValue("status", "OK"))
+	g.Expect(diagnostics).To(HaveKeyWithValue("target", "bufconn"))
+	g.Expect(diagnostics).To(HaveKey("latency_ms"))
+
+	// ListConfigs is not implemented by the backend.
+	result, err = handlers["testdata_AnnotatedService_ListConfigs"](context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeTrue())
+	g.Expect(result.Meta[runtime.DiagnosticsMetaKey]).To(HaveKeyWithValue("status", "UNIMPLEMENTED"))
+}
+
+// deprecatedConfigServer marks GetConfig deprecated in its response headers.
+type deprecatedConfigServer struct {
+	grpcConfigServer
+}
+
+func (s deprecatedConfigServer) GetConfig(ctx context.Context, in *testdata.GetConfigRequest) (*testdata.Config, error) {
+	if err := grpc.SetHeader(ctx, metadata.Pairs("deprecation", "true")); err != nil {
+		return nil, err
+	}
+	return s.grpcConfigServer.GetConfig(ctx, in)
+}
+
+// logRecorder records the data of the log messages it is given.
+type logRecorder struct {
+	data []any
+}
+
+func (r *logRecorder) Log(_ context.Context, _ runtime.LogLevel, _ string, data any) error {
+	r.data = append(r.data, data)
+	return nil
+}
+
+// TestForwardToConnBackendWarnings checks that a ForwardTo Conn forwarder
+// logs the warning headers of gRPC responses.
+func TestForwardToConnBackendWarnings(t *testing.T) {
+	g := NewWithT(t)
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	testdata.RegisterAnnotatedServiceServer(srv, deprecatedConfigServer{})
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer conn.Close()
+
+	handlers := map[string]runtime.ToolHandler{}
+	testdatamcp.ForwardToAnnotatedServiceConn(runtime.AddToolFunc(func(tool runtime.Tool, handler runtime.ToolHandler) {
+		handlers[tool.Name] = handler
+	}), conn, runtime.WithBackendWarnings(runtime.BackendWarnings{}))
+
+	r := &logRecorder{}
+	result, err := handlers["testdata_AnnotatedService_GetConfig"](runtime.WithLogger(context.Background(), r), &runtime.CallToolRequest{Arguments: map[string]any{"name": "configs/a"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeFalse(), result.Text)
+	g.Expect(r.data).To(Equal([]any{map[string]any{
+		"message": "backend responded with Deprecation: true",
+		"header":  "Deprecation",
+		"value":   "true",
+	}}))
+}
@@ -23,10 +23,18 @@ package generator
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/gen"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
 	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime/gosdk"
 	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime/mark3labs"
 	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
@@ -34,8 +42,13 @@ import (
 
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // callMark3labs issues a tools/call over the JSON-RPC surface and returns the
@@ -153,6 +166,551 @@ func TestRTT_GoSDK_OneofWrapper(t *testing.T) {
 	g.Expect(res2.IsError).To(BeTrue())
 }
 
+// TestRTT_GoSDK_Elicitation verifies a generated handler registered with
+// runtime.WithElicitation asks the user for a missing required argument.
+func TestRTT_GoSDK_Elicitation(t *testing.T) {
+	g := NewWithT(t)
+	srv := &fullTestServer{}
+	rawSrv, adapter := gosdk.NewServer("t", "1")
+	testdatamcp.RegisterTestServiceHandler(adapter, srv, runtime.WithElicitation())
+
+	ctx := context.Background()
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+
+	var asked *mcp.ElicitParams
+	action := "accept"
+	client := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, &mcp.ClientOptions{
+		ElicitationHandler: func(_ context.Context, req *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+			asked = req.Params
+			return &mcp.ElicitResult{Action: action, Content: map[string]any{"name": "Elicited"}}, nil
+		},
+	})
+	session, err := client.Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer session.Close()
+
+	res, err := session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "testdata_TestService_CreateItem",
+		Arguments: map[string]any{"description": "no name"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeFalse())
+	g.Expect(asked).ToNot(BeNil())
+	g.Expect(asked.Message).To(ContainSubstring("name"))
+	g.Expect(srv.lastCreateReq.GetName()).To(Equal("Elicited"))
+
+	action = "decline"
+	res, err = session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "testdata_TestService_CreateItem",
+		Arguments: map[string]any{"description": "no name"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeTrue())
+}
+
+// samplingServer summarizes the item it gets with the client's LLM.
+type samplingServer struct {
+	fullTestServer
+}
+
+func (s *samplingServer) GetItem(ctx context.Context, in *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+	res, err := runtime.Sample(ctx, &runtime.SamplingRequest{
+		Messages:  []runtime.SamplingMessage{{Role: "user", Text: "Summarize item " + in.GetId()}},
+		MaxTokens: 50,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &testdata.GetItemResponse{Item: &testdata.Item{Id: in.GetId(), Name: res.Text}}, nil
+}
+
+// TestRTT_GoSDK_Sampling verifies a server implementation can sample from
+// the client through the handler context.
+func TestRTT_GoSDK_Sampling(t *testing.T) {
+	g := NewWithT(t)
+	rawSrv, adapter := gosdk.NewServer("t", "1")
+	testdatamcp.RegisterTestServiceHandler(adapter, &samplingServer{})
+
+	ctx := context.Background()
+	connect := func(opts *mcp.ClientOptions) *mcp.ClientSession {
+		clientT, serverT := mcp.NewInMemoryTransports()
+		go func() { _ = rawSrv.Run(ctx, serverT) }()
+		session, err := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, opts).Connect(ctx, clientT, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		return session
+	}
+
+	var asked *mcp.CreateMessageParams
+	session := connect(&mcp.ClientOptions{
+		CreateMessageHandler: func(_ context.Context, req *mcp.CreateMessageRequest) (*mcp.CreateMessageResult, error) {
+			asked = req.Params
+			return &mcp.CreateMessageResult{Role: "assistant", Model: "m", Content: &mcp.TextContent{Text: "a summary"}}, nil
+		},
+	})
+	defer session.Close()
+
+	res, err := session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "testdata_TestService_GetItem",
+		Arguments: map[string]any{"id": "item-1"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeFalse())
+	g.Expect(asked).ToNot(BeNil())
+	g.Expect(asked.MaxTokens).To(Equal(int64(50)))
+	g.Expect(asked.Messages[0].Content.(*mcp.TextContent).Text).To(Equal("Summarize item item-1"))
+	g.Expect(res.Content[0].(*mcp.TextContent).Text).To(ContainSubstring("a summary"))
+
+	// Without the sampling capability the server method gets an error.
+	noSampling := connect(nil)
+	defer noSampling.Close()
+	res, err = noSampling.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "testdata_TestService_GetItem",
+		Arguments: map[string]any{"id": "item-1"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeTrue())
+}
+
+// progressServer reports progress while it fetches an item.
+type progressServer struct {
+	fullTestServer
+}
+
+func (s *progressServer) GetItem(ctx context.Context, in *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+	for i := 1; i <= 2; i++ {
+		if err := runtime.ReportProgress(ctx, float64(i), 2, "fetching"); err != nil {
+			return nil, err
+		}
+	}
+	return &testdata.GetItemResponse{Item: &testdata.Item{Id: in.GetId()}}, nil
+}
+
+// TestRTT_GoSDK_Progress verifies progress reported by a server
+// implementation reaches a client that sent a progress token.
+func TestRTT_GoSDK_Progress(t *testing.T) {
+	g := NewWithT(t)
+	rawSrv, adapter := gosdk.NewServer("t", "1")
+	testdatamcp.RegisterTestServiceHandler(adapter, &progressServer{})
+
+	ctx := context.Background()
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+
+	var mu sync.Mutex
+	var got []*mcp.ProgressNotificationParams
+	client := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, &mcp.ClientOptions{
+		ProgressNotificationHandler: func(_ context.Context, req *mcp.ProgressNotificationClientRequest) {
+			mu.Lock()
+			defer mu.Unlock()
+			got = append(got, req.Params)
+		},
+	})
+	session, err := client.Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer session.Close()
+
+	res, err := session.CallTool(ctx, &mcp.CallToolParams{
+		Meta:      mcp.Meta{"progressToken": "tok"},
+		Name:      "testdata_TestService_GetItem",
+		Arguments: map[string]any{"id": "item-1"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeFalse())
+
+	progress := func() []float64 {
+		mu.Lock()
+		defer mu.Unlock()
+		var p []float64
+		for _, n := range got {
+			g.Expect(n.ProgressToken).To(Equal("tok"))
+			g.Expect(n.Total).To(Equal(2.0))
+			p = append(p, n.Progress)
+		}
+		return p
+	}
+	g.Eventually(progress).Should(Equal([]float64{1, 2}))
+
+	// Without a token nothing is reported.
+	res, err = session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "testdata_TestService_GetItem",
+		Arguments: map[string]any{"id": "item-2"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeFalse())
+	g.Consistently(progress, "50ms").Should(HaveLen(2))
+}
+
+// blockingServer blocks in GetItem until its context is done.
+type blockingServer struct {
+	fullTestServer
+	started chan struct{}
+	done    chan error
+}
+
+func (s *blockingServer) GetItem(ctx context.Context, _ *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+	s.started <- struct{}{}
+	<-ctx.Done()
+	s.done <- ctx.Err()
+	return nil, ctx.Err()
+}
+
+// TestRTT_GoSDK_Cancellation verifies the context a server implementation
+// gets ends with the client's _meta timeout or when the client cancels.
+func TestRTT_GoSDK_Cancellation(t *testing.T) {
+	g := NewWithT(t)
+	srv := &blockingServer{started: make(chan struct{}, 1), done: make(chan error, 1)}
+	rawSrv, adapter := gosdk.NewServer("t", "1")
+	testdatamcp.RegisterTestServiceHandler(adapter, srv)
+
+	ctx := context.Background()
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, nil).Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer session.Close()
+
+	t.Run("meta timeout", func(t *testing.T) {
+		g := NewWithT(t)
+		res, err := session.CallTool(ctx, &mcp.CallToolParams{
+			Meta:      mcp.Meta{"timeout": "50ms"},
+			Name:      "testdata_TestService_GetItem",
+			Arguments: map[string]any{"id": "item-1"},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(res.IsError).To(BeTrue())
+		<-srv.started
+		g.Expect(<-srv.done).To(MatchError(context.DeadlineExceeded))
+	})
+
+	t.Run("client cancels", func(t *testing.T) {
+		g := NewWithT(t)
+		callCtx, cancel := context.WithCancel(ctx)
+		go func() {
+			<-srv.started
+			cancel()
+		}()
+		_, err := session.CallTool(callCtx, &mcp.CallToolParams{
+			Name:      "testdata_TestService_GetItem",
+			Arguments: map[string]any{"id": "item-1"},
+		})
+		g.Expect(err).To(HaveOccurred())
+		g.Eventually(srv.done).Should(Receive(MatchError(context.Canceled)))
+	})
+}
+
+// sessionServer counts the GetItem calls of each session.
+type sessionServer struct {
+	fullTestServer
+	sessions *runtime.SessionStore
+}
+
+func (s *sessionServer) GetItem(ctx context.Context, in *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+	calls, _ := s.sessions.Get(ctx, "calls")
+	n, _ := calls.(int)
+	s.sessions.Set(ctx, "calls", n+1)
+	return &testdata.GetItemResponse{Item: &testdata.Item{Id: in.GetId(), Name: fmt.Sprint(n + 1)}}, nil
+}
+
+// TestRTT_GoSDK_SessionState verifies a server implementation keeps state
+// across the tool calls of a session.
+func TestRTT_GoSDK_SessionState(t *testing.T) {
+	g := NewWithT(t)
+	rawSrv, adapter := gosdk.NewServer("t", "1")
+	testdatamcp.RegisterTestServiceHandler(adapter, &sessionServer{sessions: runtime.NewSessionStore(time.Hour)})
+
+	ctx := context.Background()
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, nil).Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer session.Close()
+
+	for _, want := range []string{`"name":"1"`, `"name":"2"`} {
+		res, err := session.CallTool(ctx, &mcp.CallToolParams{
+			Name:      "testdata_TestService_GetItem",
+			Arguments: map[string]any{"id": "item-1"},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(res.Content[0].(*mcp.TextContent).Text).To(ContainSubstring(want))
+	}
+}
+
+// clientInfoServer records the client info of its last call.
+type clientInfoServer struct {
+	fullTestServer
+	info *runtime.ClientInfo
+}
+
+func (s *clientInfoServer) GetItem(ctx context.Context, in *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+	s.info = runtime.ClientInfoFromContext(ctx)
+	return &testdata.GetItemResponse{Item: &testdata.Item{Id: in.GetId()}}, nil
+}
+
+// TestRTT_GoSDK_ClientInfo verifies a server implementation sees what the
+// client declared when it initialized.
+func TestRTT_GoSDK_ClientInfo(t *testing.T) {
+	g := NewWithT(t)
+	srv := &clientInfoServer{}
+	rawSrv, adapter := gosdk.NewServer("t", "1")
+	testdatamcp.RegisterTestServiceHandler(adapter, srv)
+
+	ctx := context.Background()
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+	client := mcp.NewClient(&mcp.Implementation{Name: "claude-desktop", Version: "1.2.3"}, &mcp.ClientOptions{
+		CreateMessageHandler: func(context.Context, *mcp.CreateMessageRequest) (*mcp.CreateMessageResult, error) {
+			return nil, nil
+		},
+	})
+	session, err := client.Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer session.Close()
+
+	_, err = session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "testdata_TestService_GetItem",
+		Arguments: map[string]any{"id": "item-1"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(srv.info).ToNot(BeNil())
+	g.Expect(srv.info.Name).To(Equal("claude-desktop"))
+	g.Expect(srv.info.Version).To(Equal("1.2.3"))
+	g.Expect(srv.info.ProtocolVersion).ToNot(BeEmpty())
+	g.Expect(srv.info.Capabilities.Roots).To(BeTrue())
+	g.Expect(srv.info.Capabilities.Sampling).To(BeTrue())
+	g.Expect(srv.info.Capabilities.Elicitation).To(BeFalse())
+}
+
+// TestRTT_GoSDK_Mock verifies a generated server mock answers tool calls with
+// its canned responses and records the requests.
+func TestRTT_GoSDK_Mock(t *testing.T) {
+	g := NewWithT(t)
+	mock := &testdatamcp.TestServiceServerMock{
+		GetItemResponse: &testdata.GetItemResponse{Item: &testdata.Item{Id: "item-1", Name: "canned"}},
+		CreateItemErr:   errors.New("backend down"),
+	}
+	rawSrv, adapter := gosdk.NewServer("t", "1")
+	testdatamcp.RegisterTestServiceHandler(adapter, mock)
+
+	ctx := context.Background()
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, nil).Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer session.Close()
+
+	res, err := session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "testdata_TestService_GetItem",
+		Arguments: map[string]any{"id": "item-1"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeFalse())
+	g.Expect(res.Content[0].(*mcp.TextContent).Text).To(ContainSubstring("canned"))
+	g.Expect(mock.GetItemCalls()).To(HaveLen(1))
+	g.Expect(mock.GetItemCalls()[0].GetId()).To(Equal("item-1"))
+
+	res, err = session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "testdata_TestService_CreateItem",
+		Arguments: map[string]any{"name": "Widget"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeTrue())
+	g.Expect(res.Content[0].(*mcp.TextContent).Text).To(ContainSubstring("backend down"))
+	g.Expect(mock.CreateItemCalls()[0].GetName()).To(Equal("Widget"))
+	g.Expect(mock.ProcessWellKnownTypesCalls()).To(BeEmpty())
+}
+
+func TestRTT_GoSDK_Completion(t *testing.T) {
+	g := NewWithT(t)
+	completions := runtime.NewCompletionRegistry()
+	rawSrv := mcp.NewServer(&mcp.Implementation{Name: "t", Version: "1"}, &mcp.ServerOptions{
+		CompletionHandler: gosdk.CompletionHandler(completions),
+	})
+	testdatamcp.RegisterEdgeCaseServiceHandler(gosdk.Wrap(rawSrv), &noArgumentsServer{}, runtime.WithCompletions(completions))
+
+	ctx := context.Background()
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, nil).Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer session.Close()
+
+	res, err := session.Complete(ctx, &mcp.CompleteParams{
+		Ref:      &mcp.CompleteReference{Type: "ref/prompt", Name: "testdata_EdgeCaseService_EnumFields"},
+		Argument: mcp.CompleteParamsArgument{Name: "priority", Value: "PRIORITY_H"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.Completion.Values).To(Equal([]string{"PRIORITY_HIGH"}))
+}
+
+func TestRTT_GoSDK_Prompts(t *testing.T) {
+	g := NewWithT(t)
+	rawSrv, adapter := gosdk.NewServer("t", "1")
+	testdatamcp.RegisterAnnotatedServiceHandler(adapter, annotatedServer{}, runtime.WithNamePrefix("ops"))
+
+	ctx := context.Background()
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, nil).Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer session.Close()
+
+	list, err := session.ListPrompts(ctx, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(list.Prompts).To(HaveLen(2))
+
+	res, err := session.GetPrompt(ctx, &mcp.GetPromptParams{
+		Name:      "ops_apply_from",
+		Arguments: map[string]string{"base_config": "configs/prod"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.Messages).To(HaveLen(1))
+	g.Expect(res.Messages[0].Content).To(Equal(&mcp.TextContent{
+		Text: "Use ops_testdata_AnnotatedService_ApplyConfig to apply a config based on configs/prod. ",
+	}))
+
+	// Required arguments are enforced.
+	_, err = session.GetPrompt(ctx, &mcp.GetPromptParams{Name: "ops_rollout"})
+	g.Expect(err).To(HaveOccurred())
+}
+
+// annotatedServer implements the AnnotatedService RPCs the tests call.
+type annotatedServer struct {
+	testdatamcp.AnnotatedServiceServer
+}
+
+func (annotatedServer) ListConfigs(context.Context, *testdata.ListConfigsRequest) (*testdata.ListConfigsResponse, error) {
+	return &testdata.ListConfigsResponse{Configs: []*testdata.Config{{Name: "configs/prod"}}}, nil
+}
+
+func (annotatedServer) WatchConfig(req *testdata.GetConfigRequest, stream grpc.ServerStreamingServer[testdata.Config]) error {
+	for _, suffix := range []string{"@1", "@2"} {
+		if err := stream.Send(&testdata.Config{Name: req.GetName() + suffix}); err != nil {
+			return err
+		}
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
+func TestRTT_GoSDK_Subscriptions(t *testing.T) {
+	g := NewWithT(t)
+	subscriptions := runtime.NewSubscriptionRegistry()
+	defer subscriptions.Close()
+	rawSrv := mcp.NewServer(&mcp.Implementation{Name: "t", Version: "1"}, &mcp.ServerOptions{
+		SubscribeHandler:   gosdk.SubscribeHandler(subscriptions),
+		UnsubscribeHandler: gosdk.UnsubscribeHandler(subscriptions),
+	})
+	gosdk.NotifyResourceUpdated(rawSrv, subscriptions)
+	testdatamcp.RegisterAnnotatedServiceHandler(gosdk.Wrap(rawSrv), annotatedServer{}, runtime.WithSubscriptions(subscriptions))
+
+	ctx := context.Background()
+	updated := make(chan string, 4)
+	client := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, &mcp.ClientOptions{
+		ResourceUpdatedHandler: func(_ context.Context, req *mcp.ResourceUpdatedNotificationRequest) {
+			updated <- req.Params.URI
+		},
+	})
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+	session, err := client.Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer session.Close()
+
+	templates, err := session.ListResourceTemplates(ctx, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(templates.ResourceTemplates).To(HaveLen(1))
+	g.Expect(templates.ResourceTemplates[0].URITemplate).To(Equal("configs://watch/{+name}"))
+
+	// Without a subscription, reading returns the first message of a new stream.
+	uri := "configs://watch/configs/prod"
+	res, err := session.ReadResource(ctx, &mcp.ReadResourceParams{URI: uri})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.Contents[0].Text).To(MatchJSON(`{"name":"configs/prod@1"}`))
+
+	// Every message of the stream is an update; reads return the latest.
+	g.Expect(session.Subscribe(ctx, &mcp.SubscribeParams{URI: uri})).To(Succeed())
+	g.Eventually(updated).Should(Receive(Equal(uri)))
+	g.Eventually(updated).Should(Receive(Equal(uri)))
+	res, err = session.ReadResource(ctx, &mcp.ReadResourceParams{URI: uri})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.Contents[0].Text).To(MatchJSON(`{"name":"configs/prod@2"}`))
+	g.Expect(session.Unsubscribe(ctx, &mcp.UnsubscribeParams{URI: uri})).To(Succeed())
+}
+
+func TestRTT_GoSDK_Resources(t *testing.T) {
+	g := NewWithT(t)
+	rawSrv, adapter := gosdk.NewServer("t", "1")
+	testdatamcp.RegisterAnnotatedServiceHandler(adapter, annotatedServer{})
+
+	ctx := context.Background()
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, nil).Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer session.Close()
+
+	list, err := session.ListResources(ctx, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(list.Resources).To(HaveLen(1))
+	g.Expect(list.Resources[0].URI).To(Equal("configs://list"))
+	g.Expect(list.Resources[0].Name).To(Equal("testdata_AnnotatedService_ListConfigs"))
+
+	res, err := session.ReadResource(ctx, &mcp.ReadResourceParams{URI: "configs://list"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.Contents).To(HaveLen(1))
+	g.Expect(res.Contents[0].MIMEType).To(Equal("application/json"))
+	g.Expect(res.Contents[0].Text).To(MatchJSON(`{"configs":[{"name":"configs/prod"}],"next_page_token":""}`))
+}
+
+// tenantConfigsServer records the tenant its ListConfigs calls run under.
+type tenantConfigsServer struct {
+	annotatedServer
+	tenants  []string
+	metadata []string
+}
+
+func (s *tenantConfigsServer) ListConfigs(ctx context.Context, in *testdata.ListConfigsRequest) (*testdata.ListConfigsResponse, error) {
+	tenant, _ := runtime.TenantFromContext(ctx)
+	s.tenants = append(s.tenants, tenant)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	s.metadata = append(s.metadata, md.Get("x-tenant-id")...)
+	return s.annotatedServer.ListConfigs(ctx, in)
+}
+
+func TestRTT_GoSDK_ResourcesUnderTenant(t *testing.T) {
+	g := NewWithT(t)
+	srv := &tenantConfigsServer{}
+	tenant := "org-1"
+	rawSrv, adapter := gosdk.NewServer("t", "1")
+	testdatamcp.RegisterAnnotatedServiceHandler(adapter, srv, runtime.WithTenant(runtime.Tenant{
+		Resolve: func(context.Context, *runtime.CallToolRequest) (string, error) {
+			return tenant, nil
+		},
+		Metadata: "x-tenant-id",
+	}))
+
+	ctx := context.Background()
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, nil).Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer session.Close()
+
+	// Reads resolve the tenant and send it to the backend like tool calls.
+	res, err := session.ReadResource(ctx, &mcp.ReadResourceParams{URI: "configs://list"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.Contents[0].Text).To(MatchJSON(`{"configs":[{"name":"configs/prod"}],"next_page_token":""}`))
+	g.Expect(srv.tenants).To(Equal([]string{"org-1"}))
+	g.Expect(srv.metadata).To(Equal([]string{"org-1"}))
+
+	// Reads whose tenant does not resolve never reach the backend.
+	tenant = ""
+	_, err = session.ReadResource(ctx, &mcp.ReadResourceParams{URI: "configs://list"})
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(srv.tenants).To(HaveLen(1))
+}
+
 // TestRTT_DynamicPath drives gen.RegisterService (dynamicpb) end to end: an input
 // oneof wrapper decodes onto a dynamic message, and a response whose oneof is a
 // false bool is re-wrapped (which first) in the structured result.
@@ -210,3 +768,502 @@ func TestRTT_DynamicPath(t *testing.T) {
 		g.Expect(result["isError"]).To(Equal(true))
 	})
 }
+
+// noArgumentsServer implements only NoArguments; the embedded nil interface
+// panics if any other method is reached.
+type noArgumentsServer struct {
+	testdatamcp.EdgeCaseServiceServer
+	calls int
+}
+
+func (s *noArgumentsServer) NoArguments(context.Context, *emptypb.Empty) (*testdata.NoArgumentsResponse, error) {
+	s.calls++
+	return &testdata.NoArgumentsResponse{Status: "ok"}, nil
+}
+
+// TestRTT_Mark3labs_NoArguments verifies the generated handler for a method
+// taking google.protobuf.Empty ignores missing and invented arguments.
+func TestRTT_Mark3labs_NoArguments(t *testing.T) {
+	g := NewWithT(t)
+	srv := &noArgumentsServer{}
+	raw, adapter := mark3labs.NewServer("t", "1")
+	testdatamcp.RegisterEdgeCaseServiceHandler(adapter, srv)
+
+	for _, args := range []map[string]any{nil, {}, {"verbose": true, "filter": map[string]any{"x": 1}}} {
+		result := callMark3labs(t, raw, "testdata_EdgeCaseService_NoArguments", args)
+		g.Expect(result["isError"]).To(BeNil(), "%v", args)
+		g.Expect(firstTextContent(t, result)).To(ContainSubstring(`"status":"ok"`))
+	}
+	g.Expect(srv.calls).To(Equal(3))
+}
+
+// splitConfigsMock lists two configs and a next page token.
+func splitConfigsMock() *testdatamcp.AnnotatedServiceServerMock {
+	return &testdatamcp.AnnotatedServiceServerMock{
+		ListConfigsResponse: &testdata.ListConfigsResponse{
+			Configs:       []*testdata.Config{{Name: "configs/a"}, {Name: "configs/b"}},
+			NextPageToken: "next",
+		},
+	}
+}
+
+func TestRTT_Mark3labs_SplitResults(t *testing.T) {
+	g := NewWithT(t)
+	raw, adapter := mark3labs.NewServer("t", "1")
+	testdatamcp.RegisterAnnotatedServiceHandler(adapter, splitConfigsMock(), runtime.WithSplitResults())
+
+	result := callMark3labs(t, raw, "testdata_AnnotatedService_ListConfigs", map[string]any{})
+	g.Expect(result["isError"]).To(BeNil())
+	content, _ := json.Marshal(result["content"])
+	g.Expect(content).To(MatchJSON(`[
+		{"type": "text", "text": "{\"next_page_token\":\"next\"}"},
+		{"type": "text", "text": "configs[0] (1 of 2):\n{\"name\":\"configs/a\"}"},
+		{"type": "text", "text": "configs[1] (2 of 2):\n{\"name\":\"configs/b\"}"}
+	]`))
+}
+
+func TestRTT_GoSDK_SplitResults(t *testing.T) {
+	g := NewWithT(t)
+	rawSrv, adapter := gosdk.NewServer("t", "1")
+	testdatamcp.RegisterAnnotatedServiceHandler(adapter, splitConfigsMock(), runtime.WithSplitResults("configs"))
+
+	ctx := context.Background()
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, nil).Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer session.Close()
+
+	res, err := session.CallTool(ctx, &mcp.CallToolParams{Name: "testdata_AnnotatedService_ListConfigs", Arguments: map[string]any{}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeFalse())
+	g.Expect(res.Content).To(HaveLen(3))
+	g.Expect(res.Content[2].(*mcp.TextContent).Text).To(Equal("configs[1] (2 of 2):\n{\"name\":\"configs/b\"}"))
+}
+
+func TestRTT_GoSDK_ResultChunking(t *testing.T) {
+	g := NewWithT(t)
+	rawSrv, adapter := gosdk.NewServer("t", "1")
+	testdatamcp.RegisterAnnotatedServiceHandler(adapter, splitConfigsMock(), runtime.WithResultChunking(32))
+
+	ctx := context.Background()
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, nil).Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer session.Close()
+
+	res, err := session.CallTool(ctx, &mcp.CallToolParams{Name: "testdata_AnnotatedService_ListConfigs", Arguments: map[string]any{}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeFalse())
+	g.Expect(len(res.Content)).To(BeNumerically(">", 1))
+	g.Expect(res.Content[0].(*mcp.TextContent).Text).To(HaveSuffix("\n[continues in part 2 of 3]"))
+	g.Expect(res.Meta).To(HaveKeyWithValue(runtime.ChunkedMetaKey, map[string]any{"size": float64(80), "items": float64(3)}))
+}
+
+func TestRTT_Mark3labs_ResultChunking(t *testing.T) {
+	g := NewWithT(t)
+	raw, adapter := mark3labs.NewServer("t", "1")
+	testdatamcp.RegisterAnnotatedServiceHandler(adapter, splitConfigsMock(), runtime.WithResultChunking(32))
+
+	result := callMark3labs(t, raw, "testdata_AnnotatedService_ListConfigs", map[string]any{})
+	g.Expect(result["content"]).To(HaveLen(3))
+	g.Expect(result["_meta"]).To(HaveKeyWithValue(runtime.ChunkedMetaKey, map[string]any{"size": float64(80), "items": float64(3)}))
+}
+
+// TestRTT_GoSDK_ResourceInputs verifies that fields named in the "resources"
+// argument are read from files within the client's roots.
+func TestRTT_GoSDK_ResourceInputs(t *testing.T) {
+	g := NewWithT(t)
+	root := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(root, "description.txt"), []byte("from a file"), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(root, "thumbnail.png"), []byte{0x89, 'P', 'N', 'G'}, 0o600)).To(Succeed())
+	outside := filepath.Join(t.TempDir(), "secret.txt")
+	g.Expect(os.WriteFile(outside, []byte("secret"), 0o600)).To(Succeed())
+	fileURI := func(path string) string { return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String() }
+
+	srv := &fullTestServer{}
+	rawSrv, adapter := gosdk.NewServer("t", "1")
+	testdatamcp.RegisterTestServiceHandler(adapter, srv, runtime.WithResourceInputs(nil))
+
+	ctx := context.Background()
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+	client := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, nil)
+	client.AddRoots(&mcp.Root{URI: fileURI(root)})
+	session, err := client.Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer session.Close()
+
+	tools, err := session.ListTools(ctx, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	for _, tool := range tools.Tools {
+		if tool.Name == "testdata_TestService_CreateItem" {
+			schema, _ := json.Marshal(tool.InputSchema)
+			g.Expect(string(schema)).To(ContainSubstring(`"resources":{`))
+		}
+	}
+
+	res, err := session.CallTool(ctx, &mcp.CallToolParams{
+		Name: "testdata_TestService_CreateItem",
+		Arguments: map[string]any{
+			"name": "Widget",
+			"resources": map[string]any{
+				"description": fileURI(filepath.Join(root, "description.txt")),
+				"thumbnail":   fileURI(filepath.Join(root, "thumbnail.png")),
+			},
+		},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeFalse())
+	g.Expect(srv.lastCreateReq.GetDescription()).To(Equal("from a file"))
+	g.Expect(srv.lastCreateReq.GetThumbnail()).To(Equal([]byte{0x89, 'P', 'N', 'G'}))
+
+	res, err = session.CallTool(ctx, &mcp.CallToolParams{
+		Name: "testdata_TestService_CreateItem",
+		Arguments: map[string]any{
+			"name":      "Widget",
+			"resources": map[string]any{"description": fileURI(outside)},
+		},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeTrue())
+	g.Expect(res.Content[0].(*mcp.TextContent).Text).To(ContainSubstring("outside the roots"))
+}
+
+func exportConfigMock() *testdatamcp.AnnotatedServiceServerMock {
+	return &testdatamcp.AnnotatedServiceServerMock{
+		ExportConfigResponse: &testdata.ExportConfigResponse{
+			Document:    []byte("name: a\n"),
+			ContentType: "application/yaml",
+			Filename:    "a.yaml",
+		},
+	}
+}
+
+func TestRTT_Mark3labs_FileResults(t *testing.T) {
+	g := NewWithT(t)
+	raw, adapter := mark3labs.NewServer("t", "1")
+	testdatamcp.RegisterAnnotatedServiceHandler(adapter, exportConfigMock())
+
+	result := callMark3labs(t, raw, "testdata_AnnotatedService_ExportConfig", map[string]any{"name": "configs/a"})
+	g.Expect(result["isError"]).To(BeNil())
+	content, _ := json.Marshal(result["content"])
+	g.Expect(content).To(MatchJSON(`[
+		{"type": "text", "text": "{\"content_type\":\"application/yaml\",\"document\":\"\",\"filename\":\"a.yaml\"}"},
+		{"type": "resource", "resource": {
+			"uri": "tool://testdata_AnnotatedService_ExportConfig/document/a.yaml",
+			"mimeType": "application/yaml",
+			"blob": "bmFtZTogYQo="
+		}}
+	]`))
+}
+
+func TestRTT_GoSDK_FileResults(t *testing.T) {
+	g := NewWithT(t)
+	rawSrv, adapter := gosdk.NewServer("t", "1")
+	testdatamcp.RegisterAnnotatedServiceHandler(adapter, exportConfigMock())
+
+	ctx := context.Background()
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, nil).Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer session.Close()
+
+	res, err := session.CallTool(ctx, &mcp.CallToolParams{Name: "testdata_AnnotatedService_ExportConfig", Arguments: map[string]any{"name": "configs/a"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeFalse())
+	g.Expect(res.Content).To(HaveLen(2))
+	g.Expect(res.Content[1].(*mcp.EmbeddedResource).Resource).To(Equal(&mcp.ResourceContents{
+		URI:      "tool://testdata_AnnotatedService_ExportConfig/document/a.yaml",
+		MIMEType: "application/yaml",
+		Blob:     []byte("name: a\n"),
+	}))
+	g.Expect(res.StructuredContent).To(HaveKeyWithValue("document", ""))
+}
+
+func TestRTT_Mark3labs_CallDiagnostics(t *testing.T) {
+	g := NewWithT(t)
+	raw, adapter := mark3labs.NewServer("t", "1")
+	mock := &testdatamcp.AnnotatedServiceServerMock{GetConfigErr: status.Error(codes.NotFound, "no such config")}
+	testdatamcp.RegisterAnnotatedServiceHandler(adapter, mock, runtime.WithCallDiagnostics())
+
+	// Error results carry the diagnostics too.
+	result := callMark3labs(t, raw, "testdata_AnnotatedService_GetConfig", map[string]any{"name": "configs/a"})
+	g.Expect(result["isError"]).To(BeTrue())
+	g.Expect(result["_meta"]).To(HaveKeyWithValue(runtime.DiagnosticsMetaKey, And(
+		HaveKeyWithValue("status", "NOT_FOUND"),
+		HaveKeyWithValue("retries", BeNumerically("==", 0)),
+	)))
+}
+
+func TestRTT_GoSDK_BackendWarnings(t *testing.T) {
+	g := NewWithT(t)
+	rawSrv, adapter := gosdk.NewServer("t", "1")
+	mock := &testdatamcp.AnnotatedServiceServerMock{
+		GetConfigFunc: func(ctx context.Context, req *testdata.GetConfigRequest) (*testdata.Config, error) {
+			time.Sleep(5 * time.Millisecond)
+			return &testdata.Config{Name: req.Name}, nil
+		},
+	}
+	testdatamcp.RegisterAnnotatedServiceHandler(adapter, mock, runtime.WithBackendWarnings(runtime.BackendWarnings{SlowCall: time.Millisecond}))
+
+	ctx := context.Background()
+	logged := make(chan *mcp.LoggingMessageParams, 1)
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, &mcp.ClientOptions{
+		LoggingMessageHandler: func(_ context.Context, req *mcp.LoggingMessageRequest) { logged <- req.Params },
+	}).Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer session.Close()
+	g.Expect(session.SetLoggingLevel(ctx, &mcp.SetLoggingLevelParams{Level: "warning"})).To(Succeed())
+
+	res, err := session.CallTool(ctx, &mcp.CallToolParams{Name: "testdata_AnnotatedService_GetConfig", Arguments: map[string]any{"name": "configs/a"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeFalse())
+
+	var params *mcp.LoggingMessageParams
+	g.Eventually(logged).Should(Receive(&params))
+	g.Expect(params.Level).To(Equal(mcp.LoggingLevel("warning")))
+	g.Expect(params.Logger).To(Equal("testdata_AnnotatedService_GetConfig"))
+	g.Expect(params.Data).To(HaveKeyWithValue("message", HavePrefix("backend call took ")))
+	g.Expect(params.Data).To(HaveKeyWithValue("threshold_ms", BeNumerically("==", 1)))
+}
+
+// TestRTT_GoSDK_ToolTags verifies that tags declared in proto reach the
+// _meta of the tools, and that WithTags registers only the selected ones.
+func TestRTT_GoSDK_ToolTags(t *testing.T) {
+	g := NewWithT(t)
+	rawSrv, adapter := gosdk.NewServer("t", "1")
+	testdatamcp.RegisterAnnotatedServiceHandler(adapter, annotatedServer{}, runtime.WithTags("deploy"))
+
+	ctx := context.Background()
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+	client := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, nil)
+	session, err := client.Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer session.Close()
+
+	tools, err := session.ListTools(ctx, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(tools.Tools).To(HaveLen(1))
+	g.Expect(tools.Tools[0].Name).To(Equal("testdata_AnnotatedService_ApplyConfig"))
+	g.Expect(tools.Tools[0].Meta).To(HaveKeyWithValue(runtime.TagsMetaKey, []any{"configs", "deploy"}))
+
+	// The resources of the methods filtered out are not registered either.
+	resources, err := session.ListResources(ctx, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(resources.Resources).To(BeEmpty())
+}
+
+// idServer records the id of its last GetItem call.
+type idServer struct {
+	fullTestServer
+	id string
+}
+
+func (s *idServer) GetItem(ctx context.Context, in *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+	s.id = in.GetId()
+	return &testdata.GetItemResponse{Item: &testdata.Item{Id: in.GetId()}}, nil
+}
+
+// TestRTT_GoSDK_StringSanitizers verifies the generated handlers run string
+// arguments through the registered sanitizers before calling the backend.
+func TestRTT_GoSDK_StringSanitizers(t *testing.T) {
+	g := NewWithT(t)
+	srv := &idServer{}
+	rawSrv, adapter := gosdk.NewServer("t", "1")
+	testdatamcp.RegisterTestServiceHandler(adapter, srv, runtime.WithStringSanitizers(runtime.StripControlCharacters))
+
+	ctx := context.Background()
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, nil).Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer session.Close()
+
+	res, err := session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "testdata_TestService_GetItem",
+		Arguments: map[string]any{"id": "item\x00-1\x1b"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeFalse())
+	g.Expect(srv.id).To(Equal("item-1"))
+}
+
+// hedgedClient is an AnnotatedServiceClient whose first call of each method
+// takes 50ms.
+type hedgedClient struct {
+	testdata.AnnotatedServiceClient
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (c *hedgedClient) call(ctx context.Context, method string) error {
+	c.mu.Lock()
+	c.calls[method]++
+	first := c.calls[method] == 1
+	c.mu.Unlock()
+	if !first {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	case <-time.After(50 * time.Millisecond):
+		return nil
+	}
+}
+
+func (c *hedgedClient) GetConfig(ctx context.Context, req *testdata.GetConfigRequest, _ ...grpc.CallOption) (*testdata.Config, error) {
+	if err := c.call(ctx, "GetConfig"); err != nil {
+		return nil, err
+	}
+	return &testdata.Config{Name: req.GetName()}, nil
+}
+
+func (c *hedgedClient) ApplyConfig(ctx context.Context, req *testdata.ApplyConfigRequest, _ ...grpc.CallOption) (*testdata.ApplyConfigResponse, error) {
+	if err := c.call(ctx, "ApplyConfig"); err != nil {
+		return nil, err
+	}
+	return &testdata.ApplyConfigResponse{}, nil
+}
+
+type hedgedTenantKey struct{}
+
+// TestRTT_Mark3labs_Hedging verifies that the forwarders hedge the backend
+// calls of read-only tools, and only theirs.
+func TestRTT_Mark3labs_Hedging(t *testing.T) {
+	g := NewWithT(t)
+	client := &hedgedClient{calls: map[string]int{}}
+	raw, adapter := mark3labs.NewServer("t", "1")
+	testdatamcp.ForwardToAnnotatedServiceClient(adapter, client,
+		runtime.WithHedging(runtime.Hedging{Delay: 5 * time.Millisecond}),
+		runtime.WithContextFields(runtime.ContextField{Name: "tenant", ContextKey: hedgedTenantKey{}}),
+	)
+
+	result := callMark3labs(t, raw, "testdata_AnnotatedService_GetConfig", map[string]any{"name": "configs/a"})
+	g.Expect(result).ToNot(HaveKeyWithValue("isError", true))
+	g.Expect(firstTextContent(t, result)).To(ContainSubstring(`"configs/a"`))
+	g.Expect(client.calls["GetConfig"]).To(Equal(2))
+
+	// A mutation is sent once, however long it takes.
+	req, _ := json.Marshal(map[string]any{
+		"jsonrpc": "2.0", "id": 2, "method": "tools/call",
+		"params": map[string]any{"name": "testdata_AnnotatedService_ApplyConfig", "arguments": map[string]any{"name": "a", "pipeline_yaml": "x"}},
+	})
+	raw.HandleMessage(context.WithValue(context.Background(), hedgedTenantKey{}, "acme"), req)
+	g.Expect(client.calls["ApplyConfig"]).To(Equal(1))
+}
+
+// TestRTT_LazySchemas registers a tool with compressed schemas on both
+// adapters and checks its schemas are only loaded by the first tools/list.
+func TestRTT_LazySchemas(t *testing.T) {
+	config := runtime.NewConfig()
+	runtime.WithExtraProperties(runtime.ExtraProperty{Name: "region"})(config)
+	want := runtime.ApplyConfig(testdatamcp.TestService_CreateItemTool, config)
+	data, err := runtime.CompressSchemas([]runtime.Tool{testdatamcp.TestService_CreateItemTool})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lazyTool := func(loads *int) runtime.Tool {
+		tool := runtime.NewCompressedSchemas(data).Tool(runtime.Tool{
+			Name:        testdatamcp.TestService_CreateItemTool.Name,
+			Description: testdatamcp.TestService_CreateItemTool.Description,
+		})
+		load := tool.LoadSchemas
+		tool.LoadSchemas = func() (json.RawMessage, json.RawMessage) {
+			*loads++
+			return load()
+		}
+		return runtime.ApplyConfig(tool, config)
+	}
+	handler := func(context.Context, *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		return runtime.NewToolResultText("ok"), nil
+	}
+
+	t.Run("mark3labs", func(t *testing.T) {
+		g := NewWithT(t)
+		loads := 0
+		raw, adapter := mark3labs.NewServer("t", "1")
+		adapter.AddTool(lazyTool(&loads), handler)
+		g.Expect(loads).To(BeZero())
+
+		for range 2 {
+			req, _ := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": 1, "method": "tools/list"})
+			rb, _ := json.Marshal(raw.HandleMessage(context.Background(), req))
+			var resp struct {
+				Result struct {
+					Tools []struct {
+						InputSchema  json.RawMessage `json:"inputSchema"`
+						OutputSchema json.RawMessage `json:"outputSchema"`
+					} `json:"tools"`
+				} `json:"result"`
+			}
+			g.Expect(json.Unmarshal(rb, &resp)).To(Succeed())
+			g.Expect(resp.Result.Tools).To(HaveLen(1))
+			g.Expect(resp.Result.Tools[0].InputSchema).To(MatchJSON(want.RawInputSchema))
+			g.Expect(resp.Result.Tools[0].OutputSchema).To(MatchJSON(want.RawOutputSchema))
+		}
+		g.Expect(loads).To(Equal(1))
+	})
+
+	t.Run("go-sdk", func(t *testing.T) {
+		g := NewWithT(t)
+		loads := 0
+		rawSrv, adapter := gosdk.NewServer("t", "1")
+		adapter.AddTool(lazyTool(&loads), handler)
+		g.Expect(loads).To(BeZero())
+
+		ctx := context.Background()
+		clientT, serverT := mcp.NewInMemoryTransports()
+		go func() { _ = rawSrv.Run(ctx, serverT) }()
+		session, err := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, nil).Connect(ctx, clientT, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		defer session.Close()
+
+		for range 2 {
+			tools, err := session.ListTools(ctx, nil)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tools.Tools).To(HaveLen(1))
+			input, _ := json.Marshal(tools.Tools[0].InputSchema)
+			g.Expect(input).To(MatchJSON(want.RawInputSchema))
+			output, _ := json.Marshal(tools.Tools[0].OutputSchema)
+			g.Expect(output).To(MatchJSON(want.RawOutputSchema))
+		}
+		g.Expect(loads).To(Equal(1))
+	})
+}
+
+// TestRTT_GoSDK_SubscriptionsEndWithSession verifies the watch stream of a
+// session that disconnects without unsubscribing stops.
+func TestRTT_GoSDK_SubscriptionsEndWithSession(t *testing.T) {
+	g := NewWithT(t)
+	subscriptions := runtime.NewSubscriptionRegistry()
+	defer subscriptions.Close()
+	stopped := make(chan string, 1)
+	subscriptions.Add("configs://watch/{+name}", func(ctx context.Context, uri string, _ runtime.ResourceUpdate) error {
+		<-ctx.Done()
+		stopped <- uri
+		return ctx.Err()
+	})
+	rawSrv := mcp.NewServer(&mcp.Implementation{Name: "t", Version: "1"}, &mcp.ServerOptions{
+		SubscribeHandler:   gosdk.SubscribeHandler(subscriptions),
+		UnsubscribeHandler: gosdk.UnsubscribeHandler(subscriptions),
+	})
+
+	ctx := context.Background()
+	clientT, serverT := mcp.NewInMemoryTransports()
+	go func() { _ = rawSrv.Run(ctx, serverT) }()
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "c", Version: "1"}, nil).Connect(ctx, clientT, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	uri := "configs://watch/configs/prod"
+	g.Expect(session.Subscribe(ctx, &mcp.SubscribeParams{URI: uri})).To(Succeed())
+	g.Consistently(stopped).ShouldNot(Receive())
+	g.Expect(session.Close()).To(Succeed())
+	g.Eventually(stopped).Should(Receive(Equal(uri)))
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"slices"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// WriteInstructions writes MCP server instructions summarizing the
+// inventory in entries to w: the tools of every service, read-only tools
+// first, followed by the resources and prompts and hints on the order to
+// use them in. Servers pass the text to clients in their initialize
+// result.
+func WriteInstructions(w io.Writer, entries []PreviewEntry) error {
+	var services []string
+	byService := map[string][]PreviewEntry{}
+	counts := map[string]int{}
+	var readOnly, sideEffects bool
+	for _, e := range entries {
+		if _, ok := byService[e.Service]; !ok {
+			services = append(services, e.Service)
+		}
+		byService[e.Service] = append(byService[e.Service], e)
+		counts[e.Kind]++
+		if e.Kind == "tool" {
+			readOnly = readOnly || e.ReadOnly
+			sideEffects = sideEffects || !e.ReadOnly
+		}
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "This server exposes %d tools, %d resources and %d prompts, grouped below by the API service they call.\n", counts["tool"], counts["resource"], counts["prompt"])
+	for _, service := range services {
+		fmt.Fprintf(&b, "\n## %s\n", service)
+		tools := slices.DeleteFunc(slices.Clone(byService[service]), func(e PreviewEntry) bool { return e.Kind != "tool" })
+		slices.SortStableFunc(tools, func(a, b PreviewEntry) int {
+			switch {
+			case a.ReadOnly == b.ReadOnly:
+				return 0
+			case a.ReadOnly:
+				return -1
+			}
+			return 1
+		})
+		if len(tools) > 0 {
+			b.WriteString("\nTools:\n")
+		}
+		for _, t := range tools {
+			fmt.Fprintf(&b, "- %s", t.Name)
+			if t.ReadOnly {
+				b.WriteString(" (read-only)")
+			}
+			if t.Summary != "" {
+				fmt.Fprintf(&b, ": %s", t.Summary)
+			}
+			b.WriteString("\n")
+		}
+		for _, section := range []struct{ kind, heading string }{{"resource", "Resources"}, {"prompt", "Prompts"}} {
+			first := true
+			for _, e := range byService[service] {
+				if e.Kind != section.kind {
+					continue
+				}
+				if first {
+					fmt.Fprintf(&b, "\n%s:\n", section.heading)
+					first = false
+				}
+				fmt.Fprintf(&b, "- %s (%s)\n", e.Name, e.Source)
+			}
+		}
+	}
+
+	var hints []string
+	if readOnly && sideEffects {
+		hints = append(hints, "Call the read-only tools first to look up the names and IDs the other tools take, and confirm with the user before calling tools that change state.")
+	}
+	if counts["resource"] > 0 {
+		hints = append(hints, "Resources serve the same data as the tools named after them; read them to add the data to the context without a tool call.")
+	}
+	if counts["prompt"] > 0 {
+		hints = append(hints, "Prompts walk through common tasks with these tools; prefer them when one matches the request.")
+	}
+	if len(hints) > 0 {
+		b.WriteString("\n## Usage\n\n")
+		for _, h := range hints {
+			fmt.Fprintf(&b, "- %s\n", h)
+		}
+	}
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// writeInstructions writes the WriteInstructions of entries to name in the
+// output directory of plugin.
+func writeInstructions(plugin *protogen.Plugin, name string, entries []PreviewEntry) error {
+	return WriteInstructions(plugin.NewGeneratedFile(name, ""), entries)
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestWriteInstructions(t *testing.T) {
+	g := NewWithT(t)
+
+	var b strings.Builder
+	g.Expect(WriteInstructions(&b, []PreviewEntry{
+		{Kind: "tool", Name: "delete", Source: "a.S.Delete", Service: "a.S", Summary: "Deletes a thing."},
+		{Kind: "tool", Name: "list", Source: "a.S.List", Service: "a.S", Summary: "Lists things.", ReadOnly: true},
+		{Kind: "resource", Name: "things://list", Source: "a.S.List", Service: "a.S"},
+		{Kind: "prompt", Name: "cleanup", Source: "a.S", Service: "a.S"},
+		{Kind: "tool", Name: "ping", Source: "b.T.Ping", Service: "b.T", ReadOnly: true},
+	})).To(Succeed())
+	g.Expect(b.String()).To(Equal(`This server exposes 3 tools, 1 resources and 1 prompts, grouped below by the API service they call.
+
+## a.S
+
+Tools:
+- list (read-only): Lists things.
+- delete: Deletes a thing.
+
+Resources:
+- things://list (a.S.List)
+
+Prompts:
+- cleanup (a.S)
+
+## b.T
+
+Tools:
+- ping (read-only)
+
+## Usage
+
+- Call the read-only tools first to look up the names and IDs the other tools take, and confirm with the user before calling tools that change state.
+- Resources serve the same data as the tools named after them; read them to add the data to the context without a tool call.
+- Prompts walk through common tasks with these tools; prefer them when one matches the request.
+`))
+}
+
+func TestGenerateInstructions(t *testing.T) {
+	g := NewWithT(t)
+
+	files, err := Generate(testServiceFileDescriptorSet(g), Options{PackageSuffix: "mcp", Instructions: "mcp-instructions.md"})
+	g.Expect(err).ToNot(HaveOccurred())
+	instructions := string(files["mcp-instructions.md"])
+	g.Expect(instructions).To(HavePrefix("This server exposes 4 tools"))
+	g.Expect(instructions).To(ContainSubstring("\n## testdata.TestService\n"))
+	g.Expect(instructions).To(ContainSubstring("\n- testdata_TestService_CreateItem"))
+
+	files, err = Generate(testServiceFileDescriptorSet(g), Options{PackageSuffix: "mcp", Instructions: "mcp-instructions.md", Preview: func(PreviewEntry) {}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(files).To(BeEmpty())
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"flag"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// Params parses protoc plugin parameters into the flags of a flag.FlagSet,
+// for protogen.Options.ParamFunc. Unlike FlagSet.Set it reports unknown
+// options with the closest known one, treats a bare boolean option such as
+// "mocks" as true, appends the values of repeated list options (ListFlag),
+// and rejects conflicting values of other repeated options.
+type Params struct {
+	flags *flag.FlagSet
+	given map[string]string
+}
+
+// NewParams returns Params setting the flags of flags.
+func NewParams(flags *flag.FlagSet) *Params {
+	return &Params{flags: flags, given: map[string]string{}}
+}
+
+// Set sets the option name to value.
+func (p *Params) Set(name, value string) error {
+	f := p.flags.Lookup(name)
+	if f == nil {
+		if known := p.closest(name); known != "" {
+			return fmt.Errorf("unknown option %q, did you mean %q?", name, known)
+		}
+		return fmt.Errorf("unknown option %q", name)
+	}
+	if b, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && b.IsBoolFlag() && value == "" {
+		value = "true"
+	}
+	if _, list := f.Value.(*ListFlag); !list {
+		if prev, ok := p.given[name]; ok && prev != value {
+			return fmt.Errorf("option %s given twice, as %q and %q", name, prev, value)
+		}
+		p.given[name] = value
+	}
+	if err := f.Value.Set(value); err != nil {
+		return fmt.Errorf("option %s: invalid value %q: %w", name, value, err)
+	}
+	return nil
+}
+
+// SetParameter sets the options of a plugin parameter such as
+// "openai_strict,wrap_input=request", split the way protogen splits the
+// parameter protoc passes. protogen's own options, such as paths, are
+// ignored.
+func (p *Params) SetParameter(parameter string) error {
+	_, err := protogen.Options{ParamFunc: p.Set}.New(&pluginpb.CodeGeneratorRequest{Parameter: &parameter})
+	return err
+}
+
+// closest returns the known option nearest to name, if any is near enough
+// to be a likely typo.
+func (p *Params) closest(name string) string {
+	best, bestDist := "", 3
+	p.flags.VisitAll(func(f *flag.Flag) {
+		if d := editDistance(name, f.Name); d < bestDist {
+			best, bestDist = f.Name, d
+		}
+	})
+	return best
+}
+
+// editDistance returns the Levenshtein distance of a and b.
+func editDistance(a, b string) int {
+	row := make([]int, len(b)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		prev := row[0]
+		row[0] = i
+		for j := 1; j <= len(b); j++ {
+			cur := row[j]
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			row[j] = min(row[j]+1, row[j-1]+1, prev+cost)
+			prev = cur
+		}
+	}
+	return row[len(b)]
+}
+
+// ListFlag is a flag.Value of an option that can be given several times.
+// Every value is split at commas, as protoc itself splits parameters there,
+// and appended.
+type ListFlag []string
+
+func (l *ListFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *ListFlag) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*l = append(*l, v)
+		}
+	}
+	return nil
+}
+
+// ToolPrefix is a tool_prefix plugin option: Prefix is prepended to the tool
+// names of the services matching Services, a path.Match pattern of full
+// names such as "foo.v1.Admin*", or of every service if Services is empty.
+type ToolPrefix struct {
+	Services string
+	Prefix   string
+}
+
+var toolPrefixRE = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// ParseToolPrefix parses a tool_prefix value, "[<services>:]<prefix>".
+func ParseToolPrefix(value string) (ToolPrefix, error) {
+	var tp ToolPrefix
+	if i := strings.LastIndex(value, ":"); i >= 0 {
+		tp.Services = value[:i]
+		value = value[i+1:]
+		if err := checkPattern(tp.Services); err != nil {
+			return ToolPrefix{}, err
+		}
+	}
+	if !toolPrefixRE.MatchString(value) {
+		return ToolPrefix{}, fmt.Errorf("tool_prefix %q must match %s", value, toolPrefixRE)
+	}
+	tp.Prefix = value
+	return tp, nil
+}
+
+// checkPattern returns an error if pattern is not a valid path.Match
+// pattern.
+func checkPattern(pattern string) error {
+	if _, err := path.Match(pattern, ""); err != nil || pattern == "" {
+		return fmt.Errorf("invalid pattern %q", pattern)
+	}
+	return nil
+}
+
+// matchesName reports whether pattern matches any of names.
+func matchesName(pattern string, names ...string) bool {
+	for _, name := range names {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
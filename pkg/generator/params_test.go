@@ -0,0 +1,80 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"flag"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParams(t *testing.T) {
+	g := NewWithT(t)
+
+	var flags flag.FlagSet
+	mocks := flags.Bool("mocks", false, "")
+	draft := flags.String("schema_draft", "", "")
+	maxBytes := flags.Int("max_tool_description_bytes", 0, "")
+	var directives ListFlag
+	flags.Var(&directives, "comment_directives", "")
+	p := NewParams(&flags)
+
+	// A bare boolean option is true.
+	g.Expect(p.Set("mocks", "")).To(Succeed())
+	g.Expect(*mocks).To(BeTrue())
+
+	// List options accumulate over repeated and comma-separated values.
+	g.Expect(p.Set("comment_directives", "TODO:")).To(Succeed())
+	g.Expect(p.Set("comment_directives", "FIXME:, XXX:")).To(Succeed())
+	g.Expect([]string(directives)).To(Equal([]string{"TODO:", "FIXME:", "XXX:"}))
+
+	// Other options may only be repeated with the same value.
+	g.Expect(p.Set("schema_draft", "draft-07")).To(Succeed())
+	g.Expect(p.Set("schema_draft", "draft-07")).To(Succeed())
+	g.Expect(p.Set("schema_draft", "2020-12")).To(MatchError(`option schema_draft given twice, as "draft-07" and "2020-12"`))
+	g.Expect(*draft).To(Equal("draft-07"))
+
+	g.Expect(p.Set("max_tool_description_bytes", "lots")).To(MatchError(ContainSubstring(`option max_tool_description_bytes: invalid value "lots"`)))
+	g.Expect(*maxBytes).To(BeZero())
+
+	g.Expect(p.Set("mock", "true")).To(MatchError(`unknown option "mock", did you mean "mocks"?`))
+	g.Expect(p.Set("openai_compat", "true")).To(MatchError(`unknown option "openai_compat"`))
+
+	// A whole parameter is split like protoc's, leaving protogen's options
+	// to it.
+	g.Expect(NewParams(&flags).SetParameter("paths=source_relative,max_tool_description_bytes=100,comment_directives=NOTE:")).To(Succeed())
+	g.Expect(*maxBytes).To(Equal(100))
+	g.Expect([]string(directives)).To(Equal([]string{"TODO:", "FIXME:", "XXX:", "NOTE:"}))
+}
+
+func TestParseToolPrefix(t *testing.T) {
+	g := NewWithT(t)
+
+	tp, err := ParseToolPrefix("rp_")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(tp).To(Equal(ToolPrefix{Prefix: "rp_"}))
+
+	tp, err = ParseToolPrefix("foo.v1.Admin*:admin_")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(tp).To(Equal(ToolPrefix{Services: "foo.v1.Admin*", Prefix: "admin_"}))
+
+	_, err = ParseToolPrefix("rp.")
+	g.Expect(err).To(MatchError(ContainSubstring(`tool_prefix "rp." must match`)))
+	_, err = ParseToolPrefix("foo.v1.[:admin_")
+	g.Expect(err).To(MatchError(`invalid pattern "foo.v1.["`))
+	_, err = ParseToolPrefix(":admin_")
+	g.Expect(err).To(MatchError(`invalid pattern ""`))
+}
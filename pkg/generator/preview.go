@@ -0,0 +1,121 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+// PreviewEntry is a tool, resource or prompt of the MCP surface, as listed
+// by FileGenerator.Preview.
+type PreviewEntry struct {
+	// Kind is "tool", "resource" or "prompt".
+	Kind string `json:"kind"`
+	// Name is the tool or prompt name, or the resource URI.
+	Name string `json:"name"`
+	// Source is the full name of the RPC or service it is generated from,
+	// declared in File.
+	Source string `json:"source"`
+	File   string `json:"file"`
+	// Service is the full name of the service Source belongs to.
+	Service string `json:"service"`
+	// Summary is the first line of the description of a tool, and
+	// ReadOnly is set for tools of methods marked NO_SIDE_EFFECTS.
+	Summary  string `json:"summary,omitempty"`
+	ReadOnly bool   `json:"read_only,omitempty"`
+	// DescriptionBytes is the size of the title and description of a
+	// tool, and InputSchemaBytes and OutputSchemaBytes those of its
+	// schemas.
+	DescriptionBytes  int `json:"description_bytes,omitempty"`
+	InputSchemaBytes  int `json:"input_schema_bytes,omitempty"`
+	OutputSchemaBytes int `json:"output_schema_bytes,omitempty"`
+}
+
+// inventory passes the tools, resources and prompts of the file to list,
+// in declaration order.
+func (g *FileGenerator) inventory(services map[string]map[string]Tool, watches map[string]map[string]Watch, prompts map[string][]Prompt, list func(PreviewEntry)) {
+	file := g.f.Desc.Path()
+	for _, svc := range g.f.Services {
+		name := string(svc.Desc.Name())
+		service := string(svc.Desc.FullName())
+		sources := map[string]string{}
+		for _, meth := range svc.Methods {
+			source := string(meth.Desc.FullName())
+			sources[meth.GoName] = source
+			if t, ok := services[name][meth.GoName]; ok {
+				list(PreviewEntry{
+					Kind:              "tool",
+					Name:              t.MCPTool.Name,
+					Source:            source,
+					File:              file,
+					Service:           service,
+					Summary:           summary(t.MCPTool),
+					ReadOnly:          !t.SideEffects,
+					DescriptionBytes:  len(t.MCPTool.Title) + len(t.MCPTool.Description),
+					InputSchemaBytes:  len(t.MCPTool.RawInputSchema),
+					OutputSchemaBytes: len(t.MCPTool.RawOutputSchema),
+				})
+				if t.Resource.URI != "" {
+					list(PreviewEntry{Kind: "resource", Name: t.Resource.URI, Source: source, File: file, Service: service})
+				}
+			}
+			if w, ok := watches[name][meth.GoName]; ok {
+				list(PreviewEntry{Kind: "resource", Name: w.Resource.URI, Source: source, File: file, Service: service})
+			}
+		}
+		for _, p := range prompts[name] {
+			source := service
+			if p.Method != "" {
+				source = sources[p.Method]
+			}
+			list(PreviewEntry{Kind: "prompt", Name: p.MCPPrompt.Name, Source: source, File: file, Service: service})
+		}
+	}
+}
+
+// summary returns the first line of the description of tool, or its title
+// if it has no description.
+func summary(tool runtime.Tool) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(tool.Description), "\n")
+	if line = strings.TrimSpace(line); line != "" {
+		return line
+	}
+	return tool.Title
+}
+
+// WritePreview writes entries to w as a table, followed by a summary line.
+func WritePreview(w io.Writer, entries []PreviewEntry) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "KIND\tNAME\tSOURCE\tINPUT SCHEMA\tOUTPUT SCHEMA")
+	counts := map[string]int{}
+	for _, e := range entries {
+		counts[e.Kind]++
+		input, output := "-", "-"
+		if e.Kind == "tool" {
+			input, output = fmt.Sprintf("%d B", e.InputSchemaBytes), fmt.Sprintf("%d B", e.OutputSchemaBytes)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", e.Kind, e.Name, e.Source, input, output)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "%d tools, %d resources, %d prompts; no files written\n", counts["tool"], counts["resource"], counts["prompt"])
+	return err
+}
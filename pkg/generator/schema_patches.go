@@ -0,0 +1,149 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+// The file name suffixes of schema patches, see ReadSchemaPatches. They
+// match the files emit_schemas writes.
+const (
+	inputPatchSuffix  = ".input.json"
+	outputPatchSuffix = ".output.json"
+)
+
+// SchemaPatch holds the JSON Merge Patches (RFC 7396) of the input and
+// output schemas of a tool. Either may be nil.
+type SchemaPatch struct {
+	Input  json.RawMessage
+	Output json.RawMessage
+}
+
+// ReadSchemaPatches reads the schema patches in dir by tool name: the
+// patch of the input schema of a tool is in <tool>.input.json, that of its
+// output schema in <tool>.output.json, named like the files of
+// emit_schemas. Files not ending in .json are ignored. Every patch must be
+// a JSON object.
+func ReadSchemaPatches(dir string) (map[string]SchemaPatch, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	patches := map[string]SchemaPatch{}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		var object map[string]any
+		if err := json.Unmarshal(data, &object); err != nil || object == nil {
+			return nil, fmt.Errorf("%s: a schema patch must be a JSON object", name)
+		}
+		if tool, ok := strings.CutSuffix(name, inputPatchSuffix); ok {
+			p := patches[tool]
+			p.Input = data
+			patches[tool] = p
+		} else if tool, ok := strings.CutSuffix(name, outputPatchSuffix); ok {
+			p := patches[tool]
+			p.Output = data
+			patches[tool] = p
+		} else {
+			return nil, fmt.Errorf("%s: a schema patch must be named <tool>%s or <tool>%s", name, inputPatchSuffix, outputPatchSuffix)
+		}
+	}
+	return patches, nil
+}
+
+// patchTool applies the SchemaPatches of tool, by its final name.
+func (g *FileGenerator) patchTool(tool *runtime.Tool) error {
+	patch, ok := g.SchemaPatches[tool.Name]
+	if !ok {
+		return nil
+	}
+	for _, s := range []struct {
+		schema *json.RawMessage
+		patch  json.RawMessage
+		file   string
+	}{
+		{&tool.RawInputSchema, patch.Input, tool.Name + inputPatchSuffix},
+		{&tool.RawOutputSchema, patch.Output, tool.Name + outputPatchSuffix},
+	} {
+		if s.patch == nil {
+			continue
+		}
+		if *s.schema == nil {
+			return fmt.Errorf("schema patch %s: the tool has no such schema", s.file)
+		}
+		patched, err := mergePatch(*s.schema, s.patch)
+		if err != nil {
+			return fmt.Errorf("schema patch %s: %w", s.file, err)
+		}
+		*s.schema = patched
+	}
+	return nil
+}
+
+// mergePatch applies the JSON Merge Patch patch to the JSON object doc.
+func mergePatch(doc, patch json.RawMessage) (json.RawMessage, error) {
+	var target, p map[string]any
+	if err := json.Unmarshal(doc, &target); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, err
+	}
+	merged, ok := mergeValue(target, p).(map[string]any)
+	if !ok {
+		return nil, errors.New("the patched schema is not a JSON object")
+	}
+	if merged["type"] != "object" {
+		return nil, errors.New(`the patched schema must keep "type": "object"`)
+	}
+	return json.Marshal(merged)
+}
+
+// mergeValue merges patch into target as RFC 7396 describes: objects are
+// merged key by key, null removes a key, and any other value replaces the
+// target.
+func mergeValue(target, patch any) any {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]any)
+	if !ok {
+		t = map[string]any{}
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+		t[k] = mergeValue(t[k], v)
+	}
+	return t
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestReadSchemaPatches(t *testing.T) {
+	g := NewWithT(t)
+	dir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(dir, "svc_Create.input.json"), []byte(`{"properties":{"kind":{"enum":["a","b"]}}}`), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "svc_Create.output.json"), []byte(`{"description":"The created item."}`), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "README.md"), []byte("# Patches"), 0o600)).To(Succeed())
+
+	patches, err := ReadSchemaPatches(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(patches).To(HaveLen(1))
+	g.Expect(string(patches["svc_Create"].Input)).To(ContainSubstring(`"enum"`))
+	g.Expect(string(patches["svc_Create"].Output)).To(ContainSubstring(`"The created item."`))
+
+	g.Expect(os.WriteFile(filepath.Join(dir, "svc_Delete.input.json"), []byte(`[]`), 0o600)).To(Succeed())
+	_, err = ReadSchemaPatches(dir)
+	g.Expect(err).To(MatchError(ContainSubstring("svc_Delete.input.json: a schema patch must be a JSON object")))
+	g.Expect(os.Remove(filepath.Join(dir, "svc_Delete.input.json"))).To(Succeed())
+
+	g.Expect(os.WriteFile(filepath.Join(dir, "svc_Delete.json"), []byte(`{}`), 0o600)).To(Succeed())
+	_, err = ReadSchemaPatches(dir)
+	g.Expect(err).To(MatchError(ContainSubstring("svc_Delete.json: a schema patch must be named <tool>.input.json or <tool>.output.json")))
+}
+
+func TestMergePatch(t *testing.T) {
+	g := NewWithT(t)
+	doc := []byte(`{"type":"object","properties":{"name":{"type":"string"},"force":{"type":"boolean"}},"required":["name","force"]}`)
+
+	patched, err := mergePatch(doc, []byte(`{"properties":{"force":null,"name":{"maxLength":63}},"required":["name"]}`))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(patched)).To(Equal(`{"properties":{"name":{"maxLength":63,"type":"string"}},"required":["name"],"type":"object"}`))
+
+	_, err = mergePatch(doc, []byte(`{"type":"array"}`))
+	g.Expect(err).To(MatchError(ContainSubstring(`must keep "type": "object"`)))
+}
+
+func TestGenerateSchemaPatches(t *testing.T) {
+	g := NewWithT(t)
+
+	files, err := Generate(testServiceFileDescriptorSet(g), Options{
+		PackageSuffix: "mcp",
+		Paths:         "source_relative",
+		SchemaPatches: map[string]SchemaPatch{
+			"testdata_TestService_CreateItem": {
+				Input:  []byte(`{"properties":{"thumbnail":null,"name":{"maxLength":63}}}`),
+				Output: []byte(`{"description":"The created item."}`),
+			},
+		},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	code := string(files["testdata/testdatamcp/test_service.pb.mcp.go"])
+	g.Expect(code).To(ContainSubstring(`"name":{"maxLength":63,"type":"string"}`))
+	g.Expect(code).ToNot(ContainSubstring(`"thumbnail"`))
+	g.Expect(code).To(ContainSubstring(`{"description":"The created item.",`))
+	g.Expect(code).To(ContainSubstring(`"schema_patches=testdata_TestService_CreateItem"`))
+
+	_, err = Generate(testServiceFileDescriptorSet(g), Options{
+		PackageSuffix: "mcp",
+		SchemaPatches: map[string]SchemaPatch{"testdata_TestService_CreateItem": {Input: []byte(`{"type":"string"}`)}},
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("schema patch testdata_TestService_CreateItem.input.json")))
+}
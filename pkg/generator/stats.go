@@ -0,0 +1,111 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"cmp"
+	"encoding/json"
+	"slices"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// bytesPerToken is the rule of thumb for English text and JSON with the
+// tokenizers of current LLMs.
+const bytesPerToken = 4
+
+// largestTools is how many tools Stats.Largest lists.
+const largestTools = 10
+
+// EstimateTokens estimates how many tokens n bytes of text take up in an
+// LLM context.
+func EstimateTokens(n int) int {
+	return (n + bytesPerToken - 1) / bytesPerToken
+}
+
+// ToolStats is the size of a tool in the tools/list result.
+type ToolStats struct {
+	Name              string `json:"name"`
+	Source            string `json:"source"`
+	DescriptionBytes  int    `json:"description_bytes"`
+	InputSchemaBytes  int    `json:"input_schema_bytes"`
+	OutputSchemaBytes int    `json:"output_schema_bytes"`
+	// EstimatedTokens covers the name, description and schemas, see
+	// EstimateTokens.
+	EstimatedTokens int `json:"estimated_tokens"`
+}
+
+// Stats sums up the MCP surface of a generation run, to keep the tool list
+// within LLM context budgets.
+type Stats struct {
+	Tools            int `json:"tools"`
+	Resources        int `json:"resources"`
+	Prompts          int `json:"prompts"`
+	DescriptionBytes int `json:"description_bytes"`
+	SchemaBytes      int `json:"schema_bytes"`
+	// EstimatedTokens is the sum of the estimated tokens of the tools.
+	EstimatedTokens int `json:"estimated_tokens"`
+	// Largest lists the tools taking up the most tokens, largest first.
+	Largest []ToolStats `json:"largest"`
+	// PerTool lists every tool in declaration order.
+	PerTool []ToolStats `json:"per_tool"`
+}
+
+// NewStats computes the Stats of the inventory in entries.
+func NewStats(entries []PreviewEntry) Stats {
+	stats := Stats{Largest: []ToolStats{}, PerTool: []ToolStats{}}
+	for _, e := range entries {
+		switch e.Kind {
+		case "resource":
+			stats.Resources++
+			continue
+		case "prompt":
+			stats.Prompts++
+			continue
+		}
+		t := ToolStats{
+			Name:              e.Name,
+			Source:            e.Source,
+			DescriptionBytes:  e.DescriptionBytes,
+			InputSchemaBytes:  e.InputSchemaBytes,
+			OutputSchemaBytes: e.OutputSchemaBytes,
+			EstimatedTokens:   EstimateTokens(len(e.Name) + e.DescriptionBytes + e.InputSchemaBytes + e.OutputSchemaBytes),
+		}
+		stats.Tools++
+		stats.DescriptionBytes += t.DescriptionBytes
+		stats.SchemaBytes += t.InputSchemaBytes + t.OutputSchemaBytes
+		stats.EstimatedTokens += t.EstimatedTokens
+		stats.PerTool = append(stats.PerTool, t)
+	}
+	stats.Largest = slices.Clone(stats.PerTool)
+	slices.SortStableFunc(stats.Largest, func(a, b ToolStats) int {
+		return cmp.Compare(b.EstimatedTokens, a.EstimatedTokens)
+	})
+	if len(stats.Largest) > largestTools {
+		stats.Largest = stats.Largest[:largestTools]
+	}
+	return stats
+}
+
+// writeStatsReport writes stats as a JSON document to name in the output
+// directory of plugin.
+func writeStatsReport(plugin *protogen.Plugin, name string, stats Stats) error {
+	data, err := json.MarshalIndent(stats, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = plugin.NewGeneratedFile(name, "").Write(append(data, '\n'))
+	return err
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewStats(t *testing.T) {
+	g := NewWithT(t)
+
+	stats := NewStats([]PreviewEntry{
+		{Kind: "tool", Name: "small", Source: "a.S.Small", DescriptionBytes: 3, InputSchemaBytes: 2, OutputSchemaBytes: 2},
+		{Kind: "resource", Name: "things://list", Source: "a.S.Small"},
+		{Kind: "tool", Name: "large", Source: "a.S.Large", DescriptionBytes: 95, InputSchemaBytes: 300, OutputSchemaBytes: 100},
+		{Kind: "prompt", Name: "review", Source: "a.S"},
+	})
+	g.Expect(stats.Tools).To(Equal(2))
+	g.Expect(stats.Resources).To(Equal(1))
+	g.Expect(stats.Prompts).To(Equal(1))
+	g.Expect(stats.DescriptionBytes).To(Equal(98))
+	g.Expect(stats.SchemaBytes).To(Equal(404))
+	// 12 and 500 bytes, rounded up to whole tokens.
+	g.Expect(stats.PerTool[0].EstimatedTokens).To(Equal(3))
+	g.Expect(stats.PerTool[1].EstimatedTokens).To(Equal(125))
+	g.Expect(stats.EstimatedTokens).To(Equal(128))
+	g.Expect(stats.Largest[0].Name).To(Equal("large"))
+	g.Expect(stats.Largest[1].Name).To(Equal("small"))
+
+	g.Expect(NewStats(nil).Largest).To(BeEmpty())
+}
+
+func TestGenerateStatsReport(t *testing.T) {
+	g := NewWithT(t)
+
+	files, err := Generate(testServiceFileDescriptorSet(g), Options{PackageSuffix: "mcp", StatsReport: "mcp-stats.json"})
+	g.Expect(err).ToNot(HaveOccurred())
+	var stats Stats
+	g.Expect(json.Unmarshal(files["mcp-stats.json"], &stats)).To(Succeed())
+	g.Expect(stats.Tools).To(Equal(4))
+	g.Expect(stats.PerTool[0].Name).To(Equal("testdata_TestService_CreateItem"))
+	g.Expect(stats.PerTool[0].Source).To(Equal("testdata.TestService.CreateItem"))
+	g.Expect(stats.EstimatedTokens).To(BeNumerically(">", stats.SchemaBytes/bytesPerToken))
+
+	// Previews write no files, reports included.
+	files, err = Generate(testServiceFileDescriptorSet(g), Options{PackageSuffix: "mcp", StatsReport: "mcp-stats.json", Preview: func(PreviewEntry) {}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(files).To(BeEmpty())
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/gen"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func TestGenerateTransforms(t *testing.T) {
+	g := NewWithT(t)
+
+	var transformed []string
+	files, err := Generate(testServiceFileDescriptorSet(g), Options{
+		PackageSuffix: "mcp",
+		Paths:         "source_relative",
+		SchemaOptions: gen.SchemaOptions{
+			MessageTransforms: []gen.MessageTransform{func(md protoreflect.MessageDescriptor, schema map[string]any) {
+				if md.FullName() != "testdata.CreateItemRequest" {
+					return
+				}
+				props := schema["properties"].(map[string]any)
+				delete(props, "thumbnail")
+				props["name"].(map[string]any)["description"] = "Lowercase, at most 63 characters."
+			}},
+		},
+		ToolTransforms: []ToolTransform{func(method protoreflect.MethodDescriptor, tool *runtime.Tool) error {
+			transformed = append(transformed, string(method.FullName()))
+			tool.Title = "Items: " + string(method.Name())
+			return nil
+		}},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	code := string(files["testdata/testdatamcp/test_service.pb.mcp.go"])
+	g.Expect(code).To(ContainSubstring(`"name":{"description":"Lowercase, at most 63 characters.","type":"string"}`))
+	g.Expect(code).ToNot(ContainSubstring(`"thumbnail"`))
+	g.Expect(code).To(ContainSubstring(`"Items: CreateItem"`))
+	g.Expect(transformed).To(ContainElement("testdata.TestService.CreateItem"))
+
+	_, err = Generate(testServiceFileDescriptorSet(g), Options{
+		PackageSuffix: "mcp",
+		ToolTransforms: []ToolTransform{func(protoreflect.MethodDescriptor, *runtime.Tool) error {
+			return errors.New("missing owner annotation")
+		}},
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("missing owner annotation")))
+
+	_, err = Generate(testServiceFileDescriptorSet(g), Options{
+		PackageSuffix: "mcp",
+		ToolTransforms: []ToolTransform{func(_ protoreflect.MethodDescriptor, tool *runtime.Tool) error {
+			tool.Name = "items.create"
+			return nil
+		}},
+	})
+	g.Expect(err).To(MatchError(ContainSubstring(`transformed tool name "items.create"`)))
+}
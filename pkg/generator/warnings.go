@@ -0,0 +1,110 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/gen"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Warning is a gen.Warning located in the proto sources, as passed to
+// FileGenerator.Warn and written to the warnings report.
+type Warning struct {
+	Kind gen.WarningKind `json:"kind"`
+	// Element is the full name of the proto element the warning is about.
+	Element string `json:"element"`
+	// Location is where Element is declared, see gen.Location.
+	Location string `json:"location"`
+	Message  string `json:"message"`
+}
+
+// String formats w like the errors of the generator,
+// "file.proto:line:column: element: message".
+func (w Warning) String() string {
+	return fmt.Sprintf("%s: %s: %s", w.Location, w.Element, w.Message)
+}
+
+// warn passes w to Warn, if set, or records it as an error in Strict
+// mode, unless it was already reported.
+func (g *FileGenerator) warn(w gen.Warning) {
+	key := string(w.Kind) + " " + string(w.Descriptor.FullName())
+	if g.warned[key] {
+		return
+	}
+	if g.warned == nil {
+		g.warned = map[string]bool{}
+	}
+	g.warned[key] = true
+	warning := Warning{
+		Kind:     w.Kind,
+		Element:  string(w.Descriptor.FullName()),
+		Location: gen.Location(w.Descriptor),
+		Message:  w.Message,
+	}
+	switch {
+	case g.Strict:
+		g.strictErrors = append(g.strictErrors, fmt.Errorf("%s (strict)", warning))
+	case g.Warn != nil:
+		g.Warn(warning)
+	}
+}
+
+// warnSkipped reports why method, which generatesTool rejected, gets no
+// tool, unless it is excluded on purpose.
+func (g *FileGenerator) warnSkipped(method protoreflect.MethodDescriptor) {
+	if g.excludes(method) || g.ExcludeDeprecatedMethods && gen.MethodDeprecated(method) {
+		return
+	}
+	switch {
+	case method.IsStreamingClient():
+		g.warn(gen.Warning{Kind: gen.WarningStreaming, Descriptor: method, Message: "client-streaming RPCs get no tool"})
+	case method.IsStreamingServer():
+		g.warn(gen.Warning{Kind: gen.WarningStreaming, Descriptor: method, Message: "server-streaming RPCs get no tool; set (mcp.method).resource_uri to watch it as a resource"})
+	}
+}
+
+// warnTool reports what tool, generated for method from comment with
+// opts, does not faithfully represent, see gen.SchemaWarnings.
+func (g *FileGenerator) warnTool(method protoreflect.MethodDescriptor, tool runtime.Tool, comment string, opts gen.SchemaOptions) {
+	if g.Warn == nil && !g.Strict {
+		return
+	}
+	_, mc := g.Config.method(method)
+	if name := strings.ReplaceAll(string(method.FullName()), ".", "_"); name != tool.Name && mc.Name == "" {
+		g.warn(gen.Warning{Kind: gen.WarningToolName, Descriptor: method, Message: fmt.Sprintf("tool name shortened to %q, as names are limited to 64 characters; set a name in the config file", tool.Name)})
+	}
+	for _, w := range gen.SchemaWarnings(method, comment, opts) {
+		g.warn(w)
+	}
+}
+
+// writeWarningsReport writes warnings as a JSON document to name in the
+// output directory of plugin.
+func writeWarningsReport(plugin *protogen.Plugin, name string, warnings []Warning) error {
+	data, err := json.MarshalIndent(struct {
+		Warnings []Warning `json:"warnings"`
+	}{warnings}, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = plugin.NewGeneratedFile(name, "").Write(append(data, '\n'))
+	return err
+}
@@ -0,0 +1,969 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: mcp/options.proto
+
+// Package mcp declares custom options that tune how protoc-gen-go-mcp renders
+// services, methods, messages and fields as MCP tools.
+
+package mcpoptions
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FieldOptions customizes the JSON schema generated for a single field.
+type FieldOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schema is a literal JSON Schema object used verbatim in place of the
+	// generated schema for this field. For repeated and map fields it replaces
+	// the whole array/object schema. The fragment only changes what the model
+	// sees: the value it sends must still be one protojson accepts for the field.
+	Schema string `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	// example values rendered as the JSON Schema "examples" keyword. Write each
+	// as it appears in the tool arguments: text is used as-is for fields that
+	// render as JSON strings (including int64, enums, Timestamp, Duration) and
+	// parsed as a JSON literal otherwise. On repeated and map fields each
+	// example describes a single element. Repeat the option for several values.
+	Example []string `protobuf:"bytes,2,rep,name=example,proto3" json:"example,omitempty"`
+	// title is the human-readable label emitted as the JSON Schema "title"
+	// keyword, for clients that render tool arguments as forms. It overrides the
+	// title derived from the field name when titles are enabled.
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// deprecation_note steers the model away from a field marked
+	// [deprecated = true], e.g. "Use name instead.". It is rendered when
+	// deprecated fields are annotated rather than kept as-is or omitted.
+	DeprecationNote string `protobuf:"bytes,4,opt,name=deprecation_note,json=deprecationNote,proto3" json:"deprecation_note,omitempty"`
+	// default is injected into the request when the model omits the field (or
+	// sends null), and is advertised as the JSON Schema "default" keyword. It is
+	// written like an example: text is used as-is for fields that render as
+	// JSON strings and parsed as a JSON literal otherwise, so repeated, map and
+	// message fields take a JSON array or object. A field with a default is
+	// never listed as required. Not supported on members of a oneof.
+	Default string `protobuf:"bytes,5,opt,name=default,proto3" json:"default,omitempty"`
+	// from_context names a value the server supplies from the call context,
+	// e.g. the session's tenant for an organization_id field. The field is
+	// removed from the tool's input schema and the generated handler sets it
+	// from the runtime.ContextField registered under this name, overwriting
+	// anything the model sent. Only honored on top-level request fields; not
+	// supported on members of a oneof.
+	FromContext string `protobuf:"bytes,6,opt,name=from_context,json=fromContext,proto3" json:"from_context,omitempty"`
+	// max caps a singular integer field such as a List RPC's page_size. The
+	// handler clamps larger values the model sends down to max instead of
+	// passing them to the backend, and the schema advertises it as "maximum"
+	// (in the description for 64-bit fields, which are JSON strings). Pair it
+	// with default to also fill in the page size when omitted. Zero means no
+	// cap.
+	Max int64 `protobuf:"varint,7,opt,name=max,proto3" json:"max,omitempty"`
+	// json_string renders a message field as a string holding the message's
+	// JSON, e.g. "config": "{\"retries\": 3}", instead of expanding its fields,
+	// for messages too complex for models to fill in reliably as structured
+	// arguments. On repeated and map fields each element is such a string. The
+	// handler parses the strings back, and tool results carry them the same
+	// way. Not supported on well-known types or together with schema.
+	JsonString bool `protobuf:"varint,8,opt,name=json_string,json=jsonString,proto3" json:"json_string,omitempty"`
+	// relative_time lets the model write a google.protobuf.Timestamp or
+	// Duration field the way people do: timestamps as "now", "now-2h",
+	// "now+15m", a date, or RFC 3339 without a time zone (read as UTC), and
+	// durations as "15m", "1h30m" or "2d". The handler normalizes them to the
+	// canonical protojson form. The relative_times plugin option does this for
+	// every such field. Not supported on map fields.
+	RelativeTime bool `protobuf:"varint,9,opt,name=relative_time,json=relativeTime,proto3" json:"relative_time,omitempty"`
+	// file marks a singular bytes or string response field as the contents of
+	// a file, e.g. an export archive or a rendered report. Tool results return
+	// it as an MCP embedded resource the client can offer as a download, rather
+	// than inlining it in the text content, and leave the field empty in the
+	// JSON. A sibling string field named content_type or mime_type supplies the
+	// resource's MIME type, and one named filename or file_name its name.
+	// google.api.HttpBody responses and fields are returned this way without
+	// the option. Only honored on top-level response fields.
+	File bool `protobuf:"varint,10,opt,name=file,proto3" json:"file,omitempty"`
+	// exclude removes the field from tool input schemas, for internal
+	// plumbing fields the model must never set. The generated handlers reject
+	// calls setting it. The exclude_fields plugin option excludes fields by
+	// pattern instead. Output schemas and responses keep the field.
+	Exclude bool `protobuf:"varint,11,opt,name=exclude,proto3" json:"exclude,omitempty"`
+	// exclude_from_result strips the field from tool results and output
+	// schemas, for response fields such as secrets, internal IDs or large debug
+	// blobs the model must not see. Honored at any depth of the response.
+	ExcludeFromResult bool `protobuf:"varint,12,opt,name=exclude_from_result,json=excludeFromResult,proto3" json:"exclude_from_result,omitempty"`
+	// skip_sanitizers exempts the field, and every message nested in it, from
+	// the string sanitizers registered with runtime.WithStringSanitizers, e.g.
+	// for raw payloads that must reach the backend byte for byte.
+	SkipSanitizers bool `protobuf:"varint,13,opt,name=skip_sanitizers,json=skipSanitizers,proto3" json:"skip_sanitizers,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FieldOptions) Reset() {
+	*x = FieldOptions{}
+	mi := &file_mcp_options_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldOptions) ProtoMessage() {}
+
+func (x *FieldOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_mcp_options_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldOptions.ProtoReflect.Descriptor instead.
+func (*FieldOptions) Descriptor() ([]byte, []int) {
+	return file_mcp_options_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FieldOptions) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *FieldOptions) GetExample() []string {
+	if x != nil {
+		return x.Example
+	}
+	return nil
+}
+
+func (x *FieldOptions) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *FieldOptions) GetDeprecationNote() string {
+	if x != nil {
+		return x.DeprecationNote
+	}
+	return ""
+}
+
+func (x *FieldOptions) GetDefault() string {
+	if x != nil {
+		return x.Default
+	}
+	return ""
+}
+
+func (x *FieldOptions) GetFromContext() string {
+	if x != nil {
+		return x.FromContext
+	}
+	return ""
+}
+
+func (x *FieldOptions) GetMax() int64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *FieldOptions) GetJsonString() bool {
+	if x != nil {
+		return x.JsonString
+	}
+	return false
+}
+
+func (x *FieldOptions) GetRelativeTime() bool {
+	if x != nil {
+		return x.RelativeTime
+	}
+	return false
+}
+
+func (x *FieldOptions) GetFile() bool {
+	if x != nil {
+		return x.File
+	}
+	return false
+}
+
+func (x *FieldOptions) GetExclude() bool {
+	if x != nil {
+		return x.Exclude
+	}
+	return false
+}
+
+func (x *FieldOptions) GetExcludeFromResult() bool {
+	if x != nil {
+		return x.ExcludeFromResult
+	}
+	return false
+}
+
+func (x *FieldOptions) GetSkipSanitizers() bool {
+	if x != nil {
+		return x.SkipSanitizers
+	}
+	return false
+}
+
+// MessageOptions customizes the JSON schema generated for a message type.
+type MessageOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schema is a literal JSON Schema object used verbatim wherever this
+	// message appears, instead of expanding its fields.
+	Schema        string `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageOptions) Reset() {
+	*x = MessageOptions{}
+	mi := &file_mcp_options_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageOptions) ProtoMessage() {}
+
+func (x *MessageOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_mcp_options_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageOptions.ProtoReflect.Descriptor instead.
+func (*MessageOptions) Descriptor() ([]byte, []int) {
+	return file_mcp_options_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MessageOptions) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+// MethodOptions customizes the MCP tool generated for an RPC.
+type MethodOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// title is the human-readable display name of the tool. It overrides the
+	// title derived from the method name when titles are enabled.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// extra_property declares tool arguments that are not request fields, in
+	// addition to those declared on the service. A method-level property
+	// replaces a service-level one of the same name.
+	ExtraProperty []*ExtraProperty `protobuf:"bytes,2,rep,name=extra_property,json=extraProperty,proto3" json:"extra_property,omitempty"`
+	// prompt declares MCP prompts registered next to the tool. Their template
+	// can refer to the tool as {{tool}}.
+	Prompt []*Prompt `protobuf:"bytes,3,rep,name=prompt,proto3" json:"prompt,omitempty"`
+	// resource_uri exposes the RPC as an MCP resource under this URI, or URI
+	// template (RFC 6570) such as "clusters://{name}". Reading the resource
+	// calls the RPC with the template variables set on the request fields they
+	// name, e.g. {parent.id}, and returns the response as JSON. {+name} also
+	// matches slashes. This overrides the URI the resources plugin option
+	// derives from a google.api.http GET binding. On a server-streaming RPC the
+	// resource can be subscribed to: the stream runs while someone is
+	// subscribed and every message it receives is a resource update.
+	ResourceUri string `protobuf:"bytes,4,opt,name=resource_uri,json=resourceUri,proto3" json:"resource_uri,omitempty"`
+	// example declares example calls of the tool, listed at the end of its
+	// description. A few examples measurably reduce malformed calls of tools
+	// with complex requests.
+	Example []*ToolExample `protobuf:"bytes,5,rep,name=example,proto3" json:"example,omitempty"`
+	// tag categorizes the tool, e.g. "topics" or "billing", in addition to the
+	// tags of its service. Tags are listed in the _meta of the tool, and
+	// runtime.WithTags registers only the tools of selected tags.
+	Tag           []string `protobuf:"bytes,6,rep,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MethodOptions) Reset() {
+	*x = MethodOptions{}
+	mi := &file_mcp_options_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MethodOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MethodOptions) ProtoMessage() {}
+
+func (x *MethodOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_mcp_options_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MethodOptions.ProtoReflect.Descriptor instead.
+func (*MethodOptions) Descriptor() ([]byte, []int) {
+	return file_mcp_options_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MethodOptions) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MethodOptions) GetExtraProperty() []*ExtraProperty {
+	if x != nil {
+		return x.ExtraProperty
+	}
+	return nil
+}
+
+func (x *MethodOptions) GetPrompt() []*Prompt {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+func (x *MethodOptions) GetResourceUri() string {
+	if x != nil {
+		return x.ResourceUri
+	}
+	return ""
+}
+
+func (x *MethodOptions) GetExample() []*ToolExample {
+	if x != nil {
+		return x.Example
+	}
+	return nil
+}
+
+func (x *MethodOptions) GetTag() []string {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+// ServiceOptions customizes every MCP tool generated for a service.
+type ServiceOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// extra_property declares tool arguments shared by all RPCs of the service.
+	ExtraProperty []*ExtraProperty `protobuf:"bytes,1,rep,name=extra_property,json=extraProperty,proto3" json:"extra_property,omitempty"`
+	// prompt declares MCP prompts that span several RPCs of the service, e.g.
+	// a curated workflow.
+	Prompt []*Prompt `protobuf:"bytes,2,rep,name=prompt,proto3" json:"prompt,omitempty"`
+	// tag categorizes every tool of the service, see MethodOptions.tag.
+	Tag           []string `protobuf:"bytes,3,rep,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceOptions) Reset() {
+	*x = ServiceOptions{}
+	mi := &file_mcp_options_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceOptions) ProtoMessage() {}
+
+func (x *ServiceOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_mcp_options_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceOptions.ProtoReflect.Descriptor instead.
+func (*ServiceOptions) Descriptor() ([]byte, []int) {
+	return file_mcp_options_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ServiceOptions) GetExtraProperty() []*ExtraProperty {
+	if x != nil {
+		return x.ExtraProperty
+	}
+	return nil
+}
+
+func (x *ServiceOptions) GetPrompt() []*Prompt {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+func (x *ServiceOptions) GetTag() []string {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+// ExtraProperty declares a tool argument that is not a field of the request.
+// It is baked into the generated input schema, and the generated handler
+// moves its value into the context under runtime.ExtraPropertyKey(context_key)
+// instead of unmarshaling it into the request.
+type ExtraProperty struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the argument name. It must not collide with a request field.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// description is shown to the model.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// required lists the argument as required in the schema.
+	Required bool `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	// context_key names the runtime.ExtraPropertyKey the value is stored
+	// under. Defaults to name.
+	ContextKey string `protobuf:"bytes,4,opt,name=context_key,json=contextKey,proto3" json:"context_key,omitempty"`
+	// schema is the JSON Schema object of the value. Defaults to
+	// {"type":"string"}.
+	Schema string `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
+	// sensitive marks a secret such as an API token; see
+	// runtime.ExtraProperty.Sensitive.
+	Sensitive     bool `protobuf:"varint,6,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtraProperty) Reset() {
+	*x = ExtraProperty{}
+	mi := &file_mcp_options_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtraProperty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtraProperty) ProtoMessage() {}
+
+func (x *ExtraProperty) ProtoReflect() protoreflect.Message {
+	mi := &file_mcp_options_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtraProperty.ProtoReflect.Descriptor instead.
+func (*ExtraProperty) Descriptor() ([]byte, []int) {
+	return file_mcp_options_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExtraProperty) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExtraProperty) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExtraProperty) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *ExtraProperty) GetContextKey() string {
+	if x != nil {
+		return x.ContextKey
+	}
+	return ""
+}
+
+func (x *ExtraProperty) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *ExtraProperty) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// Prompt declares an MCP prompt template. Getting the prompt renders template
+// as a single user message.
+type Prompt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name identifies the prompt. It is prefixed like tool names and must be
+	// unique within the service.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// title is the human-readable display name.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// description tells the user what the prompt is for.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// argument declares the values the user fills in.
+	Argument []*PromptArgument `protobuf:"bytes,4,rep,name=argument,proto3" json:"argument,omitempty"`
+	// template is the message text. {{name}} inserts the argument of that name
+	// (empty when an optional argument is left out), {{tool}} the name of the
+	// tool of the annotated method, and {{tool:Method}} the name of the tool of
+	// another RPC of the service, e.g. {{tool:ListClusters}}.
+	Template      string `protobuf:"bytes,5,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Prompt) Reset() {
+	*x = Prompt{}
+	mi := &file_mcp_options_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Prompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Prompt) ProtoMessage() {}
+
+func (x *Prompt) ProtoReflect() protoreflect.Message {
+	mi := &file_mcp_options_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Prompt.ProtoReflect.Descriptor instead.
+func (*Prompt) Descriptor() ([]byte, []int) {
+	return file_mcp_options_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Prompt) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Prompt) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Prompt) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Prompt) GetArgument() []*PromptArgument {
+	if x != nil {
+		return x.Argument
+	}
+	return nil
+}
+
+func (x *Prompt) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+// ToolExample is an example call of a tool.
+type ToolExample struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// intent says in a few words what the call does, e.g. "List the topics of
+	// cluster prod".
+	Intent string `protobuf:"bytes,1,opt,name=intent,proto3" json:"intent,omitempty"`
+	// arguments is the JSON object of the tool arguments, e.g.
+	// "{\"cluster\":\"prod\"}". Its keys must be arguments of the tool.
+	Arguments     string `protobuf:"bytes,2,opt,name=arguments,proto3" json:"arguments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolExample) Reset() {
+	*x = ToolExample{}
+	mi := &file_mcp_options_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolExample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolExample) ProtoMessage() {}
+
+func (x *ToolExample) ProtoReflect() protoreflect.Message {
+	mi := &file_mcp_options_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolExample.ProtoReflect.Descriptor instead.
+func (*ToolExample) Descriptor() ([]byte, []int) {
+	return file_mcp_options_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ToolExample) GetIntent() string {
+	if x != nil {
+		return x.Intent
+	}
+	return ""
+}
+
+func (x *ToolExample) GetArguments() string {
+	if x != nil {
+		return x.Arguments
+	}
+	return ""
+}
+
+// PromptArgument declares an argument of a prompt.
+type PromptArgument struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the argument name. An argument named like a top-level request
+	// field of the annotated method shares that field's completions.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// description is shown to the user.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// required makes getting the prompt fail without it.
+	Required      bool `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromptArgument) Reset() {
+	*x = PromptArgument{}
+	mi := &file_mcp_options_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromptArgument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptArgument) ProtoMessage() {}
+
+func (x *PromptArgument) ProtoReflect() protoreflect.Message {
+	mi := &file_mcp_options_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptArgument.ProtoReflect.Descriptor instead.
+func (*PromptArgument) Descriptor() ([]byte, []int) {
+	return file_mcp_options_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PromptArgument) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PromptArgument) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PromptArgument) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+var file_mcp_options_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*FieldOptions)(nil),
+		Field:         50741,
+		Name:          "mcp.field",
+		Tag:           "bytes,50741,opt,name=field",
+		Filename:      "mcp/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*MessageOptions)(nil),
+		Field:         50741,
+		Name:          "mcp.message",
+		Tag:           "bytes,50741,opt,name=message",
+		Filename:      "mcp/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.ServiceOptions)(nil),
+		ExtensionType: (*ServiceOptions)(nil),
+		Field:         50741,
+		Name:          "mcp.service",
+		Tag:           "bytes,50741,opt,name=service",
+		Filename:      "mcp/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*MethodOptions)(nil),
+		Field:         50741,
+		Name:          "mcp.method",
+		Tag:           "bytes,50741,opt,name=method",
+		Filename:      "mcp/options.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// field carries per-field MCP options, e.g. [(mcp.field).schema = "{...}"].
+	//
+	// optional mcp.FieldOptions field = 50741;
+	E_Field = &file_mcp_options_proto_extTypes[0]
+)
+
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// message carries per-message MCP options.
+	//
+	// optional mcp.MessageOptions message = 50741;
+	E_Message = &file_mcp_options_proto_extTypes[1]
+)
+
+// Extension fields to descriptorpb.ServiceOptions.
+var (
+	// service carries per-service MCP options.
+	//
+	// optional mcp.ServiceOptions service = 50741;
+	E_Service = &file_mcp_options_proto_extTypes[2]
+)
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// method carries per-RPC MCP options.
+	//
+	// optional mcp.MethodOptions method = 50741;
+	E_Method = &file_mcp_options_proto_extTypes[3]
+)
+
+var File_mcp_options_proto protoreflect.FileDescriptor
+
+const file_mcp_options_proto_rawDesc = "" +
+	"\n" +
+	"\x11mcp/options.proto\x12\x03mcp\x1a google/protobuf/descriptor.proto\"\x9d\x03\n" +
+	"\fFieldOptions\x12\x16\n" +
+	"\x06schema\x18\x01 \x01(\tR\x06schema\x12\x18\n" +
+	"\aexample\x18\x02 \x03(\tR\aexample\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12)\n" +
+	"\x10deprecation_note\x18\x04 \x01(\tR\x0fdeprecationNote\x12\x18\n" +
+	"\adefault\x18\x05 \x01(\tR\adefault\x12!\n" +
+	"\ffrom_context\x18\x06 \x01(\tR\vfromContext\x12\x10\n" +
+	"\x03max\x18\a \x01(\x03R\x03max\x12\x1f\n" +
+	"\vjson_string\x18\b \x01(\bR\n" +
+	"jsonString\x12#\n" +
+	"\rrelative_time\x18\t \x01(\bR\frelativeTime\x12\x12\n" +
+	"\x04file\x18\n" +
+	" \x01(\bR\x04file\x12\x18\n" +
+	"\aexclude\x18\v \x01(\bR\aexclude\x12.\n" +
+	"\x13exclude_from_result\x18\f \x01(\bR\x11excludeFromResult\x12'\n" +
+	"\x0fskip_sanitizers\x18\r \x01(\bR\x0eskipSanitizers\"(\n" +
+	"\x0eMessageOptions\x12\x16\n" +
+	"\x06schema\x18\x01 \x01(\tR\x06schema\"\xe6\x01\n" +
+	"\rMethodOptions\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x129\n" +
+	"\x0eextra_property\x18\x02 \x03(\v2\x12.mcp.ExtraPropertyR\rextraProperty\x12#\n" +
+	"\x06prompt\x18\x03 \x03(\v2\v.mcp.PromptR\x06prompt\x12!\n" +
+	"\fresource_uri\x18\x04 \x01(\tR\vresourceUri\x12*\n" +
+	"\aexample\x18\x05 \x03(\v2\x10.mcp.ToolExampleR\aexample\x12\x10\n" +
+	"\x03tag\x18\x06 \x03(\tR\x03tag\"\x82\x01\n" +
+	"\x0eServiceOptions\x129\n" +
+	"\x0eextra_property\x18\x01 \x03(\v2\x12.mcp.ExtraPropertyR\rextraProperty\x12#\n" +
+	"\x06prompt\x18\x02 \x03(\v2\v.mcp.PromptR\x06prompt\x12\x10\n" +
+	"\x03tag\x18\x03 \x03(\tR\x03tag\"\xb8\x01\n" +
+	"\rExtraProperty\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\x12\x1f\n" +
+	"\vcontext_key\x18\x04 \x01(\tR\n" +
+	"contextKey\x12\x16\n" +
+	"\x06schema\x18\x05 \x01(\tR\x06schema\x12\x1c\n" +
+	"\tsensitive\x18\x06 \x01(\bR\tsensitive\"\xa1\x01\n" +
+	"\x06Prompt\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12/\n" +
+	"\bargument\x18\x04 \x03(\v2\x13.mcp.PromptArgumentR\bargument\x12\x1a\n" +
+	"\btemplate\x18\x05 \x01(\tR\btemplate\"C\n" +
+	"\vToolExample\x12\x16\n" +
+	"\x06intent\x18\x01 \x01(\tR\x06intent\x12\x1c\n" +
+	"\targuments\x18\x02 \x01(\tR\targuments\"b\n" +
+	"\x0ePromptArgument\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired:H\n" +
+	"\x05field\x12\x1d.google.protobuf.FieldOptions\x18\xb5\x8c\x03 \x01(\v2\x11.mcp.FieldOptionsR\x05field:P\n" +
+	"\amessage\x12\x1f.google.protobuf.MessageOptions\x18\xb5\x8c\x03 \x01(\v2\x13.mcp.MessageOptionsR\amessage:P\n" +
+	"\aservice\x12\x1f.google.protobuf.ServiceOptions\x18\xb5\x8c\x03 \x01(\v2\x13.mcp.ServiceOptionsR\aservice:L\n" +
+	"\x06method\x12\x1e.google.protobuf.MethodOptions\x18\xb5\x8c\x03 \x01(\v2\x12.mcp.MethodOptionsR\x06methodBFZDgithub.com/redpanda-data/protoc-gen-go-mcp/pkg/mcpoptions;mcpoptionsb\x06proto3"
+
+var (
+	file_mcp_options_proto_rawDescOnce sync.Once
+	file_mcp_options_proto_rawDescData []byte
+)
+
+func file_mcp_options_proto_rawDescGZIP() []byte {
+	file_mcp_options_proto_rawDescOnce.Do(func() {
+		file_mcp_options_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mcp_options_proto_rawDesc), len(file_mcp_options_proto_rawDesc)))
+	})
+	return file_mcp_options_proto_rawDescData
+}
+
+var file_mcp_options_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_mcp_options_proto_goTypes = []any{
+	(*FieldOptions)(nil),                // 0: mcp.FieldOptions
+	(*MessageOptions)(nil),              // 1: mcp.MessageOptions
+	(*MethodOptions)(nil),               // 2: mcp.MethodOptions
+	(*ServiceOptions)(nil),              // 3: mcp.ServiceOptions
+	(*ExtraProperty)(nil),               // 4: mcp.ExtraProperty
+	(*Prompt)(nil),                      // 5: mcp.Prompt
+	(*ToolExample)(nil),                 // 6: mcp.ToolExample
+	(*PromptArgument)(nil),              // 7: mcp.PromptArgument
+	(*descriptorpb.FieldOptions)(nil),   // 8: google.protobuf.FieldOptions
+	(*descriptorpb.MessageOptions)(nil), // 9: google.protobuf.MessageOptions
+	(*descriptorpb.ServiceOptions)(nil), // 10: google.protobuf.ServiceOptions
+	(*descriptorpb.MethodOptions)(nil),  // 11: google.protobuf.MethodOptions
+}
+var file_mcp_options_proto_depIdxs = []int32{
+	4,  // 0: mcp.MethodOptions.extra_property:type_name -> mcp.ExtraProperty
+	5,  // 1: mcp.MethodOptions.prompt:type_name -> mcp.Prompt
+	6,  // 2: mcp.MethodOptions.example:type_name -> mcp.ToolExample
+	4,  // 3: mcp.ServiceOptions.extra_property:type_name -> mcp.ExtraProperty
+	5,  // 4: mcp.ServiceOptions.prompt:type_name -> mcp.Prompt
+	7,  // 5: mcp.Prompt.argument:type_name -> mcp.PromptArgument
+	8,  // 6: mcp.field:extendee -> google.protobuf.FieldOptions
+	9,  // 7: mcp.message:extendee -> google.protobuf.MessageOptions
+	10, // 8: mcp.service:extendee -> google.protobuf.ServiceOptions
+	11, // 9: mcp.method:extendee -> google.protobuf.MethodOptions
+	0,  // 10: mcp.field:type_name -> mcp.FieldOptions
+	1,  // 11: mcp.message:type_name -> mcp.MessageOptions
+	3,  // 12: mcp.service:type_name -> mcp.ServiceOptions
+	2,  // 13: mcp.method:type_name -> mcp.MethodOptions
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	10, // [10:14] is the sub-list for extension type_name
+	6,  // [6:10] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_mcp_options_proto_init() }
+func file_mcp_options_proto_init() {
+	if File_mcp_options_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mcp_options_proto_rawDesc), len(file_mcp_options_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 4,
+			NumServices:   0,
+		},
+		GoTypes:           file_mcp_options_proto_goTypes,
+		DependencyIndexes: file_mcp_options_proto_depIdxs,
+		MessageInfos:      file_mcp_options_proto_msgTypes,
+		ExtensionInfos:    file_mcp_options_proto_extTypes,
+	}.Build()
+	File_mcp_options_proto = out.File
+	file_mcp_options_proto_goTypes = nil
+	file_mcp_options_proto_depIdxs = nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"unicode/utf8"
+)
+
+// ArgumentLimits bounds the tool-call arguments a model may send, so that
+// runaway output does not push megabytes of garbage into backends. Zero
+// fields are unlimited.
+type ArgumentLimits struct {
+	// MaxBytes caps the size of the arguments encoded as JSON.
+	MaxBytes int
+
+	// MaxStringLength caps the length of every string value, in
+	// characters.
+	MaxStringLength int
+
+	// MaxArrayLength caps the number of elements of every array.
+	MaxArrayLength int
+}
+
+// WithArgumentLimits makes the generated handlers fail calls whose
+// arguments exceed limits with a tool error naming the offending argument,
+// before anything is decoded or sent to the backend.
+func WithArgumentLimits(limits ArgumentLimits) Option {
+	return func(c *config) {
+		c.ArgumentLimits = limits
+	}
+}
+
+// Check returns a model-readable error if args exceed the limits.
+func (l ArgumentLimits) Check(args map[string]any) error {
+	if l.MaxBytes > 0 {
+		encoded, err := json.Marshal(args)
+		if err != nil {
+			return err
+		}
+		if len(encoded) > l.MaxBytes {
+			return fmt.Errorf("the arguments are %d bytes of JSON, over the limit of %d; send less data per call", len(encoded), l.MaxBytes)
+		}
+	}
+	if l.MaxStringLength <= 0 && l.MaxArrayLength <= 0 {
+		return nil
+	}
+	return l.check(args, "")
+}
+
+// check walks v, the value of the argument at path, in sorted key order so
+// the reported argument is stable.
+func (l ArgumentLimits) check(v any, path string) error {
+	switch v := v.(type) {
+	case string:
+		if l.MaxStringLength > 0 {
+			if n := utf8.RuneCountInString(v); n > l.MaxStringLength {
+				return fmt.Errorf("argument %q is %d characters long, over the limit of %d; shorten it", path, n, l.MaxStringLength)
+			}
+		}
+	case []any:
+		if l.MaxArrayLength > 0 && len(v) > l.MaxArrayLength {
+			return fmt.Errorf("argument %q has %d elements, over the limit of %d; send fewer", path, len(v), l.MaxArrayLength)
+		}
+		for i, e := range v {
+			if err := l.check(e, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		for _, k := range slices.Sorted(maps.Keys(v)) {
+			child := k
+			if path != "" {
+				child = path + "." + k
+			}
+			if err := l.check(v[k], child); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Limit returns a handler that fails calls over the limits with a tool error
+// and passes the others to handler. Zero limits return handler unchanged.
+func (l ArgumentLimits) Limit(handler ToolHandler) ToolHandler {
+	if l == (ArgumentLimits{}) {
+		return handler
+	}
+	return func(ctx context.Context, request *CallToolRequest) (*CallToolResult, error) {
+		if err := l.Check(request.Arguments); err != nil {
+			return NewToolResultError(err.Error()), nil
+		}
+		return handler(ctx, request)
+	}
+}
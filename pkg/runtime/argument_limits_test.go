@@ -0,0 +1,76 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func TestArgumentLimits_Check(t *testing.T) {
+	t.Run("bytes", func(t *testing.T) {
+		g := NewWithT(t)
+		limits := runtime.ArgumentLimits{MaxBytes: 20}
+		g.Expect(limits.Check(map[string]any{"name": "short"})).To(Succeed())
+		g.Expect(limits.Check(map[string]any{"name": strings.Repeat("x", 20)})).
+			To(MatchError("the arguments are 31 bytes of JSON, over the limit of 20; send less data per call"))
+	})
+
+	t.Run("string length counts characters", func(t *testing.T) {
+		g := NewWithT(t)
+		limits := runtime.ArgumentLimits{MaxStringLength: 3}
+		g.Expect(limits.Check(map[string]any{"name": "äöü"})).To(Succeed())
+		g.Expect(limits.Check(map[string]any{"item": map[string]any{"tags": []any{"ok", "toolong"}}})).
+			To(MatchError(`argument "item.tags[1]" is 7 characters long, over the limit of 3; shorten it`))
+	})
+
+	t.Run("array length", func(t *testing.T) {
+		g := NewWithT(t)
+		limits := runtime.ArgumentLimits{MaxArrayLength: 2}
+		g.Expect(limits.Check(map[string]any{"ids": []any{1.0, 2.0}})).To(Succeed())
+		g.Expect(limits.Check(map[string]any{"a": []any{[]any{1.0, 2.0, 3.0}}})).
+			To(MatchError(`argument "a[0]" has 3 elements, over the limit of 2; send fewer`))
+	})
+
+	t.Run("zero limits allow anything", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(runtime.ArgumentLimits{}.Check(map[string]any{"name": strings.Repeat("x", 1<<20)})).To(Succeed())
+	})
+}
+
+func TestWithArgumentLimits(t *testing.T) {
+	g := NewWithT(t)
+	config := runtime.NewConfig()
+	runtime.WithArgumentLimits(runtime.ArgumentLimits{MaxStringLength: 4})(config)
+	called := false
+	handler := runtime.ApplyHandlerConfig("tool", config, func(context.Context, *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		called = true
+		return runtime.NewToolResultText("ok"), nil
+	})
+
+	result, err := handler(context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"name": "too long"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeTrue())
+	g.Expect(result.Text).To(ContainSubstring(`argument "name" is 8 characters long`))
+	g.Expect(called).To(BeFalse())
+
+	result, err = handler(context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"name": "ok"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Text).To(Equal("ok"))
+}
@@ -0,0 +1,191 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DefaultWarningHeaders are the response headers BackendWarnings reports
+// when Headers is nil: the Deprecation and Sunset headers of RFC 9745 and
+// RFC 8594, and Warning.
+var DefaultWarningHeaders = []string{"Deprecation", "Sunset", "Warning"}
+
+// BackendWarnings selects the backend signals WithBackendWarnings forwards
+// to the client.
+type BackendWarnings struct {
+	// Headers are the response headers, or gRPC header and trailer
+	// metadata, reported when the backend sets them. Nil means
+	// DefaultWarningHeaders.
+	Headers []string
+
+	// PartialFailures reports top-level google.rpc.Status fields of
+	// successful responses that carry an error, such as the
+	// partial_failure_error of batch APIs.
+	PartialFailures bool
+
+	// SlowCall reports backend calls taking longer than this. Zero
+	// disables it.
+	SlowCall time.Duration
+}
+
+// WithBackendWarnings sends the backend signals selected by warnings to the
+// client as "warning" log messages (notifications/message) named after the
+// tool, so users see operational warnings without them cluttering the tool
+// result. Each message is an object with a human-readable "message" and the
+// details of the signal. Nothing is sent to sessions that cannot receive log
+// messages, see Logger.
+func WithBackendWarnings(warnings BackendWarnings) Option {
+	return func(c *config) {
+		if warnings.Headers == nil {
+			warnings.Headers = DefaultWarningHeaders
+		}
+		c.BackendWarnings = &warnings
+	}
+}
+
+// backendSignals collects the response headers of the backend calls of a
+// tool call for WithBackendWarnings.
+type backendSignals struct {
+	tool     string
+	warnings *BackendWarnings
+
+	mu     sync.Mutex
+	header http.Header
+}
+
+type backendSignalsKey struct{}
+
+// backendSignalsFromContext returns the signals of the tool call of ctx, or
+// nil if WithBackendWarnings is not set.
+func backendSignalsFromContext(ctx context.Context) *backendSignals {
+	s, _ := ctx.Value(backendSignalsKey{}).(*backendSignals)
+	return s
+}
+
+// warnBackend returns handler, with the backend signals of its calls sent
+// as WithBackendWarnings describes.
+func warnBackend(tool string, warnings *BackendWarnings, handler ToolHandler) ToolHandler {
+	return func(ctx context.Context, request *CallToolRequest) (*CallToolResult, error) {
+		if LoggerFromContext(ctx) == nil {
+			return handler(ctx, request)
+		}
+		return handler(context.WithValue(ctx, backendSignalsKey{}, &backendSignals{tool: tool, warnings: warnings}), request)
+	}
+}
+
+// recordBackendHeader records the response headers of a backend call.
+func recordBackendHeader(ctx context.Context, header http.Header) {
+	s := backendSignalsFromContext(ctx)
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.header == nil {
+		s.header = http.Header{}
+	}
+	for k, vs := range header {
+		for _, v := range vs {
+			s.header.Add(k, v)
+		}
+	}
+}
+
+// warningCallOptions returns the gRPC call options recording the header
+// and trailer metadata of the call.
+func warningCallOptions(ctx context.Context) []grpc.CallOption {
+	if backendSignalsFromContext(ctx) == nil {
+		return nil
+	}
+	header, trailer := metadata.MD{}, metadata.MD{}
+	return []grpc.CallOption{grpc.Header(&header), grpc.Trailer(&trailer), grpc.OnFinish(func(error) {
+		recordBackendHeader(ctx, http.Header(header))
+		recordBackendHeader(ctx, http.Header(trailer))
+	})}
+}
+
+// check sends the warnings of a backend call that took elapsed and returned
+// resp and err.
+func (s *backendSignals) check(ctx context.Context, elapsed time.Duration, resp any, err error) {
+	warn := func(data map[string]any) {
+		_ = Log(ctx, LogLevelWarning, s.tool, data)
+	}
+	if s.warnings.SlowCall > 0 && elapsed > s.warnings.SlowCall {
+		warn(map[string]any{
+			"message":      fmt.Sprintf("backend call took %s, longer than %s", elapsed.Round(time.Millisecond), s.warnings.SlowCall),
+			"latency_ms":   float64(elapsed.Microseconds()) / 1000,
+			"threshold_ms": float64(s.warnings.SlowCall.Microseconds()) / 1000,
+		})
+	}
+	var msg proto.Message
+	if err == nil {
+		switch r := resp.(type) {
+		case connect.AnyResponse:
+			recordBackendHeader(ctx, r.Header())
+			recordBackendHeader(ctx, r.Trailer())
+			msg, _ = r.Any().(proto.Message)
+		case proto.Message:
+			msg = r
+		}
+	}
+
+	s.mu.Lock()
+	header := s.header
+	s.header = nil
+	s.mu.Unlock()
+	for _, name := range s.warnings.Headers {
+		for _, v := range header.Values(name) {
+			warn(map[string]any{
+				"message": fmt.Sprintf("backend responded with %s: %s", http.CanonicalHeaderKey(name), v),
+				"header":  http.CanonicalHeaderKey(name),
+				"value":   v,
+			})
+		}
+	}
+
+	if s.warnings.PartialFailures && msg != nil && msg.ProtoReflect().IsValid() {
+		m := msg.ProtoReflect()
+		fields := m.Descriptor().Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if fd.Cardinality() == protoreflect.Repeated || fd.Message() == nil || fd.Message().FullName() != "google.rpc.Status" || !m.Has(fd) {
+				continue
+			}
+			st := m.Get(fd).Message()
+			c := code.Code(st.Get(st.Descriptor().Fields().ByName("code")).Int())
+			if c == code.Code_OK {
+				continue
+			}
+			message := st.Get(st.Descriptor().Fields().ByName("message")).String()
+			warn(map[string]any{
+				"message": fmt.Sprintf("partial failure in %s: %s", fd.Name(), message),
+				"field":   string(fd.Name()),
+				"status":  c.String(),
+			})
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	. "github.com/onsi/gomega"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+// logRecorder records the log messages it is given.
+type logRecorder struct {
+	mu       sync.Mutex
+	messages []map[string]any
+}
+
+func (r *logRecorder) Log(_ context.Context, level runtime.LogLevel, logger string, data any) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messages = append(r.messages, map[string]any{"level": level, "logger": logger, "data": data})
+	return nil
+}
+
+func TestLog(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(runtime.Log(context.Background(), runtime.LogLevelInfo, "", "dropped")).To(Succeed())
+
+	r := &logRecorder{}
+	ctx := runtime.WithLogger(context.Background(), r)
+	g.Expect(runtime.LoggerFromContext(ctx)).To(BeIdenticalTo(r))
+	g.Expect(runtime.Log(ctx, runtime.LogLevelInfo, "tool", "hello")).To(Succeed())
+	g.Expect(r.messages).To(Equal([]map[string]any{{"level": runtime.LogLevelInfo, "logger": "tool", "data": "hello"}}))
+}
+
+// partialFailureResponse returns a message
+//
+//	message BatchResponse {
+//	  google.rpc.Status partial_failure_error = 1;
+//	}
+//
+// with partial_failure_error set to st.
+func partialFailureResponse(t *testing.T, st *spb.Status) *dynamicpb.Message {
+	t.Helper()
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("partial_failure_fixture.proto"),
+		Package:    proto.String("fixture"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/rpc/status.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("BatchResponse"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("partial_failure_error"),
+				JsonName: proto.String("partialFailureError"),
+				Number:   proto.Int32(1),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+				TypeName: proto.String(".google.rpc.Status"),
+			}},
+		}},
+	}
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatalf("building fixture: %v", err)
+	}
+	md := fd.Messages().ByName("BatchResponse")
+	msg := dynamicpb.NewMessage(md)
+	msg.Set(md.Fields().ByName("partial_failure_error"), protoreflect.ValueOfMessage(st.ProtoReflect()))
+	return msg
+}
+
+func TestWithBackendWarnings(t *testing.T) {
+	g := NewWithT(t)
+	config := runtime.NewConfig()
+	runtime.WithBackendWarnings(runtime.BackendWarnings{PartialFailures: true, SlowCall: time.Nanosecond})(config)
+	handler := runtime.ApplyHandlerConfig("tool", config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		done := runtime.StartBackendCall(ctx)
+		time.Sleep(time.Millisecond)
+		resp := connect.NewResponse(partialFailureResponse(t, &spb.Status{Code: 3, Message: "row 2 is invalid"}))
+		resp.Header().Set("Deprecation", "@1767225600")
+		resp.Header().Set("X-Other", "ignored")
+		resp.Trailer().Set("Warning", `299 - "use v2"`)
+		done(resp, nil)
+		return runtime.NewToolResultText("ok"), nil
+	})
+
+	r := &logRecorder{}
+	result, err := handler(runtime.WithLogger(context.Background(), r), &runtime.CallToolRequest{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Text).To(Equal("ok"))
+	g.Expect(r.messages).To(HaveLen(4))
+	for _, m := range r.messages {
+		g.Expect(m).To(HaveKeyWithValue("level", runtime.LogLevelWarning))
+		g.Expect(m).To(HaveKeyWithValue("logger", "tool"))
+	}
+	g.Expect(r.messages[0]["data"]).To(HaveKeyWithValue("message", HavePrefix("backend call took ")))
+	g.Expect(r.messages[1]["data"]).To(Equal(map[string]any{
+		"message": "backend responded with Deprecation: @1767225600",
+		"header":  "Deprecation",
+		"value":   "@1767225600",
+	}))
+	g.Expect(r.messages[2]["data"]).To(HaveKeyWithValue("header", "Warning"))
+	g.Expect(r.messages[3]["data"]).To(Equal(map[string]any{
+		"message": "partial failure in partial_failure_error: row 2 is invalid",
+		"field":   "partial_failure_error",
+		"status":  "INVALID_ARGUMENT",
+	}))
+}
+
+func TestWithBackendWarnings_Quiet(t *testing.T) {
+	g := NewWithT(t)
+	config := runtime.NewConfig()
+	runtime.WithBackendWarnings(runtime.BackendWarnings{PartialFailures: true, SlowCall: time.Hour})(config)
+	handler := runtime.ApplyHandlerConfig("tool", config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		done := runtime.StartBackendCall(ctx)
+		done(partialFailureResponse(t, &spb.Status{}), nil)
+		return runtime.NewToolResultText("ok"), nil
+	})
+
+	// A fast call with an OK status and no warning headers sends nothing.
+	r := &logRecorder{}
+	_, err := handler(runtime.WithLogger(context.Background(), r), &runtime.CallToolRequest{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(r.messages).To(BeEmpty())
+
+	// Neither does a session without a logger.
+	_, err = handler(context.Background(), &runtime.CallToolRequest{})
+	g.Expect(err).ToNot(HaveOccurred())
+}
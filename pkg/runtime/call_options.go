@@ -0,0 +1,49 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// WithCallOptions attaches the gRPC call options that options returns to
+// every backend call a ForwardTo client makes for a tool call, e.g.
+// compression or per-call credentials. options gets the call's ctx, which
+// carries the extra properties and forwarded headers, and the tool name as
+// registered, including any name prefix. Repeated WithCallOptions add up.
+// Connect clients take no call options and ignore it.
+func WithCallOptions(options func(ctx context.Context, toolName string) []grpc.CallOption) Option {
+	return func(c *config) {
+		if previous := c.CallOptions; previous != nil {
+			c.CallOptions = func(ctx context.Context, toolName string) []grpc.CallOption {
+				return append(previous(ctx, toolName), options(ctx, toolName)...)
+			}
+			return
+		}
+		c.CallOptions = options
+	}
+}
+
+// ApplyCallOptions returns the gRPC call options of the tool name for the
+// backend call of a tool call.
+func ApplyCallOptions(ctx context.Context, name string, config *config) []grpc.CallOption {
+	opts := append(diagnosticCallOptions(ctx), warningCallOptions(ctx)...)
+	if config.CallOptions == nil {
+		return opts
+	}
+	return append(opts, config.CallOptions(ctx, name)...)
+}
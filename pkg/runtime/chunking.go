@@ -0,0 +1,103 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+)
+
+// ChunkedMetaKey is the _meta key under which a result chunked by
+// WithResultChunking records how: "size" is the size of the whole text in
+// bytes and "items" the number of content items it was sent as.
+const ChunkedMetaKey = "chunked"
+
+// WithResultChunking sends text content larger than size bytes as several
+// ordered content items of at most size bytes each, for clients that
+// mishandle a single huge string. Every chunk but the first starts with a
+// "[part 2 of 3]" marker and every chunk but the last ends with a
+// "[continues in part 3 of 3]" one. Chunks are cut between UTF-8 characters,
+// so JSON has to be put back together before it is parsed. Chunked results
+// carry ChunkedMetaKey in their _meta. Structured content stays whole, and
+// tool errors are never chunked. Combined with WithSplitResults, each split
+// item is chunked on its own.
+func WithResultChunking(size int) Option {
+	return func(c *config) {
+		c.ChunkSize = size
+	}
+}
+
+// chunkResults returns handler, with its results chunked as
+// WithResultChunking describes.
+func chunkResults(handler ToolHandler, size int) ToolHandler {
+	return func(ctx context.Context, request *CallToolRequest) (*CallToolResult, error) {
+		result, err := handler(ctx, request)
+		if err != nil || result == nil || result.IsError {
+			return result, err
+		}
+		items := result.Parts
+		if items == nil {
+			items = []string{result.Text}
+		}
+		var parts []string
+		for _, item := range items {
+			parts = append(parts, ChunkText(item, size)...)
+		}
+		if len(parts) == len(items) {
+			return result, nil
+		}
+		result.Parts = parts
+		if result.Meta == nil {
+			result.Meta = map[string]any{}
+		}
+		result.Meta[ChunkedMetaKey] = map[string]any{"size": len(result.Text), "items": len(parts)}
+		return result, nil
+	}
+}
+
+// ChunkText splits text into chunks of at most size bytes, not counting
+// the continuation markers of WithResultChunking. Text that fits is
+// returned as the only chunk.
+func ChunkText(text string, size int) []string {
+	if size <= 0 || len(text) <= size {
+		return []string{text}
+	}
+	var chunks []string
+	for len(text) > size {
+		cut := size
+		for cut > 0 && !utf8.RuneStart(text[cut]) {
+			cut--
+		}
+		if cut == 0 {
+			// size is smaller than the first character.
+			_, cut = utf8.DecodeRuneInString(text)
+		}
+		chunks = append(chunks, text[:cut])
+		text = text[cut:]
+	}
+	if text != "" {
+		chunks = append(chunks, text)
+	}
+	for i := range chunks {
+		if i > 0 {
+			chunks[i] = fmt.Sprintf("[part %d of %d]\n", i+1, len(chunks)) + chunks[i]
+		}
+		if i < len(chunks)-1 {
+			chunks[i] += fmt.Sprintf("\n[continues in part %d of %d]", i+2, len(chunks))
+		}
+	}
+	return chunks
+}
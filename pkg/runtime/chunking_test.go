@@ -0,0 +1,112 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func TestChunkText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		size int
+		want []string
+	}{
+		{name: "fits", text: "abc", size: 3, want: []string{"abc"}},
+		{name: "disabled", text: "abc", size: 0, want: []string{"abc"}},
+		{
+			name: "three chunks",
+			text: "abcdefg",
+			size: 3,
+			want: []string{
+				"abc\n[continues in part 2 of 3]",
+				"[part 2 of 3]\ndef\n[continues in part 3 of 3]",
+				"[part 3 of 3]\ng",
+			},
+		},
+		{
+			// "é" is two bytes and is not cut in half.
+			name: "multi-byte characters",
+			text: "aébc",
+			size: 2,
+			want: []string{"a\n[continues in part 2 of 3]", "[part 2 of 3]\né\n[continues in part 3 of 3]", "[part 3 of 3]\nbc"},
+		},
+		{
+			name: "character larger than size",
+			text: "日本",
+			size: 1,
+			want: []string{"日\n[continues in part 2 of 2]", "[part 2 of 2]\n本"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(runtime.ChunkText(tt.text, tt.size)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestWithResultChunking(t *testing.T) {
+	g := NewWithT(t)
+	text := `{"items":["` + strings.Repeat("x", 20) + `"]}`
+	config := runtime.NewConfig()
+	runtime.WithResultChunking(16)(config)
+	handler := runtime.ApplyHandlerConfig("tool", config, func(context.Context, *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		return runtime.NewToolResultJSON([]byte(text)), nil
+	})
+
+	result, err := handler(context.Background(), &runtime.CallToolRequest{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Text).To(Equal(text))
+	g.Expect(result.Parts).To(HaveLen(3))
+	g.Expect(result.Meta).To(HaveKeyWithValue(runtime.ChunkedMetaKey, map[string]any{"size": len(text), "items": 3}))
+
+	// Small results are left alone.
+	runtime.WithResultChunking(len(text))(config)
+	handler = runtime.ApplyHandlerConfig("tool", config, func(context.Context, *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		return runtime.NewToolResultJSON([]byte(text)), nil
+	})
+	result, err = handler(context.Background(), &runtime.CallToolRequest{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Parts).To(BeNil())
+	g.Expect(result.Meta).To(BeNil())
+}
+
+func TestWithResultChunkingSplitResults(t *testing.T) {
+	g := NewWithT(t)
+	config := runtime.NewConfig()
+	runtime.WithSplitResults()(config)
+	runtime.WithResultChunking(12)(config)
+	handler := runtime.ApplyHandlerConfig("tool", config, func(context.Context, *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		return runtime.NewToolResultJSON([]byte(`{"items":[1,"` + strings.Repeat("y", 12) + `"]}`)), nil
+	})
+
+	result, err := handler(context.Background(), &runtime.CallToolRequest{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Parts).To(Equal([]string{
+		"items[0] (1 \n[continues in part 2 of 2]",
+		"[part 2 of 2]\nof 2):\n1",
+		"items[1] (2 \n[continues in part 2 of 3]",
+		"[part 2 of 3]\nof 2):\n\"yyyy\n[continues in part 3 of 3]",
+		"[part 3 of 3]\nyyyyyyyy\"",
+	}))
+}
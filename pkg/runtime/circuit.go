@@ -0,0 +1,81 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import "time"
+
+// circuitOptions are the Threshold and Cooldown shared by FailoverOptions
+// and CircuitBreakerOptions, which convert to it.
+type circuitOptions struct {
+	Threshold int
+	Cooldown  time.Duration
+}
+
+// circuit counts the consecutive failures of one backend endpoint or
+// target, for Failover and CircuitBreaker. It opens after
+// circuitOptions.Threshold of them and lets no call through until the
+// cooldown has passed; then one trial call, the probe, closes it if it
+// succeeds. Its owner guards it with a mutex.
+type circuit struct {
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// callOutcome is how a call through a circuit ended.
+type callOutcome int
+
+const (
+	callSucceeded callOutcome = iota
+	callFailed
+	callCancelled
+)
+
+// available reports whether a call may go through at now.
+func (c *circuit) available(now time.Time, opts circuitOptions) bool {
+	return c.failures < opts.Threshold || !c.probing && !now.Before(c.openUntil)
+}
+
+// acquire reports whether a call may go through at now, and whether it is
+// the probe of an open circuit whose cooldown has passed.
+func (c *circuit) acquire(now time.Time, opts circuitOptions) (ok, probe bool) {
+	if c.failures < opts.Threshold {
+		return true, false
+	}
+	if !c.available(now, opts) {
+		return false, false
+	}
+	c.probing = true
+	return true, true
+}
+
+// release records the outcome of a call that acquire let through, opening
+// the circuit until now plus the cooldown once it has failed too often.
+// Only the probe ends probing: a call let through before the circuit
+// opened may finish while the probe is still running.
+func (c *circuit) release(now time.Time, opts circuitOptions, probe bool, outcome callOutcome) {
+	if probe {
+		c.probing = false
+	}
+	switch outcome {
+	case callSucceeded:
+		c.failures = 0
+	case callFailed:
+		c.failures++
+		if c.failures >= opts.Threshold {
+			c.openUntil = now.Add(opts.Cooldown)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// CircuitBreakerOptions tunes when a CircuitBreaker stops calling a target.
+type CircuitBreakerOptions struct {
+	// Threshold is the number of consecutive failures after which a
+	// target's circuit opens. Zero means 5.
+	Threshold int
+
+	// Cooldown is how long an open circuit fails calls to its target before
+	// a single call may try it again. Zero means 30 seconds.
+	Cooldown time.Duration
+}
+
+// CircuitBreaker fails calls to a backend target, e.g. a host, quickly once
+// it keeps failing, instead of letting an eager agent stack up slow failing
+// calls. Failures are connection errors and UNAVAILABLE or DEADLINE_EXCEEDED
+// errors, and 502, 503 and 504 responses over HTTP; calls the caller
+// cancelled count neither way. Each target has a circuit that opens after
+// CircuitBreakerOptions.Threshold consecutive failures. While it is open,
+// calls fail with UNAVAILABLE, whose message tells the model when to retry
+// and which carries a google.rpc.RetryInfo. Once the cooldown has passed,
+// one call tries the target again and closes the circuit if it succeeds.
+//
+// A CircuitBreaker is opt-in and, unlike WithHedging, not a runtime.Option
+// of the generated forwarders: circuits are kept per target, which only the
+// client knows. Attach it to the forwarding layer with
+// UnaryClientInterceptor for gRPC connections, ConnectInterceptor for
+// Connect clients and HTTPClient for the ForwardTo<Service>HTTP forwarders.
+// A CircuitBreaker is safe for concurrent use and can be shared between
+// them. Failover keeps the same kind of circuit per endpoint.
+type CircuitBreaker struct {
+	opts CircuitBreakerOptions
+	now  func() time.Time
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+// NewCircuitBreaker returns a CircuitBreaker with all circuits closed.
+func NewCircuitBreaker(opts CircuitBreakerOptions) *CircuitBreaker {
+	if opts.Threshold <= 0 {
+		opts.Threshold = 5
+	}
+	if opts.Cooldown <= 0 {
+		opts.Cooldown = 30 * time.Second
+	}
+	return &CircuitBreaker{opts: opts, now: time.Now, circuits: map[string]*circuit{}}
+}
+
+// acquire returns an UNAVAILABLE error if the circuit of target is open, and
+// reports whether the call is the probe of a circuit whose cooldown has
+// passed.
+func (b *CircuitBreaker) acquire(target string) (probe bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := b.circuits[target]
+	if c == nil {
+		return false, nil
+	}
+	now := b.now()
+	ok, probe := c.acquire(now, circuitOptions(b.opts))
+	if ok {
+		return probe, nil
+	}
+	retry := max(c.openUntil.Sub(now), time.Second).Round(time.Second)
+	st := status.New(codes.Unavailable, fmt.Sprintf("backend %s is unhealthy after %d consecutive failures, retry after %s", target, c.failures, retry))
+	if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retry)}); err == nil {
+		st = detailed
+	}
+	return false, st.Err()
+}
+
+// release records the outcome of a call to target.
+func (b *CircuitBreaker) release(target string, probe bool, outcome callOutcome) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := b.circuits[target]
+	if c == nil {
+		c = &circuit{}
+		b.circuits[target] = c
+	}
+	c.release(b.now(), circuitOptions(b.opts), probe, outcome)
+}
+
+// rpcOutcome classifies the result err of an RPC made with ctx.
+func rpcOutcome(ctx context.Context, err error) callOutcome {
+	switch {
+	case err == nil:
+		return callSucceeded
+	case errors.Is(ctx.Err(), context.Canceled):
+		return callCancelled
+	case connectionFailure(err),
+		status.Code(err) == codes.DeadlineExceeded,
+		connect.CodeOf(err) == connect.CodeDeadlineExceeded:
+		return callFailed
+	default:
+		// The backend answered.
+		return callSucceeded
+	}
+}
+
+// UnaryClientInterceptor returns a gRPC interceptor breaking the circuit of
+// each connection target, for grpc.WithChainUnaryInterceptor.
+func (b *CircuitBreaker) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		target := cc.Target()
+		probe, err := b.acquire(target)
+		if err != nil {
+			return err
+		}
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		b.release(target, probe, rpcOutcome(ctx, err))
+		return err
+	}
+}
+
+// ConnectInterceptor returns a Connect interceptor breaking the circuit of
+// each backend host, for connect.WithInterceptors. Streams pass through.
+func (b *CircuitBreaker) ConnectInterceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			target := req.Peer().Addr
+			probe, err := b.acquire(target)
+			if err != nil {
+				return nil, err
+			}
+			resp, err := next(ctx, req)
+			b.release(target, probe, rpcOutcome(ctx, err))
+			return resp, err
+		}
+	})
+}
+
+// HTTPClient returns client, breaking the circuit of each backend host.
+func (b *CircuitBreaker) HTTPClient(client HTTPClient) HTTPClient {
+	return circuitHTTPClient{breaker: b, client: client}
+}
+
+type circuitHTTPClient struct {
+	breaker *CircuitBreaker
+	client  HTTPClient
+}
+
+func (c circuitHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	target := req.URL.Host
+	probe, err := c.breaker.acquire(target)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Do(req)
+	outcome := rpcOutcome(req.Context(), err)
+	if err == nil {
+		switch resp.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			outcome = callFailed
+		}
+	}
+	c.breaker.release(target, probe, outcome)
+	return resp, err
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Unix(0, 0)
+	b := NewCircuitBreaker(CircuitBreakerOptions{Threshold: 2, Cooldown: 10 * time.Second})
+	b.now = func() time.Time { return now }
+
+	conn, err := grpc.NewClient("passthrough:///backend:443", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	g.Expect(err).ToNot(HaveOccurred())
+	defer conn.Close()
+	interceptor := b.UnaryClientInterceptor()
+	var backendErr error
+	calls := 0
+	call := func(ctx context.Context) error {
+		return interceptor(ctx, "/svc/Method", nil, nil, conn, func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+			calls++
+			return backendErr
+		})
+	}
+	ctx := context.Background()
+
+	// Errors from a reachable backend and cancelled calls keep the circuit closed.
+	backendErr = status.Error(codes.NotFound, "no such cluster")
+	g.Expect(call(ctx)).ToNot(Succeed())
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	backendErr = status.Error(codes.Unavailable, "connection refused")
+	g.Expect(call(cancelled)).ToNot(Succeed())
+	g.Expect(call(ctx)).ToNot(Succeed())
+	g.Expect(calls).To(Equal(3))
+
+	// The second consecutive failure opens it.
+	backendErr = status.Error(codes.DeadlineExceeded, "deadline exceeded")
+	g.Expect(call(ctx)).ToNot(Succeed())
+	now = now.Add(4 * time.Second)
+	err = call(ctx)
+	g.Expect(calls).To(Equal(4))
+	st := status.Convert(err)
+	g.Expect(st.Code()).To(Equal(codes.Unavailable))
+	g.Expect(st.Message()).To(Equal("backend passthrough:///backend:443 is unhealthy after 2 consecutive failures, retry after 6s"))
+	g.Expect(st.Details()).To(HaveLen(1))
+	g.Expect(st.Details()[0].(*errdetails.RetryInfo).GetRetryDelay().AsDuration()).To(Equal(6 * time.Second))
+
+	// After the cooldown a single trial call goes through; others still fail fast.
+	now = now.Add(6 * time.Second)
+	backendErr = nil
+	probe, err := b.acquire(conn.Target())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(probe).To(BeTrue())
+	g.Expect(call(ctx)).ToNot(Succeed())
+	b.release(conn.Target(), probe, callSucceeded)
+	g.Expect(call(ctx)).To(Succeed())
+	g.Expect(calls).To(Equal(5))
+
+	// A failed trial reopens the circuit.
+	backendErr = status.Error(codes.Unavailable, "connection refused")
+	g.Expect(call(ctx)).ToNot(Succeed())
+	g.Expect(call(ctx)).ToNot(Succeed())
+	now = now.Add(10 * time.Second)
+	g.Expect(call(ctx)).ToNot(Succeed())
+	g.Expect(calls).To(Equal(8))
+	g.Expect(call(ctx)).ToNot(Succeed())
+	g.Expect(calls).To(Equal(8))
+}
+
+func TestCircuitBreaker_Probe(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Unix(0, 0)
+	b := NewCircuitBreaker(CircuitBreakerOptions{Threshold: 1, Cooldown: 10 * time.Second})
+	b.now = func() time.Time { return now }
+	const target = "backend:443"
+	b.release(target, false, callSucceeded)
+
+	// A slow call goes through while the circuit is closed, then another
+	// call opens it.
+	stale, err := b.acquire(target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(stale).To(BeFalse())
+	b.release(target, false, callFailed)
+	now = now.Add(10 * time.Second)
+	probe, err := b.acquire(target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(probe).To(BeTrue())
+
+	// The slow call finishing doesn't let a second probe through.
+	b.release(target, stale, callFailed)
+	now = now.Add(10 * time.Second)
+	_, err = b.acquire(target)
+	g.Expect(status.Code(err)).To(Equal(codes.Unavailable))
+
+	b.release(target, probe, callSucceeded)
+	_, err = b.acquire(target)
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestCircuitBreaker_HTTPClient(t *testing.T) {
+	g := NewWithT(t)
+	code := http.StatusServiceUnavailable
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.WriteHeader(code)
+	}))
+	defer srv.Close()
+	client := NewCircuitBreaker(CircuitBreakerOptions{Threshold: 2}).HTTPClient(srv.Client())
+	do := func() error {
+		req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return errors.New(resp.Status)
+		}
+		return nil
+	}
+
+	// Client errors are answers, not failures.
+	code = http.StatusNotFound
+	g.Expect(do()).ToNot(Succeed())
+	code = http.StatusServiceUnavailable
+	g.Expect(do()).ToNot(Succeed())
+	g.Expect(do()).ToNot(Succeed())
+	g.Expect(calls).To(Equal(3))
+
+	err := do()
+	g.Expect(status.Code(err)).To(Equal(codes.Unavailable))
+	g.Expect(err.Error()).To(ContainSubstring("is unhealthy after 2 consecutive failures, retry after 30s"))
+	g.Expect(calls).To(Equal(3))
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import "context"
+
+// ClientInfo describes the MCP client a tool call came from, as it introduced
+// itself when the session was initialized. Handlers can use it for
+// per-client behavior, e.g. to return structured content only to clients
+// that read it.
+type ClientInfo struct {
+	Name    string
+	Version string
+	// ProtocolVersion is the MCP version the client asked for, if the MCP
+	// library records it.
+	ProtocolVersion string
+	Capabilities    ClientCapabilities
+}
+
+// ClientCapabilities lists the optional MCP features the client declared.
+type ClientCapabilities struct {
+	Roots       bool
+	Sampling    bool
+	Elicitation bool
+	// Experimental holds non-standard capabilities by name.
+	Experimental map[string]any
+}
+
+type clientInfoKey struct{}
+
+// WithClientInfo returns a copy of ctx carrying info. Adapters call it for
+// every tool call of an initialized session.
+func WithClientInfo(ctx context.Context, info *ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, info)
+}
+
+// ClientInfoFromContext returns the ClientInfo in ctx, or nil if the client
+// is unknown, e.g. because the session was not initialized.
+func ClientInfoFromContext(ctx context.Context) *ClientInfo {
+	info, _ := ctx.Value(clientInfoKey{}).(*ClientInfo)
+	return info
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// CodecOptions configures UnmarshalArguments and MarshalResult. The zero
+// value behaves like a generated handler with default plugin options.
+type CodecOptions struct {
+	// WrapInput is the property the request fields are nested under, as set
+	// with the wrap_input plugin option. Empty reads them from the top level.
+	WrapInput string
+
+	// FlattenNested reassembles the dotted properties of the flatten_nested
+	// plugin option into nested objects.
+	FlattenNested bool
+
+	// RelativeTimes accepts the Timestamp and Duration forms of the
+	// relative_times plugin option in every field; see NormalizeTimes.
+	RelativeTimes bool
+
+	// StringSanitizers clean up the string values of the arguments like
+	// WithStringSanitizers; see SanitizeStrings.
+	StringSanitizers []StringSanitizer
+
+	// RejectUnknown fails on arguments that are not fields of the message.
+	// Generated handlers silently drop them.
+	RejectUnknown bool
+
+	// IdempotencyScope scopes the idempotency key derived for a request that
+	// leaves it out; pass IdempotencyScope of the call's context so other
+	// sessions and tenants never share a key. See FillIdempotencyKey.
+	IdempotencyScope string
+
+	// TextOnly returns the result as text content only, for tools whose
+	// declared output schema the message does not match.
+	TextOnly bool
+}
+
+// UnmarshalArguments decodes tool call arguments into a new message of type
+// md the same way generated handlers do: discriminated oneof wrappers and
+// recursion placeholders are rewritten, and the result is read with
+// protojson. The message is of the registered Go type when there is one,
+// and dynamic otherwise. args may be rewritten in place.
+//
+// Extra properties, headers and dry_run are not removed; hand-written tools
+// extract them first with ExtractExtraProperties, ExtractHeaders and
+// ExtractDryRun. Errors of the argument rewriting are model-readable and
+// best returned with NewToolResultError.
+func UnmarshalArguments(md protoreflect.MessageDescriptor, args map[string]any, opts CodecOptions) (proto.Message, error) {
+	var msg proto.Message
+	if mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName()); err == nil && mt.Descriptor() == md {
+		msg = mt.New().Interface()
+	} else {
+		msg = dynamicpb.NewMessage(md)
+	}
+	// google.protobuf.Empty takes no arguments; ignore whatever the model sent.
+	if md.FullName() == "google.protobuf.Empty" {
+		return msg, nil
+	}
+
+	args, err := UnwrapArguments(args, opts.WrapInput)
+	if err != nil {
+		return nil, err
+	}
+	if args == nil {
+		args = map[string]any{}
+	}
+	if opts.FlattenNested {
+		if err := UnflattenArguments(args); err != nil {
+			return nil, err
+		}
+	}
+	if err := DecodeArguments(md, args); err != nil {
+		return nil, err
+	}
+	if err := SanitizeStrings(md, args, opts.StringSanitizers); err != nil {
+		return nil, err
+	}
+	if opts.RelativeTimes {
+		if err := NormalizeTimes(md, args); err != nil {
+			return nil, err
+		}
+	}
+	if err := fillIdempotencyKey(md, args, opts.IdempotencyScope); err != nil {
+		return nil, err
+	}
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: !opts.RejectUnknown}).Unmarshal(marshaled, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// MarshalResult encodes msg as the successful result of a tool call the same
+// way generated handlers do, matching the output schema generated for its
+// type. See EncodeMessage.
+func MarshalResult(msg proto.Message, opts CodecOptions) (*CallToolResult, error) {
+	encoded, err := EncodeMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+	if opts.TextOnly {
+		return NewToolResultText(string(encoded)), nil
+	}
+	return NewToolResultJSON(encoded), nil
+}
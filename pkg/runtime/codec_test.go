@@ -0,0 +1,132 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestUnmarshalArguments(t *testing.T) {
+	g := NewWithT(t)
+
+	md := (&testdata.MultipleOneofsRequest{}).ProtoReflect().Descriptor()
+	msg, err := runtime.UnmarshalArguments(md, map[string]any{
+		"name":    "n",
+		"source":  map[string]any{"which": "url", "url": "http://x"},
+		"unknown": true,
+	}, runtime.CodecOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(msg).To(BeAssignableToTypeOf(&testdata.MultipleOneofsRequest{}))
+	g.Expect(msg.(*testdata.MultipleOneofsRequest).GetUrl()).To(Equal("http://x"))
+
+	// Decoding errors are the model-readable ones of DecodeArguments.
+	_, err = runtime.UnmarshalArguments(md, map[string]any{
+		"source": map[string]any{"which": "nope"},
+	}, runtime.CodecOptions{})
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = runtime.UnmarshalArguments(md, map[string]any{"unknown": true}, runtime.CodecOptions{RejectUnknown: true})
+	g.Expect(err).To(MatchError(ContainSubstring("unknown")))
+}
+
+func TestUnmarshalArgumentsSanitizesStrings(t *testing.T) {
+	g := NewWithT(t)
+
+	md := (&testdata.MultipleOneofsRequest{}).ProtoReflect().Descriptor()
+	opts := runtime.CodecOptions{StringSanitizers: []runtime.StringSanitizer{runtime.StripControlCharacters}}
+	msg, err := runtime.UnmarshalArguments(md, map[string]any{
+		"name":   "n\x00ame",
+		"source": map[string]any{"which": "url", "url": "http://x\x1b"},
+	}, opts)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(msg.(*testdata.MultipleOneofsRequest).GetName()).To(Equal("name"))
+	g.Expect(msg.(*testdata.MultipleOneofsRequest).GetUrl()).To(Equal("http://x"))
+
+	// A sanitizer error fails the call like in generated handlers.
+	opts.StringSanitizers = append(opts.StringSanitizers, func(fd protoreflect.FieldDescriptor, value string) (string, error) {
+		return "", fmt.Errorf("%s is not allowed", fd.Name())
+	})
+	_, err = runtime.UnmarshalArguments(md, map[string]any{"name": "n"}, opts)
+	g.Expect(err).To(MatchError(ContainSubstring("name is not allowed")))
+}
+
+func TestUnmarshalArgumentsWrapInput(t *testing.T) {
+	g := NewWithT(t)
+
+	md := (&testdata.GetItemRequest{}).ProtoReflect().Descriptor()
+	msg, err := runtime.UnmarshalArguments(md, map[string]any{
+		"request": map[string]any{"id": "a"},
+	}, runtime.CodecOptions{WrapInput: "request"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(msg.(*testdata.GetItemRequest).GetId()).To(Equal("a"))
+
+	_, err = runtime.UnmarshalArguments(md, map[string]any{"id": "a"}, runtime.CodecOptions{WrapInput: "request"})
+	g.Expect(err).To(MatchError(ContainSubstring(`missing argument "request"`)))
+}
+
+func TestUnmarshalArgumentsEmptyIgnoresArguments(t *testing.T) {
+	g := NewWithT(t)
+
+	msg, err := runtime.UnmarshalArguments((&emptypb.Empty{}).ProtoReflect().Descriptor(), map[string]any{"x": 1}, runtime.CodecOptions{RejectUnknown: true})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(msg).To(BeAssignableToTypeOf(&emptypb.Empty{}))
+}
+
+func TestUnmarshalArgumentsDynamic(t *testing.T) {
+	g := NewWithT(t)
+
+	// A descriptor that is not the registered one decodes into a dynamic
+	// message, e.g. one loaded from a descriptor set at runtime.
+	fd, err := protodesc.NewFile(protodesc.ToFileDescriptorProto(testdata.File_testdata_test_service_proto), protoregistry.GlobalFiles)
+	g.Expect(err).ToNot(HaveOccurred())
+	md := fd.Messages().ByName("GetItemRequest")
+	msg, err := runtime.UnmarshalArguments(md, map[string]any{"id": "a"}, runtime.CodecOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(msg).To(BeAssignableToTypeOf(&dynamicpb.Message{}))
+	g.Expect(msg.ProtoReflect().Get(md.Fields().ByName("id")).String()).To(Equal("a"))
+}
+
+func TestMarshalResult(t *testing.T) {
+	g := NewWithT(t)
+
+	msg := &testdata.MultipleOneofsRequest{Name: "n", Source: &testdata.MultipleOneofsRequest_Url{Url: "http://x"}}
+	encoded, err := runtime.EncodeMessage(msg)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	result, err := runtime.MarshalResult(msg, runtime.CodecOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeFalse())
+	g.Expect(result.Text).To(MatchJSON(encoded))
+	g.Expect(result.StructuredContent).To(MatchJSON(encoded))
+	var structured map[string]any
+	g.Expect(json.Unmarshal(result.StructuredContent.(json.RawMessage), &structured)).To(Succeed())
+	g.Expect(structured["source"]).To(HaveKeyWithValue("which", "url"))
+
+	result, err = runtime.MarshalResult(msg, runtime.CodecOptions{TextOnly: true})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Text).To(MatchJSON(encoded))
+	g.Expect(result.StructuredContent).To(BeNil())
+}
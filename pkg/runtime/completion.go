@@ -0,0 +1,245 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MaxCompletionValues is the most values a completion returns, the cap the
+// MCP specification sets for completion/complete results.
+const MaxCompletionValues = 100
+
+// Completer returns the candidate values of an argument. value is what the
+// user typed so far and args holds the arguments already filled in. The
+// registry filters the candidates by value, so a completer may return all of
+// them.
+type Completer func(ctx context.Context, value string, args map[string]string) ([]string, error)
+
+// Completion is the result of completing an argument.
+type Completion struct {
+	// Values are the matching candidates, at most MaxCompletionValues.
+	Values []string
+	// Total is the number of matching candidates.
+	Total int
+	// HasMore is set when Values was truncated.
+	HasMore bool
+}
+
+// CompletionRegistry holds the completers of tool arguments, keyed by tool
+// name and argument name. Generated Register and ForwardTo functions add the
+// completers of their tools to the registry passed with WithCompletions, and
+// the MCP library adapter serves completion requests from it.
+type CompletionRegistry struct {
+	mu         sync.RWMutex
+	completers map[string]map[string]Completer
+}
+
+// NewCompletionRegistry returns an empty completion registry.
+func NewCompletionRegistry() *CompletionRegistry {
+	return &CompletionRegistry{completers: map[string]map[string]Completer{}}
+}
+
+// WithCompletions makes the generated registration functions add the
+// completers of their tools to r.
+func WithCompletions(r *CompletionRegistry) Option {
+	return func(c *config) {
+		c.Completions = r
+	}
+}
+
+// Add registers c as the completer of argument of the named tool, replacing
+// any earlier one. Adding to a nil registry does nothing, so generated code
+// can call it whether or not completions are enabled.
+func (r *CompletionRegistry) Add(name, argument string, c Completer) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.completers[name] == nil {
+		r.completers[name] = map[string]Completer{}
+	}
+	r.completers[name][argument] = c
+}
+
+// Inherit registers, under name, the completers that the from tool has for
+// arguments. A prompt wrapping a tool uses it to share the tool's
+// completions; arguments without a completer are skipped.
+func (r *CompletionRegistry) Inherit(name, from string, arguments ...string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, argument := range arguments {
+		c := r.completers[from][argument]
+		if c == nil {
+			continue
+		}
+		if r.completers[name] == nil {
+			r.completers[name] = map[string]Completer{}
+		}
+		r.completers[name][argument] = c
+	}
+}
+
+// Remove forgets the completers of the named tools, prompts or resource
+// templates.
+func (r *CompletionRegistry) Remove(names ...string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, name := range names {
+		delete(r.completers, name)
+	}
+}
+
+// Replace takes the completers of from, in place of all those of the same
+// tools, and removes the completers of the tools in remove, in one step.
+func (r *CompletionRegistry) Replace(from *CompletionRegistry, remove ...string) {
+	if r == nil {
+		return
+	}
+	from.mu.RLock()
+	defer from.mu.RUnlock()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, name := range remove {
+		delete(r.completers, name)
+	}
+	for name, completers := range from.completers {
+		r.completers[name] = completers
+	}
+}
+
+// Complete returns the candidates of argument of the named tool that start
+// with value, ignoring case. An unknown tool or argument completes to nothing.
+func (r *CompletionRegistry) Complete(ctx context.Context, name, argument, value string, args map[string]string) (*Completion, error) {
+	completion := &Completion{Values: []string{}}
+	if r == nil {
+		return completion, nil
+	}
+	r.mu.RLock()
+	c := r.completers[name][argument]
+	r.mu.RUnlock()
+	if c == nil {
+		return completion, nil
+	}
+	candidates, err := c(ctx, value, args)
+	if err != nil {
+		return nil, err
+	}
+	prefix := strings.ToLower(value)
+	for _, v := range candidates {
+		if strings.HasPrefix(strings.ToLower(v), prefix) {
+			completion.Values = append(completion.Values, v)
+		}
+	}
+	completion.Total = len(completion.Values)
+	if completion.Total > MaxCompletionValues {
+		completion.Values = completion.Values[:MaxCompletionValues]
+		completion.HasMore = true
+	}
+	return completion, nil
+}
+
+// EnumCompleter completes to a fixed set of values, such as the names of an
+// enum.
+func EnumCompleter(values ...string) Completer {
+	return func(context.Context, string, map[string]string) ([]string, error) {
+		return values, nil
+	}
+}
+
+// ResourceCompleter completes resource names by calling list, the List RPC
+// of the referenced resource type. See ListCompleter for how the request is
+// built and the names are read from the response.
+func ResourceCompleter[Req, Resp proto.Message](list func(context.Context, Req) (Resp, error)) Completer {
+	var zero Req
+	typ := zero.ProtoReflect().Type()
+	return ListCompleter(
+		func() proto.Message { return typ.New().Interface() },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			resp, err := list(ctx, req.(Req))
+			if err != nil {
+				return nil, err
+			}
+			return resp, nil
+		},
+	)
+}
+
+// ListCompleter completes resource names through a List RPC following
+// AIP-132. A string "parent" field in the request is set from the "parent"
+// argument when the user already filled it in, and an integer "page_size"
+// field asks for MaxCompletionValues. The names are the "name" fields of the
+// first repeated message field of the response. Only the first page is
+// read.
+func ListCompleter(newRequest func() proto.Message, list func(context.Context, proto.Message) (proto.Message, error)) Completer {
+	return func(ctx context.Context, _ string, args map[string]string) ([]string, error) {
+		req := newRequest()
+		m := req.ProtoReflect()
+		fields := m.Descriptor().Fields()
+		if fd := fields.ByName("parent"); fd != nil && !fd.IsList() && fd.Kind() == protoreflect.StringKind && args["parent"] != "" {
+			m.Set(fd, protoreflect.ValueOfString(args["parent"]))
+		}
+		if fd := fields.ByName("page_size"); fd != nil && !fd.IsList() {
+			switch fd.Kind() {
+			case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+				m.Set(fd, protoreflect.ValueOfInt32(MaxCompletionValues))
+			case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+				m.Set(fd, protoreflect.ValueOfInt64(MaxCompletionValues))
+			}
+		}
+		resp, err := list(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return resourceNames(resp.ProtoReflect()), nil
+	}
+}
+
+// resourceNames returns the "name" fields of the first repeated message field
+// of a List response.
+func resourceNames(resp protoreflect.Message) []string {
+	fields := resp.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !fd.IsList() || fd.Message() == nil {
+			continue
+		}
+		nameField := fd.Message().Fields().ByName("name")
+		if nameField == nil || nameField.Kind() != protoreflect.StringKind || nameField.IsList() {
+			continue
+		}
+		list := resp.Get(fd).List()
+		names := make([]string, 0, list.Len())
+		for j := 0; j < list.Len(); j++ {
+			if name := list.Get(j).Message().Get(nameField).String(); name != "" {
+				names = append(names, name)
+			}
+		}
+		return names
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestCompletionRegistry(t *testing.T) {
+	t.Run("enum values filtered by prefix", func(t *testing.T) {
+		g := NewWithT(t)
+		reg := runtime.NewCompletionRegistry()
+		reg.Add("svc_Create", "priority", runtime.EnumCompleter("PRIORITY_LOW", "PRIORITY_HIGH", "URGENT"))
+
+		got, err := reg.Complete(context.Background(), "svc_Create", "priority", "priority_h", nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).To(Equal(&runtime.Completion{Values: []string{"PRIORITY_HIGH"}, Total: 1}))
+	})
+
+	t.Run("unknown argument completes to nothing", func(t *testing.T) {
+		g := NewWithT(t)
+		reg := runtime.NewCompletionRegistry()
+		got, err := reg.Complete(context.Background(), "svc_Create", "name", "", nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got.Values).To(BeEmpty())
+		g.Expect(got.Values).ToNot(BeNil())
+	})
+
+	t.Run("nil registry", func(t *testing.T) {
+		g := NewWithT(t)
+		var reg *runtime.CompletionRegistry
+		reg.Add("svc_Create", "priority", runtime.EnumCompleter("LOW"))
+		got, err := reg.Complete(context.Background(), "svc_Create", "priority", "", nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got.Values).To(BeEmpty())
+	})
+
+	t.Run("truncated to the MCP limit", func(t *testing.T) {
+		g := NewWithT(t)
+		var values []string
+		for i := 0; i < 150; i++ {
+			values = append(values, fmt.Sprintf("v%03d", i))
+		}
+		reg := runtime.NewCompletionRegistry()
+		reg.Add("svc_Create", "tag", runtime.EnumCompleter(values...))
+		got, err := reg.Complete(context.Background(), "svc_Create", "tag", "v", nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got.Values).To(HaveLen(runtime.MaxCompletionValues))
+		g.Expect(got.Total).To(Equal(150))
+		g.Expect(got.HasMore).To(BeTrue())
+	})
+
+	t.Run("completer error", func(t *testing.T) {
+		g := NewWithT(t)
+		reg := runtime.NewCompletionRegistry()
+		reg.Add("svc_Create", "config", func(context.Context, string, map[string]string) ([]string, error) {
+			return nil, errors.New("unavailable")
+		})
+		_, err := reg.Complete(context.Background(), "svc_Create", "config", "", nil)
+		g.Expect(err).To(MatchError("unavailable"))
+	})
+}
+
+func TestResourceCompleter(t *testing.T) {
+	g := NewWithT(t)
+	var got *testdata.ListConfigsRequest
+	complete := runtime.ResourceCompleter(func(_ context.Context, req *testdata.ListConfigsRequest) (*testdata.ListConfigsResponse, error) {
+		got = req
+		return &testdata.ListConfigsResponse{Configs: []*testdata.Config{
+			{Name: "configs/a"},
+			{},
+			{Name: "configs/b"},
+		}}, nil
+	})
+
+	names, err := complete(context.Background(), "configs/", map[string]string{"parent": "ignored"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(names).To(Equal([]string{"configs/a", "configs/b"}))
+	g.Expect(got.GetPageSize()).To(BeEquivalentTo(runtime.MaxCompletionValues))
+
+	complete = runtime.ResourceCompleter(func(context.Context, *testdata.ListConfigsRequest) (*testdata.ListConfigsResponse, error) {
+		return nil, errors.New("unavailable")
+	})
+	_, err = complete(context.Background(), "", nil)
+	g.Expect(err).To(MatchError("unavailable"))
+}
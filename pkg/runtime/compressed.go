@@ -0,0 +1,129 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// CompressedSchemas holds the tool schemas of a file generated with
+// compress_schemas=true: a gzip-compressed JSON object mapping tool names to
+// their schemas. They are decompressed once, when the schemas of the first
+// tool are loaded, usually by the first tools/list, so binaries that link
+// many large APIs only pay for what they list.
+type CompressedSchemas struct {
+	data string
+
+	once    sync.Once
+	schemas map[string]compressedSchema
+}
+
+type compressedSchema struct {
+	Input  json.RawMessage `json:"input"`
+	Output json.RawMessage `json:"output,omitempty"`
+}
+
+// NewCompressedSchemas returns the schemas compressed into data by
+// CompressSchemas.
+func NewCompressedSchemas(data string) *CompressedSchemas {
+	return &CompressedSchemas{data: data}
+}
+
+// Tool returns tool with LoadSchemas set to load the schemas stored for its
+// name. Loading panics if the data is corrupt or has no schemas for the
+// tool, which only happens when a generated file was edited.
+func (s *CompressedSchemas) Tool(tool Tool) Tool {
+	name := tool.Name
+	tool.LoadSchemas = func() (json.RawMessage, json.RawMessage) {
+		s.once.Do(func() {
+			schemas, err := decompressSchemas(s.data)
+			if err != nil {
+				panic(fmt.Sprintf("runtime: decompressing tool schemas: %v", err))
+			}
+			s.schemas = schemas
+		})
+		schema, ok := s.schemas[name]
+		if !ok {
+			panic(fmt.Sprintf("runtime: no compressed schemas for tool %q", name))
+		}
+		return schema.Input, schema.Output
+	}
+	return tool
+}
+
+// CompressSchemas gzips the schemas of tools for NewCompressedSchemas. The
+// output is deterministic, so regenerating unchanged protos yields the same
+// file.
+func CompressSchemas(tools []Tool) (string, error) {
+	schemas := make(map[string]compressedSchema, len(tools))
+	for _, tool := range tools {
+		schemas[tool.Name] = compressedSchema{Input: tool.RawInputSchema, Output: tool.RawOutputSchema}
+	}
+	raw, err := json.Marshal(schemas)
+	if err != nil {
+		return "", err
+	}
+	return compress(raw)
+}
+
+// CompressDefinitions gzips the shared schema definitions defs for
+// NewSchemaDefinitions.
+func CompressDefinitions(defs json.RawMessage) (string, error) {
+	return compress(defs)
+}
+
+func decompressSchemas(data string) (map[string]compressedSchema, error) {
+	raw, err := decompress(data)
+	if err != nil {
+		return nil, err
+	}
+	var schemas map[string]compressedSchema
+	if err := json.Unmarshal(raw, &schemas); err != nil {
+		return nil, err
+	}
+	return schemas, nil
+}
+
+// gzipMagic starts every gzip stream, and no JSON document.
+const gzipMagic = "\x1f\x8b"
+
+func compress(raw []byte) (string, error) {
+	var buf bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := zw.Write(raw); err != nil {
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func decompress(data string) ([]byte, error) {
+	zr, err := gzip.NewReader(strings.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(zr)
+}
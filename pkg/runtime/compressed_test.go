@@ -0,0 +1,53 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func TestCompressedSchemas(t *testing.T) {
+	g := NewWithT(t)
+	get := runtime.Tool{
+		Name:            "svc_Get",
+		Description:     "Get an item.",
+		RawInputSchema:  json.RawMessage(`{"properties":{"id":{"type":"string"}},"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"name":{"type":"string"}},"type":"object"}`),
+	}
+	ping := runtime.Tool{
+		Name:           "svc_Ping",
+		RawInputSchema: json.RawMessage(`{"type":"object"}`),
+	}
+	data, err := runtime.CompressSchemas([]runtime.Tool{get, ping})
+	g.Expect(err).ToNot(HaveOccurred())
+	again, err := runtime.CompressSchemas([]runtime.Tool{ping, get})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(again).To(Equal(data))
+
+	// Nothing is decompressed until the schemas are loaded.
+	schemas := runtime.NewCompressedSchemas(data)
+	lazy := schemas.Tool(runtime.Tool{Name: "svc_Get", Description: "Get an item."})
+	g.Expect(lazy.RawInputSchema).To(BeNil())
+	g.Expect(runtime.NewCompressedSchemas("not gzip").Tool(get).Name).To(Equal("svc_Get"))
+
+	g.Expect(lazy.Loaded()).To(Equal(get))
+	g.Expect(schemas.Tool(runtime.Tool{Name: "svc_Ping"}).Loaded()).To(Equal(ping))
+	g.Expect(func() { schemas.Tool(runtime.Tool{Name: "svc_Delete"}).Loaded() }).To(PanicWith(`runtime: no compressed schemas for tool "svc_Delete"`))
+	g.Expect(func() { runtime.NewCompressedSchemas("not gzip").Tool(get).Loaded() }).To(Panic())
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+// ConnectProtocol is the wire protocol of the client of a generated
+// ForwardToConnect<Service>URL function.
+type ConnectProtocol string
+
+const (
+	// ConnectProtocolConnect is the Connect protocol, the default.
+	ConnectProtocolConnect ConnectProtocol = "connect"
+	// ConnectProtocolGRPC is the gRPC protocol, for gRPC backends.
+	ConnectProtocolGRPC ConnectProtocol = "grpc"
+	// ConnectProtocolGRPCWeb is the gRPC-Web protocol, for backends behind
+	// a proxy that only speaks HTTP/1.1.
+	ConnectProtocolGRPCWeb ConnectProtocol = "grpcweb"
+)
+
+// ConnectCodec is the message encoding of the client of a generated
+// ForwardToConnect<Service>URL function.
+type ConnectCodec string
+
+const (
+	// ConnectCodecProto is the binary protobuf encoding, the default.
+	ConnectCodecProto ConnectCodec = "proto"
+	// ConnectCodecJSON is the protobuf JSON encoding.
+	ConnectCodecJSON ConnectCodec = "json"
+)
+
+// WithConnectProtocol selects the wire protocol the generated
+// ForwardToConnect<Service>URL functions talk to the backend with.
+func WithConnectProtocol(protocol ConnectProtocol) Option {
+	return func(c *config) {
+		c.ConnectProtocol = protocol
+	}
+}
+
+// WithConnectCodec selects the message encoding the generated
+// ForwardToConnect<Service>URL functions send to the backend.
+func WithConnectCodec(codec ConnectCodec) Option {
+	return func(c *config) {
+		c.ConnectCodec = codec
+	}
+}
+
+// WithConnectClientOptions passes further options, such as interceptors or
+// compression, to the client of the generated ForwardToConnect<Service>URL
+// functions. Repeated WithConnectClientOptions add up.
+func WithConnectClientOptions(opts ...connect.ClientOption) Option {
+	return func(c *config) {
+		c.ConnectOptions = append(c.ConnectOptions, opts...)
+	}
+}
+
+// WithConnectHeaders sets the request headers that headers returns on every
+// backend call a ForwardToConnect client makes for a tool call, e.g. an
+// authorization header taken from the caller's session. headers gets the
+// call's ctx and the tool name as registered, including any name prefix.
+// The headers replace forwarded headers of the same name. Repeated
+// WithConnectHeaders add up. gRPC clients ignore it; see WithCallOptions.
+func WithConnectHeaders(headers func(ctx context.Context, toolName string) http.Header) Option {
+	return func(c *config) {
+		c.ConnectHeaders = append(c.ConnectHeaders, headers)
+	}
+}
+
+// ConnectClientOptions returns the client options of the protocol, codec
+// and further options set with WithConnectProtocol, WithConnectCodec and
+// WithConnectClientOptions.
+func ConnectClientOptions(config *config) []connect.ClientOption {
+	var opts []connect.ClientOption
+	switch config.ConnectProtocol {
+	case ConnectProtocolGRPC:
+		opts = append(opts, connect.WithGRPC())
+	case ConnectProtocolGRPCWeb:
+		opts = append(opts, connect.WithGRPCWeb())
+	}
+	if config.ConnectCodec == ConnectCodecJSON {
+		opts = append(opts, connect.WithProtoJSON())
+	}
+	return append(opts, config.ConnectOptions...)
+}
+
+// ApplyConnectHeaders sets the request headers of the tool name on header,
+// the headers of the backend call of a tool call.
+func ApplyConnectHeaders(ctx context.Context, name string, config *config, header http.Header) {
+	for _, headers := range config.ConnectHeaders {
+		for key, values := range headers(ctx, name) {
+			header[http.CanonicalHeaderKey(key)] = values
+		}
+	}
+}
+
+// ConnectProcedureURL returns the URL of procedure, e.g.
+// "/acme.v1.ClusterService/GetCluster", on the backend at baseURL.
+func ConnectProcedureURL(baseURL, procedure string) string {
+	return strings.TrimRight(baseURL, "/") + procedure
+}
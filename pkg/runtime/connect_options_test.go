@@ -0,0 +1,66 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	. "github.com/onsi/gomega"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func TestConnectClientOptions(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(runtime.ConnectClientOptions(runtime.NewConfig())).To(BeEmpty())
+
+	config := runtime.NewConfig()
+	for _, opt := range []runtime.Option{
+		runtime.WithConnectProtocol(runtime.ConnectProtocolGRPC),
+		runtime.WithConnectCodec(runtime.ConnectCodecJSON),
+		runtime.WithConnectClientOptions(connect.WithSendGzip()),
+	} {
+		opt(config)
+	}
+	g.Expect(runtime.ConnectClientOptions(config)).To(HaveLen(3))
+}
+
+func TestApplyConnectHeaders(t *testing.T) {
+	g := NewWithT(t)
+	config := runtime.NewConfig()
+	runtime.WithConnectHeaders(func(_ context.Context, toolName string) http.Header {
+		return http.Header{"x-tool": {toolName}, "Authorization": {"Bearer a"}}
+	})(config)
+	runtime.WithConnectHeaders(func(context.Context, string) http.Header {
+		return http.Header{"Authorization": {"Bearer b"}}
+	})(config)
+
+	header := http.Header{"Authorization": {"forwarded"}, "X-Request-Id": {"r1"}}
+	runtime.ApplyConnectHeaders(context.Background(), "get_item", config, header)
+	g.Expect(header).To(Equal(http.Header{
+		"Authorization": {"Bearer b"},
+		"X-Request-Id":  {"r1"},
+		"X-Tool":        {"get_item"},
+	}))
+}
+
+func TestConnectProcedureURL(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(runtime.ConnectProcedureURL("https://api.example.com/", "/acme.v1.ClusterService/GetCluster")).To(Equal("https://api.example.com/acme.v1.ClusterService/GetCluster"))
+	g.Expect(runtime.ConnectProcedureURL("https://api.example.com", "/acme.v1.ClusterService/GetCluster")).To(Equal("https://api.example.com/acme.v1.ClusterService/GetCluster"))
+}
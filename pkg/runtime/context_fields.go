@@ -0,0 +1,79 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ContextField supplies the value of request fields annotated with
+// (mcp.field).from_context = Name. It is the inverse of ExtraProperty: instead
+// of moving a tool argument into the context, the handler reads
+// ctx.Value(ContextKey) and writes it into the request, so the model never sees
+// or sets the field.
+type ContextField struct {
+	Name       string
+	ContextKey interface{}
+}
+
+// WithContextFields registers the context values that back
+// (mcp.field).from_context annotations.
+func WithContextFields(fields ...ContextField) Option {
+	return func(c *config) {
+		c.ContextFields = append(c.ContextFields, fields...)
+	}
+}
+
+// PopulateFromContext sets every top-level field of md annotated with
+// (mcp.field).from_context to its value from ctx, overwriting whatever the
+// model put in args. It fails closed: a field whose name has no registered
+// ContextField, or whose context value is missing, is an error rather than
+// left for the model to fill.
+func PopulateFromContext(ctx context.Context, md protoreflect.MessageDescriptor, args map[string]any, fields []ContextField) error {
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		name := fieldOptions(fd).GetFromContext()
+		if name == "" {
+			continue
+		}
+		key, ok := contextKey(fields, name)
+		if !ok {
+			return fmt.Errorf("field %q takes its value from context %q, but no runtime.ContextField is registered under that name", fd.Name(), name)
+		}
+		v := ctx.Value(key)
+		if v == nil {
+			return fmt.Errorf("field %q takes its value from context %q, but the context carries no value for it", fd.Name(), name)
+		}
+		if args == nil {
+			return fmt.Errorf("field %q takes its value from context %q, but there are no arguments to set it in", fd.Name(), name)
+		}
+		delete(args, fd.JSONName())
+		args[string(fd.Name())] = v
+	}
+	return nil
+}
+
+// contextKey returns the ContextKey registered under name.
+func contextKey(fields []ContextField, name string) (interface{}, bool) {
+	for _, f := range fields {
+		if f.Name == name {
+			return f.ContextKey, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+type tenantKey struct{}
+
+func TestPopulateFromContext(t *testing.T) {
+	md := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor()
+	fields := []runtime.ContextField{{Name: "tenant", ContextKey: tenantKey{}}}
+	ctx := context.WithValue(context.Background(), tenantKey{}, "org-1")
+
+	t.Run("sets field from context", func(t *testing.T) {
+		g := NewWithT(t)
+		args := map[string]any{"name": "p"}
+		g.Expect(runtime.PopulateFromContext(ctx, md, args, fields)).To(Succeed())
+		g.Expect(args).To(Equal(map[string]any{"name": "p", "organization_id": "org-1"}))
+	})
+
+	t.Run("overwrites model-supplied value under either name", func(t *testing.T) {
+		g := NewWithT(t)
+		args := map[string]any{"organization_id": "spoofed", "organizationId": "spoofed"}
+		g.Expect(runtime.PopulateFromContext(ctx, md, args, fields)).To(Succeed())
+		g.Expect(args).To(Equal(map[string]any{"organization_id": "org-1"}))
+	})
+
+	t.Run("unregistered name fails closed", func(t *testing.T) {
+		g := NewWithT(t)
+		err := runtime.PopulateFromContext(ctx, md, map[string]any{}, nil)
+		g.Expect(err).To(MatchError(ContainSubstring(`no runtime.ContextField is registered under that name`)))
+	})
+
+	t.Run("missing context value fails closed", func(t *testing.T) {
+		g := NewWithT(t)
+		err := runtime.PopulateFromContext(context.Background(), md, map[string]any{}, fields)
+		g.Expect(err).To(MatchError(ContainSubstring(`the context carries no value for it`)))
+	})
+
+	t.Run("nil arguments fail instead of panicking", func(t *testing.T) {
+		g := NewWithT(t)
+		err := runtime.PopulateFromContext(ctx, md, nil, fields)
+		g.Expect(err).To(MatchError(ContainSubstring(`there are no arguments to set it in`)))
+	})
+
+	t.Run("messages without annotations are untouched", func(t *testing.T) {
+		g := NewWithT(t)
+		args := map[string]any{"name": "p"}
+		other := (&testdata.ApplyConfigResponse{}).ProtoReflect().Descriptor()
+		g.Expect(runtime.PopulateFromContext(context.Background(), other, args, nil)).To(Succeed())
+		g.Expect(args).To(Equal(map[string]any{"name": "p"}))
+	})
+}
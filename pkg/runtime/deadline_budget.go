@@ -0,0 +1,128 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"time"
+)
+
+// DeadlineBudget bounds the time a tool call may take as a whole, e.g. so
+// that it answers before the client gives up on it. The budget is shared by
+// every stage of the call instead of each stage getting the full timeout:
+// time spent waiting in a worker pool is gone, the backend call stops early
+// enough to leave Reserve for encoding the response, and retries against
+// standby endpoints split what is left; see AttemptContext.
+type DeadlineBudget struct {
+	// Timeout is the deadline of every call, counted from when it arrives.
+	// A timeout the client sent in _meta applies if it is earlier. Zero
+	// leaves only the client's timeout.
+	Timeout time.Duration
+
+	// Reserve is kept back from the backend call for turning its response
+	// into the tool result.
+	Reserve time.Duration
+}
+
+// WithDeadlineBudget makes the generated handlers run every call within
+// budget.
+func WithDeadlineBudget(budget DeadlineBudget) Option {
+	return func(c *config) {
+		c.DeadlineBudget = budget
+	}
+}
+
+type deadlineBudgetKey struct{}
+
+// Bound returns a handler that runs handler within the budget. A zero budget
+// returns handler unchanged.
+func (b DeadlineBudget) Bound(handler ToolHandler) ToolHandler {
+	if b == (DeadlineBudget{}) {
+		return handler
+	}
+	return func(ctx context.Context, request *CallToolRequest) (*CallToolResult, error) {
+		ctx = context.WithValue(ctx, deadlineBudgetKey{}, b.Reserve)
+		if b.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, b.Timeout)
+			defer cancel()
+		}
+		return handler(ctx, request)
+	}
+}
+
+// AttemptContext returns a copy of ctx for the next of attemptsLeft
+// attempts a call still has to make, e.g. retries or polls of a long-running
+// operation. Within a DeadlineBudget, the attempt gets an even share of the
+// time left, so a hanging first attempt leaves time for the others.
+// Otherwise, or for the last attempt, it gets all of it. The caller must
+// always call the returned cancel function.
+func AttemptContext(ctx context.Context, attemptsLeft int) (context.Context, context.CancelFunc) {
+	_, budgeted := ctx.Value(deadlineBudgetKey{}).(time.Duration)
+	deadline, ok := ctx.Deadline()
+	if !budgeted || !ok || attemptsLeft <= 1 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, time.Until(deadline)/time.Duration(attemptsLeft))
+}
+
+// Poll calls poll every interval until it reports done or fails, e.g. to
+// wait for a long-running operation, and fails with the error of ctx if that
+// ends first. Within a DeadlineBudget, each poll gets its share of the time
+// left among the polls that still fit before the deadline, see
+// AttemptContext, so a hanging poll leaves time for the next ones; a poll
+// that runs out of its share is not an error.
+func Poll(ctx context.Context, interval time.Duration, poll func(ctx context.Context) (done bool, err error)) error {
+	for {
+		attemptsLeft := 1
+		if deadline, ok := ctx.Deadline(); ok && interval > 0 {
+			attemptsLeft += int(time.Until(deadline) / interval)
+		}
+		attemptCtx, cancel := AttemptContext(ctx, attemptsLeft)
+		done, err := poll(attemptCtx)
+		expired := attemptCtx.Err() != nil && ctx.Err() == nil
+		cancel()
+		if err != nil && !expired {
+			return err
+		}
+		if done && err == nil {
+			return nil
+		}
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserveDeadline moves deadline, or the deadline of ctx if it is earlier,
+// forward by the reserve of the DeadlineBudget of ctx. It returns the zero
+// time if there is no deadline.
+func reserveDeadline(ctx context.Context, deadline time.Time) time.Time {
+	reserve, ok := ctx.Value(deadlineBudgetKey{}).(time.Duration)
+	if !ok || reserve <= 0 {
+		return deadline
+	}
+	if parent, ok := ctx.Deadline(); ok && (deadline.IsZero() || parent.Before(deadline)) {
+		deadline = parent
+	}
+	if deadline.IsZero() {
+		return deadline
+	}
+	return deadline.Add(-reserve)
+}
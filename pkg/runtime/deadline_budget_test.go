@@ -0,0 +1,133 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func TestDeadlineBudget(t *testing.T) {
+	// budgetHandler reports the time left for the backend call and for one of
+	// three attempts.
+	var remaining, attempt time.Duration
+	handler := func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+		remaining = 0
+		if deadline, ok := ctx.Deadline(); ok {
+			remaining = time.Until(deadline)
+		}
+		attemptCtx, cancel := runtime.AttemptContext(ctx, 3)
+		defer cancel()
+		attempt = 0
+		if deadline, ok := attemptCtx.Deadline(); ok {
+			attempt = time.Until(deadline)
+		}
+		return runtime.NewToolResultText("ok"), nil
+	}
+
+	t.Run("timeout and reserve", func(t *testing.T) {
+		g := NewWithT(t)
+		config := runtime.NewConfig()
+		runtime.WithDeadlineBudget(runtime.DeadlineBudget{Timeout: time.Minute, Reserve: 15 * time.Second})(config)
+		h := runtime.ApplyHandlerConfig("svc_Get", config, handler)
+
+		_, err := h(context.Background(), &runtime.CallToolRequest{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(remaining).To(BeNumerically("~", 45*time.Second, time.Second))
+		g.Expect(attempt).To(BeNumerically("~", 15*time.Second, time.Second))
+
+		// An earlier client timeout wins, minus the reserve.
+		_, err = h(context.Background(), &runtime.CallToolRequest{Meta: map[string]any{"timeout": float64(30)}})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(remaining).To(BeNumerically("~", 15*time.Second, time.Second))
+	})
+
+	t.Run("reserve only", func(t *testing.T) {
+		g := NewWithT(t)
+		h := runtime.DeadlineBudget{Reserve: 5 * time.Second}.Bound(handler)
+
+		_, err := h(context.Background(), &runtime.CallToolRequest{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(remaining).To(BeZero())
+
+		_, err = h(context.Background(), &runtime.CallToolRequest{Meta: map[string]any{"timeout": "20s"}})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(remaining).To(BeNumerically("~", 15*time.Second, time.Second))
+	})
+
+	t.Run("no budget", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := handler(context.Background(), &runtime.CallToolRequest{Meta: map[string]any{"timeout": "20s"}})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(remaining).To(BeNumerically("~", 20*time.Second, time.Second))
+		g.Expect(attempt).To(BeNumerically("~", 20*time.Second, time.Second))
+	})
+}
+
+func TestPoll(t *testing.T) {
+	t.Run("until done", func(t *testing.T) {
+		g := NewWithT(t)
+		polls := 0
+		err := runtime.Poll(context.Background(), time.Millisecond, func(ctx context.Context) (bool, error) {
+			polls++
+			return polls == 3, nil
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(polls).To(Equal(3))
+
+		failed := errors.New("operation failed")
+		err = runtime.Poll(context.Background(), time.Millisecond, func(ctx context.Context) (bool, error) {
+			return false, failed
+		})
+		g.Expect(err).To(MatchError(failed))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		err = runtime.Poll(ctx, time.Millisecond, func(ctx context.Context) (bool, error) {
+			return false, nil
+		})
+		g.Expect(err).To(MatchError(context.DeadlineExceeded))
+	})
+
+	t.Run("polls share the deadline budget", func(t *testing.T) {
+		g := NewWithT(t)
+		var shares []time.Duration
+		h := runtime.DeadlineBudget{Timeout: time.Second}.Bound(func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+			return nil, runtime.Poll(ctx, 100*time.Millisecond, func(ctx context.Context) (bool, error) {
+				deadline, _ := ctx.Deadline()
+				shares = append(shares, time.Until(deadline))
+				if len(shares) == 1 {
+					// The first poll hangs until its share runs out.
+					<-ctx.Done()
+					return false, ctx.Err()
+				}
+				return true, nil
+			})
+		})
+
+		_, err := h(context.Background(), &runtime.CallToolRequest{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(shares).To(HaveLen(2))
+		g.Expect(shares[0]).To(BeNumerically("~", 90*time.Millisecond, 20*time.Millisecond))
+	})
+}
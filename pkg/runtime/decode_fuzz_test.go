@@ -68,7 +68,7 @@ func FuzzDecodeArguments(f *testing.F) {
 
 	f.Fuzz(func(t *testing.T, in string) {
 		var args map[string]any
-		if err := json.Unmarshal([]byte(in), &args); err != nil {
+		if err := json.Unmarshal([]byte(in), &args); err != nil || args == nil {
 			return // only object inputs are meaningful tool arguments
 		}
 		for i, md := range descriptors {
@@ -0,0 +1,135 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/mcpoptions"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldDefault returns the (mcp.field).default of fd as the JSON value that is
+// injected into the tool arguments when the model omits the field, or nil if
+// the field declares no default. Text is taken verbatim for fields protojson
+// renders as JSON strings; anything else must be a JSON literal.
+func FieldDefault(fd protoreflect.FieldDescriptor) (any, error) {
+	raw := fieldOptions(fd).GetDefault()
+	if raw == "" {
+		return nil, nil
+	}
+	if !fd.IsList() && !fd.IsMap() && rendersAsString(fd) {
+		return raw, nil
+	}
+	var v any
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		return nil, fmt.Errorf("default %q is not a JSON literal: %w", raw, err)
+	}
+	if v == nil {
+		return nil, fmt.Errorf("default must not be null")
+	}
+	return v, nil
+}
+
+// fieldOptions returns the (mcp.field) options of fd, or nil if unset.
+func fieldOptions(fd protoreflect.FieldDescriptor) *mcpoptions.FieldOptions {
+	opts := fd.Options()
+	if opts == nil || !proto.HasExtension(opts, mcpoptions.E_Field) {
+		return nil
+	}
+	o, _ := proto.GetExtension(opts, mcpoptions.E_Field).(*mcpoptions.FieldOptions)
+	return o
+}
+
+// injectDefaults sets every field of md that is absent (or null) in obj and
+// declares an (mcp.field).default to that default. Oneof members are skipped:
+// a default there would silently pick a member for the model.
+func injectDefaults(md protoreflect.MessageDescriptor, obj map[string]any) error {
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if oo := fd.ContainingOneof(); oo != nil && !oo.IsSynthetic() {
+			continue
+		}
+		v, err := FieldDefault(fd)
+		if err != nil {
+			return fmt.Errorf("field %q: invalid (mcp.field).default: %w", fd.Name(), err)
+		}
+		if v == nil {
+			continue
+		}
+		if name := resolveFieldName(fd, obj); name != "" {
+			if obj[name] != nil {
+				continue
+			}
+			delete(obj, name)
+		}
+		obj[string(fd.Name())] = v
+	}
+	return nil
+}
+
+// clampFields caps every singular field of md that declares an
+// (mcp.field).max at that value. Values that are not numbers are left for
+// protojson to reject.
+func clampFields(md protoreflect.MessageDescriptor, obj map[string]any) {
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		max := fieldOptions(fd).GetMax()
+		if max <= 0 || fd.IsList() || fd.IsMap() {
+			continue
+		}
+		name := resolveFieldName(fd, obj)
+		if name == "" {
+			continue
+		}
+		switch v := obj[name].(type) {
+		case float64:
+			if v > float64(max) {
+				obj[name] = float64(max)
+			}
+		case string:
+			// 64-bit integers travel as strings.
+			if n, err := strconv.ParseFloat(v, 64); err == nil && n > float64(max) {
+				obj[name] = strconv.FormatInt(max, 10)
+			}
+		}
+	}
+}
+
+// rendersAsString reports whether protojson encodes a singular value of fd as
+// a JSON string.
+func rendersAsString(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Kind() {
+	case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.EnumKind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return true
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		switch string(fd.Message().FullName()) {
+		case "google.protobuf.Timestamp",
+			"google.protobuf.Duration",
+			"google.protobuf.FieldMask",
+			"google.protobuf.Int64Value",
+			"google.protobuf.UInt64Value",
+			"google.protobuf.StringValue",
+			"google.protobuf.BytesValue":
+			return true
+		}
+	}
+	return false
+}
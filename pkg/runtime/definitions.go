@@ -0,0 +1,130 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// definitionRef matches the "$ref"s to shared definitions in a schema.
+var definitionRef = regexp.MustCompile(`"\$ref":"#/(\$defs|definitions)/([A-Za-z0-9]+)"`)
+
+// SchemaDefinitions holds the schema definitions shared by the tools of a
+// file generated with shared_definitions=true. The file stores each
+// definition once and the tool schemas refer to them with "$ref". Listed
+// tools must be self-contained, so each one gets a copy of the definitions
+// it uses: a message repeated within a tool is sent once, but a message
+// several tools use is sent with each of them.
+type SchemaDefinitions struct {
+	data string
+
+	once sync.Once
+	defs map[string]json.RawMessage
+}
+
+// NewSchemaDefinitions returns the definitions in data, a JSON object
+// mapping names to schemas or its compression by CompressDefinitions. It
+// is decoded when the schemas of the first tool are loaded.
+func NewSchemaDefinitions(data string) *SchemaDefinitions {
+	return &SchemaDefinitions{data: data}
+}
+
+// Tool returns tool with LoadSchemas set to add the definitions its schemas
+// refer to, directly or through other definitions, under "$defs"
+// ("definitions" for draft-07 refs). Loading panics if the data is corrupt
+// or a definition is missing, which only happens when a generated file was
+// edited.
+func (d *SchemaDefinitions) Tool(tool Tool) Tool {
+	load := tool.LoadSchemas
+	if load == nil {
+		input, output := tool.RawInputSchema, tool.RawOutputSchema
+		load = func() (json.RawMessage, json.RawMessage) { return input, output }
+		tool.RawInputSchema, tool.RawOutputSchema = nil, nil
+	}
+	tool.LoadSchemas = func() (json.RawMessage, json.RawMessage) {
+		input, output := load()
+		d.decode()
+		return d.attach(input), d.attach(output)
+	}
+	return tool
+}
+
+// decode decodes the definitions once.
+func (d *SchemaDefinitions) decode() {
+	d.once.Do(func() {
+		raw := []byte(d.data)
+		if strings.HasPrefix(d.data, gzipMagic) {
+			var err error
+			if raw, err = decompress(d.data); err != nil {
+				panic(fmt.Sprintf("runtime: decompressing schema definitions: %v", err))
+			}
+		}
+		if err := json.Unmarshal(raw, &d.defs); err != nil {
+			panic(fmt.Sprintf("runtime: decoding schema definitions: %v", err))
+		}
+	})
+}
+
+// attach adds the definitions schema refers to at its root.
+func (d *SchemaDefinitions) attach(schema json.RawMessage) json.RawMessage {
+	keyword := ""
+	used := map[string]json.RawMessage{}
+	pending := []json.RawMessage{schema}
+	for len(pending) > 0 {
+		for _, m := range definitionRef.FindAllSubmatch(pending[0], -1) {
+			keyword = string(m[1])
+			name := string(m[2])
+			if _, ok := used[name]; ok {
+				continue
+			}
+			def, ok := d.defs[name]
+			if !ok {
+				panic(fmt.Sprintf("runtime: no schema definition %q", name))
+			}
+			used[name] = def
+			pending = append(pending, def)
+		}
+		pending = pending[1:]
+	}
+	if len(used) == 0 {
+		return schema
+	}
+
+	// Only the root is decoded, so the key order of nested objects, like
+	// the discriminator first in oneof wrappers, survives.
+	var root map[string]json.RawMessage
+	if err := json.Unmarshal(schema, &root); err != nil {
+		panic(fmt.Sprintf("runtime: decoding tool schema: %v", err))
+	}
+	if existing, ok := root[keyword]; ok {
+		if err := json.Unmarshal(existing, &used); err != nil {
+			panic(fmt.Sprintf("runtime: decoding tool schema %s: %v", keyword, err))
+		}
+	}
+	defs, err := json.Marshal(used)
+	if err != nil {
+		panic(err)
+	}
+	root[keyword] = defs
+	out, err := json.Marshal(root)
+	if err != nil {
+		panic(err)
+	}
+	return out
+}
@@ -0,0 +1,184 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// DiagnosticsMetaKey is the _meta key under which WithCallDiagnostics
+// records how a tool call went:
+//
+//   - "latency_ms": time spent in backend calls, in milliseconds
+//   - "status": the gRPC status of the last backend call, e.g. "OK" or
+//     "NOT_FOUND"
+//   - "target": the address of the backend, when known
+//   - "retries": the number of calls retried against another endpoint
+//   - "chunked": whether WithResultChunking cut the text content into parts
+//
+// latency_ms and status are missing when the backend was not called, e.g.
+// for a dry run.
+const DiagnosticsMetaKey = "diagnostics"
+
+// WithCallDiagnostics attaches the diagnostics of DiagnosticsMetaKey to the
+// _meta of every tool result, errors included, for agent frameworks and
+// dashboards that collect per-call telemetry. The target is the peer of
+// gRPC calls, the target of a Dialer or the host of CallHTTP; hand-written
+// clients can report theirs with RecordTarget.
+func WithCallDiagnostics() Option {
+	return func(c *config) {
+		c.CallDiagnostics = true
+	}
+}
+
+// callDiagnostics collects the diagnostics of a tool call. Backend calls may
+// run concurrently, e.g. in a fan-out.
+type callDiagnostics struct {
+	mu      sync.Mutex
+	calls   int
+	latency time.Duration
+	code    code.Code
+	target  string
+	retries int
+}
+
+type diagnosticsKey struct{}
+
+// diagnosticsFromContext returns the diagnostics of the tool call of ctx, or
+// nil if WithCallDiagnostics is not set.
+func diagnosticsFromContext(ctx context.Context) *callDiagnostics {
+	d, _ := ctx.Value(diagnosticsKey{}).(*callDiagnostics)
+	return d
+}
+
+// StartBackendCall marks the start of the backend call of a tool call. Call
+// the returned function with the response and error of the call once it
+// returns; the response is a proto.Message or a Connect response.
+func StartBackendCall(ctx context.Context) func(resp any, err error) {
+	d := diagnosticsFromContext(ctx)
+	s := backendSignalsFromContext(ctx)
+	h := healthCheckerFromContext(ctx)
+	if d == nil && s == nil && h == nil {
+		return func(any, error) {}
+	}
+	start := time.Now()
+	return func(resp any, err error) {
+		elapsed := time.Since(start)
+		if d != nil {
+			d.mu.Lock()
+			d.calls++
+			d.latency += elapsed
+			d.code = errorCode(err)
+			d.mu.Unlock()
+		}
+		if s != nil {
+			s.check(ctx, elapsed, resp, err)
+		}
+		if h != nil {
+			h.RecordCall(err)
+		}
+	}
+}
+
+// RecordTarget records target as the backend address of the tool call of
+// ctx, if WithCallDiagnostics is set.
+func RecordTarget(ctx context.Context, target string) {
+	if d := diagnosticsFromContext(ctx); d != nil && target != "" {
+		d.mu.Lock()
+		d.target = target
+		d.mu.Unlock()
+	}
+}
+
+// recordRetry counts a call retried against another endpoint.
+func recordRetry(ctx context.Context) {
+	if d := diagnosticsFromContext(ctx); d != nil {
+		d.mu.Lock()
+		d.retries++
+		d.mu.Unlock()
+	}
+}
+
+// errorCode returns the gRPC code of err, which may also be a Connect error.
+func errorCode(err error) code.Code {
+	if err == nil {
+		return code.Code_OK
+	}
+	if st, ok := status.FromError(err); ok {
+		return code.Code(st.Code())
+	}
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return code.Code(connectErr.Code())
+	}
+	if errors.Is(err, context.Canceled) {
+		return code.Code_CANCELLED
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return code.Code_DEADLINE_EXCEEDED
+	}
+	return code.Code_UNKNOWN
+}
+
+// diagnosticCallOptions returns the gRPC call options recording the peer of
+// the call as its target.
+func diagnosticCallOptions(ctx context.Context) []grpc.CallOption {
+	if diagnosticsFromContext(ctx) == nil {
+		return nil
+	}
+	p := &peer.Peer{}
+	return []grpc.CallOption{grpc.Peer(p), grpc.OnFinish(func(error) {
+		if p.Addr != nil {
+			RecordTarget(ctx, p.Addr.String())
+		}
+	})}
+}
+
+// diagnose returns handler, with its results carrying the diagnostics of
+// WithCallDiagnostics.
+func diagnose(handler ToolHandler) ToolHandler {
+	return func(ctx context.Context, request *CallToolRequest) (*CallToolResult, error) {
+		d := &callDiagnostics{}
+		result, err := handler(context.WithValue(ctx, diagnosticsKey{}, d), request)
+		if err != nil || result == nil {
+			return result, err
+		}
+		_, chunked := result.Meta[ChunkedMetaKey]
+		d.mu.Lock()
+		meta := map[string]any{"retries": d.retries, "chunked": chunked}
+		if d.calls > 0 {
+			meta["latency_ms"] = float64(d.latency.Microseconds()) / 1000
+			meta["status"] = d.code.String()
+		}
+		if d.target != "" {
+			meta["target"] = d.target
+		}
+		d.mu.Unlock()
+		if result.Meta == nil {
+			result.Meta = map[string]any{}
+		}
+		result.Meta[DiagnosticsMetaKey] = meta
+		return result, nil
+	}
+}
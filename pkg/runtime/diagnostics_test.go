@@ -0,0 +1,104 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func TestWithCallDiagnostics(t *testing.T) {
+	g := NewWithT(t)
+	config := runtime.NewConfig()
+	runtime.WithCallDiagnostics()(config)
+	runtime.WithResultChunking(8)(config)
+	failover := runtime.NewFailover([]string{"a:1", "b:2"}, runtime.FailoverOptions{})
+	handler := runtime.ApplyHandlerConfig("tool", config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		target, err := runtime.CallWithFailover(ctx, failover, func(ctx context.Context, target string) (string, error) {
+			done := runtime.StartBackendCall(ctx)
+			var err error
+			if target == "a:1" {
+				err = status.Error(codes.Unavailable, "down")
+			}
+			done(target, err)
+			return target, err
+		})
+		runtime.RecordTarget(ctx, target)
+		return runtime.NewToolResultJSON([]byte(`{"target":"` + target + `"}`)), err
+	})
+
+	result, err := handler(context.Background(), &runtime.CallToolRequest{})
+	g.Expect(err).ToNot(HaveOccurred())
+	diagnostics := result.Meta[runtime.DiagnosticsMetaKey]
+	g.Expect(diagnostics).To(HaveKeyWithValue("status", "OK"))
+	g.Expect(diagnostics).To(HaveKeyWithValue("target", "b:2"))
+	g.Expect(diagnostics).To(HaveKeyWithValue("retries", 1))
+	g.Expect(diagnostics).To(HaveKeyWithValue("chunked", true))
+	g.Expect(diagnostics).To(HaveKeyWithValue("latency_ms", BeNumerically(">=", 0)))
+}
+
+func TestWithCallDiagnostics_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "grpc", err: status.Error(codes.NotFound, "missing"), want: "NOT_FOUND"},
+		{name: "connect", err: connect.NewError(connect.CodePermissionDenied, nil), want: "PERMISSION_DENIED"},
+		{name: "deadline", err: context.DeadlineExceeded, want: "DEADLINE_EXCEEDED"},
+		{name: "other", err: errors.New("boom"), want: "UNKNOWN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := runtime.NewConfig()
+			runtime.WithCallDiagnostics()(config)
+			handler := runtime.ApplyHandlerConfig("tool", config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+				done := runtime.StartBackendCall(ctx)
+				done(nil, tt.err)
+				return runtime.HandleError(tt.err)
+			})
+
+			result, err := handler(context.Background(), &runtime.CallToolRequest{})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result.IsError).To(BeTrue())
+			g.Expect(result.Meta[runtime.DiagnosticsMetaKey]).To(HaveKeyWithValue("status", tt.want))
+		})
+	}
+}
+
+func TestWithCallDiagnostics_NoBackendCall(t *testing.T) {
+	g := NewWithT(t)
+	config := runtime.NewConfig()
+	runtime.WithCallDiagnostics()(config)
+	handler := runtime.ApplyHandlerConfig("tool", config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		return runtime.NewToolResultText("dry run"), nil
+	})
+
+	result, err := handler(context.Background(), &runtime.CallToolRequest{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Meta[runtime.DiagnosticsMetaKey]).To(Equal(map[string]any{"retries": 0, "chunked": false}))
+
+	// Without the option nothing is recorded.
+	g.Expect(func() { runtime.StartBackendCall(context.Background())(nil, nil) }).ToNot(Panic())
+}
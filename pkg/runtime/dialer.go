@@ -0,0 +1,200 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DialerOptions configures how a Dialer dials its targets.
+type DialerOptions struct {
+	// Target returns the target of a call, e.g. the value of an extra
+	// property with ExtraPropertyTarget. It is required.
+	Target func(ctx context.Context) (string, error)
+
+	// ResolverScheme is the name resolver of targets that name none, e.g.
+	// "dns" or "xds". Empty leaves them to grpc's default, "dns".
+	ResolverScheme string
+
+	// LoadBalancingPolicy is the load-balancing policy of every connection,
+	// e.g. "round_robin". Empty means grpc's default, "pick_first". A
+	// policy set by ServiceConfig takes precedence.
+	LoadBalancingPolicy string
+
+	// ServiceConfig is the default service config of every connection, in
+	// JSON, e.g. to set retry policies. A service config from the resolver
+	// replaces it.
+	ServiceConfig string
+
+	// DialOptions are added to every connection. They must set transport
+	// credentials.
+	DialOptions []grpc.DialOption
+}
+
+// Dialer is a grpc.ClientConnInterface that sends each call to the target
+// DialerOptions.Target picks for it, dialing that target the first time it
+// is used. Passed to a generated ForwardTo<Service>Conn function together
+// with an extra property naming the backend, it lets the model route tool
+// calls to backends that are not known up front. Connections are kept until
+// Close. A Dialer is safe for concurrent use.
+type Dialer struct {
+	target func(ctx context.Context) (string, error)
+	scheme string
+	opts   []grpc.DialOption
+
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+var _ grpc.ClientConnInterface = (*Dialer)(nil)
+
+// NewDialer returns a Dialer for opts. It fails if opts has no Target, or
+// dial options or a service config grpc rejects.
+func NewDialer(opts DialerOptions) (*Dialer, error) {
+	if opts.Target == nil {
+		return nil, errors.New("dialer: no Target")
+	}
+	serviceConfig, err := dialerServiceConfig(opts.ServiceConfig, opts.LoadBalancingPolicy)
+	if err != nil {
+		return nil, err
+	}
+	dialOpts := append([]grpc.DialOption{}, opts.DialOptions...)
+	if serviceConfig != "" {
+		dialOpts = append(dialOpts, grpc.WithDefaultServiceConfig(serviceConfig))
+	}
+	// Catch invalid options now rather than on the first call. The
+	// connection stays idle and never dials.
+	conn, err := grpc.NewClient("passthrough:///dialer", dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("dialer: %w", err)
+	}
+	_ = conn.Close()
+	return &Dialer{
+		target: opts.Target,
+		scheme: opts.ResolverScheme,
+		opts:   dialOpts,
+		conns:  map[string]*grpc.ClientConn{},
+	}, nil
+}
+
+// dialerServiceConfig merges the load-balancing policy into the service
+// config, unless that sets a policy itself.
+func dialerServiceConfig(serviceConfig, policy string) (string, error) {
+	if policy == "" {
+		return serviceConfig, nil
+	}
+	config := map[string]any{}
+	if serviceConfig != "" {
+		if err := json.Unmarshal([]byte(serviceConfig), &config); err != nil {
+			return "", fmt.Errorf("dialer: invalid ServiceConfig: %w", err)
+		}
+	}
+	_, hasConfig := config["loadBalancingConfig"]
+	_, hasPolicy := config["loadBalancingPolicy"]
+	if hasConfig || hasPolicy {
+		return serviceConfig, nil
+	}
+	config["loadBalancingConfig"] = []any{map[string]any{policy: map[string]any{}}}
+	b, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Conn returns the connection to the target of ctx, dialing it if needed.
+// A missing or undialable target is an INVALID_ARGUMENT error, as it
+// usually comes from the model.
+func (d *Dialer) Conn(ctx context.Context) (*grpc.ClientConn, error) {
+	target, err := d.target(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if target == "" {
+		return nil, status.Error(codes.InvalidArgument, "no target to send the call to")
+	}
+	if d.scheme != "" && !strings.Contains(target, "://") {
+		target = d.scheme + ":///" + target
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.conns == nil {
+		return nil, status.Error(codes.Unavailable, "dialer is closed")
+	}
+	if conn, ok := d.conns[target]; ok {
+		return conn, nil
+	}
+	conn, err := grpc.NewClient(target, d.opts...)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "target %q: %v", target, err)
+	}
+	d.conns[target] = conn
+	return conn, nil
+}
+
+// Invoke sends a unary call to the target of ctx.
+func (d *Dialer) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	conn, err := d.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	RecordTarget(ctx, conn.Target())
+	return conn.Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream opens a stream to the target of ctx.
+func (d *Dialer) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	conn, err := d.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	RecordTarget(ctx, conn.Target())
+	return conn.NewStream(ctx, desc, method, opts...)
+}
+
+// Close closes every connection. Calls after Close fail with UNAVAILABLE.
+func (d *Dialer) Close() error {
+	d.mu.Lock()
+	conns := d.conns
+	d.conns = nil
+	d.mu.Unlock()
+	var errs []error
+	for _, conn := range conns {
+		errs = append(errs, conn.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// ExtraPropertyTarget returns a DialerOptions.Target that reads the target
+// from the string value of the extra property prop.
+func ExtraPropertyTarget(prop ExtraProperty) func(ctx context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		target, _ := ctx.Value(prop.ContextKey).(string)
+		if target == "" {
+			return "", status.Errorf(codes.InvalidArgument, "argument %q is required to pick the backend", prop.Name)
+		}
+		return target, nil
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// namedItemServer answers GetItem with its own name.
+type namedItemServer struct {
+	testdata.UnimplementedTestServiceServer
+	name string
+}
+
+func (s namedItemServer) GetItem(context.Context, *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+	return &testdata.GetItemResponse{Item: &testdata.Item{Name: s.name}}, nil
+}
+
+// bufconnBackends serves a namedItemServer per name and returns a dial
+// option reaching them by address.
+func bufconnBackends(t *testing.T, names ...string) grpc.DialOption {
+	listeners := map[string]*bufconn.Listener{}
+	for _, name := range names {
+		lis := bufconn.Listen(1 << 20)
+		srv := grpc.NewServer()
+		testdata.RegisterTestServiceServer(srv, namedItemServer{name: name})
+		go func() { _ = srv.Serve(lis) }()
+		t.Cleanup(srv.Stop)
+		listeners[name] = lis
+	}
+	return grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+		return listeners[addr].DialContext(ctx)
+	})
+}
+
+var backendProperty = runtime.ExtraProperty{Name: "backend", ContextKey: runtime.ExtraPropertyKey("backend")}
+
+func TestDialer(t *testing.T) {
+	g := NewWithT(t)
+	dialer, err := runtime.NewDialer(runtime.DialerOptions{
+		Target:              runtime.ExtraPropertyTarget(backendProperty),
+		ResolverScheme:      "passthrough",
+		LoadBalancingPolicy: "round_robin",
+		ServiceConfig:       `{"methodConfig":[{"name":[{"service":"testdata.TestService"}],"timeout":"5s"}]}`,
+		DialOptions: []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			bufconnBackends(t, "eu", "us"),
+		},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	defer dialer.Close()
+	client := testdata.NewTestServiceClient(dialer)
+
+	for _, backend := range []string{"eu", "us", "eu"} {
+		ctx := context.WithValue(context.Background(), backendProperty.ContextKey, backend)
+		resp, err := client.GetItem(ctx, &testdata.GetItemRequest{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.Item.Name).To(Equal(backend))
+	}
+
+	_, err = client.GetItem(context.Background(), &testdata.GetItemRequest{})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	g.Expect(err.Error()).To(ContainSubstring(`argument "backend" is required`))
+
+	g.Expect(dialer.Close()).To(Succeed())
+	ctx := context.WithValue(context.Background(), backendProperty.ContextKey, "eu")
+	_, err = client.GetItem(ctx, &testdata.GetItemRequest{})
+	g.Expect(status.Code(err)).To(Equal(codes.Unavailable))
+}
+
+func TestDialerTargetScheme(t *testing.T) {
+	g := NewWithT(t)
+	var targets []string
+	dialer, err := runtime.NewDialer(runtime.DialerOptions{
+		Target: func(ctx context.Context) (string, error) {
+			return ctx.Value(backendProperty.ContextKey).(string), nil
+		},
+		ResolverScheme: "dns",
+		DialOptions: []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithChainUnaryInterceptor(func(_ context.Context, _ string, _, _ any, cc *grpc.ClientConn, _ grpc.UnaryInvoker, _ ...grpc.CallOption) error {
+				targets = append(targets, cc.Target())
+				return nil
+			}),
+		},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	defer dialer.Close()
+
+	for _, target := range []string{"items.internal:443", "passthrough:///items.internal:443"} {
+		ctx := context.WithValue(context.Background(), backendProperty.ContextKey, target)
+		g.Expect(dialer.Invoke(ctx, "/testdata.TestService/GetItem", &testdata.GetItemRequest{}, &testdata.GetItemResponse{})).To(Succeed())
+	}
+	g.Expect(targets).To(Equal([]string{"dns:///items.internal:443", "passthrough:///items.internal:443"}))
+}
+
+func TestNewDialerInvalid(t *testing.T) {
+	g := NewWithT(t)
+	creds := grpc.WithTransportCredentials(insecure.NewCredentials())
+	target := runtime.ExtraPropertyTarget(backendProperty)
+
+	_, err := runtime.NewDialer(runtime.DialerOptions{DialOptions: []grpc.DialOption{creds}})
+	g.Expect(err).To(MatchError(ContainSubstring("no Target")))
+
+	_, err = runtime.NewDialer(runtime.DialerOptions{Target: target})
+	g.Expect(err).To(MatchError(ContainSubstring("credentials")))
+
+	_, err = runtime.NewDialer(runtime.DialerOptions{Target: target, ServiceConfig: "{", LoadBalancingPolicy: "round_robin", DialOptions: []grpc.DialOption{creds}})
+	g.Expect(err).To(MatchError(ContainSubstring("invalid ServiceConfig")))
+
+	_, err = runtime.NewDialer(runtime.DialerOptions{Target: target, LoadBalancingPolicy: "no_such_policy", DialOptions: []grpc.DialOption{creds}})
+	g.Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrDraining is the cancellation cause of tool calls still running when
+// CallTracker.Drain gives up waiting for them.
+var ErrDraining = errors.New("server is shutting down")
+
+// CallTracker tracks the tool calls in flight so a server can shut down
+// gracefully, e.g. during a rolling restart. Generated registration functions
+// track their tools with the tracker passed with WithCallTracker; hand-written
+// tools are wrapped with Track. A CallTracker is safe for concurrent use.
+type CallTracker struct {
+	mu       sync.Mutex
+	draining bool
+	calls    map[*trackedCall]struct{}
+	idle     chan struct{}
+}
+
+type trackedCall struct {
+	cancel context.CancelCauseFunc
+}
+
+// NewCallTracker returns a CallTracker with no calls in flight.
+func NewCallTracker() *CallTracker {
+	return &CallTracker{calls: make(map[*trackedCall]struct{})}
+}
+
+// WithCallTracker makes the generated registration functions track the calls
+// of their tools with t.
+func WithCallTracker(t *CallTracker) Option {
+	return func(c *config) {
+		c.CallTracker = t
+	}
+}
+
+// Track returns a handler that counts the calls of handler as in flight
+// while they run. Once Drain was called, new calls fail with a tool error
+// asking the model to try again, which then reaches the restarted server.
+// Tracking with a nil tracker returns handler unchanged.
+func (t *CallTracker) Track(handler ToolHandler) ToolHandler {
+	if t == nil {
+		return handler
+	}
+	return func(ctx context.Context, request *CallToolRequest) (*CallToolResult, error) {
+		t.mu.Lock()
+		if t.draining {
+			t.mu.Unlock()
+			return NewToolResultError("The server is shutting down. Try again shortly."), nil
+		}
+		ctx, cancel := context.WithCancelCause(ctx)
+		call := &trackedCall{cancel: cancel}
+		t.calls[call] = struct{}{}
+		t.mu.Unlock()
+
+		defer func() {
+			cancel(nil)
+			t.mu.Lock()
+			delete(t.calls, call)
+			if len(t.calls) == 0 && t.idle != nil {
+				close(t.idle)
+				t.idle = nil
+			}
+			t.mu.Unlock()
+		}()
+		return handler(ctx, request)
+	}
+}
+
+// InFlight returns the number of calls running.
+func (t *CallTracker) InFlight() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.calls)
+}
+
+// Drain stops accepting new calls and waits for the calls in flight to
+// finish. When ctx is done first, typically at a shutdown timeout, it cancels
+// the calls still running with ErrDraining as the cause and returns ctx's
+// error without waiting for them further. Draining is permanent.
+func (t *CallTracker) Drain(ctx context.Context) error {
+	t.mu.Lock()
+	t.draining = true
+	if len(t.calls) == 0 {
+		t.mu.Unlock()
+		return nil
+	}
+	if t.idle == nil {
+		t.idle = make(chan struct{})
+	}
+	idle := t.idle
+	t.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		t.mu.Lock()
+		for call := range t.calls {
+			call.cancel(ErrDraining)
+		}
+		t.mu.Unlock()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func TestCallTracker(t *testing.T) {
+	// waitingHandler runs until release is closed or its ctx is cancelled,
+	// and returns the cancellation cause.
+	waitingHandler := func(started chan<- struct{}, release <-chan struct{}) runtime.ToolHandler {
+		return func(ctx context.Context, _ *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+			started <- struct{}{}
+			select {
+			case <-release:
+				return runtime.NewToolResultText("done"), nil
+			case <-ctx.Done():
+				return nil, context.Cause(ctx)
+			}
+		}
+	}
+
+	t.Run("drain waits for calls in flight", func(t *testing.T) {
+		g := NewWithT(t)
+		tracker := runtime.NewCallTracker()
+		started := make(chan struct{}, 1)
+		release := make(chan struct{})
+		h := tracker.Track(waitingHandler(started, release))
+
+		results := make(chan *runtime.CallToolResult, 1)
+		go func() {
+			result, _ := h(context.Background(), &runtime.CallToolRequest{})
+			results <- result
+		}()
+		<-started
+		g.Expect(tracker.InFlight()).To(Equal(1))
+
+		drained := make(chan error, 1)
+		go func() { drained <- tracker.Drain(context.Background()) }()
+		// New calls are rejected once draining started.
+		probe := tracker.Track(func(context.Context, *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+			return runtime.NewToolResultText("ok"), nil
+		})
+		g.Eventually(func() bool {
+			result, _ := probe(context.Background(), &runtime.CallToolRequest{})
+			return result.IsError
+		}).Should(BeTrue())
+		g.Consistently(drained).ShouldNot(Receive())
+
+		close(release)
+		g.Eventually(drained).Should(Receive(BeNil()))
+		g.Expect((<-results).Text).To(Equal("done"))
+		g.Expect(tracker.InFlight()).To(Equal(0))
+	})
+
+	t.Run("drain cancels calls at the deadline", func(t *testing.T) {
+		g := NewWithT(t)
+		tracker := runtime.NewCallTracker()
+		started := make(chan struct{}, 1)
+		h := tracker.Track(waitingHandler(started, nil))
+
+		errs := make(chan error, 1)
+		go func() {
+			_, err := h(context.Background(), &runtime.CallToolRequest{})
+			errs <- err
+		}()
+		<-started
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		g.Expect(tracker.Drain(ctx)).To(MatchError(context.DeadlineExceeded))
+		g.Eventually(errs).Should(Receive(MatchError(runtime.ErrDraining)))
+	})
+
+	t.Run("drain without calls", func(t *testing.T) {
+		g := NewWithT(t)
+		tracker := runtime.NewCallTracker()
+		g.Expect(tracker.Drain(context.Background())).To(Succeed())
+	})
+
+	t.Run("handler config", func(t *testing.T) {
+		g := NewWithT(t)
+		tracker := runtime.NewCallTracker()
+		config := runtime.NewConfig()
+		runtime.WithCallTracker(tracker)(config)
+		started := make(chan struct{}, 1)
+		release := make(chan struct{})
+		h := runtime.ApplyHandlerConfig("svc_Get", config, waitingHandler(started, release))
+		go func() { _, _ = h(context.Background(), &runtime.CallToolRequest{}) }()
+		<-started
+		g.Expect(tracker.InFlight()).To(Equal(1))
+		close(release)
+		g.Eventually(tracker.InFlight).Should(Equal(0))
+	})
+}
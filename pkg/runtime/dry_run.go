@@ -0,0 +1,94 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"buf.build/go/protovalidate"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DryRunProperty is the tool argument that asks a mutating tool to validate
+// its request without executing it.
+const DryRunProperty = "dry_run"
+
+// ValidateOnlyField is the request field through which a backend supports dry
+// runs natively, following AIP-163.
+const ValidateOnlyField = "validate_only"
+
+// AddDryRunToTool adds the optional "dry_run" boolean to the tool's input
+// schema. In strict mode it is required and nullable, like any extra property.
+func AddDryRunToTool(tool Tool) Tool {
+	return AddExtraPropertiesToTool(tool, []ExtraProperty{{
+		Name:        DryRunProperty,
+		Description: "If true, validate the request and return it without executing it.",
+		Schema:      json.RawMessage(`{"type":"boolean"}`),
+	}})
+}
+
+// ExtractDryRun removes the "dry_run" argument from args and reports whether
+// it was set. Null counts as unset.
+func ExtractDryRun(args map[string]any) (bool, error) {
+	v, ok := args[DryRunProperty]
+	if !ok {
+		return false, nil
+	}
+	delete(args, DryRunProperty)
+	switch v := v.(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return v, nil
+	default:
+		return false, fmt.Errorf("argument %q must be a boolean, got %T", DryRunProperty, v)
+	}
+}
+
+// SetValidateOnly sets the singular bool "validate_only" field of msg, if it
+// has one, and reports whether it did. The backend then validates the request
+// without executing it.
+func SetValidateOnly(msg proto.Message) bool {
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(ValidateOnlyField)
+	if fd == nil || fd.Kind() != protoreflect.BoolKind || fd.Cardinality() == protoreflect.Repeated {
+		return false
+	}
+	m.Set(fd, protoreflect.ValueOfBool(true))
+	return true
+}
+
+// DryRunResult is the tool result of a dry run the backend cannot perform
+// itself: the fully decoded request the tool would have sent, once its
+// buf.validate rules pass. It is returned as text only, since it does not
+// match the tool's output schema. A request breaking the rules gets a tool
+// error listing the violations.
+func DryRunResult(msg proto.Message) (*CallToolResult, error) {
+	if err := protovalidate.Validate(msg); err != nil {
+		var invalid *protovalidate.ValidationError
+		if !errors.As(err, &invalid) {
+			return nil, err
+		}
+		return NewToolResultError("Dry run: the request is invalid and was not sent.\n" + invalid.Error()), nil
+	}
+	encoded, err := EncodeMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+	return NewToolResultText("Dry run: the request is valid and was not sent. It would have been:\n" + string(encoded)), nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestAddDryRunToTool(t *testing.T) {
+	t.Run("optional boolean", func(t *testing.T) {
+		g := NewWithT(t)
+		tool := runtime.AddDryRunToTool(runtime.Tool{RawInputSchema: json.RawMessage(`{"type":"object","properties":{"name":{"type":"string"}},"required":["name"]}`)})
+
+		var schema map[string]any
+		g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+		g.Expect(schema["properties"]).To(HaveKeyWithValue("dry_run", HaveKeyWithValue("type", "boolean")))
+		g.Expect(schema["required"]).To(Equal([]any{"name"}))
+	})
+
+	t.Run("strict mode", func(t *testing.T) {
+		g := NewWithT(t)
+		tool := runtime.AddDryRunToTool(runtime.Tool{RawInputSchema: json.RawMessage(`{"type":"object","properties":{},"required":[],"additionalProperties":false}`)})
+
+		var schema map[string]any
+		g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+		g.Expect(schema["properties"]).To(HaveKeyWithValue("dry_run", HaveKeyWithValue("type", []any{"boolean", "null"})))
+		g.Expect(schema["required"]).To(Equal([]any{"dry_run"}))
+	})
+}
+
+func TestExtractDryRun(t *testing.T) {
+	for name, tc := range map[string]struct {
+		args map[string]any
+		want bool
+	}{
+		"absent": {map[string]any{"name": "x"}, false},
+		"null":   {map[string]any{"dry_run": nil}, false},
+		"false":  {map[string]any{"dry_run": false}, false},
+		"true":   {map[string]any{"dry_run": true}, true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := runtime.ExtractDryRun(tc.args)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tc.want))
+			g.Expect(tc.args).ToNot(HaveKey("dry_run"))
+		})
+	}
+
+	g := NewWithT(t)
+	_, err := runtime.ExtractDryRun(map[string]any{"dry_run": "yes"})
+	g.Expect(err).To(MatchError(`argument "dry_run" must be a boolean, got string`))
+}
+
+func TestSetValidateOnly(t *testing.T) {
+	g := NewWithT(t)
+
+	apply := &testdata.ApplyConfigRequest{}
+	g.Expect(runtime.SetValidateOnly(apply)).To(BeTrue())
+	g.Expect(apply.GetValidateOnly()).To(BeTrue())
+
+	g.Expect(runtime.SetValidateOnly(&testdata.CreateItemRequest{})).To(BeFalse())
+}
+
+func TestDryRunResult(t *testing.T) {
+	g := NewWithT(t)
+	result, err := runtime.DryRunResult(&testdata.CreateItemRequest{Name: "Widget"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeFalse())
+	// Text only: the request does not match the tool's output schema.
+	g.Expect(result.StructuredContent).To(BeNil())
+	g.Expect(result.Text).To(ContainSubstring(`"name":"Widget"`))
+
+	// A request breaking its buf.validate rules is reported, not echoed.
+	result, err = runtime.DryRunResult(&testdata.NumericValidationRequest{Age: 200, Score: 50, Count: 1, BigCount: 1})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeTrue())
+	g.Expect(result.Text).To(HavePrefix("Dry run: the request is invalid and was not sent."))
+	g.Expect(result.Text).To(ContainSubstring("age: value must be greater than or equal to 0 and less than or equal to 150"))
+}
@@ -0,0 +1,184 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DuplicateCallCache suppresses repeated identical calls of mutating tools.
+// Agents stuck in a retry loop often re-issue the same Create within seconds;
+// a call with the same tool name and arguments as one that succeeded less
+// than the window ago, for the same tenant and MCP session (see
+// IdempotencyScope), returns that call's result instead of reaching the
+// backend again. An identical call that arrives while the first is still
+// running waits for it.
+//
+// Failed calls are not remembered, so retrying them goes through; if the
+// first call panics, the identical calls waiting for it fail, and if it is
+// cancelled by its own client, they run themselves instead. A
+// DuplicateCallCache is safe for concurrent use and can be shared between
+// registrations.
+type DuplicateCallCache struct {
+	window time.Duration
+	now    func() time.Time
+
+	mu        sync.Mutex
+	calls     map[[sha256.Size]byte]*duplicateCall
+	lastSweep time.Time
+}
+
+type duplicateCall struct {
+	done    chan struct{}
+	result  *CallToolResult
+	err     error
+	expires time.Time
+	// cancelled is set when the call failed because its context was
+	// done, which says nothing about the calls waiting for it.
+	cancelled bool
+}
+
+// NewDuplicateCallCache returns a DuplicateCallCache that returns the result
+// of a call for identical calls made within window of its completion. A
+// window of zero only merges identical calls that run at the same time.
+func NewDuplicateCallCache(window time.Duration) *DuplicateCallCache {
+	return &DuplicateCallCache{
+		window: window,
+		now:    time.Now,
+		calls:  make(map[[sha256.Size]byte]*duplicateCall),
+	}
+}
+
+// WithDuplicateCallSuppression makes the generated registration functions
+// route the tools of RPCs not marked idempotency_level = NO_SIDE_EFFECTS
+// through c.
+func WithDuplicateCallSuppression(c *DuplicateCallCache) Option {
+	return func(cfg *config) {
+		cfg.DuplicateCalls = c
+	}
+}
+
+// Suppress returns a handler that runs handler once for identical calls of
+// the tool name within the window. Arguments are compared by their JSON
+// encoding, so key order does not matter. The tenant is only known inside
+// Tenant.Propagate, so wrap the handler it propagates to, as
+// ApplyMutatingHandlerConfig does. Suppressing with a nil cache returns
+// handler unchanged, so generated code can call it whether or not
+// suppression is enabled.
+func (c *DuplicateCallCache) Suppress(name string, handler ToolHandler) ToolHandler {
+	if c == nil {
+		return handler
+	}
+	return func(ctx context.Context, request *CallToolRequest) (*CallToolResult, error) {
+		key, ok := duplicateCallKey(ctx, name, request.Arguments)
+		if !ok {
+			return handler(ctx, request)
+		}
+
+		for {
+			c.mu.Lock()
+			now := c.now()
+			c.sweep(now)
+			call, ok := c.calls[key]
+			if !ok || call.expired(now) {
+				break
+			}
+			c.mu.Unlock()
+			select {
+			case <-call.done:
+				if !call.cancelled {
+					return call.result, call.err
+				}
+				// The first call was abandoned by its client; run this one.
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		call := &duplicateCall{done: make(chan struct{})}
+		c.calls[key] = call
+		c.mu.Unlock()
+
+		defer func() {
+			if r := recover(); r != nil {
+				// Fail the waiting calls instead of leaving them blocked,
+				// then let the panic go on.
+				call.result, call.err = nil, fmt.Errorf("tool %s panicked: %v", name, r)
+				c.complete(key, call)
+				panic(r)
+			}
+		}()
+		call.result, call.err = handler(ctx, request)
+		call.cancelled = call.err != nil && ctx.Err() != nil
+		c.complete(key, call)
+		return call.result, call.err
+	}
+}
+
+// complete remembers the result of call for the window if it succeeded, and
+// hands it to the identical calls waiting for it.
+func (c *DuplicateCallCache) complete(key [sha256.Size]byte, call *duplicateCall) {
+	c.mu.Lock()
+	if call.err != nil || call.result == nil || call.result.IsError || c.window <= 0 {
+		delete(c.calls, key)
+	} else {
+		call.expires = c.now().Add(c.window)
+	}
+	c.mu.Unlock()
+	close(call.done)
+}
+
+// sweep drops the calls whose window has passed, at most once per window.
+// Calls still running have no expiry yet and are kept. c.mu must be held.
+func (c *DuplicateCallCache) sweep(now time.Time) {
+	if now.Sub(c.lastSweep) < c.window {
+		return
+	}
+	for key, call := range c.calls {
+		if call.expired(now) {
+			delete(c.calls, key)
+		}
+	}
+	c.lastSweep = now
+}
+
+// expired reports whether the window of a completed call has passed.
+func (call *duplicateCall) expired(now time.Time) bool {
+	return !call.expires.IsZero() && !now.Before(call.expires)
+}
+
+// duplicateCallKey hashes the IdempotencyScope, fan-out target, tool name
+// and arguments of a call. It reports false for arguments that cannot be
+// encoded, which are never suppressed.
+func duplicateCallKey(ctx context.Context, name string, args map[string]any) ([sha256.Size]byte, bool) {
+	encoded, err := json.Marshal(args)
+	if err != nil {
+		return [sha256.Size]byte{}, false
+	}
+	target, _ := FanOutTargetFromContext(ctx)
+	h := sha256.New()
+	for _, part := range []string{IdempotencyScope(ctx), target, name} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	h.Write(encoded)
+	var key [sha256.Size]byte
+	h.Sum(key[:0])
+	return key, true
+}
@@ -0,0 +1,257 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+type (
+	cancelledKey struct{}
+	orgKey       struct{}
+)
+
+func TestDuplicateCallCache(t *testing.T) {
+	// countingHandler returns a handler that reports how often it ran.
+	countingHandler := func(result func(n int) (*CallToolResult, error)) (ToolHandler, *int) {
+		var mu sync.Mutex
+		calls := 0
+		return func(context.Context, *CallToolRequest) (*CallToolResult, error) {
+			mu.Lock()
+			calls++
+			n := calls
+			mu.Unlock()
+			return result(n)
+		}, &calls
+	}
+	ok := func(n int) (*CallToolResult, error) {
+		return NewToolResultText(string(rune('0' + n))), nil
+	}
+	call := func(h ToolHandler, ctx context.Context, args map[string]any) *CallToolResult {
+		result, err := h(ctx, &CallToolRequest{Arguments: args})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return result
+	}
+	ctx := WithSessionID(context.Background(), "alice")
+
+	t.Run("identical calls within the window", func(t *testing.T) {
+		g := NewWithT(t)
+		now := time.Unix(0, 0)
+		c := NewDuplicateCallCache(10 * time.Second)
+		c.now = func() time.Time { return now }
+		raw, calls := countingHandler(ok)
+		h := c.Suppress("CreateItem", raw)
+
+		g.Expect(call(h, ctx, map[string]any{"name": "a", "labels": map[string]any{"x": 1, "y": 2}}).Text).To(Equal("1"))
+		now = now.Add(5 * time.Second)
+		// Key order does not matter.
+		g.Expect(call(h, ctx, map[string]any{"labels": map[string]any{"y": 2, "x": 1}, "name": "a"}).Text).To(Equal("1"))
+		g.Expect(*calls).To(Equal(1))
+
+		// Other arguments, tools and sessions are separate calls.
+		g.Expect(call(h, ctx, map[string]any{"name": "b"}).Text).To(Equal("2"))
+		g.Expect(call(c.Suppress("DeleteItem", raw), ctx, map[string]any{"name": "b"}).Text).To(Equal("3"))
+		bob := WithSessionID(context.Background(), "bob")
+		g.Expect(call(h, bob, map[string]any{"name": "a"}).Text).To(Equal("4"))
+
+		// The window runs from the end of the first call.
+		now = now.Add(6 * time.Second)
+		g.Expect(call(h, ctx, map[string]any{"name": "a", "labels": map[string]any{"x": 1, "y": 2}}).Text).To(Equal("5"))
+	})
+
+	t.Run("failed calls are not remembered", func(t *testing.T) {
+		g := NewWithT(t)
+		c := NewDuplicateCallCache(time.Minute)
+		h, calls := countingHandler(func(n int) (*CallToolResult, error) {
+			switch n {
+			case 1:
+				return nil, errors.New("unavailable")
+			case 2:
+				return NewToolResultError("already exists"), nil
+			}
+			return ok(n)
+		})
+		h = c.Suppress("CreateItem", h)
+
+		_, err := h(ctx, &CallToolRequest{Arguments: map[string]any{"name": "a"}})
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(call(h, ctx, map[string]any{"name": "a"}).IsError).To(BeTrue())
+		g.Expect(call(h, ctx, map[string]any{"name": "a"}).Text).To(Equal("3"))
+		g.Expect(call(h, ctx, map[string]any{"name": "a"}).Text).To(Equal("3"))
+		g.Expect(*calls).To(Equal(3))
+	})
+
+	t.Run("concurrent calls wait for the first", func(t *testing.T) {
+		g := NewWithT(t)
+		c := NewDuplicateCallCache(time.Minute)
+		release := make(chan struct{})
+		started := make(chan struct{}, 2)
+		h, calls := countingHandler(func(n int) (*CallToolResult, error) {
+			started <- struct{}{}
+			<-release
+			return ok(n)
+		})
+		h = c.Suppress("CreateItem", h)
+
+		results := make(chan string, 2)
+		go func() { results <- call(h, ctx, map[string]any{"name": "a"}).Text }()
+		<-started
+		go func() { results <- call(h, ctx, map[string]any{"name": "a"}).Text }()
+		// Whether the second call still waits or finds the first done, the
+		// backend is only called once.
+		close(release)
+		g.Expect(<-results).To(Equal("1"))
+		g.Expect(<-results).To(Equal("1"))
+		g.Expect(*calls).To(Equal(1))
+	})
+
+	t.Run("a panicking call fails the calls waiting for it", func(t *testing.T) {
+		g := NewWithT(t)
+		c := NewDuplicateCallCache(time.Minute)
+		release := make(chan struct{})
+		started := make(chan struct{}, 2)
+		h, calls := countingHandler(func(n int) (*CallToolResult, error) {
+			if n == 1 {
+				started <- struct{}{}
+				<-release
+				panic("boom")
+			}
+			return ok(n)
+		})
+		h = c.Suppress("CreateItem", h)
+
+		panicked := make(chan any, 1)
+		go func() {
+			defer func() { panicked <- recover() }()
+			_, _ = h(ctx, &CallToolRequest{Arguments: map[string]any{"name": "a"}})
+		}()
+		<-started
+		type outcome struct {
+			result *CallToolResult
+			err    error
+		}
+		waiter := make(chan outcome, 1)
+		go func() {
+			result, err := h(ctx, &CallToolRequest{Arguments: map[string]any{"name": "a"}})
+			waiter <- outcome{result, err}
+		}()
+		close(release)
+		g.Expect(<-panicked).To(Equal("boom"))
+		// Whether the second call still waits or finds the first gone, it
+		// doesn't block.
+		if got := <-waiter; got.err != nil {
+			g.Expect(got.err).To(MatchError("tool CreateItem panicked: boom"))
+		} else {
+			g.Expect(got.result.Text).To(Equal("2"))
+		}
+		// The panicked call isn't remembered.
+		g.Expect(call(h, ctx, map[string]any{"name": "a"}).Text).To(Equal("2"))
+		g.Expect(*calls).To(Equal(2))
+	})
+
+	t.Run("a cancelled call lets the calls waiting for it run", func(t *testing.T) {
+		g := NewWithT(t)
+		c := NewDuplicateCallCache(time.Minute)
+		started := make(chan struct{}, 2)
+		raw, calls := countingHandler(ok)
+		h := c.Suppress("CreateItem", func(ctx context.Context, request *CallToolRequest) (*CallToolResult, error) {
+			started <- struct{}{}
+			if ctx.Value(cancelledKey{}) != nil {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+			return raw(ctx, request)
+		})
+
+		first, cancel := context.WithCancel(context.WithValue(ctx, cancelledKey{}, true))
+		errs := make(chan error, 1)
+		go func() {
+			_, err := h(first, &CallToolRequest{Arguments: map[string]any{"name": "a"}})
+			errs <- err
+		}()
+		<-started
+		results := make(chan string, 1)
+		go func() { results <- call(h, ctx, map[string]any{"name": "a"}).Text }()
+		cancel()
+		g.Expect(<-errs).To(MatchError(context.Canceled))
+		// Whether the second call still waits or finds the first gone, it
+		// reaches the backend itself.
+		g.Expect(<-results).To(Equal("1"))
+		g.Expect(*calls).To(Equal(1))
+	})
+
+	t.Run("tenants without a session are separate", func(t *testing.T) {
+		g := NewWithT(t)
+		c := NewDuplicateCallCache(time.Minute)
+		raw, calls := countingHandler(ok)
+		h := ApplyMutatingHandlerConfig("CreateItem", &config{
+			DuplicateCalls: c,
+			Tenant: &Tenant{Resolve: func(ctx context.Context, _ *CallToolRequest) (string, error) {
+				org, _ := ctx.Value(orgKey{}).(string)
+				return org, nil
+			}},
+		}, raw)
+
+		acme := context.WithValue(context.Background(), orgKey{}, "acme")
+		globex := context.WithValue(context.Background(), orgKey{}, "globex")
+		g.Expect(call(h, acme, map[string]any{"name": "a"}).Text).To(Equal("1"))
+		g.Expect(call(h, globex, map[string]any{"name": "a"}).Text).To(Equal("2"))
+		g.Expect(call(h, acme, map[string]any{"name": "a"}).Text).To(Equal("1"))
+		g.Expect(*calls).To(Equal(2))
+	})
+
+	t.Run("zero window", func(t *testing.T) {
+		g := NewWithT(t)
+		c := NewDuplicateCallCache(0)
+		h, calls := countingHandler(ok)
+		h = c.Suppress("CreateItem", h)
+		call(h, ctx, map[string]any{"name": "a"})
+		call(h, ctx, map[string]any{"name": "a"})
+		g.Expect(*calls).To(Equal(2))
+		g.Expect(c.calls).To(BeEmpty())
+	})
+
+	t.Run("expired calls are swept", func(t *testing.T) {
+		g := NewWithT(t)
+		now := time.Unix(0, 0)
+		c := NewDuplicateCallCache(time.Second)
+		c.now = func() time.Time { return now }
+		h, _ := countingHandler(ok)
+		h = c.Suppress("CreateItem", h)
+		call(h, ctx, map[string]any{"name": "a"})
+		call(h, ctx, map[string]any{"name": "b"})
+		now = now.Add(2 * time.Second)
+		call(h, ctx, map[string]any{"name": "c"})
+		g.Expect(c.calls).To(HaveLen(1))
+	})
+
+	t.Run("nil cache", func(t *testing.T) {
+		g := NewWithT(t)
+		var c *DuplicateCallCache
+		h, calls := countingHandler(ok)
+		h = c.Suppress("CreateItem", h)
+		call(h, ctx, map[string]any{"name": "a"})
+		call(h, ctx, map[string]any{"name": "a"})
+		g.Expect(*calls).To(Equal(2))
+	})
+}
@@ -0,0 +1,164 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Elicitation actions, as defined by the MCP specification.
+const (
+	ElicitActionAccept  = "accept"
+	ElicitActionDecline = "decline"
+	ElicitActionCancel  = "cancel"
+)
+
+// ElicitResult is the user's response to an elicitation request.
+type ElicitResult struct {
+	// Action is one of the ElicitAction constants.
+	Action string
+	// Content holds the submitted values when Action is ElicitActionAccept.
+	Content map[string]any
+}
+
+// Elicitor sends an elicitation request to the MCP client, which asks the
+// user for values matching requestedSchema. Adapters put one in the handler
+// context, via WithElicitor, when the client supports elicitation.
+type Elicitor interface {
+	Elicit(ctx context.Context, message string, requestedSchema json.RawMessage) (*ElicitResult, error)
+}
+
+type elicitorKey struct{}
+
+// WithElicitor returns a copy of ctx carrying e.
+func WithElicitor(ctx context.Context, e Elicitor) context.Context {
+	return context.WithValue(ctx, elicitorKey{}, e)
+}
+
+// ElicitorFromContext returns the Elicitor in ctx, or nil if the MCP library
+// or the client does not support elicitation.
+func ElicitorFromContext(ctx context.Context) Elicitor {
+	e, _ := ctx.Value(elicitorKey{}).(Elicitor)
+	return e
+}
+
+// WithElicitation makes handlers ask the user for required arguments the
+// model left out, through an MCP elicitation round-trip, instead of failing
+// the call. It only takes effect when the client supports elicitation.
+func WithElicitation() Option {
+	return func(c *config) {
+		c.Elicitation = true
+	}
+}
+
+// ElicitMissingArguments asks the user for the required top-level arguments
+// of tool that are absent (or null) in args, and returns args with the
+// submitted values filled in. Only arguments with a string, number, integer
+// or boolean schema can be elicited; other missing arguments are left for the
+// usual validation to report. args is returned unchanged when nothing can be
+// elicited or ctx carries no Elicitor. A declined or cancelled request is
+// returned as an error.
+//
+// Call it after ExtractExtraProperties with the same extraProperties, which
+// are never elicited: they were already taken out of args, and may be
+// secrets a form should not collect.
+func ElicitMissingArguments(ctx context.Context, tool Tool, args map[string]any, extraProperties []ExtraProperty) (map[string]any, error) {
+	elicitor := ElicitorFromContext(ctx)
+	if elicitor == nil {
+		return args, nil
+	}
+	tool = tool.Loaded()
+	var schema struct {
+		Properties map[string]map[string]any `json:"properties"`
+		Required   []string                  `json:"required"`
+	}
+	if err := json.Unmarshal(tool.RawInputSchema, &schema); err != nil {
+		return args, nil
+	}
+
+	props := map[string]any{}
+	var missing []string
+	for _, name := range schema.Required {
+		if args[name] != nil || slices.ContainsFunc(extraProperties, func(p ExtraProperty) bool { return p.Name == name }) {
+			continue
+		}
+		prop, ok := elicitableSchema(schema.Properties[name])
+		if !ok {
+			continue
+		}
+		props[name] = prop
+		missing = append(missing, name)
+	}
+	if len(missing) == 0 {
+		return args, nil
+	}
+	sort.Strings(missing)
+
+	requested, err := json.Marshal(map[string]any{
+		"type":       "object",
+		"properties": props,
+		"required":   missing,
+	})
+	if err != nil {
+		return nil, err
+	}
+	name := tool.Title
+	if name == "" {
+		name = tool.Name
+	}
+	message := fmt.Sprintf("%s needs values for: %s.", name, strings.Join(missing, ", "))
+
+	result, err := elicitor.Elicit(ctx, message, requested)
+	if err != nil {
+		return nil, fmt.Errorf("asking the user for missing arguments %s: %w", strings.Join(missing, ", "), err)
+	}
+	if result.Action != ElicitActionAccept {
+		return nil, fmt.Errorf("the user did not provide the missing arguments %s (%s)", strings.Join(missing, ", "), result.Action)
+	}
+	if args == nil {
+		args = map[string]any{}
+	}
+	for _, name := range missing {
+		if v, ok := result.Content[name]; ok {
+			args[name] = v
+		}
+	}
+	return args, nil
+}
+
+// elicitableSchema reduces a property schema to the primitive subset MCP
+// elicitation accepts, reporting false if the property is not a primitive.
+// A nullable property is never missing: strict mode lists optional
+// arguments as required but nullable.
+func elicitableSchema(prop map[string]any) (map[string]any, bool) {
+	typ, _ := prop["type"].(string)
+	switch typ {
+	case "string", "number", "integer", "boolean":
+	default:
+		return nil, false
+	}
+	out := map[string]any{"type": typ}
+	for _, key := range []string{"title", "description", "enum", "format", "minimum", "maximum", "minLength", "maxLength", "default"} {
+		if v, ok := prop[key]; ok {
+			out[key] = v
+		}
+	}
+	return out, true
+}
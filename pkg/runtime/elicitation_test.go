@@ -0,0 +1,119 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+// fakeElicitor records the request and answers with a fixed result.
+type fakeElicitor struct {
+	message string
+	schema  map[string]any
+	result  *runtime.ElicitResult
+	err     error
+}
+
+func (f *fakeElicitor) Elicit(_ context.Context, message string, requestedSchema json.RawMessage) (*runtime.ElicitResult, error) {
+	f.message = message
+	if err := json.Unmarshal(requestedSchema, &f.schema); err != nil {
+		return nil, err
+	}
+	return f.result, f.err
+}
+
+func TestElicitMissingArguments(t *testing.T) {
+	tool := runtime.Tool{
+		Name:  "svc_Create",
+		Title: "Create item",
+		RawInputSchema: json.RawMessage(`{"type":"object","properties":{
+			"name":{"type":"string","description":"Item name"},
+			"size":{"type":"integer","minimum":1},
+			"labels":{"type":"object"},
+			"note":{"type":["string","null"]}
+		},"required":["name","size","labels","note"]}`),
+	}
+
+	t.Run("no elicitor", func(t *testing.T) {
+		g := NewWithT(t)
+		args := map[string]any{"size": 1}
+		got, err := runtime.ElicitMissingArguments(context.Background(), tool, args, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).To(Equal(args))
+	})
+
+	t.Run("accept fills primitive arguments", func(t *testing.T) {
+		g := NewWithT(t)
+		e := &fakeElicitor{result: &runtime.ElicitResult{
+			Action:  runtime.ElicitActionAccept,
+			Content: map[string]any{"name": "Widget", "size": float64(3), "other": true},
+		}}
+		ctx := runtime.WithElicitor(context.Background(), e)
+		got, err := runtime.ElicitMissingArguments(ctx, tool, nil, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).To(Equal(map[string]any{"name": "Widget", "size": float64(3)}))
+
+		g.Expect(e.message).To(Equal("Create item needs values for: name, size."))
+		// Objects and nullable arguments are never elicited.
+		g.Expect(e.schema["required"]).To(Equal([]any{"name", "size"}))
+		g.Expect(e.schema["properties"]).To(Equal(map[string]any{
+			"name": map[string]any{"type": "string", "description": "Item name"},
+			"size": map[string]any{"type": "integer", "minimum": float64(1)},
+		}))
+	})
+
+	t.Run("extra properties are not elicited", func(t *testing.T) {
+		g := NewWithT(t)
+		e := &fakeElicitor{result: &runtime.ElicitResult{
+			Action:  runtime.ElicitActionAccept,
+			Content: map[string]any{"size": float64(3)},
+		}}
+		ctx := runtime.WithElicitor(context.Background(), e)
+		extra := []runtime.ExtraProperty{{Name: "name", Required: true, Sensitive: true}}
+		got, err := runtime.ElicitMissingArguments(ctx, tool, map[string]any{}, extra)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).To(Equal(map[string]any{"size": float64(3)}))
+		g.Expect(e.schema["required"]).To(Equal([]any{"size"}))
+	})
+
+	t.Run("nothing missing", func(t *testing.T) {
+		g := NewWithT(t)
+		e := &fakeElicitor{}
+		ctx := runtime.WithElicitor(context.Background(), e)
+		_, err := runtime.ElicitMissingArguments(ctx, tool, map[string]any{"name": "x", "size": 1}, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(e.message).To(BeEmpty())
+	})
+
+	t.Run("decline", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := runtime.WithElicitor(context.Background(), &fakeElicitor{result: &runtime.ElicitResult{Action: runtime.ElicitActionDecline}})
+		_, err := runtime.ElicitMissingArguments(ctx, tool, map[string]any{"size": 1}, nil)
+		g.Expect(err).To(MatchError("the user did not provide the missing arguments name (decline)"))
+	})
+
+	t.Run("elicitor error", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := runtime.WithElicitor(context.Background(), &fakeElicitor{err: errors.New("boom")})
+		_, err := runtime.ElicitMissingArguments(ctx, tool, map[string]any{"size": 1}, nil)
+		g.Expect(err).To(MatchError(ContainSubstring("boom")))
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RejectFields fails a tool call whose arguments set one of fields, the full
+// names of the fields left out of the input schema by (mcp.field).exclude or
+// the exclude_fields plugin option. It walks args in their schema shape, before
+// DecodeArguments: nested messages, repeated messages, map values (as an object
+// or an entry list) and oneof wrappers. Well-known types and recursion-depth
+// placeholders are not descended into.
+func RejectFields(md protoreflect.MessageDescriptor, args map[string]any, fields []string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	return rejectFields(md, args, fields, "")
+}
+
+func rejectFields(md protoreflect.MessageDescriptor, obj map[string]any, fields []string, prefix string) error {
+	for i := 0; i < md.Oneofs().Len(); i++ {
+		oo := md.Oneofs().Get(i)
+		if oo.IsSynthetic() {
+			continue
+		}
+		wrapper, ok := obj[string(oo.Name())].(map[string]any)
+		if !ok {
+			continue
+		}
+		path := prefix + string(oo.Name()) + "."
+		for j := 0; j < oo.Fields().Len(); j++ {
+			if err := rejectField(oo.Fields().Get(j), wrapper, fields, path); err != nil {
+				return err
+			}
+		}
+	}
+	for i := 0; i < md.Fields().Len(); i++ {
+		if err := rejectField(md.Fields().Get(i), obj, fields, prefix); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func rejectField(fd protoreflect.FieldDescriptor, obj map[string]any, fields []string, prefix string) error {
+	name := resolveFieldName(fd, obj)
+	if name == "" {
+		return nil
+	}
+	path := prefix + name
+	if slices.Contains(fields, string(fd.FullName())) {
+		return fmt.Errorf("argument %q is not accepted by this tool; remove it from the call", path)
+	}
+	child := fd
+	if fd.IsMap() {
+		child = fd.MapValue()
+	}
+	if child.Kind() != protoreflect.MessageKind || isWellKnown(child.Message()) {
+		return nil
+	}
+	md := child.Message()
+	switch v := obj[name].(type) {
+	case map[string]any:
+		if !fd.IsMap() {
+			return rejectFields(md, v, fields, path+".")
+		}
+		for k, value := range v {
+			if m, ok := value.(map[string]any); ok {
+				if err := rejectFields(md, m, fields, fmt.Sprintf("%s[%q].", path, k)); err != nil {
+					return err
+				}
+			}
+		}
+	case []any:
+		for idx, value := range v {
+			m, ok := value.(map[string]any)
+			if !ok {
+				continue
+			}
+			if fd.IsMap() {
+				// A map entry list: [{"key":k,"value":v}, ...].
+				if m, ok = m["value"].(map[string]any); !ok {
+					continue
+				}
+			}
+			if err := rejectFields(md, m, fields, fmt.Sprintf("%s[%d].", path, idx)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestRejectFields(t *testing.T) {
+	apply := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor()
+	traceID := []string{"testdata.ApplyConfigRequest.internal_trace_id"}
+
+	t.Run("rejects an excluded field under either name", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(runtime.RejectFields(apply, map[string]any{"internal_trace_id": "t"}, traceID)).
+			To(MatchError(`argument "internal_trace_id" is not accepted by this tool; remove it from the call`))
+		g.Expect(runtime.RejectFields(apply, map[string]any{"internalTraceId": "t"}, traceID)).
+			To(MatchError(ContainSubstring(`"internalTraceId"`)))
+	})
+
+	t.Run("accepts calls without excluded fields", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(runtime.RejectFields(apply, map[string]any{"name": "p"}, traceID)).To(Succeed())
+		g.Expect(runtime.RejectFields(apply, map[string]any{"internal_trace_id": "t"}, nil)).To(Succeed())
+	})
+
+	t.Run("walks nested, repeated and map messages", func(t *testing.T) {
+		g := NewWithT(t)
+		md := (&testdata.DeepNestingRequest{}).ProtoReflect().Descriptor()
+		id := []string{"testdata.InnerMessage.id"}
+
+		g.Expect(runtime.RejectFields(md, map[string]any{
+			"middle": map[string]any{"inner": map[string]any{"id": "x"}},
+		}, id)).To(MatchError(ContainSubstring(`"middle.inner.id"`)))
+		g.Expect(runtime.RejectFields(md, map[string]any{
+			"middles": []any{map[string]any{}, map[string]any{"items": []any{map[string]any{"id": "x"}}}},
+		}, id)).To(MatchError(ContainSubstring(`"middles[1].items[0].id"`)))
+		g.Expect(runtime.RejectFields(md, map[string]any{
+			"middle": map[string]any{"named_items": map[string]any{"a": map[string]any{"id": "x"}}},
+		}, id)).To(MatchError(ContainSubstring(`"middle.named_items[\"a\"].id"`)))
+		g.Expect(runtime.RejectFields(md, map[string]any{
+			"middle": map[string]any{"named_items": []any{map[string]any{"key": "a", "value": map[string]any{"id": "x"}}}},
+		}, id)).To(MatchError(ContainSubstring(`"middle.named_items[0].id"`)))
+		g.Expect(runtime.RejectFields(md, map[string]any{
+			"middle": map[string]any{"inner": map[string]any{"tags": map[string]any{"id": "x"}}},
+		}, id)).To(Succeed())
+	})
+
+	t.Run("walks oneof wrappers", func(t *testing.T) {
+		g := NewWithT(t)
+		md := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor()
+		g.Expect(runtime.RejectFields(md, map[string]any{
+			"item_type": map[string]any{"which": "product", "product": map[string]any{"price": 1.0}},
+		}, []string{"testdata.ProductDetails.price"})).To(MatchError(ContainSubstring(`"item_type.product.price"`)))
+		g.Expect(runtime.RejectFields(md, map[string]any{
+			"item_type": map[string]any{"which": "service", "service": map[string]any{}},
+		}, []string{"testdata.CreateItemRequest.service"})).To(MatchError(ContainSubstring(`"item_type.service"`)))
+	})
+}
@@ -1,7 +1,16 @@
 package runtime
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"sync"
+
+	"connectrpc.com/connect"
+	"google.golang.org/grpc"
 )
 
 // Option defines functional options for MCP functions
@@ -13,11 +22,210 @@ type ExtraProperty struct {
 	Description string
 	Required    bool
 	ContextKey  interface{}
+
+	// Schema is the JSON Schema of the property value, e.g.
+	// {"type":"integer","minimum":1} or {"type":"string","enum":["eu","us"]}.
+	// Nil means {"type":"string"}. Description is filled in unless the schema
+	// carries its own.
+	Schema json.RawMessage
+
+	// Extract converts the argument the model sent into the value stored under
+	// ContextKey. Nil stores the decoded JSON value as-is (string, float64,
+	// bool, []any or map[string]any). An error is returned to the model so it
+	// can correct the argument; see ExtractAs for a typed decoder.
+	Extract func(v any) (any, error)
+
+	// EnvVar names an environment variable that supplies the value when the
+	// model omits the argument, e.g. a dataplane URL fixed per deployment.
+	// An empty or unset variable is skipped.
+	EnvVar string
+
+	// Default is the value used when the model omits the argument and EnvVar
+	// yields nothing. It is advertised as the schema "default".
+	//
+	// Values from EnvVar and Default go through Extract like model-supplied
+	// ones. A property with either fallback is never listed as required.
+	Default any
+
+	// Sensitive marks a secret such as a token. The schema marks it
+	// "writeOnly", conversion errors do not quote it, and RedactArguments
+	// masks it.
+	Sensitive bool
+}
+
+// hasFallback reports whether p resolves a value when the model omits it.
+func (p ExtraProperty) hasFallback() bool {
+	return p.EnvVar != "" || p.Default != nil
+}
+
+// fallback returns the value of p when the model omitted it.
+func (p ExtraProperty) fallback() (any, bool) {
+	if p.EnvVar != "" {
+		if v := os.Getenv(p.EnvVar); v != "" {
+			return v, true
+		}
+	}
+	if p.Default != nil {
+		return p.Default, true
+	}
+	return nil, false
+}
+
+// ExtraPropertyKey is the context key of extra properties declared in proto
+// with (mcp.service).extra_property or (mcp.method).extra_property. Read the
+// value with ctx.Value(runtime.ExtraPropertyKey("cluster_id")).
+type ExtraPropertyKey string
+
+// propertySchema returns the schema added to the tool for p.
+func (p ExtraProperty) propertySchema() (map[string]interface{}, error) {
+	var def map[string]interface{}
+	if p.Schema == nil {
+		// All extra properties are treated as strings by default
+		def = map[string]interface{}{
+			"type":        "string",
+			"description": p.Description,
+		}
+	} else {
+		if err := json.Unmarshal(p.Schema, &def); err != nil || def == nil {
+			return nil, fmt.Errorf("extra property %q: schema is not a JSON object", p.Name)
+		}
+		if _, ok := def["description"]; !ok && p.Description != "" {
+			def["description"] = p.Description
+		}
+	}
+	if p.Default != nil {
+		def["default"] = p.Default
+	}
+	if p.Sensitive {
+		def["writeOnly"] = true
+	}
+	return def, nil
+}
+
+// required reports whether p is listed as required in the tool schema.
+func (p ExtraProperty) required() bool {
+	return p.Required && !p.hasFallback()
+}
+
+// ExtractAs returns an ExtraProperty.Extract function that decodes the
+// argument into a T through JSON, so the context holds e.g. an int or a
+// config struct instead of the raw decoded JSON. A string that does not
+// decode as T directly is parsed as JSON text, which covers values read from
+// EnvVar and models that quote numbers.
+func ExtractAs[T any]() func(v any) (any, error) {
+	return func(v any) (any, error) {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		var out T
+		err = json.Unmarshal(raw, &out)
+		if s, ok := v.(string); ok && err != nil {
+			var fromText T
+			if json.Unmarshal([]byte(s), &fromText) == nil {
+				return fromText, nil
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+}
+
+// ExtractExtraProperties moves the extra properties present in args into ctx
+// under their ContextKey, converted with their Extract function, and deletes
+// them from args so they don't leak into proto unmarshaling. An absent or null
+// argument falls back to EnvVar, then Default. Errors are phrased for the
+// model.
+//
+// All extra properties are deleted from args before any is converted, so a
+// Sensitive value never survives in args, even when the call fails. The
+// Usage recorded by WithUsageRecorder gets the arguments through
+// RedactArguments with properties.
+func ExtractExtraProperties(ctx context.Context, args map[string]any, properties []ExtraProperty) (context.Context, error) {
+	redactUsageArguments(ctx, properties)
+	values := make([]any, len(properties))
+	for i, prop := range properties {
+		values[i] = args[prop.Name]
+		delete(args, prop.Name)
+	}
+	for i, prop := range properties {
+		propVal := values[i]
+		if propVal == nil {
+			var ok bool
+			if propVal, ok = prop.fallback(); !ok {
+				continue
+			}
+		}
+		if prop.Extract != nil {
+			v, err := prop.Extract(propVal)
+			if err != nil {
+				if prop.Sensitive {
+					// The conversion error may quote the secret.
+					return ctx, fmt.Errorf("argument %q is invalid", prop.Name)
+				}
+				return ctx, fmt.Errorf("argument %q: %w", prop.Name, err)
+			}
+			propVal = v
+		}
+		ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+	}
+	return ctx, nil
+}
+
+// RedactedValue replaces Sensitive extra properties in RedactArguments.
+const RedactedValue = "[REDACTED]"
+
+// RedactArguments returns a shallow copy of args with every Sensitive extra
+// property replaced by RedactedValue. Use it in audit or logging hooks that see
+// the raw tool-call arguments before the handler extracts them.
+func RedactArguments(args map[string]any, properties []ExtraProperty) map[string]any {
+	redacted := make(map[string]any, len(args))
+	for k, v := range args {
+		redacted[k] = v
+	}
+	for _, prop := range properties {
+		if _, ok := redacted[prop.Name]; ok && prop.Sensitive {
+			redacted[prop.Name] = RedactedValue
+		}
+	}
+	return redacted
 }
 
 type config struct {
-	ExtraProperties []ExtraProperty
-	NamePrefix      string
+	ExtraProperties   []ExtraProperty
+	ContextFields     []ContextField
+	ForwardedHeaders  []string
+	NamePrefix        string
+	Elicitation       bool
+	Completions       *CompletionRegistry
+	Subscriptions     *SubscriptionRegistry
+	DuplicateCalls    *DuplicateCallCache
+	WorkerPools       map[string]*WorkerPool
+	ServiceWorkerPool *WorkerPool
+	CallTracker       *CallTracker
+	FanOutParallelism int
+	CallOptions       func(ctx context.Context, toolName string) []grpc.CallOption
+	ConnectProtocol   ConnectProtocol
+	ConnectCodec      ConnectCodec
+	ConnectOptions    []connect.ClientOption
+	ConnectHeaders    []func(ctx context.Context, toolName string) http.Header
+	SplitResults      bool
+	SplitFields       []string
+	ChunkSize         int
+	ResourceReader    ResourceReader
+	CallDiagnostics   bool
+	BackendWarnings   *BackendWarnings
+	Tags              []string
+	SchemaOverrides   []SchemaOverride
+	ArgumentLimits    ArgumentLimits
+	StringSanitizers  []StringSanitizer
+	Tenant            *Tenant
+	UsageRecorder     UsageRecorder
+	DeadlineBudget    DeadlineBudget
+	Hedging           Hedging
+	HealthChecker     *HealthChecker
 }
 
 // WithNamePrefix prepends prefix + "_" to every tool name at registration
@@ -41,15 +249,87 @@ func NewConfig() *config {
 	return &config{}
 }
 
-// ApplyConfig applies all config options (name prefix, extra properties) to a tool.
+// ApplyConfig applies all config options (name prefix, resource inputs,
+// extra properties, forwarded headers, schema overrides) to a tool. The
+// schemas of a tool with LoadSchemas are changed when they are loaded.
 func ApplyConfig(tool Tool, config *config) Tool {
 	if config.NamePrefix != "" {
 		tool.Name = config.NamePrefix + "_" + tool.Name
 	}
+	if load := tool.LoadSchemas; load != nil {
+		// Leave the schemas to be loaded, and changed, when first needed.
+		named := tool
+		tool.LoadSchemas = sync.OnceValues(func() (json.RawMessage, json.RawMessage) {
+			loaded := applySchemaConfig(named.Loaded(), config)
+			return loaded.RawInputSchema, loaded.RawOutputSchema
+		})
+		return tool
+	}
+	return applySchemaConfig(tool, config)
+}
+
+// applySchemaConfig applies the config options that change the schemas of
+// a tool.
+func applySchemaConfig(tool Tool, config *config) Tool {
+	if config.ResourceReader != nil {
+		tool = AddResourceInputsToTool(tool)
+	}
 	if len(config.ExtraProperties) > 0 {
 		tool = AddExtraPropertiesToTool(tool, config.ExtraProperties)
 	}
-	return tool
+	if len(config.ForwardedHeaders) > 0 {
+		tool = AddHeadersToTool(tool, config.ForwardedHeaders)
+	}
+	return overrideSchema(tool, config.SchemaOverrides)
+}
+
+// ApplyHandlerConfig applies the config options that act on the handler
+// (worker pools, call tracking, split and chunked results, diagnostics,
+// backend warnings, usage recording, tenants, argument limits) to the
+// handler of the tool name. Calls waiting for a worker count as in flight;
+// calls over the argument limits never wait.
+func ApplyHandlerConfig(name string, config *config, handler ToolHandler) ToolHandler {
+	return applyHandlerConfig(name, config, false, handler)
+}
+
+// ApplyMutatingHandlerConfig is ApplyHandlerConfig for the tools of RPCs not
+// marked idempotency_level = NO_SIDE_EFFECTS, whose identical calls it also
+// suppresses, see WithDuplicateCallSuppression. Calls are told apart by
+// tenant, so the cache sits inside the tenant resolution.
+func ApplyMutatingHandlerConfig(name string, config *config, handler ToolHandler) ToolHandler {
+	return applyHandlerConfig(name, config, true, handler)
+}
+
+func applyHandlerConfig(name string, config *config, mutating bool, handler ToolHandler) ToolHandler {
+	if config.SplitResults {
+		handler = splitResults(handler, config.SplitFields)
+	}
+	if config.ChunkSize > 0 {
+		handler = chunkResults(handler, config.ChunkSize)
+	}
+	if config.CallDiagnostics {
+		handler = diagnose(handler)
+	}
+	if config.BackendWarnings != nil {
+		handler = warnBackend(name, config.BackendWarnings, handler)
+	}
+	if config.HealthChecker != nil {
+		handler = config.HealthChecker.track(handler)
+	}
+	if p := config.ServiceWorkerPool; p != nil {
+		handler = p.RunService(handler)
+	}
+	if p := config.WorkerPools[name]; p != nil {
+		handler = p.Run(handler)
+	}
+	handler = RecordUsage(name, config.UsageRecorder, handler)
+	if mutating {
+		handler = config.DuplicateCalls.Suppress(name, handler)
+	}
+	handler = config.Tenant.Propagate(handler)
+	handler = config.ArgumentLimits.Limit(handler)
+	handler = config.DeadlineBudget.Bound(handler)
+	return config.CallTracker.Track(handler)
 }
 
 // AddExtraPropertiesToTool modifies a tool's schema to include additional properties
@@ -80,18 +360,32 @@ func AddExtraPropertiesToTool(tool Tool, properties []ExtraProperty) Tool {
 		requiredFields = req
 	}
 
+	// A strict-mode schema (OpenAI structured outputs) closes the object and
+	// lists every property as required; optional ones are expressed as
+	// nullable instead.
+	strict := schema["additionalProperties"] == false
+
 	// Add each extra property
 	for _, prop := range properties {
-		// All extra properties are treated as strings by default
-		propertyDef := map[string]interface{}{
-			"type":        "string",
-			"description": prop.Description,
+		propertyDef, err := prop.propertySchema()
+		if err != nil {
+			// If the property schema is malformed, return the original tool
+			return tool
+		}
+		if strict {
+			// Strict mode rejects these annotations; the fallback still
+			// applies and RedactArguments still masks the value.
+			delete(propertyDef, "default")
+			delete(propertyDef, "writeOnly")
+			if !prop.required() {
+				propertyDef = nullableSchema(propertyDef)
+			}
 		}
 
 		schemaProperties[prop.Name] = propertyDef
 
 		// Add to required fields if needed
-		if prop.Required {
+		if (prop.required() || strict) && !slices.Contains(requiredFields, interface{}(prop.Name)) {
 			requiredFields = append(requiredFields, prop.Name)
 		}
 	}
@@ -113,3 +407,26 @@ func AddExtraPropertiesToTool(tool Tool, properties []ExtraProperty) Tool {
 	modifiedTool.RawInputSchema = json.RawMessage(modifiedSchema)
 	return modifiedTool
 }
+
+// nullableSchema widens def to also accept null, the strict-mode spelling of
+// an optional property.
+func nullableSchema(def map[string]interface{}) map[string]interface{} {
+	if enum, ok := def["enum"].([]interface{}); ok && !slices.Contains(enum, nil) {
+		def["enum"] = append(enum, nil)
+	}
+	switch t := def["type"].(type) {
+	case string:
+		if t != "null" {
+			def["type"] = []interface{}{t, "null"}
+		}
+		return def
+	case []interface{}:
+		if !slices.Contains(t, interface{}("null")) {
+			def["type"] = append(t, "null")
+		}
+		return def
+	}
+	return map[string]interface{}{
+		"anyOf": []interface{}{def, map[string]interface{}{"type": "null"}},
+	}
+}
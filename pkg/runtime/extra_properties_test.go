@@ -15,6 +15,7 @@
 package runtime
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -225,3 +226,224 @@ func TestAddExtraPropertiesToToolWithCustomFieldName(t *testing.T) {
 	// Verify the URL field was added to required fields
 	g.Expect(modifiedSchema["required"]).To(Equal([]interface{}{"name", "api_url"}))
 }
+
+func TestAddExtraPropertiesToToolWithTypedSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	tool := Tool{
+		Name:           "test_tool",
+		RawInputSchema: json.RawMessage(`{"type":"object","properties":{"name":{"type":"string"}}}`),
+	}
+	modifiedTool := AddExtraPropertiesToTool(tool, []ExtraProperty{
+		{
+			Name:        "page_size",
+			Description: "Results per page",
+			Schema:      json.RawMessage(`{"type":"integer","minimum":1}`),
+		},
+		{
+			Name:        "region",
+			Description: "Ignored, the schema has its own",
+			Schema:      json.RawMessage(`{"type":"string","enum":["eu","us"],"description":"Deployment region"}`),
+			Required:    true,
+		},
+	})
+
+	var modifiedSchema map[string]interface{}
+	g.Expect(json.Unmarshal(modifiedTool.RawInputSchema, &modifiedSchema)).To(Succeed())
+	properties := modifiedSchema["properties"].(map[string]interface{})
+	g.Expect(properties["page_size"]).To(Equal(map[string]interface{}{
+		"type":        "integer",
+		"minimum":     1.0,
+		"description": "Results per page",
+	}))
+	g.Expect(properties["region"]).To(Equal(map[string]interface{}{
+		"type":        "string",
+		"enum":        []interface{}{"eu", "us"},
+		"description": "Deployment region",
+	}))
+	g.Expect(modifiedSchema["required"]).To(Equal([]interface{}{"region"}))
+
+	// A malformed property schema leaves the tool untouched.
+	g.Expect(AddExtraPropertiesToTool(tool, []ExtraProperty{{Name: "bad", Schema: json.RawMessage(`[1]`)}})).To(Equal(tool))
+}
+
+func TestAddExtraPropertiesToToolStrictSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	tool := Tool{
+		Name:           "test_tool",
+		RawInputSchema: json.RawMessage(`{"type":"object","properties":{"name":{"type":"string"}},"required":["name"],"additionalProperties":false}`),
+	}
+	modifiedTool := AddExtraPropertiesToTool(tool, []ExtraProperty{
+		{Name: "base_url", Description: "Base URL", Required: true},
+		{Name: "api_key", Description: "API key"},
+		{Name: "region", Schema: json.RawMessage(`{"type":"string","enum":["eu","us"]}`)},
+		{Name: "limits", Schema: json.RawMessage(`{"type":["object"],"additionalProperties":false,"properties":{}}`)},
+		{Name: "any", Schema: json.RawMessage(`{"anyOf":[{"type":"string"},{"type":"integer"}]}`)},
+		{Name: "token", Description: "API token", Sensitive: true, Default: "t"},
+	})
+
+	var modifiedSchema map[string]interface{}
+	g.Expect(json.Unmarshal(modifiedTool.RawInputSchema, &modifiedSchema)).To(Succeed())
+	properties := modifiedSchema["properties"].(map[string]interface{})
+
+	// Every property is required; optional ones become nullable instead.
+	g.Expect(modifiedSchema["required"]).To(Equal([]interface{}{"name", "base_url", "api_key", "region", "limits", "any", "token"}))
+	g.Expect(properties["base_url"]).To(HaveKeyWithValue("type", "string"))
+	g.Expect(properties["api_key"]).To(HaveKeyWithValue("type", []interface{}{"string", "null"}))
+	g.Expect(properties["region"]).To(HaveKeyWithValue("enum", []interface{}{"eu", "us", nil}))
+	g.Expect(properties["limits"]).To(HaveKeyWithValue("type", []interface{}{"object", "null"}))
+	g.Expect(properties["any"]).To(Equal(map[string]interface{}{
+		"anyOf": []interface{}{
+			map[string]interface{}{"anyOf": []interface{}{
+				map[string]interface{}{"type": "string"},
+				map[string]interface{}{"type": "integer"},
+			}},
+			map[string]interface{}{"type": "null"},
+		},
+	}))
+	// Strict mode rejects the annotations of defaults and secrets.
+	g.Expect(properties["token"]).To(Equal(map[string]interface{}{"type": []interface{}{"string", "null"}, "description": "API token"}))
+}
+
+func TestExtractExtraProperties(t *testing.T) {
+	type pageSizeKey struct{}
+	type regionKey struct{}
+	props := []ExtraProperty{
+		{Name: "page_size", ContextKey: pageSizeKey{}, Extract: ExtractAs[int]()},
+		{Name: "region", ContextKey: regionKey{}},
+	}
+
+	t.Run("typed and raw values", func(t *testing.T) {
+		g := NewWithT(t)
+		args := map[string]any{"name": "n", "page_size": 25.0, "region": "eu"}
+		ctx, err := ExtractExtraProperties(context.Background(), args, props)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ctx.Value(pageSizeKey{})).To(Equal(25))
+		g.Expect(ctx.Value(regionKey{})).To(Equal("eu"))
+		// Extra properties never reach protojson.
+		g.Expect(args).To(Equal(map[string]any{"name": "n"}))
+	})
+
+	t.Run("null is absent", func(t *testing.T) {
+		g := NewWithT(t)
+		args := map[string]any{"page_size": nil}
+		ctx, err := ExtractExtraProperties(context.Background(), args, props)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ctx.Value(pageSizeKey{})).To(BeNil())
+		g.Expect(args).To(BeEmpty())
+	})
+
+	t.Run("extract error names the argument", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := ExtractExtraProperties(context.Background(), map[string]any{"page_size": "many"}, props)
+		g.Expect(err).To(MatchError(ContainSubstring(`argument "page_size"`)))
+	})
+}
+
+func TestAddExtraPropertiesToToolWithFallbacks(t *testing.T) {
+	g := NewWithT(t)
+
+	tool := Tool{
+		Name:           "test_tool",
+		RawInputSchema: json.RawMessage(`{"type":"object","properties":{}}`),
+	}
+	modifiedTool := AddExtraPropertiesToTool(tool, []ExtraProperty{
+		{Name: "dataplane_url", Description: "Dataplane URL", Required: true, EnvVar: "DATAPLANE_URL"},
+		{Name: "page_size", Required: true, Default: 50, Schema: json.RawMessage(`{"type":"integer"}`)},
+		{Name: "token", Required: true},
+	})
+
+	var modifiedSchema map[string]interface{}
+	g.Expect(json.Unmarshal(modifiedTool.RawInputSchema, &modifiedSchema)).To(Succeed())
+	properties := modifiedSchema["properties"].(map[string]interface{})
+	g.Expect(properties["page_size"]).To(HaveKeyWithValue("default", 50.0))
+	g.Expect(properties["dataplane_url"]).ToNot(HaveKey("default"))
+	// Only the property without a fallback stays required.
+	g.Expect(modifiedSchema["required"]).To(Equal([]interface{}{"token"}))
+}
+
+func TestExtractExtraPropertiesFallbacks(t *testing.T) {
+	type urlKey struct{}
+	type pageSizeKey struct{}
+	props := []ExtraProperty{
+		{Name: "dataplane_url", ContextKey: urlKey{}, EnvVar: "TEST_DATAPLANE_URL", Default: "http://localhost:8080"},
+		{Name: "page_size", ContextKey: pageSizeKey{}, EnvVar: "TEST_PAGE_SIZE", Extract: ExtractAs[int]()},
+	}
+
+	t.Run("argument wins", func(t *testing.T) {
+		g := NewWithT(t)
+		t.Setenv("TEST_DATAPLANE_URL", "http://env")
+		ctx, err := ExtractExtraProperties(context.Background(), map[string]any{"dataplane_url": "http://arg"}, props)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ctx.Value(urlKey{})).To(Equal("http://arg"))
+	})
+
+	t.Run("env var before default", func(t *testing.T) {
+		g := NewWithT(t)
+		t.Setenv("TEST_DATAPLANE_URL", "http://env")
+		t.Setenv("TEST_PAGE_SIZE", "25")
+		ctx, err := ExtractExtraProperties(context.Background(), map[string]any{"dataplane_url": nil}, props)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ctx.Value(urlKey{})).To(Equal("http://env"))
+		// Env values go through Extract like arguments.
+		g.Expect(ctx.Value(pageSizeKey{})).To(Equal(25))
+	})
+
+	t.Run("default when env var is empty", func(t *testing.T) {
+		g := NewWithT(t)
+		t.Setenv("TEST_DATAPLANE_URL", "")
+		ctx, err := ExtractExtraProperties(context.Background(), map[string]any{}, props)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ctx.Value(urlKey{})).To(Equal("http://localhost:8080"))
+		g.Expect(ctx.Value(pageSizeKey{})).To(BeNil())
+	})
+
+	t.Run("bad env value is reported", func(t *testing.T) {
+		g := NewWithT(t)
+		t.Setenv("TEST_PAGE_SIZE", "lots")
+		_, err := ExtractExtraProperties(context.Background(), map[string]any{}, props)
+		g.Expect(err).To(MatchError(ContainSubstring(`argument "page_size"`)))
+	})
+}
+
+func TestSensitiveExtraProperties(t *testing.T) {
+	type tokenKey struct{}
+	type pageSizeKey struct{}
+	props := []ExtraProperty{
+		{Name: "page_size", ContextKey: pageSizeKey{}, Extract: ExtractAs[int]()},
+		{Name: "token", Description: "API token", ContextKey: tokenKey{}, Sensitive: true, Extract: ExtractAs[int]()},
+	}
+
+	t.Run("schema marks writeOnly", func(t *testing.T) {
+		g := NewWithT(t)
+		tool := AddExtraPropertiesToTool(Tool{Name: "t", RawInputSchema: json.RawMessage(`{"type":"object"}`)}, props)
+		var schema map[string]interface{}
+		g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+		properties := schema["properties"].(map[string]interface{})
+		g.Expect(properties["token"]).To(HaveKeyWithValue("writeOnly", true))
+		g.Expect(properties["page_size"]).ToNot(HaveKey("writeOnly"))
+	})
+
+	t.Run("deleted from args even when extraction fails", func(t *testing.T) {
+		g := NewWithT(t)
+		args := map[string]any{"page_size": "many", "token": "s3cret", "name": "n"}
+		_, err := ExtractExtraProperties(context.Background(), args, props)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(args).To(Equal(map[string]any{"name": "n"}))
+	})
+
+	t.Run("errors do not quote the value", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := ExtractExtraProperties(context.Background(), map[string]any{"token": "s3cret"}, props)
+		g.Expect(err).To(MatchError(`argument "token" is invalid`))
+	})
+
+	t.Run("redacted for audit hooks", func(t *testing.T) {
+		g := NewWithT(t)
+		args := map[string]any{"page_size": 5.0, "token": "s3cret"}
+		g.Expect(RedactArguments(args, props)).To(Equal(map[string]any{"page_size": 5.0, "token": RedactedValue}))
+		// The original arguments are left alone.
+		g.Expect(args).To(HaveKeyWithValue("token", "s3cret"))
+	})
+}
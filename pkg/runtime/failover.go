@@ -0,0 +1,165 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FailoverOptions tunes when a Failover stops trying an endpoint.
+type FailoverOptions struct {
+	// Threshold is the number of consecutive connection failures after
+	// which an endpoint's circuit opens and it is skipped. Zero means 3.
+	Threshold int
+
+	// Cooldown is how long an open circuit skips its endpoint before a
+	// single call may try it again. Zero means 30 seconds.
+	Cooldown time.Duration
+}
+
+// Failover sends calls to the first available of an ordered list of
+// clients, e.g. a primary backend endpoint and its standbys, so a single
+// dead endpoint doesn't take down the tools forwarded to it. A call moves on
+// to the next endpoint when one fails with a connection error or
+// UNAVAILABLE; other errors come from a reachable backend and are returned
+// as they are. Each endpoint has a circuit that opens after
+// FailoverOptions.Threshold consecutive connection failures. An endpoint
+// with an open circuit is skipped until its cooldown has passed, after which
+// one call tries it again and closes the circuit if it succeeds. The
+// generated FailoverTo functions use a Failover with CallWithFailover. A
+// Failover is safe for concurrent use.
+type Failover[C any] struct {
+	opts FailoverOptions
+	now  func() time.Time
+
+	mu        sync.Mutex
+	endpoints []*failoverEndpoint[C]
+}
+
+type failoverEndpoint[C any] struct {
+	client  C
+	circuit circuit
+}
+
+// NewFailover returns a Failover over clients, in order of priority.
+func NewFailover[C any](clients []C, opts FailoverOptions) *Failover[C] {
+	if opts.Threshold <= 0 {
+		opts.Threshold = 3
+	}
+	if opts.Cooldown <= 0 {
+		opts.Cooldown = 30 * time.Second
+	}
+	f := &Failover[C]{opts: opts, now: time.Now}
+	for _, client := range clients {
+		f.endpoints = append(f.endpoints, &failoverEndpoint[C]{client: client})
+	}
+	return f
+}
+
+// Available returns the number of endpoints whose circuit is closed or
+// ready to be tried again.
+func (f *Failover[C]) Available() int {
+	return f.remaining(0)
+}
+
+// acquire reports whether a call may try the endpoint, and whether it is the
+// probe of an endpoint whose cooldown has passed.
+func (f *Failover[C]) acquire(e *failoverEndpoint[C]) (ok, probe bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return e.circuit.acquire(f.now(), circuitOptions(f.opts))
+}
+
+// release records the outcome of a call to the endpoint.
+func (f *Failover[C]) release(e *failoverEndpoint[C], probe, failed bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	outcome := callSucceeded
+	if failed {
+		outcome = callFailed
+	}
+	e.circuit.release(f.now(), circuitOptions(f.opts), probe, outcome)
+}
+
+// remaining returns the number of endpoints from index i on that a call may
+// still try.
+func (f *Failover[C]) remaining(i int) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	now := f.now()
+	n := 0
+	for _, e := range f.endpoints[i:] {
+		if e.circuit.available(now, circuitOptions(f.opts)) {
+			n++
+		}
+	}
+	return n
+}
+
+// CallWithFailover runs call against the clients of f in order of priority,
+// moving on to the next one while it fails with a connection error or
+// UNAVAILABLE. It returns the result of the first call that does not, or the
+// last error. If every circuit is open, it fails with UNAVAILABLE without
+// calling any client. Within a DeadlineBudget, the endpoints split the time
+// left, see AttemptContext, and one that runs out of its share counts as
+// failed.
+func CallWithFailover[C, R any](ctx context.Context, f *Failover[C], call func(ctx context.Context, client C) (R, error)) (R, error) {
+	var zero R
+	var lastErr error
+	for i, e := range f.endpoints {
+		ok, probe := f.acquire(e)
+		if !ok {
+			continue
+		}
+		if lastErr != nil {
+			recordRetry(ctx)
+		}
+		attemptCtx, cancel := AttemptContext(ctx, 1+f.remaining(i+1))
+		result, err := call(attemptCtx, e.client)
+		failed := err != nil && ctx.Err() == nil && (connectionFailure(err) || attemptCtx.Err() != nil)
+		cancel()
+		f.release(e, probe, failed)
+		if !failed {
+			return result, err
+		}
+		lastErr = err
+	}
+	if lastErr == nil {
+		return zero, status.Error(codes.Unavailable, fmt.Sprintf("all %d endpoints are unavailable, try again later", len(f.endpoints)))
+	}
+	return zero, lastErr
+}
+
+// connectionFailure reports whether err means the backend could not be
+// reached, as opposed to an error the backend returned.
+func connectionFailure(err error) bool {
+	if st, ok := status.FromError(err); ok {
+		return st.Code() == codes.Unavailable
+	}
+	if connect.CodeOf(err) == connect.CodeUnavailable {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,178 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeEndpoint is a backend client that fails with err while it is set.
+type fakeEndpoint struct {
+	name  string
+	err   error
+	calls int
+}
+
+func (e *fakeEndpoint) call(context.Context) (string, error) {
+	e.calls++
+	if e.err != nil {
+		return "", e.err
+	}
+	return e.name, nil
+}
+
+func callFailover(f *Failover[*fakeEndpoint]) (string, error) {
+	return CallWithFailover(context.Background(), f, func(ctx context.Context, e *fakeEndpoint) (string, error) {
+		return e.call(ctx)
+	})
+}
+
+func TestFailover(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+
+	t.Run("priority order", func(t *testing.T) {
+		g := NewWithT(t)
+		primary, standby := &fakeEndpoint{name: "primary"}, &fakeEndpoint{name: "standby"}
+		f := NewFailover([]*fakeEndpoint{primary, standby}, FailoverOptions{})
+
+		g.Expect(callFailover(f)).To(Equal("primary"))
+		g.Expect(standby.calls).To(Equal(0))
+
+		primary.err = unavailable
+		g.Expect(callFailover(f)).To(Equal("standby"))
+		g.Expect(primary.calls).To(Equal(2))
+	})
+
+	t.Run("backend errors are returned", func(t *testing.T) {
+		g := NewWithT(t)
+		primary, standby := &fakeEndpoint{name: "primary"}, &fakeEndpoint{name: "standby"}
+		f := NewFailover([]*fakeEndpoint{primary, standby}, FailoverOptions{})
+
+		primary.err = status.Error(codes.NotFound, "no such cluster")
+		_, err := callFailover(f)
+		g.Expect(status.Code(err)).To(Equal(codes.NotFound))
+		g.Expect(standby.calls).To(Equal(0))
+	})
+
+	t.Run("circuit", func(t *testing.T) {
+		g := NewWithT(t)
+		now := time.Unix(0, 0)
+		primary, standby := &fakeEndpoint{name: "primary", err: unavailable}, &fakeEndpoint{name: "standby"}
+		f := NewFailover([]*fakeEndpoint{primary, standby}, FailoverOptions{Threshold: 2, Cooldown: 10 * time.Second})
+		f.now = func() time.Time { return now }
+
+		for range 4 {
+			g.Expect(callFailover(f)).To(Equal("standby"))
+		}
+		// The circuit opened after two failures, so the primary was skipped.
+		g.Expect(primary.calls).To(Equal(2))
+		g.Expect(f.Available()).To(Equal(1))
+
+		// Once the cooldown passed, one call tries the primary again.
+		now = now.Add(10 * time.Second)
+		g.Expect(f.Available()).To(Equal(2))
+		g.Expect(callFailover(f)).To(Equal("standby"))
+		g.Expect(primary.calls).To(Equal(3))
+		g.Expect(callFailover(f)).To(Equal("standby"))
+		g.Expect(primary.calls).To(Equal(3))
+
+		// A successful trial closes it.
+		now = now.Add(10 * time.Second)
+		primary.err = nil
+		g.Expect(callFailover(f)).To(Equal("primary"))
+		g.Expect(callFailover(f)).To(Equal("primary"))
+		g.Expect(f.Available()).To(Equal(2))
+	})
+
+	t.Run("all unavailable", func(t *testing.T) {
+		g := NewWithT(t)
+		primary, standby := &fakeEndpoint{err: unavailable}, &fakeEndpoint{err: connect.NewError(connect.CodeUnavailable, errors.New("dial tcp: connection refused"))}
+		f := NewFailover([]*fakeEndpoint{primary, standby}, FailoverOptions{Threshold: 1})
+
+		// The last error is returned while endpoints are tried.
+		_, err := callFailover(f)
+		g.Expect(connect.CodeOf(err)).To(Equal(connect.CodeUnavailable))
+
+		// Then every circuit is open and no endpoint is called.
+		_, err = callFailover(f)
+		g.Expect(status.Code(err)).To(Equal(codes.Unavailable))
+		g.Expect(err.Error()).To(ContainSubstring("all 2 endpoints are unavailable"))
+		g.Expect(primary.calls).To(Equal(1))
+		g.Expect(standby.calls).To(Equal(1))
+	})
+
+	t.Run("cancelled calls don't fail over", func(t *testing.T) {
+		g := NewWithT(t)
+		primary, standby := &fakeEndpoint{err: unavailable}, &fakeEndpoint{name: "standby"}
+		f := NewFailover([]*fakeEndpoint{primary, standby}, FailoverOptions{Threshold: 1})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := CallWithFailover(ctx, f, func(ctx context.Context, e *fakeEndpoint) (string, error) {
+			return e.call(ctx)
+		})
+		g.Expect(err).To(MatchError(unavailable))
+		g.Expect(standby.calls).To(Equal(0))
+		g.Expect(f.Available()).To(Equal(2))
+	})
+
+	t.Run("deadline budget", func(t *testing.T) {
+		g := NewWithT(t)
+		f := NewFailover([]*fakeEndpoint{{name: "primary"}, {name: "standby"}}, FailoverOptions{})
+
+		// The hanging primary gets half of the budget; the standby the rest.
+		var shares []time.Duration
+		handler := DeadlineBudget{Timeout: 200 * time.Millisecond}.Bound(func(ctx context.Context, _ *CallToolRequest) (*CallToolResult, error) {
+			name, err := CallWithFailover(ctx, f, func(ctx context.Context, e *fakeEndpoint) (string, error) {
+				deadline, _ := ctx.Deadline()
+				shares = append(shares, time.Until(deadline))
+				if e.name == "primary" {
+					<-ctx.Done()
+					return "", status.FromContextError(ctx.Err()).Err()
+				}
+				return e.name, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			return NewToolResultText(name), nil
+		})
+		result, err := handler(context.Background(), &CallToolRequest{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Text).To(Equal("standby"))
+		g.Expect(shares).To(HaveLen(2))
+		g.Expect(shares[0]).To(BeNumerically("~", 100*time.Millisecond, 20*time.Millisecond))
+		g.Expect(shares[1]).To(BeNumerically("~", 100*time.Millisecond, 20*time.Millisecond))
+	})
+}
+
+func TestConnectionFailure(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(connectionFailure(status.Error(codes.Unavailable, ""))).To(BeTrue())
+	g.Expect(connectionFailure(connect.NewError(connect.CodeUnavailable, errors.New("")))).To(BeTrue())
+	g.Expect(connectionFailure(&net.OpError{Op: "dial", Err: errors.New("connection refused")})).To(BeTrue())
+	g.Expect(connectionFailure(status.Error(codes.Internal, ""))).To(BeFalse())
+	g.Expect(connectionFailure(connect.NewError(connect.CodeNotFound, errors.New("")))).To(BeFalse())
+	g.Expect(connectionFailure(errors.New("boom"))).To(BeFalse())
+}
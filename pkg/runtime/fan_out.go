@@ -0,0 +1,230 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// FanOutTargetsProperty is the optional tool argument that restricts a
+// fanned-out call to some of the targets.
+const FanOutTargetsProperty = "targets"
+
+// WithFanOutParallelism bounds how many targets a fanned-out call runs
+// against at once. Zero, the default, runs against all of them at once.
+func WithFanOutParallelism(n int) Option {
+	return func(c *config) {
+		c.FanOutParallelism = n
+	}
+}
+
+type fanOutTargetKey struct{}
+
+// WithFanOutTarget returns a copy of ctx carrying the name of the target a
+// fanned-out call runs against.
+func WithFanOutTarget(ctx context.Context, target string) context.Context {
+	return context.WithValue(ctx, fanOutTargetKey{}, target)
+}
+
+// FanOutTargetFromContext returns the target of a fanned-out call, and
+// whether ctx belongs to one.
+func FanOutTargetFromContext(ctx context.Context) (string, bool) {
+	target, ok := ctx.Value(fanOutTargetKey{}).(string)
+	return target, ok
+}
+
+// FanOut registers tools on s that run every call against each of clients,
+// e.g. the control plane of every region, and return the results by target
+// name. register registers the tools of one client, typically through a
+// generated ForwardTo function; the generated FanOutTo functions call FanOut
+// this way.
+//
+// The tools take an optional "targets" argument that restricts a call to
+// some of the targets, unless their request has a field of that name. The
+// result is a JSON object with a {"result": ...} or {"error": "..."} entry
+// per target; it is an error only if every target failed. Handlers see the
+// target in ctx, see FanOutTargetFromContext. opts configure the
+// parallelism; register gets no options and should pass on its own.
+func FanOut[C any](s MCPServer, clients map[string]C, register func(s MCPServer, client C), opts ...Option) {
+	config := NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	targets := make([]string, 0, len(clients))
+	for name := range clients {
+		targets = append(targets, name)
+	}
+	slices.Sort(targets)
+
+	var tools []Tool
+	handlers := map[string]map[string]ToolHandler{}
+	for _, target := range targets {
+		register(AddToolFunc(func(tool Tool, handler ToolHandler) {
+			if handlers[tool.Name] == nil {
+				handlers[tool.Name] = map[string]ToolHandler{}
+				tools = append(tools, tool)
+			}
+			handlers[tool.Name][target] = handler
+		}), clients[target])
+	}
+
+	for _, tool := range tools {
+		tool, selectable := fanOutTool(tool, targets)
+		s.AddTool(tool, fanOutHandler(targets, handlers[tool.Name], selectable, config.FanOutParallelism))
+	}
+}
+
+// fanOutTool returns the fanned-out variant of tool and whether it takes the
+// "targets" argument.
+func fanOutTool(tool Tool, targets []string) (Tool, bool) {
+	tool = tool.Loaded()
+	tool.Description = strings.TrimSpace(tool.Description + "\n\nRuns against each of the targets " + strings.Join(targets, ", ") + " and returns the results by target.")
+
+	if len(tool.RawOutputSchema) > 0 {
+		properties := map[string]any{}
+		for _, target := range targets {
+			properties[target] = map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"result": json.RawMessage(tool.RawOutputSchema),
+					"error":  map[string]any{"type": "string"},
+				},
+			}
+		}
+		if schema, err := json.Marshal(map[string]any{"type": "object", "properties": properties}); err == nil {
+			tool.RawOutputSchema = schema
+		}
+	}
+
+	var input struct {
+		Properties map[string]json.RawMessage `json:"properties"`
+	}
+	if err := json.Unmarshal(tool.RawInputSchema, &input); err != nil {
+		return tool, false
+	}
+	if _, ok := input.Properties[FanOutTargetsProperty]; ok {
+		return tool, false
+	}
+	items, _ := json.Marshal(map[string]any{"type": "array", "items": map[string]any{"type": "string", "enum": targets}})
+	return AddExtraPropertiesToTool(tool, []ExtraProperty{{
+		Name:        FanOutTargetsProperty,
+		Description: "The targets to run against. Omit to run against all of them.",
+		Schema:      items,
+	}}), true
+}
+
+// fanOutResult is the result of a fanned-out call for one target.
+type fanOutResult struct {
+	Result any    `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// fanOutHandler runs a call against the handlers of the targets, at most
+// parallelism at once.
+func fanOutHandler(targets []string, handlers map[string]ToolHandler, selectable bool, parallelism int) ToolHandler {
+	return func(ctx context.Context, request *CallToolRequest) (*CallToolResult, error) {
+		args := request.Arguments
+		selected := targets
+		if selectable {
+			var err error
+			if selected, err = extractFanOutTargets(args, targets); err != nil {
+				return NewToolResultError(err.Error()), nil
+			}
+		}
+		encoded, err := json.Marshal(args)
+		if err != nil {
+			return nil, err
+		}
+
+		if parallelism <= 0 {
+			parallelism = len(selected)
+		}
+		limit := make(chan struct{}, max(parallelism, 1))
+		results := make(map[string]fanOutResult, len(selected))
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		for _, target := range selected {
+			wg.Add(1)
+			limit <- struct{}{}
+			go func() {
+				defer func() { <-limit; wg.Done() }()
+				// Each handler consumes its own copy of the arguments.
+				var targetArgs map[string]any
+				_ = json.Unmarshal(encoded, &targetArgs)
+				result, err := handlers[target](WithFanOutTarget(ctx, target), &CallToolRequest{Arguments: targetArgs, Meta: request.Meta})
+				var r fanOutResult
+				switch {
+				case err != nil:
+					r.Error = err.Error()
+				case result == nil:
+				case result.IsError:
+					r.Error = result.Text
+				case result.StructuredContent != nil:
+					r.Result = result.StructuredContent
+				default:
+					r.Result = result.Text
+				}
+				mu.Lock()
+				results[target] = r
+				mu.Unlock()
+			}()
+		}
+		wg.Wait()
+
+		encoded, err = json.Marshal(results)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range results {
+			if r.Error == "" {
+				return NewToolResultJSON(encoded), nil
+			}
+		}
+		return NewToolResultError(string(encoded)), nil
+	}
+}
+
+// extractFanOutTargets removes the "targets" argument from args and returns
+// the targets it selects, all of them if it is unset.
+func extractFanOutTargets(args map[string]any, targets []string) ([]string, error) {
+	v, ok := args[FanOutTargetsProperty]
+	delete(args, FanOutTargetsProperty)
+	if !ok || v == nil {
+		return targets, nil
+	}
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("argument %q must be an array of target names", FanOutTargetsProperty)
+	}
+	var selected []string
+	for _, item := range list {
+		name, ok := item.(string)
+		if !ok || !slices.Contains(targets, name) {
+			return nil, fmt.Errorf("argument %q: unknown target %v, expected one of %s", FanOutTargetsProperty, item, strings.Join(targets, ", "))
+		}
+		if !slices.Contains(selected, name) {
+			selected = append(selected, name)
+		}
+	}
+	if len(selected) == 0 {
+		return targets, nil
+	}
+	return selected, nil
+}
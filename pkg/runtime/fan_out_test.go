@@ -0,0 +1,142 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+// regionClient stands in for a generated client of one backend.
+type regionClient struct {
+	fail  bool
+	delay time.Duration
+}
+
+// registerRegion registers a tool that echoes the target and its argument,
+// the way a generated ForwardTo function registers forwarding tools.
+func registerRegion(s runtime.MCPServer, client regionClient) {
+	tool := runtime.Tool{
+		Name:            "get_cluster",
+		Description:     "Gets a cluster.",
+		RawInputSchema:  json.RawMessage(`{"type":"object","properties":{"id":{"type":"string"}}}`),
+		RawOutputSchema: json.RawMessage(`{"type":"object","properties":{"region":{"type":"string"}}}`),
+	}
+	s.AddTool(tool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		time.Sleep(client.delay)
+		target, _ := runtime.FanOutTargetFromContext(ctx)
+		if client.fail {
+			return nil, errors.New(target + " is down")
+		}
+		out, _ := json.Marshal(map[string]any{"region": target, "id": request.Arguments["id"]})
+		return runtime.NewToolResultJSON(out), nil
+	})
+}
+
+// fanOutServer registers the fanned-out tools and returns them by name.
+func fanOutServer(clients map[string]regionClient, opts ...runtime.Option) (map[string]runtime.Tool, map[string]runtime.ToolHandler) {
+	tools := map[string]runtime.Tool{}
+	handlers := map[string]runtime.ToolHandler{}
+	runtime.FanOut(runtime.AddToolFunc(func(tool runtime.Tool, handler runtime.ToolHandler) {
+		tools[tool.Name] = tool
+		handlers[tool.Name] = handler
+	}), clients, registerRegion, opts...)
+	return tools, handlers
+}
+
+func TestFanOut(t *testing.T) {
+	g := NewWithT(t)
+	tools, handlers := fanOutServer(map[string]regionClient{"us": {}, "eu": {}, "ap": {fail: true}})
+	g.Expect(tools).To(HaveLen(1))
+
+	tool := tools["get_cluster"]
+	g.Expect(tool.Description).To(Equal("Gets a cluster.\n\nRuns against each of the targets ap, eu, us and returns the results by target."))
+	g.Expect(string(tool.RawInputSchema)).To(ContainSubstring(`"targets":{"description":"The targets to run against. Omit to run against all of them.","items":{"enum":["ap","eu","us"],"type":"string"},"type":"array"}`))
+	g.Expect(string(tool.RawOutputSchema)).To(ContainSubstring(`"eu":{"properties":{"error":{"type":"string"},"result":{"type":"object","properties":{"region":{"type":"string"}}}},"type":"object"}`))
+
+	result, err := handlers["get_cluster"](context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"id": "c1"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeFalse())
+	g.Expect(result.Text).To(MatchJSON(`{
+		"ap": {"error": "ap is down"},
+		"eu": {"result": {"region": "eu", "id": "c1"}},
+		"us": {"result": {"region": "us", "id": "c1"}}
+	}`))
+}
+
+func TestFanOutTargets(t *testing.T) {
+	g := NewWithT(t)
+	_, handlers := fanOutServer(map[string]regionClient{"us": {}, "eu": {}})
+	h := handlers["get_cluster"]
+
+	result, err := h(context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"id": "c1", "targets": []any{"eu"}}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Text).To(MatchJSON(`{"eu": {"result": {"region": "eu", "id": "c1"}}}`))
+
+	result, err = h(context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"targets": []any{"mars"}}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeTrue())
+	g.Expect(result.Text).To(ContainSubstring(`unknown target mars, expected one of eu, us`))
+
+	result, err = h(context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"targets": "eu"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeTrue())
+}
+
+func TestFanOutAllFailed(t *testing.T) {
+	g := NewWithT(t)
+	_, handlers := fanOutServer(map[string]regionClient{"us": {fail: true}, "eu": {fail: true}})
+
+	result, err := handlers["get_cluster"](context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeTrue())
+	g.Expect(result.Text).To(MatchJSON(`{"eu": {"error": "eu is down"}, "us": {"error": "us is down"}}`))
+}
+
+func TestFanOutParallelism(t *testing.T) {
+	g := NewWithT(t)
+	var running, peak atomic.Int32
+	clients := map[string]regionClient{}
+	for i := range 6 {
+		clients[fmt.Sprintf("r%d", i)] = regionClient{}
+	}
+	register := func(s runtime.MCPServer, client regionClient) {
+		s.AddTool(runtime.Tool{Name: "ping", RawInputSchema: json.RawMessage(`{"type":"object"}`)}, func(context.Context, *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+			n := running.Add(1)
+			for p := peak.Load(); n > p && !peak.CompareAndSwap(p, n); p = peak.Load() {
+			}
+			time.Sleep(10 * time.Millisecond)
+			running.Add(-1)
+			return runtime.NewToolResultText("pong"), nil
+		})
+	}
+	var handler runtime.ToolHandler
+	runtime.FanOut(runtime.AddToolFunc(func(_ runtime.Tool, h runtime.ToolHandler) { handler = h }), clients, register, runtime.WithFanOutParallelism(2))
+
+	result, err := handler(context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeFalse())
+	g.Expect(peak.Load()).To(BeNumerically("<=", 2))
+	g.Expect(result.Text).To(ContainSubstring(`"r5":{"result":"pong"}`))
+}
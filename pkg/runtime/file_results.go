@@ -0,0 +1,128 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"net/url"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// httpBody is the full name of google.api.HttpBody, whose data is always
+// returned as a file.
+const httpBody protoreflect.FullName = "google.api.HttpBody"
+
+// EmbeddedResource is a file returned by a tool as an MCP embedded resource
+// instead of inline in its text content. Text holds the contents of string
+// fields and Blob those of bytes fields.
+type EmbeddedResource struct {
+	URI      string
+	MIMEType string
+	Text     string
+	Blob     []byte
+}
+
+// NewToolResultFiles creates a successful result for msg, the response of
+// the tool named tool, like NewToolResultJSON(EncodeMessage(msg)), except
+// that every set file field of msg is returned as an embedded resource and
+// left empty in the JSON. The file fields are the top-level fields marked
+// (mcp.field).file, the data of google.api.HttpBody fields, and that of msg
+// itself if it is a google.api.HttpBody.
+//
+// A resource's MIME type is the value of a sibling string field named
+// content_type or mime_type, defaulting to application/octet-stream for
+// bytes and text/plain for strings, and its URI is
+// tool://<tool>/<field>, followed by /<name> if a sibling field named
+// filename or file_name is set.
+func NewToolResultFiles(tool string, msg proto.Message) (*CallToolResult, error) {
+	m := proto.Clone(msg).ProtoReflect()
+	var resources []EmbeddedResource
+	if md := m.Descriptor(); md.FullName() == httpBody {
+		resources = appendFile(resources, tool, "data", m, md.Fields().ByName("data"))
+	} else {
+		for i := 0; i < md.Fields().Len(); i++ {
+			fd := md.Fields().Get(i)
+			switch {
+			case isFileField(fd):
+				resources = appendFile(resources, tool, string(fd.Name()), m, fd)
+			case isHTTPBodyField(fd) && m.Has(fd):
+				body := m.Mutable(fd).Message()
+				resources = appendFile(resources, tool, string(fd.Name()), body, body.Descriptor().Fields().ByName("data"))
+			}
+		}
+	}
+	structured, err := EncodeMessage(m.Interface())
+	if err != nil {
+		return nil, err
+	}
+	result := NewToolResultJSON(structured)
+	result.Resources = resources
+	return result, nil
+}
+
+// appendFile appends the field fd of m to resources if it is set, and
+// clears it.
+func appendFile(resources []EmbeddedResource, tool, name string, m protoreflect.Message, fd protoreflect.FieldDescriptor) []EmbeddedResource {
+	if fd == nil || !m.Has(fd) {
+		return resources
+	}
+	uri := "tool://" + url.PathEscape(tool) + "/" + url.PathEscape(name)
+	if filename := siblingString(m, "filename", "file_name"); filename != "" {
+		uri += "/" + url.PathEscape(filename)
+	}
+	r := EmbeddedResource{URI: uri, MIMEType: siblingString(m, "content_type", "mime_type")}
+	if fd.Kind() == protoreflect.BytesKind {
+		r.Blob = m.Get(fd).Bytes()
+		if r.MIMEType == "" {
+			r.MIMEType = "application/octet-stream"
+		}
+	} else {
+		r.Text = m.Get(fd).String()
+		if r.MIMEType == "" {
+			r.MIMEType = "text/plain"
+		}
+	}
+	m.Clear(fd)
+	return append(resources, r)
+}
+
+// siblingString returns the first non-empty string field of m among names.
+func siblingString(m protoreflect.Message, names ...protoreflect.Name) string {
+	for _, name := range names {
+		fd := m.Descriptor().Fields().ByName(name)
+		if fd == nil || fd.Kind() != protoreflect.StringKind || fd.Cardinality() == protoreflect.Repeated {
+			continue
+		}
+		if s := m.Get(fd).String(); s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+// isFileField reports whether fd is a singular bytes or string field marked
+// (mcp.field).file.
+func isFileField(fd protoreflect.FieldDescriptor) bool {
+	if fd.Cardinality() == protoreflect.Repeated || !fieldOptions(fd).GetFile() {
+		return false
+	}
+	return fd.Kind() == protoreflect.BytesKind || fd.Kind() == protoreflect.StringKind
+}
+
+// isHTTPBodyField reports whether fd is a singular google.api.HttpBody field.
+func isHTTPBodyField(fd protoreflect.FieldDescriptor) bool {
+	return fd.Cardinality() != protoreflect.Repeated && fd.Message() != nil && fd.Message().FullName() == httpBody
+}
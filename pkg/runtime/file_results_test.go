@@ -0,0 +1,74 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestNewToolResultFiles(t *testing.T) {
+	g := NewWithT(t)
+	resp := &testdata.ExportConfigResponse{
+		Document:    []byte("name: a\n"),
+		ContentType: "application/yaml",
+		Filename:    "a b.yaml",
+	}
+	result, err := runtime.NewToolResultFiles("export_config", resp)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Resources).To(Equal([]runtime.EmbeddedResource{{
+		URI:      "tool://export_config/document/a%20b.yaml",
+		MIMEType: "application/yaml",
+		Blob:     []byte("name: a\n"),
+	}}))
+	g.Expect(result.Text).To(MatchJSON(`{"document":"","content_type":"application/yaml","filename":"a b.yaml"}`))
+	g.Expect(result.StructuredContent).To(MatchJSON(result.Text))
+
+	// The response itself is left alone.
+	g.Expect(resp.Document).To(Equal([]byte("name: a\n")))
+}
+
+func TestNewToolResultFiles_Defaults(t *testing.T) {
+	g := NewWithT(t)
+	result, err := runtime.NewToolResultFiles("export_config", &testdata.ExportConfigResponse{Document: []byte{0xff}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Resources).To(Equal([]runtime.EmbeddedResource{{
+		URI:      "tool://export_config/document",
+		MIMEType: "application/octet-stream",
+		Blob:     []byte{0xff},
+	}}))
+
+	// Unset file fields are not returned.
+	result, err = runtime.NewToolResultFiles("export_config", &testdata.ExportConfigResponse{Filename: "a.yaml"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Resources).To(BeEmpty())
+}
+
+func TestNewToolResultFiles_HttpBody(t *testing.T) {
+	g := NewWithT(t)
+	result, err := runtime.NewToolResultFiles("download", &httpbody.HttpBody{ContentType: "text/csv", Data: []byte("a,b\n")})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Resources).To(Equal([]runtime.EmbeddedResource{{
+		URI:      "tool://download/data",
+		MIMEType: "text/csv",
+		Blob:     []byte("a,b\n"),
+	}}))
+	g.Expect(result.Text).To(MatchJSON(`{"content_type":"text/csv","data":"","extensions":[]}`))
+}
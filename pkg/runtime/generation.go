@@ -0,0 +1,96 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// GenerationInfo records the protoc-gen-go-mcp version and the plugin
+// options the tools of a proto file were generated with. Generated files
+// declare one per proto file, as File_<path>_GenerationInfo.
+type GenerationInfo struct {
+	// Source is the path of the proto file.
+	Source string
+	// Version is the protoc-gen-go-mcp version, "devel" for builds other
+	// than releases.
+	Version string
+	// Options holds the options that affect the generated tools as
+	// sorted name=value pairs, including those left at their defaults.
+	Options []string
+	// Cosmetic holds, like Options, the options that only change the
+	// descriptions and the formatting of the tools, such as
+	// comment_markdown. Tools differing in them accept the same arguments,
+	// so CheckGenerationInfo does not compare them.
+	Cosmetic []string
+}
+
+// Option returns the value of the named option, from Options or Cosmetic,
+// and whether it is recorded.
+func (i GenerationInfo) Option(name string) (string, bool) {
+	for _, opt := range slices.Concat(i.Options, i.Cosmetic) {
+		if n, v, _ := strings.Cut(opt, "="); n == name {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// CheckGenerationInfo returns an error naming every option whose value
+// differs between infos, typically those of all packages registered on one
+// server: tools generated with e.g. openai_strict=true next to tools
+// without it suggest a stale package. Options recorded by only some of the
+// infos, as by older versions, are not compared, and neither are versions.
+// Whether a mismatch is fatal or only logged is up to the caller.
+func CheckGenerationInfo(infos ...GenerationInfo) error {
+	var names []string
+	for _, info := range infos {
+		for _, opt := range info.Options {
+			name, _, _ := strings.Cut(opt, "=")
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	slices.Sort(names)
+
+	var errs []error
+	for _, name := range names {
+		var values []string
+		sources := map[string][]string{}
+		for _, info := range infos {
+			value, ok := info.Option(name)
+			if !ok {
+				continue
+			}
+			if _, seen := sources[value]; !seen {
+				values = append(values, value)
+			}
+			sources[value] = append(sources[value], info.Source)
+		}
+		if len(values) < 2 {
+			continue
+		}
+		var desc []string
+		for _, value := range values {
+			desc = append(desc, fmt.Sprintf("%q in %s", value, strings.Join(sources[value], ", ")))
+		}
+		errs = append(errs, fmt.Errorf("option %s differs: %s", name, strings.Join(desc, "; ")))
+	}
+	return errors.Join(errs...)
+}
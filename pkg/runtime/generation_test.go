@@ -0,0 +1,54 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func TestCheckGenerationInfo(t *testing.T) {
+	g := NewWithT(t)
+	a := runtime.GenerationInfo{Source: "a.proto", Version: "v1.0.0", Options: []string{"openai_strict=true", "titles=false"}}
+	b := runtime.GenerationInfo{Source: "b.proto", Version: "v1.1.0", Options: []string{"openai_strict=true", "titles=false"}}
+	c := runtime.GenerationInfo{Source: "c.proto", Version: "v1.0.0", Options: []string{"openai_strict=false", "titles=false"}}
+
+	// Versions alone do not matter.
+	g.Expect(runtime.CheckGenerationInfo(a, b)).To(Succeed())
+	g.Expect(runtime.CheckGenerationInfo()).To(Succeed())
+
+	err := runtime.CheckGenerationInfo(a, b, c)
+	g.Expect(err).To(MatchError(`option openai_strict differs: "true" in a.proto, b.proto; "false" in c.proto`))
+
+	// Options an older generator did not record are not compared.
+	old := runtime.GenerationInfo{Source: "old.proto", Options: []string{"titles=false"}}
+	g.Expect(runtime.CheckGenerationInfo(a, old)).To(Succeed())
+
+	value, ok := c.Option("openai_strict")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(value).To(Equal("false"))
+	_, ok = old.Option("openai_strict")
+	g.Expect(ok).To(BeFalse())
+
+	// Cosmetic options are recorded but not compared.
+	a.Cosmetic = []string{"comment_markdown=keep"}
+	b.Cosmetic = []string{"comment_markdown=strip"}
+	g.Expect(runtime.CheckGenerationInfo(a, b)).To(Succeed())
+	value, ok = b.Option("comment_markdown")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(value).To(Equal("strip"))
+}
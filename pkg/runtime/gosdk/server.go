@@ -19,6 +19,8 @@ package gosdk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"sync"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
@@ -26,6 +28,9 @@ import (
 
 type server struct {
 	s *mcp.Server
+
+	lazyOnce sync.Once
+	lazy     runtime.LazySchemas
 }
 
 // Wrap returns a runtime.MCPServer backed by a go-sdk Server.
@@ -48,6 +53,7 @@ func NewServer(name, version string) (*mcp.Server, runtime.MCPServer) {
 func (w *server) AddTool(tool runtime.Tool, handler runtime.ToolHandler) {
 	mcpTool := &mcp.Tool{
 		Name:        tool.Name,
+		Title:       tool.Title,
 		Description: tool.Description,
 		// InputSchema/OutputSchema accept any JSON-marshalable value; json.RawMessage works.
 		InputSchema: json.RawMessage(tool.RawInputSchema),
@@ -55,6 +61,15 @@ func (w *server) AddTool(tool runtime.Tool, handler runtime.ToolHandler) {
 	if len(tool.RawOutputSchema) > 0 {
 		mcpTool.OutputSchema = json.RawMessage(tool.RawOutputSchema)
 	}
+	if len(tool.Tags) > 0 {
+		mcpTool.Meta = mcp.Meta{runtime.TagsMetaKey: tool.Tags}
+	}
+	if tool.LoadSchemas != nil {
+		// go-sdk wants an object schema now; tools/list gets the real one.
+		mcpTool.InputSchema = placeholderSchema
+		w.lazy.Add(tool)
+		w.lazyOnce.Do(func() { w.s.AddReceivingMiddleware(w.loadSchemas) })
+	}
 
 	w.s.AddTool(mcpTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		var args map[string]any
@@ -66,8 +81,26 @@ func (w *server) AddTool(tool runtime.Tool, handler runtime.ToolHandler) {
 		if args == nil {
 			args = make(map[string]any)
 		}
+		ctx = runtime.WithSessionID(ctx, request.Session.ID())
+		ctx = runtime.WithLogger(ctx, logger{request.Session})
+		if info := clientInfo(request.Session); info != nil {
+			ctx = runtime.WithClientInfo(ctx, info)
+		}
+		if supportsElicitation(request.Session) {
+			ctx = runtime.WithElicitor(ctx, elicitor{request.Session})
+		}
+		if supportsSampling(request.Session) {
+			ctx = runtime.WithSampler(ctx, sampler{request.Session})
+		}
+		if supportsRoots(request.Session) {
+			ctx = runtime.WithRootsLister(ctx, rootsLister{request.Session})
+		}
+		if token := request.Params.GetProgressToken(); token != nil {
+			ctx = runtime.WithProgressReporter(ctx, progressReporter{request.Session, token})
+		}
 		result, err := handler(ctx, &runtime.CallToolRequest{
 			Arguments: args,
+			Meta:      request.Params.Meta,
 		})
 		if err != nil {
 			return nil, err
@@ -75,10 +108,382 @@ func (w *server) AddTool(tool runtime.Tool, handler runtime.ToolHandler) {
 		if result == nil {
 			return nil, nil
 		}
+		content := []mcp.Content{&mcp.TextContent{Text: result.Text}}
+		if len(result.Parts) > 0 {
+			content = make([]mcp.Content, len(result.Parts))
+			for i, part := range result.Parts {
+				content[i] = &mcp.TextContent{Text: part}
+			}
+		}
+		for _, r := range result.Resources {
+			content = append(content, &mcp.EmbeddedResource{Resource: &mcp.ResourceContents{
+				URI:      r.URI,
+				MIMEType: r.MIMEType,
+				Text:     r.Text,
+				Blob:     r.Blob,
+			}})
+		}
 		return &mcp.CallToolResult{
-			Content:           []mcp.Content{&mcp.TextContent{Text: result.Text}},
+			Meta:              result.Meta,
+			Content:           content,
 			StructuredContent: result.StructuredContent,
 			IsError:           result.IsError,
 		}, nil
 	})
 }
+
+// placeholderSchema stands in for the input schema of a tool until its
+// schemas are loaded.
+var placeholderSchema = json.RawMessage(`{"type":"object"}`)
+
+// loadSchemas fills the schemas of the tools registered with
+// runtime.Tool.LoadSchemas into tools/list results.
+func (w *server) loadSchemas(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		result, err := next(ctx, method, req)
+		list, ok := result.(*mcp.ListToolsResult)
+		if err != nil || !ok {
+			return result, err
+		}
+		for i, listed := range list.Tools {
+			tool, ok := w.lazy.Load(listed.Name)
+			if !ok {
+				continue
+			}
+			// The listed tool is the one the server holds; change a copy.
+			loaded := *listed
+			loaded.InputSchema = json.RawMessage(tool.RawInputSchema)
+			if len(tool.RawOutputSchema) > 0 {
+				loaded.OutputSchema = json.RawMessage(tool.RawOutputSchema)
+			}
+			list.Tools[i] = &loaded
+		}
+		return result, nil
+	}
+}
+
+// logger implements runtime.Logger for a session. The session drops
+// messages until the client sets a level.
+type logger struct {
+	ss *mcp.ServerSession
+}
+
+func (l logger) Log(ctx context.Context, level runtime.LogLevel, name string, data any) error {
+	return l.ss.Log(ctx, &mcp.LoggingMessageParams{Level: mcp.LoggingLevel(level), Logger: name, Data: data})
+}
+
+// progressReporter implements runtime.ProgressReporter for the request with
+// the given progress token.
+type progressReporter struct {
+	ss    *mcp.ServerSession
+	token any
+}
+
+func (r progressReporter) Report(ctx context.Context, progress, total float64, message string) error {
+	return r.ss.NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+		ProgressToken: r.token,
+		Progress:      progress,
+		Total:         total,
+		Message:       message,
+	})
+}
+
+// clientInfo returns what the client of session declared when it
+// initialized, or nil before initialization.
+func clientInfo(session *mcp.ServerSession) *runtime.ClientInfo {
+	if session == nil {
+		return nil
+	}
+	params := session.InitializeParams()
+	if params == nil {
+		return nil
+	}
+	info := &runtime.ClientInfo{ProtocolVersion: params.ProtocolVersion}
+	if params.ClientInfo != nil {
+		info.Name = params.ClientInfo.Name
+		info.Version = params.ClientInfo.Version
+	}
+	if c := params.Capabilities; c != nil {
+		info.Capabilities = runtime.ClientCapabilities{
+			Roots:        c.RootsV2 != nil,
+			Sampling:     c.Sampling != nil,
+			Elicitation:  c.Elicitation != nil,
+			Experimental: c.Experimental,
+		}
+	}
+	return info
+}
+
+// elicitor implements runtime.Elicitor on a go-sdk server session.
+type elicitor struct {
+	session *mcp.ServerSession
+}
+
+func (e elicitor) Elicit(ctx context.Context, message string, requestedSchema json.RawMessage) (*runtime.ElicitResult, error) {
+	res, err := e.session.Elicit(ctx, &mcp.ElicitParams{
+		Message:         message,
+		RequestedSchema: requestedSchema,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.ElicitResult{Action: res.Action, Content: res.Content}, nil
+}
+
+// supportsElicitation reports whether the client behind session declared the
+// elicitation capability.
+func supportsElicitation(session *mcp.ServerSession) bool {
+	if session == nil {
+		return false
+	}
+	params := session.InitializeParams()
+	return params != nil && params.Capabilities != nil && params.Capabilities.Elicitation != nil
+}
+
+// rootsLister implements runtime.RootsLister on a go-sdk server session.
+type rootsLister struct {
+	session *mcp.ServerSession
+}
+
+func (l rootsLister) ListRoots(ctx context.Context) ([]string, error) {
+	res, err := l.session.ListRoots(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	uris := make([]string, 0, len(res.Roots))
+	for _, root := range res.Roots {
+		uris = append(uris, root.URI)
+	}
+	return uris, nil
+}
+
+// supportsRoots reports whether the client behind session declared the
+// roots capability.
+func supportsRoots(session *mcp.ServerSession) bool {
+	if session == nil {
+		return false
+	}
+	params := session.InitializeParams()
+	return params != nil && params.Capabilities != nil && params.Capabilities.RootsV2 != nil
+}
+
+// sampler implements runtime.Sampler on a go-sdk server session.
+type sampler struct {
+	session *mcp.ServerSession
+}
+
+func (s sampler) CreateMessage(ctx context.Context, request *runtime.SamplingRequest) (*runtime.SamplingResult, error) {
+	params := &mcp.CreateMessageParams{
+		MaxTokens:     int64(request.MaxTokens),
+		SystemPrompt:  request.SystemPrompt,
+		Temperature:   request.Temperature,
+		StopSequences: request.StopSequences,
+	}
+	for _, m := range request.Messages {
+		params.Messages = append(params.Messages, &mcp.SamplingMessage{
+			Role:    mcp.Role(m.Role),
+			Content: &mcp.TextContent{Text: m.Text},
+		})
+	}
+	if len(request.ModelHints) > 0 {
+		params.ModelPreferences = &mcp.ModelPreferences{}
+		for _, name := range request.ModelHints {
+			params.ModelPreferences.Hints = append(params.ModelPreferences.Hints, &mcp.ModelHint{Name: name})
+		}
+	}
+	res, err := s.session.CreateMessage(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	text, ok := res.Content.(*mcp.TextContent)
+	if !ok {
+		return nil, fmt.Errorf("sampling returned %T, not text", res.Content)
+	}
+	return &runtime.SamplingResult{
+		Role:       string(res.Role),
+		Text:       text.Text,
+		Model:      res.Model,
+		StopReason: res.StopReason,
+	}, nil
+}
+
+// supportsSampling reports whether the client behind session declared the
+// sampling capability.
+func supportsSampling(session *mcp.ServerSession) bool {
+	if session == nil {
+		return false
+	}
+	params := session.InitializeParams()
+	return params != nil && params.Capabilities != nil && params.Capabilities.Sampling != nil
+}
+
+// CompletionHandler serves completion/complete requests from r; set it as
+// mcp.ServerOptions.CompletionHandler. MCP completes the arguments of prompts
+// and resource templates, so a reference resolves to the completers added
+// under the prompt name or the resource template URI.
+func CompletionHandler(r *runtime.CompletionRegistry) func(context.Context, *mcp.CompleteRequest) (*mcp.CompleteResult, error) {
+	return func(ctx context.Context, request *mcp.CompleteRequest) (*mcp.CompleteResult, error) {
+		if request.Session != nil {
+			ctx = runtime.WithSessionID(ctx, request.Session.ID())
+		}
+		params := request.Params
+		var name string
+		if ref := params.Ref; ref != nil {
+			name = ref.Name
+			if ref.Type == "ref/resource" {
+				name = ref.URI
+			}
+		}
+		var args map[string]string
+		if params.Context != nil {
+			args = params.Context.Arguments
+		}
+		completion, err := r.Complete(ctx, name, params.Argument.Name, params.Argument.Value, args)
+		if err != nil {
+			return nil, err
+		}
+		return &mcp.CompleteResult{
+			Completion: mcp.CompletionResultDetails{
+				Values:  completion.Values,
+				Total:   completion.Total,
+				HasMore: completion.HasMore,
+			},
+		}, nil
+	}
+}
+
+// SubscribeHandler starts the watch streams of r for resources/subscribe
+// requests; set it as mcp.ServerOptions.SubscribeHandler together with
+// UnsubscribeHandler, and call NotifyResourceUpdated once the server exists.
+// The subscriptions of a session are dropped when it ends.
+func SubscribeHandler(r *runtime.SubscriptionRegistry) func(context.Context, *mcp.SubscribeRequest) error {
+	var sessions sync.Map
+	return func(ctx context.Context, request *mcp.SubscribeRequest) error {
+		if err := r.Subscribe(ctx, request.Params.URI, request.Session); err != nil {
+			return err
+		}
+		if _, watched := sessions.LoadOrStore(request.Session, true); !watched {
+			go func() {
+				_ = request.Session.Wait()
+				r.UnsubscribeAll(request.Session)
+				sessions.Delete(request.Session)
+			}()
+		}
+		return nil
+	}
+}
+
+// UnsubscribeHandler stops the watch streams of r nobody is subscribed to
+// anymore; set it as mcp.ServerOptions.UnsubscribeHandler.
+func UnsubscribeHandler(r *runtime.SubscriptionRegistry) func(context.Context, *mcp.UnsubscribeRequest) error {
+	return func(_ context.Context, request *mcp.UnsubscribeRequest) error {
+		r.Unsubscribe(request.Params.URI, request.Session)
+		return nil
+	}
+}
+
+// NotifyResourceUpdated makes s send notifications/resources/updated to the
+// subscribers of a resource whenever its watch stream in r receives a
+// message.
+func NotifyResourceUpdated(s *mcp.Server, r *runtime.SubscriptionRegistry) {
+	r.OnUpdate(func(ctx context.Context, uri string) {
+		_ = s.ResourceUpdated(ctx, &mcp.ResourceUpdatedNotificationParams{URI: uri})
+	})
+}
+
+// AddTools adds tools one by one; go-sdk sends a single
+// notifications/tools/list_changed for changes made together.
+func (w *server) AddTools(tools ...runtime.ServerTool) {
+	for _, t := range tools {
+		w.AddTool(t.Tool, t.Handler)
+	}
+}
+
+func (w *server) RemoveTools(names ...string) {
+	w.s.RemoveTools(names...)
+	w.lazy.Remove(names...)
+}
+
+func (w *server) RemovePrompts(names ...string) {
+	w.s.RemovePrompts(names...)
+}
+
+func (w *server) RemoveResources(uris ...string) {
+	for _, uri := range uris {
+		if (runtime.Resource{URI: uri}).IsTemplate() {
+			w.s.RemoveResourceTemplates(uri)
+		} else {
+			w.s.RemoveResources(uri)
+		}
+	}
+}
+
+func (w *server) AddPrompt(prompt runtime.Prompt, handler runtime.PromptHandler) {
+	mcpPrompt := &mcp.Prompt{
+		Name:        prompt.Name,
+		Title:       prompt.Title,
+		Description: prompt.Description,
+	}
+	for _, arg := range prompt.Arguments {
+		mcpPrompt.Arguments = append(mcpPrompt.Arguments, &mcp.PromptArgument{
+			Name:        arg.Name,
+			Description: arg.Description,
+			Required:    arg.Required,
+		})
+	}
+
+	w.s.AddPrompt(mcpPrompt, func(ctx context.Context, request *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		result, err := handler(ctx, &runtime.GetPromptRequest{
+			Arguments: request.Params.Arguments,
+		})
+		if err != nil {
+			return nil, err
+		}
+		mcpResult := &mcp.GetPromptResult{Description: result.Description}
+		for _, m := range result.Messages {
+			mcpResult.Messages = append(mcpResult.Messages, &mcp.PromptMessage{
+				Role:    mcp.Role(m.Role),
+				Content: &mcp.TextContent{Text: m.Text},
+			})
+		}
+		return mcpResult, nil
+	})
+}
+
+func (w *server) AddResource(resource runtime.Resource, handler runtime.ResourceHandler) {
+	h := func(ctx context.Context, request *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		if request.Session != nil {
+			ctx = runtime.WithSessionID(ctx, request.Session.ID())
+		}
+		result, err := handler(ctx, &runtime.ReadResourceRequest{URI: request.Params.URI})
+		if err != nil {
+			return nil, err
+		}
+		mcpResult := &mcp.ReadResourceResult{}
+		for _, c := range result.Contents {
+			mcpResult.Contents = append(mcpResult.Contents, &mcp.ResourceContents{
+				URI:      c.URI,
+				MIMEType: c.MIMEType,
+				Text:     c.Text,
+			})
+		}
+		return mcpResult, nil
+	}
+	if resource.IsTemplate() {
+		w.s.AddResourceTemplate(&mcp.ResourceTemplate{
+			URITemplate: resource.URI,
+			Name:        resource.Name,
+			Title:       resource.Title,
+			Description: resource.Description,
+			MIMEType:    resource.MIMEType,
+		}, h)
+		return
+	}
+	w.s.AddResource(&mcp.Resource{
+		URI:         resource.URI,
+		Name:        resource.Name,
+		Title:       resource.Title,
+		Description: resource.Description,
+		MIMEType:    resource.MIMEType,
+	}, h)
+}
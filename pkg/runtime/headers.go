@@ -0,0 +1,170 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// HeadersProperty is the tool argument that carries forwarded headers.
+const HeadersProperty = "headers"
+
+// WithForwardedHeaders adds an optional "headers" object to every tool whose
+// values the handler sends along as outgoing gRPC metadata (or Connect request
+// headers). Only the listed header names are accepted; matching is
+// case-insensitive. Use it for trace or idempotency headers an agent may set.
+func WithForwardedHeaders(names ...string) Option {
+	return func(c *config) {
+		c.ForwardedHeaders = append(c.ForwardedHeaders, names...)
+	}
+}
+
+// AddHeadersToTool adds the "headers" object for the allowed header names to
+// the tool's input schema. A tool whose request already has a "headers"
+// property is returned unchanged.
+func AddHeadersToTool(tool Tool, allowed []string) Tool {
+	if len(allowed) == 0 {
+		return tool
+	}
+	var schema map[string]interface{}
+	if err := json.Unmarshal(tool.RawInputSchema, &schema); err != nil {
+		return tool
+	}
+	props, _ := schema["properties"].(map[string]interface{})
+	if props == nil {
+		props = map[string]interface{}{}
+		schema["properties"] = props
+	}
+	if _, ok := props[HeadersProperty]; ok {
+		return tool
+	}
+
+	strict := schema["additionalProperties"] == false
+	names := canonicalHeaderNames(allowed)
+	headerProps := make(map[string]interface{}, len(names))
+	required := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		if strict {
+			headerProps[name] = map[string]interface{}{"type": []interface{}{"string", "null"}}
+			required = append(required, name)
+		} else {
+			headerProps[name] = map[string]interface{}{"type": "string"}
+		}
+	}
+	headers := map[string]interface{}{
+		"type":                 "object",
+		"description":          "Optional request headers forwarded to the backend.",
+		"properties":           headerProps,
+		"additionalProperties": false,
+	}
+	if strict {
+		headers["type"] = []interface{}{"object", "null"}
+		headers["required"] = required
+		req, _ := schema["required"].([]interface{})
+		schema["required"] = append(req, HeadersProperty)
+	}
+	props[HeadersProperty] = headers
+
+	modified, err := json.Marshal(schema)
+	if err != nil {
+		return tool
+	}
+	tool.RawInputSchema = json.RawMessage(modified)
+	return tool
+}
+
+// ExtractHeaders removes the "headers" argument from args and appends the
+// allowed headers to the outgoing gRPC metadata of ctx. It does nothing when
+// no headers are allowed or when md has its own "headers" field. A header
+// outside the allowlist or a non-string value is a model-readable error.
+func ExtractHeaders(ctx context.Context, md protoreflect.MessageDescriptor, args map[string]any, allowed []string) (context.Context, error) {
+	if len(allowed) == 0 || md.Fields().ByName(HeadersProperty) != nil || md.Fields().ByJSONName(HeadersProperty) != nil {
+		return ctx, nil
+	}
+	raw, ok := args[HeadersProperty]
+	delete(args, HeadersProperty)
+	if !ok || raw == nil {
+		return ctx, nil
+	}
+	headers, ok := raw.(map[string]any)
+	if !ok {
+		return ctx, fmt.Errorf("argument %q must be an object of header names to string values", HeadersProperty)
+	}
+
+	allow := map[string]bool{}
+	for _, name := range allowed {
+		allow[http.CanonicalHeaderKey(name)] = true
+	}
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var kv []string
+	for _, k := range keys {
+		v := headers[k]
+		if v == nil {
+			continue
+		}
+		if !allow[http.CanonicalHeaderKey(k)] {
+			return ctx, fmt.Errorf("header %q is not allowed; allowed headers: %s", k, strings.Join(canonicalHeaderNames(allowed), ", "))
+		}
+		s, ok := v.(string)
+		if !ok {
+			return ctx, fmt.Errorf("header %q must be a string", k)
+		}
+		kv = append(kv, strings.ToLower(k), s)
+	}
+	if len(kv) == 0 {
+		return ctx, nil
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...), nil
+}
+
+// SetOutgoingHeaders copies the outgoing gRPC metadata of ctx, including
+// headers added by ExtractHeaders, into h. The generated Connect forwarders
+// use it because Connect clients do not read gRPC metadata.
+func SetOutgoingHeaders(ctx context.Context, h http.Header) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	for k, vs := range md {
+		for _, v := range vs {
+			h.Add(k, v)
+		}
+	}
+}
+
+// canonicalHeaderNames returns names in canonical form, sorted and deduplicated.
+func canonicalHeaderNames(names []string) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, n := range names {
+		c := http.CanonicalHeaderKey(n)
+		if !seen[c] {
+			seen[c] = true
+			out = append(out, c)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAddHeadersToTool(t *testing.T) {
+	allowed := []string{"x-request-id", "Idempotency-Key", "X-Request-Id"}
+
+	t.Run("optional object of allowed names", func(t *testing.T) {
+		g := NewWithT(t)
+		tool := runtime.AddHeadersToTool(runtime.Tool{Name: "t", RawInputSchema: json.RawMessage(`{"type":"object","properties":{"name":{"type":"string"}},"required":["name"]}`)}, allowed)
+
+		var schema map[string]any
+		g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+		g.Expect(schema["required"]).To(Equal([]any{"name"}))
+		headers := schema["properties"].(map[string]any)["headers"].(map[string]any)
+		g.Expect(headers["additionalProperties"]).To(BeFalse())
+		g.Expect(headers["properties"]).To(Equal(map[string]any{
+			"Idempotency-Key": map[string]any{"type": "string"},
+			"X-Request-Id":    map[string]any{"type": "string"},
+		}))
+	})
+
+	t.Run("strict schema", func(t *testing.T) {
+		g := NewWithT(t)
+		tool := runtime.AddHeadersToTool(runtime.Tool{Name: "t", RawInputSchema: json.RawMessage(`{"type":"object","properties":{},"required":[],"additionalProperties":false}`)}, allowed)
+
+		var schema map[string]any
+		g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+		g.Expect(schema["required"]).To(Equal([]any{"headers"}))
+		headers := schema["properties"].(map[string]any)["headers"].(map[string]any)
+		g.Expect(headers["type"]).To(Equal([]any{"object", "null"}))
+		g.Expect(headers["required"]).To(Equal([]any{"Idempotency-Key", "X-Request-Id"}))
+	})
+
+	t.Run("request field named headers wins", func(t *testing.T) {
+		g := NewWithT(t)
+		tool := runtime.Tool{Name: "t", RawInputSchema: json.RawMessage(`{"type":"object","properties":{"headers":{"type":"string"}}}`)}
+		g.Expect(runtime.AddHeadersToTool(tool, allowed)).To(Equal(tool))
+	})
+}
+
+func TestExtractHeaders(t *testing.T) {
+	md := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor()
+	allowed := []string{"X-Request-Id", "idempotency-key"}
+
+	t.Run("allowed headers become outgoing metadata", func(t *testing.T) {
+		g := NewWithT(t)
+		args := map[string]any{"name": "n", "headers": map[string]any{"x-request-id": "r-1", "Idempotency-Key": "k-1", "X-Ignored": nil}}
+		ctx, err := runtime.ExtractHeaders(context.Background(), md, args, allowed)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(args).To(Equal(map[string]any{"name": "n"}))
+
+		out, ok := metadata.FromOutgoingContext(ctx)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(out.Get("x-request-id")).To(Equal([]string{"r-1"}))
+		g.Expect(out.Get("idempotency-key")).To(Equal([]string{"k-1"}))
+
+		h := http.Header{}
+		runtime.SetOutgoingHeaders(ctx, h)
+		g.Expect(h.Get("X-Request-Id")).To(Equal("r-1"))
+	})
+
+	t.Run("header outside the allowlist", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := runtime.ExtractHeaders(context.Background(), md, map[string]any{"headers": map[string]any{"Authorization": "x"}}, allowed)
+		g.Expect(err).To(MatchError(`header "Authorization" is not allowed; allowed headers: Idempotency-Key, X-Request-Id`))
+	})
+
+	t.Run("non-string value", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := runtime.ExtractHeaders(context.Background(), md, map[string]any{"headers": map[string]any{"X-Request-Id": 1.0}}, allowed)
+		g.Expect(err).To(MatchError(`header "X-Request-Id" must be a string`))
+	})
+
+	t.Run("disabled without allowlist", func(t *testing.T) {
+		g := NewWithT(t)
+		args := map[string]any{"headers": map[string]any{"X-Request-Id": "r"}}
+		ctx, err := runtime.ExtractHeaders(context.Background(), md, args, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(args).To(HaveKey("headers"))
+		_, ok := metadata.FromOutgoingContext(ctx)
+		g.Expect(ok).To(BeFalse())
+	})
+}
@@ -0,0 +1,263 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+)
+
+// HealthCheck reports whether a dependency of the server is usable.
+type HealthCheck func(ctx context.Context) error
+
+// HealthOptions tunes the error rate a HealthChecker tolerates.
+type HealthOptions struct {
+	// Window is how far back backend calls count towards the error rate.
+	// Zero means one minute. Windows under 10ns count as 10ns.
+	Window time.Duration
+
+	// MaxErrorRate is the fraction of failed backend calls in the window
+	// above which the server is not ready. Zero means 0.5.
+	MaxErrorRate float64
+
+	// MinCalls is the number of calls in the window below which the error
+	// rate is not judged, so a single failure after a quiet period does not
+	// take the server out of rotation. Zero means 5.
+	MinCalls int
+}
+
+// healthBuckets is the number of buckets the window is divided into.
+const healthBuckets = 10
+
+// HealthChecker serves the liveness and readiness endpoints of an MCP server,
+// so orchestrators don't route traffic to instances with a broken upstream.
+// Readiness reflects the checks added with AddCheck, such as GRPCConnCheck
+// for the connection of a ForwardTo client, and the recent error rate of
+// backend calls, recorded by the generated handlers with WithHealthChecker
+// or by its client interceptors. A HealthChecker is safe for concurrent use.
+type HealthChecker struct {
+	opts HealthOptions
+	now  func() time.Time
+
+	mu      sync.Mutex
+	checks  map[string]HealthCheck
+	buckets [healthBuckets]healthBucket
+}
+
+type healthBucket struct {
+	index    int64
+	calls    int
+	failures int
+}
+
+// NewHealthChecker returns a HealthChecker without checks.
+func NewHealthChecker(opts HealthOptions) *HealthChecker {
+	if opts.Window <= 0 {
+		opts.Window = time.Minute
+	}
+	if opts.MaxErrorRate <= 0 {
+		opts.MaxErrorRate = 0.5
+	}
+	if opts.MinCalls <= 0 {
+		opts.MinCalls = 5
+	}
+	return &HealthChecker{opts: opts, now: time.Now, checks: make(map[string]HealthCheck)}
+}
+
+// AddCheck adds a readiness check under name, replacing any check of that
+// name.
+func (h *HealthChecker) AddCheck(name string, check HealthCheck) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.checks[name] = check
+}
+
+// WithHealthChecker makes the generated handlers record the outcome of
+// every backend call with h, so its readiness reflects the error rate of
+// the forwarders without client interceptors. Use one or the other, or
+// calls are counted twice.
+func WithHealthChecker(h *HealthChecker) Option {
+	return func(c *config) {
+		c.HealthChecker = h
+	}
+}
+
+type healthCheckerKey struct{}
+
+// track returns handler, with the backend calls of its tool calls recorded
+// by StartBackendCall.
+func (h *HealthChecker) track(handler ToolHandler) ToolHandler {
+	return func(ctx context.Context, request *CallToolRequest) (*CallToolResult, error) {
+		return handler(context.WithValue(ctx, healthCheckerKey{}, h), request)
+	}
+}
+
+func healthCheckerFromContext(ctx context.Context) *HealthChecker {
+	h, _ := ctx.Value(healthCheckerKey{}).(*HealthChecker)
+	return h
+}
+
+// GRPCConnCheck fails while conn is in TRANSIENT_FAILURE or shut down. An
+// idle connection is asked to connect and counts as healthy.
+func GRPCConnCheck(conn *grpc.ClientConn) HealthCheck {
+	return func(context.Context) error {
+		switch state := conn.GetState(); state {
+		case connectivity.TransientFailure, connectivity.Shutdown:
+			return fmt.Errorf("connection to %s is %s", conn.Target(), state)
+		case connectivity.Idle:
+			conn.Connect()
+		}
+		return nil
+	}
+}
+
+// RecordCall records the outcome of a backend call. Only errors that point
+// at the backend count as failures: Unavailable, DeadlineExceeded, Internal,
+// Unknown and DataLoss. Errors caused by the request, such as
+// InvalidArgument or NotFound, count as successful calls.
+func (h *HealthChecker) RecordCall(err error) {
+	failed := backendFailure(err)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	b := h.bucket(h.now())
+	b.calls++
+	if failed {
+		b.failures++
+	}
+}
+
+// UnaryClientInterceptor records the outcome of every call of a gRPC client.
+// Pass it with grpc.WithUnaryInterceptor when dialing the backend of a
+// ForwardTo function.
+func (h *HealthChecker) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		h.RecordCall(err)
+		return err
+	}
+}
+
+// ConnectInterceptor records the outcome of every unary call of a Connect
+// client. Pass it with connect.WithInterceptors when creating the client of
+// a ForwardToConnect function.
+func (h *HealthChecker) ConnectInterceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			resp, err := next(ctx, req)
+			h.RecordCall(err)
+			return resp, err
+		}
+	})
+}
+
+// Ready runs the checks and judges the error rate. The error lists every
+// failed check by name.
+func (h *HealthChecker) Ready(ctx context.Context) error {
+	h.mu.Lock()
+	checks := make(map[string]HealthCheck, len(h.checks))
+	for name, check := range h.checks {
+		checks[name] = check
+	}
+	var calls, failures int
+	current := h.bucketIndex(h.now())
+	for _, b := range h.buckets {
+		if current-b.index < healthBuckets {
+			calls += b.calls
+			failures += b.failures
+		}
+	}
+	h.mu.Unlock()
+
+	var errs []error
+	if calls >= h.opts.MinCalls && float64(failures)/float64(calls) > h.opts.MaxErrorRate {
+		errs = append(errs, fmt.Errorf("backend: %d of the last %d calls failed", failures, calls))
+	}
+	names := make([]string, 0, len(checks))
+	for name := range checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := checks[name](ctx); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// LivenessHandler answers every request with 200 OK: a process that can
+// serve HTTP is alive, and a broken upstream is no reason to restart it.
+func (h *HealthChecker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok\n"))
+	})
+}
+
+// ReadinessHandler answers with 200 OK while Ready succeeds and with 503
+// Service Unavailable, listing the failed checks, otherwise.
+func (h *HealthChecker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := h.Ready(r.Context()); err != nil {
+			http.Error(w, strings.ReplaceAll(err.Error(), "\n", "; "), http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok\n"))
+	})
+}
+
+// bucket returns the bucket of t, resetting it if it last held an older
+// part of the window. h.mu must be held.
+func (h *HealthChecker) bucket(t time.Time) *healthBucket {
+	index := h.bucketIndex(t)
+	b := &h.buckets[index%healthBuckets]
+	if b.index != index {
+		*b = healthBucket{index: index}
+	}
+	return b
+}
+
+func (h *HealthChecker) bucketIndex(t time.Time) int64 {
+	// Windows too short to split into buckets still get buckets of 1ns.
+	return t.UnixNano() / int64(max(h.opts.Window/healthBuckets, 1))
+}
+
+// backendFailure reports whether err is a gRPC or Connect error that points
+// at the backend rather than at the request.
+func backendFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	code := codes.Code(connect.CodeOf(err))
+	if st, ok := status.FromError(err); ok {
+		code = st.Code()
+	}
+	switch code {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown, codes.DataLoss:
+		return true
+	}
+	return false
+}
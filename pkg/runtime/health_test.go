@@ -0,0 +1,149 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func TestHealthChecker(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("error rate", func(t *testing.T) {
+		g := NewWithT(t)
+		now := time.Unix(0, 0)
+		h := NewHealthChecker(HealthOptions{Window: 10 * time.Second, MinCalls: 4})
+		h.now = func() time.Time { return now }
+		g.Expect(h.Ready(ctx)).To(Succeed())
+
+		// Request errors don't count against the backend.
+		for range 4 {
+			h.RecordCall(status.Error(codes.InvalidArgument, "bad name"))
+		}
+		g.Expect(h.Ready(ctx)).To(Succeed())
+
+		now = now.Add(time.Second)
+		for range 5 {
+			h.RecordCall(status.Error(codes.Unavailable, "connection refused"))
+		}
+		h.RecordCall(connect.NewError(connect.CodeDeadlineExceeded, errors.New("slow")))
+		g.Expect(h.Ready(ctx)).To(MatchError("backend: 6 of the last 10 calls failed"))
+
+		// The invalid calls leave the window first.
+		now = now.Add(9500 * time.Millisecond)
+		g.Expect(h.Ready(ctx)).To(MatchError("backend: 6 of the last 6 calls failed"))
+		now = now.Add(time.Second)
+		g.Expect(h.Ready(ctx)).To(Succeed())
+	})
+
+	t.Run("too few calls", func(t *testing.T) {
+		g := NewWithT(t)
+		h := NewHealthChecker(HealthOptions{})
+		for range 4 {
+			h.RecordCall(status.Error(codes.Unavailable, "connection refused"))
+		}
+		g.Expect(h.Ready(ctx)).To(Succeed())
+		h.RecordCall(nil)
+		g.Expect(h.Ready(ctx)).To(HaveOccurred())
+	})
+
+	t.Run("tiny window", func(t *testing.T) {
+		g := NewWithT(t)
+		now := time.Unix(0, 0)
+		h := NewHealthChecker(HealthOptions{Window: time.Nanosecond, MinCalls: 1})
+		h.now = func() time.Time { return now }
+		h.RecordCall(status.Error(codes.Unavailable, "connection refused"))
+		g.Expect(h.Ready(ctx)).To(MatchError("backend: 1 of the last 1 calls failed"))
+		now = now.Add(10 * time.Nanosecond)
+		g.Expect(h.Ready(ctx)).To(Succeed())
+	})
+
+	t.Run("checks", func(t *testing.T) {
+		g := NewWithT(t)
+		h := NewHealthChecker(HealthOptions{})
+		conn, err := grpc.NewClient("passthrough:///backend:443", grpc.WithTransportCredentials(insecure.NewCredentials()))
+		g.Expect(err).ToNot(HaveOccurred())
+		h.AddCheck("items", GRPCConnCheck(conn))
+		g.Expect(h.Ready(ctx)).To(Succeed())
+
+		g.Expect(conn.Close()).To(Succeed())
+		h.AddCheck("cache", func(context.Context) error { return errors.New("down") })
+		g.Expect(h.Ready(ctx)).To(MatchError("cache: down\nitems: connection to passthrough:///backend:443 is SHUTDOWN"))
+	})
+
+	t.Run("handlers", func(t *testing.T) {
+		g := NewWithT(t)
+		h := NewHealthChecker(HealthOptions{})
+		serve := func(handler http.Handler) *httptest.ResponseRecorder {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+			return rec
+		}
+		g.Expect(serve(h.ReadinessHandler()).Code).To(Equal(http.StatusOK))
+
+		h.AddCheck("a", func(context.Context) error { return errors.New("down") })
+		h.AddCheck("b", func(context.Context) error { return errors.New("down") })
+		rec := serve(h.ReadinessHandler())
+		g.Expect(rec.Code).To(Equal(http.StatusServiceUnavailable))
+		g.Expect(rec.Body.String()).To(Equal("a: down; b: down\n"))
+		g.Expect(serve(h.LivenessHandler()).Code).To(Equal(http.StatusOK))
+	})
+
+	t.Run("interceptors", func(t *testing.T) {
+		g := NewWithT(t)
+		h := NewHealthChecker(HealthOptions{MinCalls: 1})
+		err := h.UnaryClientInterceptor()(ctx, "/svc/Get", nil, nil, nil, func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+			return status.Error(codes.Unavailable, "down")
+		})
+		g.Expect(status.Code(err)).To(Equal(codes.Unavailable))
+		g.Expect(h.Ready(ctx)).To(HaveOccurred())
+
+		h = NewHealthChecker(HealthOptions{MinCalls: 1})
+		call := h.ConnectInterceptor().WrapUnary(func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+			return nil, connect.NewError(connect.CodeInternal, errors.New("boom"))
+		})
+		_, err = call(ctx, nil)
+		g.Expect(connect.CodeOf(err)).To(Equal(connect.CodeInternal))
+		g.Expect(h.Ready(ctx)).To(HaveOccurred())
+	})
+
+	t.Run("generated handlers", func(t *testing.T) {
+		g := NewWithT(t)
+		h := NewHealthChecker(HealthOptions{MinCalls: 1})
+		config := NewConfig()
+		WithHealthChecker(h)(config)
+		handler := ApplyHandlerConfig("svc_Get", config, func(ctx context.Context, request *CallToolRequest) (*CallToolResult, error) {
+			done := StartBackendCall(ctx)
+			err := status.Error(codes.Unavailable, "connection refused")
+			done(nil, err)
+			return HandleError(err)
+		})
+		_, err := handler(ctx, &CallToolRequest{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(h.Ready(ctx)).To(MatchError("backend: 1 of the last 1 calls failed"))
+	})
+}
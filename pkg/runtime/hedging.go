@@ -0,0 +1,107 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"time"
+)
+
+// Hedging cuts the tail latency of read-only tools: when the backend has not
+// answered a call after Delay, the call is sent again and the first answer
+// wins, cancelling the others. Only the backend calls of RPCs marked
+// idempotency_level = NO_SIDE_EFFECTS are hedged, since sending a mutation
+// twice could apply it twice.
+type Hedging struct {
+	// Delay is how long an attempt may take before the next one is sent.
+	// Zero disables hedging.
+	Delay time.Duration
+
+	// MaxAttempts is the number of attempts a call may make in total,
+	// including the first. Zero means 2.
+	MaxAttempts int
+}
+
+// WithHedging makes the generated forwarders hedge the backend calls of
+// read-only tools.
+func WithHedging(hedging Hedging) Option {
+	return func(c *config) {
+		c.Hedging = hedging
+	}
+}
+
+// Hedge runs call, the backend call of a read-only tool, with the hedging of
+// config. An attempt that fails with a connection error or UNAVAILABLE sends
+// the next one right away; the call then fails with the last such error if
+// no attempt is left. Any other result, including an error from a reachable
+// backend, is returned as soon as it arrives, since another attempt would
+// get the same answer. Within a DeadlineBudget, each attempt gets its share
+// of the time left, see AttemptContext, and one that runs out of it counts
+// as a connection error. Generated code calls it; call must be safe to run
+// concurrently.
+func Hedge[R any](ctx context.Context, config *config, call func(ctx context.Context) (R, error)) (R, error) {
+	h := config.Hedging
+	if h.Delay <= 0 {
+		return call(ctx)
+	}
+	attempts := h.MaxAttempts
+	if attempts <= 0 {
+		attempts = 2
+	}
+
+	// Losing attempts are cancelled when the call returns.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	type result struct {
+		resp    R
+		err     error
+		expired bool
+	}
+	results := make(chan result, attempts)
+	started, pending := 0, 0
+	start := func() {
+		attemptCtx, attemptCancel := AttemptContext(ctx, attempts-started)
+		started++
+		pending++
+		go func() {
+			defer attemptCancel()
+			resp, err := call(attemptCtx)
+			results <- result{resp, err, attemptCtx.Err() != nil}
+		}()
+	}
+	start()
+	timer := time.NewTimer(h.Delay)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+			if started < attempts {
+				start()
+				timer.Reset(h.Delay)
+			}
+		case r := <-results:
+			pending--
+			if r.err == nil || ctx.Err() != nil || !(connectionFailure(r.err) || r.expired) {
+				return r.resp, r.err
+			}
+			if started < attempts {
+				start()
+				timer.Reset(h.Delay)
+			} else if pending == 0 {
+				return r.resp, r.err
+			}
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+// attempts runs the attempts of a hedged call: attempt i waits delays[i],
+// or until it is cancelled, and then returns errs[i].
+type attempts struct {
+	delays []time.Duration
+	errs   []error
+
+	mu        sync.Mutex
+	started   int
+	cancelled int
+}
+
+func (a *attempts) call(ctx context.Context) (int, error) {
+	a.mu.Lock()
+	i := a.started
+	a.started++
+	a.mu.Unlock()
+	select {
+	case <-time.After(a.delays[i]):
+		return i, a.errs[i]
+	case <-ctx.Done():
+		a.mu.Lock()
+		a.cancelled++
+		a.mu.Unlock()
+		return -1, ctx.Err()
+	}
+}
+
+func (a *attempts) counts() (started, cancelled int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.started, a.cancelled
+}
+
+func TestHedge(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+	hedge := func(h runtime.Hedging, a *attempts) (int, error) {
+		config := runtime.NewConfig()
+		runtime.WithHedging(h)(config)
+		return runtime.Hedge(context.Background(), config, a.call)
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		g := NewWithT(t)
+		a := &attempts{delays: []time.Duration{20 * time.Millisecond}, errs: []error{nil}}
+		g.Expect(hedge(runtime.Hedging{}, a)).To(Equal(0))
+		g.Expect(a.counts()).To(Equal(1))
+	})
+
+	t.Run("first answer wins", func(t *testing.T) {
+		g := NewWithT(t)
+		a := &attempts{delays: []time.Duration{time.Second, 0}, errs: []error{nil, nil}}
+		g.Expect(hedge(runtime.Hedging{Delay: 10 * time.Millisecond}, a)).To(Equal(1))
+		g.Eventually(func() int { _, cancelled := a.counts(); return cancelled }).Should(Equal(1))
+	})
+
+	t.Run("fast answers are not hedged", func(t *testing.T) {
+		g := NewWithT(t)
+		a := &attempts{delays: []time.Duration{0}, errs: []error{nil}}
+		g.Expect(hedge(runtime.Hedging{Delay: 10 * time.Millisecond}, a)).To(Equal(0))
+		g.Consistently(func() int { started, _ := a.counts(); return started }, 30*time.Millisecond).Should(Equal(1))
+	})
+
+	t.Run("max attempts", func(t *testing.T) {
+		g := NewWithT(t)
+		a := &attempts{delays: []time.Duration{time.Second, time.Second, 0}, errs: []error{nil, nil, nil}}
+		g.Expect(hedge(runtime.Hedging{Delay: 10 * time.Millisecond, MaxAttempts: 3}, a)).To(Equal(2))
+	})
+
+	t.Run("backend errors are returned", func(t *testing.T) {
+		g := NewWithT(t)
+		notFound := status.Error(codes.NotFound, "no such config")
+		a := &attempts{delays: []time.Duration{0, 0}, errs: []error{notFound, nil}}
+		_, err := hedge(runtime.Hedging{Delay: time.Second}, a)
+		g.Expect(err).To(MatchError(notFound))
+		g.Expect(a.counts()).To(Equal(1))
+	})
+
+	t.Run("connection failures try the next attempt", func(t *testing.T) {
+		g := NewWithT(t)
+		a := &attempts{delays: []time.Duration{0, 0}, errs: []error{unavailable, nil}}
+		g.Expect(hedge(runtime.Hedging{Delay: time.Second}, a)).To(Equal(1))
+
+		a = &attempts{delays: []time.Duration{0, 0}, errs: []error{unavailable, unavailable}}
+		_, err := hedge(runtime.Hedging{Delay: time.Second}, a)
+		g.Expect(err).To(MatchError(unavailable))
+		g.Expect(a.counts()).To(Equal(2))
+	})
+
+	t.Run("attempts share the deadline budget", func(t *testing.T) {
+		g := NewWithT(t)
+		config := runtime.NewConfig()
+		runtime.WithHedging(runtime.Hedging{Delay: time.Hour})(config)
+		a := &attempts{delays: []time.Duration{time.Hour, 0}, errs: []error{nil, nil}}
+		var got int
+		h := runtime.DeadlineBudget{Timeout: 200 * time.Millisecond}.Bound(func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+			var err error
+			got, err = runtime.Hedge(ctx, config, a.call)
+			return nil, err
+		})
+
+		// The hanging first attempt runs out of its half of the budget, and
+		// the second gets the rest.
+		start := time.Now()
+		_, err := h(context.Background(), &runtime.CallToolRequest{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).To(Equal(1))
+		g.Expect(time.Since(start)).To(BeNumerically("<", 200*time.Millisecond))
+		started, cancelled := a.counts()
+		g.Expect(started).To(Equal(2))
+		g.Expect(cancelled).To(Equal(1))
+	})
+}
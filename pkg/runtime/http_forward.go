@@ -0,0 +1,354 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// HTTPClient sends the requests of the generated ForwardTo<Service>HTTP
+// forwarders. *http.Client implements it.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// HTTPBinding is the google.api.http binding of an RPC, the REST endpoint a
+// JSON gateway such as grpc-gateway serves it under.
+type HTTPBinding struct {
+	// Method is the HTTP method, e.g. "GET", or the kind of a custom
+	// binding.
+	Method string
+	// Path is the URL path template, e.g. "/v1/{name=clusters/*}".
+	Path string
+	// Body is the request field sent as the body, "*" for the whole
+	// request, or empty for none.
+	Body string
+	// ResponseBody is the response field the body holds, or empty for the
+	// whole response.
+	ResponseBody string
+}
+
+// httpPathVariable matches a {field} or {field=pattern} segment of a path.
+var httpPathVariable = regexp.MustCompile(`\{([A-Za-z0-9_.]+)(?:=([^}]*))?\}`)
+
+// ErrNoHTTPBinding returns the error of calling an RPC through a
+// ForwardTo<Service>HTTP forwarder when it has no unary google.api.http
+// binding.
+func ErrNoHTTPBinding(method string) error {
+	return status.Errorf(codes.Unimplemented, "%s has no HTTP binding", method)
+}
+
+// CallHTTP calls the RPC bound to binding on the JSON gateway at baseURL,
+// the way grpc-gateway maps it: the fields named in the path are expanded
+// into it, the body field, if any, is sent as JSON, and the other fields go
+// into the query string. The JSON response is decoded into resp. Gateway
+// errors, in the google.rpc.Status JSON shape or as bare HTTP statuses, and
+// connection errors are returned as gRPC status errors, so HandleError
+// reports them like those of a gRPC backend. Outgoing metadata in ctx, such
+// as forwarded headers, is sent as request headers.
+func CallHTTP(ctx context.Context, client HTTPClient, baseURL string, binding HTTPBinding, req, resp proto.Message) error {
+	body, path, query, err := httpRequestParts(binding, req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	u := strings.TrimRight(baseURL, "/") + path
+	if encoded := query.Encode(); encoded != "" {
+		u += "?" + encoded
+	}
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, binding.Method, u, reader)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	SetOutgoingHeaders(ctx, httpReq.Header)
+	RecordTarget(ctx, httpReq.URL.Host)
+	httpReq.Header.Set("Accept", "application/json")
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		if _, ok := status.FromError(err); ok {
+			// E.g. an open CircuitBreaker.
+			return err
+		}
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer httpResp.Body.Close()
+	recordBackendHeader(ctx, httpResp.Header)
+	data, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	if httpResp.StatusCode < 200 || httpResp.StatusCode >= 300 {
+		return httpError(httpResp.StatusCode, data)
+	}
+
+	if binding.ResponseBody != "" {
+		// Decode the body into the field it holds.
+		field, err := json.Marshal(binding.ResponseBody)
+		if err != nil {
+			return err
+		}
+		data = []byte(`{` + string(field) + `:` + string(data) + `}`)
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, resp); err != nil {
+		return status.Errorf(codes.Internal, "decoding %s response: %v", binding.Path, err)
+	}
+	return nil
+}
+
+// httpRequestParts returns the body, expanded path and query of the request
+// for binding.
+func httpRequestParts(binding HTTPBinding, req proto.Message) ([]byte, string, url.Values, error) {
+	marshaled, err := (protojson.MarshalOptions{UseProtoNames: true}).Marshal(req)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	fields := map[string]any{}
+	if err := json.Unmarshal(marshaled, &fields); err != nil {
+		return nil, "", nil, err
+	}
+
+	var pathErr error
+	path := httpPathVariable.ReplaceAllStringFunc(binding.Path, func(v string) string {
+		m := httpPathVariable.FindStringSubmatch(v)
+		value, err := httpPathValue(req.ProtoReflect(), m[1])
+		if err != nil {
+			if pathErr == nil {
+				pathErr = err
+			}
+			return ""
+		}
+		deleteJSONField(fields, m[1])
+		if err == nil {
+			err = matchPathPattern(value, m[2])
+		}
+		if err != nil {
+			if pathErr == nil {
+				pathErr = fmt.Errorf("path variable {%s}: %w", m[1], err)
+			}
+			return ""
+		}
+		// Multi-segment patterns keep their slashes.
+		segments := strings.Split(value, "/")
+		for i, segment := range segments {
+			segments[i] = url.PathEscape(segment)
+		}
+		return strings.Join(segments, "/")
+	})
+	if pathErr != nil {
+		return nil, "", nil, pathErr
+	}
+
+	var body []byte
+	switch binding.Body {
+	case "":
+	case "*":
+		body, err = json.Marshal(fields)
+		fields = nil
+	default:
+		value, ok := fields[binding.Body]
+		if !ok {
+			value = map[string]any{}
+		}
+		body, err = json.Marshal(value)
+		delete(fields, binding.Body)
+	}
+	if err != nil {
+		return nil, "", nil, err
+	}
+	query := url.Values{}
+	addQueryValues(query, "", fields)
+	return body, path, query, nil
+}
+
+// matchPathPattern checks value against the pattern of a {field=pattern}
+// path variable, "*" if empty: literal segments must be equal, "*" matches
+// exactly one segment and a trailing "**" the rest. Empty, "." and ".."
+// segments never match, so a value cannot leave the path it is bound to.
+func matchPathPattern(value, pattern string) error {
+	if pattern == "" {
+		pattern = "*"
+	}
+	segments := strings.Split(value, "/")
+	for _, segment := range segments {
+		if segment == "" || segment == "." || segment == ".." {
+			return fmt.Errorf("%q has an empty, \".\" or \"..\" segment", value)
+		}
+	}
+	patterns := strings.Split(pattern, "/")
+	for i, p := range patterns {
+		if p == "**" && i == len(patterns)-1 {
+			return nil
+		}
+		if i >= len(segments) || (p != "*" && p != segments[i]) {
+			return fmt.Errorf("%q does not match the pattern %q", value, pattern)
+		}
+	}
+	if len(segments) != len(patterns) {
+		return fmt.Errorf("%q does not match the pattern %q", value, pattern)
+	}
+	return nil
+}
+
+// httpPathValue returns the value of the field path, e.g. "parent.id", of m
+// as it appears in a URL path.
+func httpPathValue(m protoreflect.Message, path string) (string, error) {
+	segments := strings.Split(path, ".")
+	for i, segment := range segments {
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(segment))
+		if fd == nil || fd.IsList() || fd.IsMap() {
+			return "", fmt.Errorf("path variable {%s} does not name a singular field of %s", path, m.Descriptor().FullName())
+		}
+		if i < len(segments)-1 {
+			if fd.Message() == nil {
+				return "", fmt.Errorf("path variable {%s}: field %q is not a message", path, segment)
+			}
+			m = m.Get(fd).Message()
+			continue
+		}
+		if !m.Has(fd) {
+			return "", fmt.Errorf("field %q is required", path)
+		}
+		v := m.Get(fd)
+		switch fd.Kind() {
+		case protoreflect.StringKind:
+			return v.String(), nil
+		case protoreflect.BytesKind:
+			return base64.URLEncoding.EncodeToString(v.Bytes()), nil
+		case protoreflect.EnumKind:
+			if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+				return string(ev.Name()), nil
+			}
+			return strconv.Itoa(int(v.Enum())), nil
+		case protoreflect.MessageKind, protoreflect.GroupKind:
+			return "", fmt.Errorf("path variable {%s}: field %q is a message", path, segment)
+		default:
+			return fmt.Sprint(v.Interface()), nil
+		}
+	}
+	return "", nil
+}
+
+// deleteJSONField removes the field path, e.g. "parent.id", from fields.
+func deleteJSONField(fields map[string]any, path string) {
+	segments := strings.Split(path, ".")
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := fields[segment].(map[string]any)
+		if !ok {
+			return
+		}
+		fields = next
+	}
+	delete(fields, segments[len(segments)-1])
+}
+
+// addQueryValues adds value to query as grpc-gateway reads it: nested fields
+// as dotted names and repeated fields as repeated parameters.
+func addQueryValues(query url.Values, name string, value any) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			if name != "" {
+				key = name + "." + key
+			}
+			addQueryValues(query, key, nested)
+		}
+	case []any:
+		for _, item := range v {
+			addQueryValues(query, name, item)
+		}
+	case string:
+		query.Add(name, v)
+	case float64:
+		query.Add(name, strconv.FormatFloat(v, 'f', -1, 64))
+	case bool:
+		query.Add(name, strconv.FormatBool(v))
+	}
+}
+
+// httpError returns the gRPC status error of a gateway error response.
+func httpError(statusCode int, body []byte) error {
+	st := &spb.Status{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, st); err == nil && st.GetCode() != 0 {
+		return status.ErrorProto(st)
+	}
+	message := strings.TrimSpace(string(body))
+	if message == "" {
+		message = http.StatusText(statusCode)
+	} else if len(message) > 1024 {
+		// Don't hand the model a whole HTML error page.
+		message = message[:1024] + "..."
+	}
+	return status.Error(httpStatusCode(statusCode), message)
+}
+
+// httpStatusCode returns the gRPC code of an HTTP status, the inverse of
+// the mapping of grpc-gateway.
+func httpStatusCode(statusCode int) codes.Code {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.Aborted
+	case http.StatusPreconditionFailed:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case 499:
+		return codes.Canceled
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+	if statusCode >= 500 {
+		return codes.Internal
+	}
+	return codes.Unknown
+}
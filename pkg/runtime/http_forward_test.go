@@ -0,0 +1,186 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// gatewayRequest is a request received by a fake JSON gateway.
+type gatewayRequest struct {
+	method string
+	path   string
+	query  url.Values
+	body   string
+	header http.Header
+}
+
+// fakeGateway serves response with statusCode and records the requests.
+func fakeGateway(t *testing.T, statusCode int, response string) (*httptest.Server, *[]gatewayRequest) {
+	var requests []gatewayRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, gatewayRequest{method: r.Method, path: r.URL.EscapedPath(), query: r.URL.Query(), body: string(body), header: r.Header})
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		_, _ = io.WriteString(w, response)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func TestCallHTTP(t *testing.T) {
+	ctx := context.Background()
+	req := &testdata.ApplyConfigRequest{
+		Name:      "pipelines/p 1",
+		Labels:    []string{"env=prod", "team=data"},
+		Threshold: &testdata.Threshold{Value: 0.5},
+		Replicas:  3,
+	}
+
+	t.Run("query", func(t *testing.T) {
+		g := NewWithT(t)
+		srv, requests := fakeGateway(t, http.StatusOK, `{"applied": true, "unknown": 1}`)
+		binding := runtime.HTTPBinding{Method: http.MethodGet, Path: "/v1/{name=pipelines/*}:check"}
+
+		var resp testdata.ApplyConfigResponse
+		g.Expect(runtime.CallHTTP(ctx, srv.Client(), srv.URL+"/", binding, req, &resp)).To(Succeed())
+		g.Expect(resp.GetApplied()).To(BeTrue())
+
+		r := (*requests)[0]
+		g.Expect(r.method).To(Equal(http.MethodGet))
+		g.Expect(r.path).To(Equal("/v1/pipelines/p%201:check"))
+		g.Expect(r.body).To(BeEmpty())
+		g.Expect(r.query).To(Equal(url.Values{
+			"labels":          {"env=prod", "team=data"},
+			"threshold.value": {"0.5"},
+			"replicas":        {"3"},
+		}))
+	})
+
+	t.Run("body", func(t *testing.T) {
+		g := NewWithT(t)
+		srv, requests := fakeGateway(t, http.StatusOK, `{}`)
+
+		var resp testdata.ApplyConfigResponse
+		binding := runtime.HTTPBinding{Method: http.MethodPost, Path: "/v1/pipelines/{name}", Body: "*"}
+		g.Expect(runtime.CallHTTP(ctx, srv.Client(), srv.URL, binding, &testdata.ApplyConfigRequest{
+			Name:      "p 1",
+			Labels:    req.Labels,
+			Threshold: req.Threshold,
+			Replicas:  req.Replicas,
+		}, &resp)).To(Succeed())
+		r := (*requests)[0]
+		g.Expect(r.path).To(Equal("/v1/pipelines/p%201"))
+		g.Expect(r.query).To(BeEmpty())
+		g.Expect(r.body).To(MatchJSON(`{"labels": ["env=prod", "team=data"], "threshold": {"value": 0.5}, "replicas": 3}`))
+		g.Expect(r.header.Get("Content-Type")).To(Equal("application/json"))
+
+		binding = runtime.HTTPBinding{Method: http.MethodPatch, Path: "/v1/{name=pipelines/*}", Body: "threshold"}
+		g.Expect(runtime.CallHTTP(ctx, srv.Client(), srv.URL, binding, req, &resp)).To(Succeed())
+		r = (*requests)[1]
+		g.Expect(r.method).To(Equal(http.MethodPatch))
+		g.Expect(r.body).To(MatchJSON(`{"value": 0.5}`))
+		g.Expect(r.query).To(HaveKey("labels"))
+		g.Expect(r.query).ToNot(HaveKey("threshold.value"))
+	})
+
+	t.Run("response body", func(t *testing.T) {
+		g := NewWithT(t)
+		srv, _ := fakeGateway(t, http.StatusOK, `0.25`)
+
+		var resp testdata.Threshold
+		binding := runtime.HTTPBinding{Method: http.MethodGet, Path: "/v1/threshold", ResponseBody: "value"}
+		g.Expect(runtime.CallHTTP(ctx, srv.Client(), srv.URL, binding, &testdata.GetConfigRequest{}, &resp)).To(Succeed())
+		g.Expect(resp.GetValue()).To(Equal(0.25))
+	})
+
+	t.Run("headers", func(t *testing.T) {
+		g := NewWithT(t)
+		srv, requests := fakeGateway(t, http.StatusOK, `{}`)
+
+		ctx := metadata.AppendToOutgoingContext(ctx, "x-request-id", "r1")
+		binding := runtime.HTTPBinding{Method: http.MethodGet, Path: "/v1/{name=configs/*}"}
+		g.Expect(runtime.CallHTTP(ctx, srv.Client(), srv.URL, binding, &testdata.GetConfigRequest{Name: "configs/a"}, &testdata.Config{})).To(Succeed())
+		g.Expect((*requests)[0].header.Get("X-Request-Id")).To(Equal("r1"))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		g := NewWithT(t)
+		binding := runtime.HTTPBinding{Method: http.MethodGet, Path: "/v1/{name=configs/*}"}
+		get := func(srv *httptest.Server, name string) error {
+			return runtime.CallHTTP(ctx, srv.Client(), srv.URL, binding, &testdata.GetConfigRequest{Name: name}, &testdata.Config{})
+		}
+
+		srv, _ := fakeGateway(t, http.StatusNotFound, `{"code": 5, "message": "config a not found", "details": []}`)
+		err := get(srv, "configs/a")
+		g.Expect(status.Code(err)).To(Equal(codes.NotFound))
+		g.Expect(status.Convert(err).Message()).To(Equal("config a not found"))
+
+		srv, _ = fakeGateway(t, http.StatusServiceUnavailable, ``)
+		err = get(srv, "configs/a")
+		g.Expect(status.Code(err)).To(Equal(codes.Unavailable))
+		g.Expect(status.Convert(err).Message()).To(Equal("Service Unavailable"))
+
+		// The path field is required.
+		err = get(srv, "")
+		g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		g.Expect(err.Error()).To(ContainSubstring(`field "name" is required`))
+
+		// Path values must match the pattern of their variable and cannot
+		// climb out of it; the gateway never sees them.
+		srv, requests := fakeGateway(t, http.StatusOK, `{}`)
+		for _, name := range []string{"configs/../../admin/x", "configs/..", "configs/.", "configs/", "configs/a/b", "clusters/a", "a"} {
+			err = get(srv, name)
+			g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument), name)
+			g.Expect(err.Error()).To(ContainSubstring(`path variable {name}`), name)
+		}
+		err = runtime.CallHTTP(ctx, srv.Client(), srv.URL, runtime.HTTPBinding{Method: http.MethodGet, Path: "/v1/configs/{name}"}, &testdata.GetConfigRequest{Name: ".."}, &testdata.Config{})
+		g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		g.Expect(*requests).To(BeEmpty())
+
+		// A trailing ** takes the remaining segments, but no dot segments.
+		multi := runtime.HTTPBinding{Method: http.MethodGet, Path: "/v1/{name=configs/**}"}
+		g.Expect(runtime.CallHTTP(ctx, srv.Client(), srv.URL, multi, &testdata.GetConfigRequest{Name: "configs/a/b"}, &testdata.Config{})).To(Succeed())
+		g.Expect((*requests)[0].path).To(Equal("/v1/configs/a/b"))
+		err = runtime.CallHTTP(ctx, srv.Client(), srv.URL, multi, &testdata.GetConfigRequest{Name: "configs/a/../../admin"}, &testdata.Config{})
+		g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		g.Expect(*requests).To(HaveLen(1))
+
+		srv.Close()
+		err = get(srv, "configs/a")
+		g.Expect(status.Code(err)).To(Equal(codes.Unavailable))
+	})
+}
+
+func TestErrNoHTTPBinding(t *testing.T) {
+	g := NewWithT(t)
+	err := runtime.ErrNoHTTPBinding("/testdata.AnnotatedService/ApplyConfig")
+	g.Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+	g.Expect(status.Convert(err).Message()).To(Equal("/testdata.AnnotatedService/ApplyConfig has no HTTP binding"))
+}
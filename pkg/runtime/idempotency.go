@@ -0,0 +1,119 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// idempotencyKeyNames are the field names that hold an idempotency key by
+// convention, as request_id does in AIP-155.
+var idempotencyKeyNames = []protoreflect.Name{"request_id", "idempotency_key"}
+
+// IdempotencyKeyField returns the top-level field of md that carries an
+// idempotency key, or nil if there is none. That is a singular string field
+// annotated with (google.api.field_info).format = UUID4 or, failing that, one
+// named request_id or idempotency_key. Oneof members and fields filled with
+// (mcp.field).from_context never qualify.
+func IdempotencyKeyField(md protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	qualifies := func(fd protoreflect.FieldDescriptor) bool {
+		if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+			return false
+		}
+		if oo := fd.ContainingOneof(); oo != nil && !oo.IsSynthetic() {
+			return false
+		}
+		return fieldOptions(fd).GetFromContext() == ""
+	}
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if qualifies(fd) && isUUID4Field(fd) {
+			return fd
+		}
+	}
+	for _, name := range idempotencyKeyNames {
+		if fd := md.Fields().ByName(name); qualifies(fd) {
+			return fd
+		}
+	}
+	return nil
+}
+
+func isUUID4Field(fd protoreflect.FieldDescriptor) bool {
+	opts := fd.Options()
+	if opts == nil || !proto.HasExtension(opts, annotations.E_FieldInfo) {
+		return false
+	}
+	info, _ := proto.GetExtension(opts, annotations.E_FieldInfo).(*annotations.FieldInfo)
+	return info.GetFormat() == annotations.FieldInfo_UUID4
+}
+
+// FillIdempotencyKey sets the IdempotencyKeyField of md, when args leave it
+// out, to a UUID derived from md, the other arguments and the
+// IdempotencyScope of ctx. A retried tool call thus sends the backend the key
+// of the first attempt, and the backend can recognize the duplicate, while
+// the same arguments sent by another session or tenant never share a key.
+// The UUID has the version 4 layout that AIP-155 asks for, though it is not
+// random. Generated handlers call it once the arguments are decoded and
+// their times normalized, so a retry spelling the same time differently
+// derives the same key.
+func FillIdempotencyKey(ctx context.Context, md protoreflect.MessageDescriptor, args map[string]any) error {
+	return fillIdempotencyKey(md, args, IdempotencyScope(ctx))
+}
+
+// IdempotencyScope returns the scope of the idempotency keys derived for the
+// tool call of ctx: its tenant, see TenantFromContext, and its session, see
+// SessionIDFromContext.
+func IdempotencyScope(ctx context.Context) string {
+	tenant, _ := TenantFromContext(ctx)
+	session, _ := SessionIDFromContext(ctx)
+	return tenant + "\x00" + session
+}
+
+func fillIdempotencyKey(md protoreflect.MessageDescriptor, args map[string]any, scope string) error {
+	fd := IdempotencyKeyField(md)
+	if fd == nil {
+		return nil
+	}
+	if args == nil {
+		return fmt.Errorf("field %q: no arguments to set the idempotency key in", fd.Name())
+	}
+	if name := resolveFieldName(fd, args); name != "" {
+		if v := args[name]; v != nil && v != "" {
+			return nil
+		}
+		delete(args, name)
+	}
+	// encoding/json sorts map keys, so equal arguments hash equally.
+	canonical, err := json.Marshal(args)
+	if err != nil {
+		return err
+	}
+	h := sha256.New()
+	h.Write([]byte(scope + "\x00" + string(md.FullName()) + "\x00"))
+	h.Write(canonical)
+	sum := h.Sum(nil)
+	sum[6] = sum[6]&0x0f | 0x40
+	sum[8] = sum[8]&0x3f | 0x80
+	args[string(fd.Name())] = fmt.Sprintf("%x-%x-%x-%x-%x", sum[0:4], sum[4:6], sum[6:8], sum[8:10], sum[10:16])
+	return nil
+}
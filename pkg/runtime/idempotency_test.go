@@ -0,0 +1,126 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// idempotencyFixture returns
+//
+//	message CreateRequest { string name = 1; string request_id = 2; }
+//	message OperationRequest {
+//	  string name = 1;
+//	  string request_id = 2;
+//	  string operation_id = 3 [(google.api.field_info).format = UUID4];
+//	}
+func idempotencyFixture(t *testing.T) (create, operation protoreflect.MessageDescriptor) {
+	t.Helper()
+	uuid := &descriptorpb.FieldOptions{}
+	proto.SetExtension(uuid, annotations.E_FieldInfo, &annotations.FieldInfo{Format: annotations.FieldInfo_UUID4})
+	field := func(name string, number int32) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:   descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+		}
+	}
+	operationID := field("operation_id", 3)
+	operationID.Options = uuid
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("idempotency_fixture.proto"),
+		Package:    proto.String("fixture"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/api/field_info.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{Name: proto.String("CreateRequest"), Field: []*descriptorpb.FieldDescriptorProto{field("name", 1), field("request_id", 2)}},
+			{Name: proto.String("OperationRequest"), Field: []*descriptorpb.FieldDescriptorProto{field("name", 1), field("request_id", 2), operationID}},
+		},
+	}
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatalf("building fixture: %v", err)
+	}
+	return fd.Messages().ByName("CreateRequest"), fd.Messages().ByName("OperationRequest")
+}
+
+func TestIdempotencyKeyField(t *testing.T) {
+	g := NewWithT(t)
+	create, operation := idempotencyFixture(t)
+
+	g.Expect(runtime.IdempotencyKeyField(create).Name()).To(BeEquivalentTo("request_id"))
+	// The AIP-155 annotation wins over the conventional name.
+	g.Expect(runtime.IdempotencyKeyField(operation).Name()).To(BeEquivalentTo("operation_id"))
+	g.Expect(runtime.IdempotencyKeyField((&testdata.GetItemRequest{}).ProtoReflect().Descriptor())).To(BeNil())
+}
+
+func TestFillIdempotencyKey(t *testing.T) {
+	g := NewWithT(t)
+	create, _ := idempotencyFixture(t)
+	session := runtime.WithSessionID(context.Background(), "session-1")
+
+	fill := func(ctx context.Context, args map[string]any) map[string]any {
+		g.Expect(runtime.DecodeArguments(create, args)).To(Succeed())
+		g.Expect(runtime.FillIdempotencyKey(ctx, create, args)).To(Succeed())
+		return args
+	}
+	first := fill(session, map[string]any{"name": "a"})
+	g.Expect(first["request_id"]).To(MatchRegexp(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`))
+
+	// A retry of the same call derives the same key, and null counts as unset.
+	g.Expect(fill(session, map[string]any{"name": "a", "request_id": nil})["request_id"]).To(Equal(first["request_id"]))
+	// Different arguments derive a different one.
+	g.Expect(fill(session, map[string]any{"name": "b"})["request_id"]).ToNot(Equal(first["request_id"]))
+	// A key the model sent is kept.
+	g.Expect(fill(session, map[string]any{"name": "a", "requestId": "k"})).To(Equal(map[string]any{"name": "a", "requestId": "k"}))
+
+	// The same arguments in another session or tenant derive another key.
+	other := runtime.WithSessionID(context.Background(), "session-2")
+	g.Expect(fill(other, map[string]any{"name": "a"})["request_id"]).ToNot(Equal(first["request_id"]))
+	var tenantCtx context.Context
+	_, err := (&runtime.Tenant{
+		Resolve: func(context.Context, *runtime.CallToolRequest) (string, error) { return "org-2", nil },
+	}).Propagate(func(ctx context.Context, _ *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		tenantCtx = ctx
+		return nil, nil
+	})(session, &runtime.CallToolRequest{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(fill(tenantCtx, map[string]any{"name": "a"})["request_id"]).ToNot(Equal(first["request_id"]))
+
+	// DecodeArguments alone leaves the key unset, and nil arguments fail
+	// instead of panicking.
+	args := map[string]any{"name": "a"}
+	g.Expect(runtime.DecodeArguments(create, args)).To(Succeed())
+	g.Expect(args).ToNot(HaveKey("request_id"))
+	g.Expect(runtime.FillIdempotencyKey(session, create, nil)).ToNot(Succeed())
+
+	// UnmarshalArguments derives the key within the scope it is given.
+	msg, err := runtime.UnmarshalArguments(create, map[string]any{"name": "a"}, runtime.CodecOptions{IdempotencyScope: runtime.IdempotencyScope(session)})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(msg.ProtoReflect().Get(create.Fields().ByName("request_id")).String()).To(Equal(first["request_id"]))
+}
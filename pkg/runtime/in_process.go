@@ -0,0 +1,149 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// InProcessHTTPClient returns an HTTPClient that serves requests with
+// handler in the same process, e.g. the mux a connectrpc service handler is
+// mounted on, instead of sending them over the network. Passed to a
+// generated ForwardToConnect<Service>URL function, it forwards MCP calls to
+// the API server embedded in the same binary with its interceptors and
+// streaming intact but without a loopback TCP hop. Response bodies are
+// streamed as handler writes them. It suits the Connect and gRPC-Web
+// protocols; gRPC needs HTTP/2.
+func InProcessHTTPClient(handler http.Handler) HTTPClient {
+	return inProcessClient{handler: handler}
+}
+
+type inProcessClient struct {
+	handler http.Handler
+}
+
+func (c inProcessClient) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	serverReq := req.Clone(ctx)
+	serverReq.RequestURI = req.URL.RequestURI()
+	serverReq.RemoteAddr = "in-process"
+	if serverReq.Body == nil {
+		serverReq.Body = http.NoBody
+	}
+	if serverReq.Host == "" {
+		serverReq.Host = req.URL.Host
+	}
+
+	body, pw := io.Pipe()
+	w := &inProcessResponseWriter{header: http.Header{}, body: pw, started: make(chan struct{})}
+	resp := &http.Response{
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Body:          body,
+		ContentLength: -1,
+		Trailer:       http.Header{},
+		Request:       req,
+	}
+	go func() {
+		var err error
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("handler panicked: %v", r)
+			}
+			w.WriteHeader(http.StatusOK)
+			w.trailers(resp.Trailer)
+			_ = pw.CloseWithError(err)
+		}()
+		c.handler.ServeHTTP(w, serverReq)
+	}()
+
+	select {
+	case <-w.started:
+	case <-ctx.Done():
+		_ = body.CloseWithError(ctx.Err())
+		return nil, ctx.Err()
+	}
+	resp.StatusCode = w.status
+	resp.Status = fmt.Sprintf("%d %s", w.status, http.StatusText(w.status))
+	resp.Header = w.written
+	return resp, nil
+}
+
+// inProcessResponseWriter writes the response of an in-process request into
+// a pipe read by the client.
+type inProcessResponseWriter struct {
+	header  http.Header
+	body    *io.PipeWriter
+	started chan struct{}
+
+	once    sync.Once
+	status  int
+	written http.Header
+}
+
+func (w *inProcessResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *inProcessResponseWriter) WriteHeader(statusCode int) {
+	w.once.Do(func() {
+		w.status = statusCode
+		w.written = w.header.Clone()
+		close(w.started)
+	})
+}
+
+func (w *inProcessResponseWriter) Write(p []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(p)
+}
+
+// Flush is a no-op: writes reach the client as they happen.
+func (w *inProcessResponseWriter) Flush() {
+	w.WriteHeader(http.StatusOK)
+}
+
+// trailers copies the trailers the handler set, those announced in the
+// Trailer header and those with the http.TrailerPrefix, to trailer.
+func (w *inProcessResponseWriter) trailers(trailer http.Header) {
+	for _, name := range w.written.Values("Trailer") {
+		for _, key := range strings.Split(name, ",") {
+			key = http.CanonicalHeaderKey(strings.TrimSpace(key))
+			if values, ok := w.header[key]; ok {
+				trailer[key] = values
+			}
+		}
+	}
+	for key, values := range w.header {
+		if strings.HasPrefix(key, http.TrailerPrefix) {
+			trailer[http.CanonicalHeaderKey(strings.TrimPrefix(key, http.TrailerPrefix))] = values
+		}
+	}
+}
+
+// ErrInProcessStream returns the error of calling a server-streaming RPC
+// through a generated ForwardToConnect<Service>Handler forwarder, which can
+// only call unary methods directly.
+func ErrInProcessStream(procedure string) error {
+	return status.Errorf(codes.Unimplemented, "%s streams, which a handler called in process cannot; mount it and use runtime.InProcessHTTPClient", procedure)
+}
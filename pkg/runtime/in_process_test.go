@@ -0,0 +1,101 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func TestInProcessHTTPClient(t *testing.T) {
+	g := NewWithT(t)
+	var received *http.Request
+	var body string
+	client := runtime.InProcessHTTPClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.Header().Set("Trailer", "Grpc-Status")
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte("hello "))
+		w.(http.Flusher).Flush()
+		_, _ = w.Write([]byte("world"))
+		w.Header().Set("Grpc-Status", "0")
+		w.Header().Set(http.TrailerPrefix+"Grpc-Message", "done")
+	}))
+
+	req, err := http.NewRequest(http.MethodPost, "http://in-process/svc/Method?x=1", strings.NewReader("payload"))
+	g.Expect(err).ToNot(HaveOccurred())
+	resp, err := client.Do(req)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(resp.StatusCode).To(Equal(http.StatusAccepted))
+	g.Expect(resp.Header.Get("Content-Type")).To(Equal("text/plain"))
+	data, err := io.ReadAll(resp.Body)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(Equal("hello world"))
+	g.Expect(resp.Trailer.Get("Grpc-Status")).To(Equal("0"))
+	g.Expect(resp.Trailer.Get("Grpc-Message")).To(Equal("done"))
+	g.Expect(received.RequestURI).To(Equal("/svc/Method?x=1"))
+	g.Expect(received.Host).To(Equal("in-process"))
+	g.Expect(body).To(Equal("payload"))
+}
+
+func TestInProcessHTTPClientPanic(t *testing.T) {
+	g := NewWithT(t)
+	client := runtime.InProcessHTTPClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("partial"))
+		panic("boom")
+	}))
+
+	req, err := http.NewRequest(http.MethodGet, "http://in-process/", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	resp, err := client.Do(req)
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = io.ReadAll(resp.Body)
+	g.Expect(err).To(MatchError(ContainSubstring("handler panicked: boom")))
+}
+
+func TestInProcessHTTPClientCanceled(t *testing.T) {
+	g := NewWithT(t)
+	release := make(chan struct{})
+	defer close(release)
+	client := runtime.InProcessHTTPClient(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		<-release
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://in-process/", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = client.Do(req)
+	g.Expect(err).To(MatchError(context.Canceled))
+}
+
+func TestErrInProcessStream(t *testing.T) {
+	g := NewWithT(t)
+	err := runtime.ErrInProcessStream("/testdata.AnnotatedService/WatchConfig")
+	g.Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+	g.Expect(err.Error()).To(ContainSubstring("/testdata.AnnotatedService/WatchConfig"))
+}
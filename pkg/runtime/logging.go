@@ -0,0 +1,63 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import "context"
+
+// LogLevel is the severity of an MCP log message, in increasing order:
+// debug, info, notice, warning, error, critical, alert, emergency.
+type LogLevel string
+
+const (
+	LogLevelDebug   LogLevel = "debug"
+	LogLevelInfo    LogLevel = "info"
+	LogLevelNotice  LogLevel = "notice"
+	LogLevelWarning LogLevel = "warning"
+	LogLevelError   LogLevel = "error"
+)
+
+// Logger sends MCP log messages (notifications/message) to the client of a
+// tool call. Adapters put one in the handler context, via WithLogger, when
+// the session can receive them; messages below the level the client set
+// are dropped.
+type Logger interface {
+	// Log sends data, any JSON value, at level. logger names the source of
+	// the message and may be empty.
+	Log(ctx context.Context, level LogLevel, logger string, data any) error
+}
+
+type loggerKey struct{}
+
+// WithLogger returns a copy of ctx carrying l.
+func WithLogger(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// LoggerFromContext returns the Logger in ctx, or nil if the session cannot
+// receive log messages.
+func LoggerFromContext(ctx context.Context) Logger {
+	l, _ := ctx.Value(loggerKey{}).(Logger)
+	return l
+}
+
+// Log sends a log message to the client of the tool call handled with ctx.
+// It does nothing if the session cannot receive log messages.
+func Log(ctx context.Context, level LogLevel, logger string, data any) error {
+	l := LoggerFromContext(ctx)
+	if l == nil {
+		return nil
+	}
+	return l.Log(ctx, level, logger, data)
+}
@@ -18,7 +18,9 @@ package mark3labs
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	mcpserver "github.com/mark3labs/mcp-go/server"
@@ -27,9 +29,15 @@ import (
 
 type server struct {
 	s *mcpserver.MCPServer
+
+	// lazy is nil unless the server lists tools through loadSchemas.
+	lazy *runtime.LazySchemas
 }
 
-// Wrap returns a runtime.MCPServer backed by a mark3labs MCPServer.
+// Wrap returns a runtime.MCPServer backed by a mark3labs MCPServer. Tools
+// registered with runtime.Tool.LoadSchemas load their schemas when they are
+// added, as mcp-go cannot change listed tools after the server is created;
+// NewServer defers them to the first tools/list.
 func Wrap(s *mcpserver.MCPServer) runtime.MCPServer {
 	return &server{s: s}
 }
@@ -39,20 +47,94 @@ func Wrap(s *mcpserver.MCPServer) runtime.MCPServer {
 // for transport setup (e.g. server.ServeStdio) and the adapter for
 // tool registration.
 func NewServer(name, version string, opts ...mcpserver.ServerOption) (*mcpserver.MCPServer, runtime.MCPServer) {
-	s := mcpserver.NewMCPServer(name, version, opts...)
-	return s, Wrap(s)
+	w := &server{lazy: &runtime.LazySchemas{}}
+	w.s = mcpserver.NewMCPServer(name, version, append([]mcpserver.ServerOption{mcpserver.WithToolFilter(w.loadSchemas)}, opts...)...)
+	return w.s, w
+}
+
+// placeholderSchema stands in for the input schema of a tool until its
+// schemas are loaded.
+var placeholderSchema = json.RawMessage(`{"type":"object"}`)
+
+// loadSchemas fills the schemas of the tools registered with
+// runtime.Tool.LoadSchemas into the listed tools.
+func (w *server) loadSchemas(_ context.Context, tools []mcp.Tool) []mcp.Tool {
+	for i, listed := range tools {
+		if tool, ok := w.lazy.Load(listed.Name); ok {
+			tools[i].RawInputSchema = json.RawMessage(tool.RawInputSchema)
+			tools[i].RawOutputSchema = json.RawMessage(tool.RawOutputSchema)
+		}
+	}
+	return tools
 }
 
 func (w *server) AddTool(tool runtime.Tool, handler runtime.ToolHandler) {
+	st := w.serverTool(tool, handler)
+	w.s.AddTool(st.Tool, st.Handler)
+}
+
+// AddTools adds tools as one change, with a single
+// notifications/tools/list_changed.
+func (w *server) AddTools(tools ...runtime.ServerTool) {
+	serverTools := make([]mcpserver.ServerTool, len(tools))
+	for i, t := range tools {
+		serverTools[i] = w.serverTool(t.Tool, t.Handler)
+	}
+	w.s.AddTools(serverTools...)
+}
+
+// serverTool adapts tool and handler to mcp-go.
+func (w *server) serverTool(tool runtime.Tool, handler runtime.ToolHandler) mcpserver.ServerTool {
+	if w.lazy == nil {
+		tool = tool.Loaded()
+	}
 	mcpTool := mcp.Tool{
 		Name:            tool.Name,
 		Description:     tool.Description,
 		RawInputSchema:  json.RawMessage(tool.RawInputSchema),
 		RawOutputSchema: json.RawMessage(tool.RawOutputSchema),
+		Annotations:     mcp.ToolAnnotation{Title: tool.Title},
+	}
+	if len(tool.Tags) > 0 {
+		// mcp-go does not marshal the _meta of tools yet; in-process
+		// clients and middleware see the tags.
+		mcpTool.Meta = &mcp.Meta{AdditionalFields: map[string]any{runtime.TagsMetaKey: tool.Tags}}
+	}
+	if tool.LoadSchemas != nil {
+		mcpTool.RawInputSchema = placeholderSchema
+		w.lazy.Add(tool)
 	}
-	w.s.AddTool(mcpTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return mcpserver.ServerTool{Tool: mcpTool, Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if session := mcpserver.ClientSessionFromContext(ctx); session != nil {
+			ctx = runtime.WithSessionID(ctx, session.SessionID())
+			if info, ok := session.(mcpserver.SessionWithClientInfo); ok {
+				ctx = runtime.WithClientInfo(ctx, clientInfo(info))
+			}
+			if _, ok := session.(mcpserver.SessionWithLogging); ok {
+				ctx = runtime.WithLogger(ctx, logger{w.s})
+			}
+		}
+		if supportsSampling(ctx) {
+			ctx = runtime.WithSampler(ctx, sampler{w.s})
+		}
+		// Notifications need a session to go to.
+		if request.Params.Meta != nil && request.Params.Meta.ProgressToken != nil && mcpserver.ClientSessionFromContext(ctx) != nil {
+			ctx = runtime.WithProgressReporter(ctx, progressReporter{w.s, request.Params.Meta.ProgressToken})
+		}
+		var meta map[string]any
+		if request.Params.Meta != nil {
+			meta = request.Params.Meta.AdditionalFields
+		}
+		args := request.GetArguments()
+		if args == nil {
+			if request.Params.Arguments != nil {
+				return nil, fmt.Errorf("arguments must be an object, not %T", request.Params.Arguments)
+			}
+			args = make(map[string]any)
+		}
 		result, err := handler(ctx, &runtime.CallToolRequest{
-			Arguments: request.GetArguments(),
+			Arguments: args,
+			Meta:      meta,
 		})
 		if err != nil {
 			return nil, err
@@ -60,11 +142,220 @@ func (w *server) AddTool(tool runtime.Tool, handler runtime.ToolHandler) {
 		if result == nil {
 			return nil, nil
 		}
+		var mcpResult *mcp.CallToolResult
 		if result.IsError {
-			return mcp.NewToolResultError(result.Text), nil
+			mcpResult = mcp.NewToolResultError(result.Text)
+		} else {
+			mcpResult = mcp.NewToolResultText(result.Text)
+			mcpResult.StructuredContent = result.StructuredContent
+		}
+		if len(result.Parts) > 0 {
+			mcpResult.Content = make([]mcp.Content, len(result.Parts))
+			for i, part := range result.Parts {
+				mcpResult.Content[i] = mcp.NewTextContent(part)
+			}
+		}
+		for _, r := range result.Resources {
+			var contents mcp.ResourceContents = mcp.TextResourceContents{URI: r.URI, MIMEType: r.MIMEType, Text: r.Text}
+			if r.Blob != nil {
+				contents = mcp.BlobResourceContents{URI: r.URI, MIMEType: r.MIMEType, Blob: base64.StdEncoding.EncodeToString(r.Blob)}
+			}
+			mcpResult.Content = append(mcpResult.Content, mcp.NewEmbeddedResource(contents))
+		}
+		if result.Meta != nil {
+			mcpResult.Meta = mcp.NewMetaFromMap(result.Meta)
 		}
-		mcpResult := mcp.NewToolResultText(result.Text)
-		mcpResult.StructuredContent = result.StructuredContent
 		return mcpResult, nil
+	}}
+}
+
+// clientInfo returns what the client of session declared when it
+// initialized. mcp-go v0.37 neither records the protocol version nor knows
+// elicitation.
+func clientInfo(session mcpserver.SessionWithClientInfo) *runtime.ClientInfo {
+	impl := session.GetClientInfo()
+	caps := session.GetClientCapabilities()
+	return &runtime.ClientInfo{
+		Name:    impl.Name,
+		Version: impl.Version,
+		Capabilities: runtime.ClientCapabilities{
+			Roots:        caps.Roots != nil,
+			Sampling:     caps.Sampling != nil,
+			Experimental: caps.Experimental,
+		},
+	}
+}
+
+// logger implements runtime.Logger for the session of the request. The
+// server needs mcpserver.WithLogging for clients to set a level.
+type logger struct {
+	s *mcpserver.MCPServer
+}
+
+func (l logger) Log(ctx context.Context, level runtime.LogLevel, name string, data any) error {
+	return l.s.SendLogMessageToClient(ctx, mcp.NewLoggingMessageNotification(mcp.LoggingLevel(level), name, data))
+}
+
+// progressReporter implements runtime.ProgressReporter for the request with
+// the given progress token.
+type progressReporter struct {
+	s     *mcpserver.MCPServer
+	token mcp.ProgressToken
+}
+
+func (r progressReporter) Report(ctx context.Context, progress, total float64, message string) error {
+	params := map[string]any{
+		"progressToken": r.token,
+		"progress":      progress,
+	}
+	if total != 0 {
+		params["total"] = total
+	}
+	if message != "" {
+		params["message"] = message
+	}
+	return r.s.SendNotificationToClient(ctx, "notifications/progress", params)
+}
+
+// sampler implements runtime.Sampler on a mark3labs server. The server must
+// have sampling enabled with EnableSampling.
+type sampler struct {
+	s *mcpserver.MCPServer
+}
+
+func (s sampler) CreateMessage(ctx context.Context, request *runtime.SamplingRequest) (*runtime.SamplingResult, error) {
+	params := mcp.CreateMessageParams{
+		MaxTokens:     request.MaxTokens,
+		SystemPrompt:  request.SystemPrompt,
+		Temperature:   request.Temperature,
+		StopSequences: request.StopSequences,
+	}
+	for _, m := range request.Messages {
+		params.Messages = append(params.Messages, mcp.SamplingMessage{
+			Role:    mcp.Role(m.Role),
+			Content: mcp.NewTextContent(m.Text),
+		})
+	}
+	if len(request.ModelHints) > 0 {
+		params.ModelPreferences = &mcp.ModelPreferences{}
+		for _, name := range request.ModelHints {
+			params.ModelPreferences.Hints = append(params.ModelPreferences.Hints, mcp.ModelHint{Name: name})
+		}
+	}
+	res, err := s.s.RequestSampling(ctx, mcp.CreateMessageRequest{CreateMessageParams: params})
+	if err != nil {
+		return nil, err
+	}
+	// Transports decode the content as a plain JSON object.
+	content := res.Content
+	if m, ok := content.(map[string]any); ok {
+		if parsed, err := mcp.ParseContent(m); err == nil {
+			content = parsed
+		}
+	}
+	text, ok := mcp.AsTextContent(content)
+	if !ok {
+		return nil, fmt.Errorf("sampling returned %T, not text", res.Content)
+	}
+	return &runtime.SamplingResult{
+		Role:       string(res.Role),
+		Text:       text.Text,
+		Model:      res.Model,
+		StopReason: res.StopReason,
+	}, nil
+}
+
+// supportsSampling reports whether the session of ctx can send sampling
+// requests and its client declared the sampling capability.
+func supportsSampling(ctx context.Context) bool {
+	session := mcpserver.ClientSessionFromContext(ctx)
+	if _, ok := session.(mcpserver.SessionWithSampling); !ok {
+		return false
+	}
+	if info, ok := session.(mcpserver.SessionWithClientInfo); ok {
+		return info.GetClientCapabilities().Sampling != nil
+	}
+	return true
+}
+
+func (w *server) RemoveTools(names ...string) {
+	w.s.DeleteTools(names...)
+	if w.lazy != nil {
+		w.lazy.Remove(names...)
+	}
+}
+
+func (w *server) RemovePrompts(names ...string) {
+	w.s.DeletePrompts(names...)
+}
+
+func (w *server) RemoveResources(uris ...string) {
+	for _, uri := range uris {
+		// mcp-go v0.37 cannot remove resource templates.
+		if !(runtime.Resource{URI: uri}).IsTemplate() {
+			w.s.RemoveResource(uri)
+		}
+	}
+}
+
+func (w *server) AddPrompt(prompt runtime.Prompt, handler runtime.PromptHandler) {
+	// mcp-go v0.37 prompts have no title; clients fall back to the name.
+	mcpPrompt := mcp.Prompt{
+		Name:        prompt.Name,
+		Description: prompt.Description,
+	}
+	for _, arg := range prompt.Arguments {
+		mcpPrompt.Arguments = append(mcpPrompt.Arguments, mcp.PromptArgument{
+			Name:        arg.Name,
+			Description: arg.Description,
+			Required:    arg.Required,
+		})
+	}
+
+	w.s.AddPrompt(mcpPrompt, func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		result, err := handler(ctx, &runtime.GetPromptRequest{
+			Arguments: request.Params.Arguments,
+		})
+		if err != nil {
+			return nil, err
+		}
+		var messages []mcp.PromptMessage
+		for _, m := range result.Messages {
+			messages = append(messages, mcp.NewPromptMessage(mcp.Role(m.Role), mcp.NewTextContent(m.Text)))
+		}
+		return mcp.NewGetPromptResult(result.Description, messages), nil
 	})
 }
+
+func (w *server) AddResource(resource runtime.Resource, handler runtime.ResourceHandler) {
+	h := func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		if session := mcpserver.ClientSessionFromContext(ctx); session != nil {
+			ctx = runtime.WithSessionID(ctx, session.SessionID())
+		}
+		result, err := handler(ctx, &runtime.ReadResourceRequest{URI: request.Params.URI})
+		if err != nil {
+			return nil, err
+		}
+		var contents []mcp.ResourceContents
+		for _, c := range result.Contents {
+			contents = append(contents, mcp.TextResourceContents{
+				URI:      c.URI,
+				MIMEType: c.MIMEType,
+				Text:     c.Text,
+			})
+		}
+		return contents, nil
+	}
+	// mcp-go v0.37 resources have no title; clients fall back to the name.
+	if resource.IsTemplate() {
+		w.s.AddResourceTemplate(mcp.NewResourceTemplate(resource.URI, resource.Name,
+			mcp.WithTemplateDescription(resource.Description),
+			mcp.WithTemplateMIMEType(resource.MIMEType),
+		), h)
+		return
+	}
+	w.s.AddResource(mcp.NewResource(resource.URI, resource.Name,
+		mcp.WithResourceDescription(resource.Description),
+		mcp.WithMIMEType(resource.MIMEType),
+	), h)
+}
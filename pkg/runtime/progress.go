@@ -0,0 +1,51 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import "context"
+
+// ProgressReporter sends MCP progress notifications for a slow tool call.
+// Adapters put one in the handler context, via WithProgressReporter, when the
+// client asked for progress by sending a progressToken, so a <Service>Server
+// implementation can call ReportProgress with the ctx it is given.
+type ProgressReporter interface {
+	// Report sends the progress made so far. progress must increase with
+	// every call. total is zero when unknown.
+	Report(ctx context.Context, progress, total float64, message string) error
+}
+
+type progressKey struct{}
+
+// WithProgressReporter returns a copy of ctx carrying r.
+func WithProgressReporter(ctx context.Context, r ProgressReporter) context.Context {
+	return context.WithValue(ctx, progressKey{}, r)
+}
+
+// ProgressReporterFromContext returns the ProgressReporter in ctx, or nil if
+// the client did not ask for progress.
+func ProgressReporterFromContext(ctx context.Context) ProgressReporter {
+	r, _ := ctx.Value(progressKey{}).(ProgressReporter)
+	return r
+}
+
+// ReportProgress reports progress for the tool call handled with ctx. It does
+// nothing if the client did not ask for progress.
+func ReportProgress(ctx context.Context, progress, total float64, message string) error {
+	r := ProgressReporterFromContext(ctx)
+	if r == nil {
+		return nil
+	}
+	return r.Report(ctx, progress, total, message)
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+// progressRecorder records the progress it is given.
+type progressRecorder struct {
+	reports []string
+}
+
+func (r *progressRecorder) Report(_ context.Context, progress, total float64, message string) error {
+	r.reports = append(r.reports, fmt.Sprintf("%v/%v %s", progress, total, message))
+	return nil
+}
+
+func TestReportProgress(t *testing.T) {
+	t.Run("no reporter", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(runtime.ReportProgress(context.Background(), 1, 2, "half")).To(Succeed())
+	})
+
+	t.Run("forwards to reporter", func(t *testing.T) {
+		g := NewWithT(t)
+		r := &progressRecorder{}
+		ctx := runtime.WithProgressReporter(context.Background(), r)
+		g.Expect(runtime.ProgressReporterFromContext(ctx)).To(BeIdenticalTo(r))
+
+		g.Expect(runtime.ReportProgress(ctx, 1, 2, "half")).To(Succeed())
+		g.Expect(runtime.ReportProgress(ctx, 3, 0, "")).To(Succeed())
+		g.Expect(r.reports).To(Equal([]string{"1/2 half", "3/0 "}))
+	})
+}
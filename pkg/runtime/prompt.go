@@ -0,0 +1,186 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// PromptServer is implemented by MCPServer adapters whose MCP library
+// supports prompts. Both bundled adapters do.
+type PromptServer interface {
+	AddPrompt(prompt Prompt, handler PromptHandler)
+}
+
+// Prompt describes an MCP prompt independent of any MCP library.
+type Prompt struct {
+	Name        string
+	Title       string
+	Description string
+	Arguments   []PromptArgument
+}
+
+// PromptArgument describes an argument of a prompt.
+type PromptArgument struct {
+	Name        string
+	Description string
+	Required    bool
+}
+
+// PromptHandler is the callback invoked when an MCP client gets a prompt.
+type PromptHandler func(ctx context.Context, request *GetPromptRequest) (*GetPromptResult, error)
+
+// GetPromptRequest carries the arguments the user filled in.
+type GetPromptRequest struct {
+	Arguments map[string]string
+}
+
+// GetPromptResult is the rendered prompt.
+type GetPromptResult struct {
+	Description string
+	Messages    []PromptMessage
+}
+
+// PromptMessage is a text message of a rendered prompt.
+type PromptMessage struct {
+	// Role is "user" or "assistant".
+	Role string
+	Text string
+}
+
+// AddPrompt registers prompt on s if its MCP library supports prompts, and
+// reports whether it did.
+func AddPrompt(s MCPServer, prompt Prompt, handler PromptHandler) bool {
+	ps, ok := s.(PromptServer)
+	if !ok {
+		return false
+	}
+	ps.AddPrompt(prompt, handler)
+	return true
+}
+
+// PromptRemover is implemented by MCPServer adapters whose MCP library can
+// remove prompts. Both bundled adapters do.
+type PromptRemover interface {
+	RemovePrompts(names ...string)
+}
+
+// RemovePrompts removes the named prompts from s if its MCP library
+// supports it, and reports whether it did.
+func RemovePrompts(s MCPServer, names ...string) bool {
+	pr, ok := s.(PromptRemover)
+	if !ok {
+		return false
+	}
+	pr.RemovePrompts(names...)
+	return true
+}
+
+// ApplyPromptConfig applies the name prefix of config to a prompt, like
+// ApplyConfig does for tools.
+func ApplyPromptConfig(prompt Prompt, config *config) Prompt {
+	if config.NamePrefix != "" {
+		prompt.Name = config.NamePrefix + "_" + prompt.Name
+	}
+	return prompt
+}
+
+// TemplatePromptHandler returns a handler that renders template as a single
+// user message. Placeholders are replaced by the prompt arguments and by
+// tools, which maps tool references such as "tool" or "tool:ListClusters"
+// to registered tool names. A missing required argument is an error.
+func TemplatePromptHandler(prompt Prompt, template string, tools map[string]string) PromptHandler {
+	return func(_ context.Context, request *GetPromptRequest) (*GetPromptResult, error) {
+		vars := make(map[string]string, len(tools)+len(prompt.Arguments))
+		for ref, name := range tools {
+			vars[ref] = name
+		}
+		for _, arg := range prompt.Arguments {
+			v := request.Arguments[arg.Name]
+			if v == "" && arg.Required {
+				return nil, fmt.Errorf("prompt %q: missing required argument %q", prompt.Name, arg.Name)
+			}
+			vars[arg.Name] = v
+		}
+		text, err := RenderPromptTemplate(template, vars)
+		if err != nil {
+			return nil, fmt.Errorf("prompt %q: %w", prompt.Name, err)
+		}
+		return &GetPromptResult{
+			Description: prompt.Description,
+			Messages:    []PromptMessage{{Role: "user", Text: text}},
+		}, nil
+	}
+}
+
+// RenderPromptTemplate replaces each {{name}} placeholder in template by
+// vars[name]. An unknown placeholder is an error.
+func RenderPromptTemplate(template string, vars map[string]string) (string, error) {
+	var b strings.Builder
+	err := scanPromptTemplate(template, func(text, placeholder string) error {
+		b.WriteString(text)
+		if placeholder == "" {
+			return nil
+		}
+		v, ok := vars[placeholder]
+		if !ok {
+			return fmt.Errorf("unknown placeholder {{%s}}", placeholder)
+		}
+		b.WriteString(v)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// PromptTemplatePlaceholders returns the placeholder names of template in
+// order of appearance, so generators can check them up front.
+func PromptTemplatePlaceholders(template string) ([]string, error) {
+	var names []string
+	err := scanPromptTemplate(template, func(_, placeholder string) error {
+		if placeholder != "" {
+			names = append(names, placeholder)
+		}
+		return nil
+	})
+	return names, err
+}
+
+// scanPromptTemplate calls emit with each run of literal text and the
+// placeholder following it, "" after the last run.
+func scanPromptTemplate(template string, emit func(text, placeholder string) error) error {
+	for {
+		start := strings.Index(template, "{{")
+		if start < 0 {
+			return emit(template, "")
+		}
+		end := strings.Index(template[start:], "}}")
+		if end < 0 {
+			return fmt.Errorf("unterminated placeholder at %q", template[start:])
+		}
+		name := strings.TrimSpace(template[start+2 : start+end])
+		if name == "" {
+			return fmt.Errorf("empty placeholder at %q", template[start:start+end+2])
+		}
+		if err := emit(template[:start], name); err != nil {
+			return err
+		}
+		template = template[start+end+2:]
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func TestRenderPromptTemplate(t *testing.T) {
+	g := NewWithT(t)
+	text, err := runtime.RenderPromptTemplate("Call {{ tool }} for {{name}}.", map[string]string{"tool": "svc_Get", "name": "a"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(text).To(Equal("Call svc_Get for a."))
+
+	_, err = runtime.RenderPromptTemplate("Hello {{who}}", nil)
+	g.Expect(err).To(MatchError("unknown placeholder {{who}}"))
+}
+
+func TestPromptTemplatePlaceholders(t *testing.T) {
+	g := NewWithT(t)
+	names, err := runtime.PromptTemplatePlaceholders("{{a}} and {{tool:List}}, no braces }} here")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(names).To(Equal([]string{"a", "tool:List"}))
+
+	_, err = runtime.PromptTemplatePlaceholders("open {{a")
+	g.Expect(err).To(MatchError(ContainSubstring("unterminated placeholder")))
+	_, err = runtime.PromptTemplatePlaceholders("empty {{ }}")
+	g.Expect(err).To(MatchError(ContainSubstring("empty placeholder")))
+}
+
+func TestTemplatePromptHandler(t *testing.T) {
+	g := NewWithT(t)
+	prompt := runtime.Prompt{
+		Name:        "review",
+		Description: "Review an item.",
+		Arguments: []runtime.PromptArgument{
+			{Name: "id", Required: true},
+			{Name: "focus"},
+		},
+	}
+	handler := runtime.TemplatePromptHandler(prompt, "Fetch {{id}} with {{tool}}. {{focus}}", map[string]string{"tool": "svc_Get"})
+
+	result, err := handler(context.Background(), &runtime.GetPromptRequest{Arguments: map[string]string{"id": "42"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result).To(Equal(&runtime.GetPromptResult{
+		Description: "Review an item.",
+		Messages:    []runtime.PromptMessage{{Role: "user", Text: "Fetch 42 with svc_Get. "}},
+	}))
+
+	_, err = handler(context.Background(), &runtime.GetPromptRequest{})
+	g.Expect(err).To(MatchError(`prompt "review": missing required argument "id"`))
+}
+
+// toolOnlyServer supports tools but no optional capability.
+type toolOnlyServer struct{}
+
+func (toolOnlyServer) AddTool(runtime.Tool, runtime.ToolHandler) {}
+
+func TestAddPrompt_Unsupported(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(runtime.AddPrompt(toolOnlyServer{}, runtime.Prompt{Name: "p"}, nil)).To(BeFalse())
+}
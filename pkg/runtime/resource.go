@@ -0,0 +1,273 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ResourceServer is implemented by MCPServer adapters whose MCP library
+// supports resources. Both bundled adapters do.
+type ResourceServer interface {
+	AddResource(resource Resource, handler ResourceHandler)
+}
+
+// Resource describes an MCP resource or resource template independent of
+// any MCP library.
+type Resource struct {
+	// URI is the resource URI, or a URI template when it has {variables}.
+	URI         string
+	Name        string
+	Title       string
+	Description string
+	MIMEType    string
+}
+
+// IsTemplate reports whether r.URI is a URI template.
+func (r Resource) IsTemplate() bool {
+	return strings.Contains(r.URI, "{")
+}
+
+// ResourceHandler is the callback invoked when an MCP client reads a
+// resource.
+type ResourceHandler func(ctx context.Context, request *ReadResourceRequest) (*ReadResourceResult, error)
+
+// ReadResourceRequest carries the URI of the resource being read.
+type ReadResourceRequest struct {
+	URI string
+}
+
+// ReadResourceResult is the content of a resource.
+type ReadResourceResult struct {
+	Contents []ResourceContents
+}
+
+// ResourceContents is the text content of a resource.
+type ResourceContents struct {
+	URI      string
+	MIMEType string
+	Text     string
+}
+
+// AddResource registers resource on s if its MCP library supports
+// resources, and reports whether it did.
+func AddResource(s MCPServer, resource Resource, handler ResourceHandler) bool {
+	rs, ok := s.(ResourceServer)
+	if !ok {
+		return false
+	}
+	rs.AddResource(resource, handler)
+	return true
+}
+
+// ResourceRemover is implemented by MCPServer adapters whose MCP library can
+// remove resources. Both bundled adapters do; mark3labs/mcp-go v0.37 cannot
+// remove resource templates, so its adapter leaves them in place.
+type ResourceRemover interface {
+	// RemoveResources removes the resources and resource templates with the
+	// given URIs.
+	RemoveResources(uris ...string)
+}
+
+// RemoveResources removes the resources with the given URIs, or URI
+// templates, from s if its MCP library supports it, and reports whether it
+// did.
+func RemoveResources(s MCPServer, uris ...string) bool {
+	rr, ok := s.(ResourceRemover)
+	if !ok {
+		return false
+	}
+	rr.RemoveResources(uris...)
+	return true
+}
+
+// ApplyResourceConfig applies the name prefix of config to a resource, like
+// ApplyConfig does for tools. The URI is left alone.
+func ApplyResourceConfig(resource Resource, config *config) Resource {
+	if config.NamePrefix != "" {
+		resource.Name = config.NamePrefix + "_" + resource.Name
+	}
+	return resource
+}
+
+// NewResourceResultJSON returns msg as the JSON content of the resource at
+// uri, encoded like tool results.
+func NewResourceResultJSON(uri string, msg proto.Message) (*ReadResourceResult, error) {
+	encoded, err := EncodeMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &ReadResourceResult{Contents: []ResourceContents{{
+		URI:      uri,
+		MIMEType: "application/json",
+		Text:     string(encoded),
+	}}}, nil
+}
+
+// uriVariable matches a {name} or {+name} expression of a URI template.
+var uriVariable = regexp.MustCompile(`\{(\+?)([A-Za-z0-9_.]+)\}`)
+
+// MatchURITemplate returns the variables of template bound by uri, or an
+// error if uri does not match. Templates support {name}, which matches one
+// path segment, and {+name}, which also matches slashes; other RFC 6570
+// operators are not supported.
+func MatchURITemplate(template, uri string) (map[string]string, error) {
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	var names []string
+	last := 0
+	for _, m := range uriVariable.FindAllStringSubmatchIndex(template, -1) {
+		pattern.WriteString(regexp.QuoteMeta(template[last:m[0]]))
+		if m[3] > m[2] {
+			pattern.WriteString("([^?#]+)")
+		} else {
+			pattern.WriteString("([^/?#]+)")
+		}
+		names = append(names, template[m[4]:m[5]])
+		last = m[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(template[last:]))
+	pattern.WriteString("$")
+
+	match := regexp.MustCompile(pattern.String()).FindStringSubmatch(uri)
+	if match == nil {
+		return nil, fmt.Errorf("resource URI %q does not match %q", uri, template)
+	}
+	vars := make(map[string]string, len(names))
+	for i, name := range names {
+		decoded, err := url.PathUnescape(match[i+1])
+		if err != nil {
+			return nil, fmt.Errorf("resource URI %q: variable %s: %w", uri, name, err)
+		}
+		vars[name] = decoded
+	}
+	return vars, nil
+}
+
+// SetURIVariables sets the request fields named by the variables of
+// template, such as {name} or {parent.id}, from uri.
+func SetURIVariables(req proto.Message, template, uri string) error {
+	fields, err := uriArguments(req.ProtoReflect().Descriptor(), template, uri)
+	if err != nil {
+		return err
+	}
+	raw, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return protojson.Unmarshal(raw, req)
+}
+
+// ToolResource returns a handler that reads the resource at a URI matching
+// template by calling handler, the tool of the same method, with the URI
+// variables as arguments. Reads so take the path of tool calls, and
+// from_context fields, defaults, string sanitizers and the tool middleware
+// apply to them alike. md is the request message; wrapInput nests the
+// arguments like the wrap_input plugin option. A tool error fails the read.
+func ToolResource(template string, md protoreflect.MessageDescriptor, wrapInput string, handler ToolHandler) ResourceHandler {
+	return func(ctx context.Context, request *ReadResourceRequest) (*ReadResourceResult, error) {
+		args, err := uriArguments(md, template, request.URI)
+		if err != nil {
+			return nil, err
+		}
+		if wrapInput != "" {
+			args = map[string]any{wrapInput: args}
+		}
+		result, err := handler(ctx, &CallToolRequest{Arguments: args})
+		if err != nil {
+			return nil, err
+		}
+		if result.IsError {
+			return nil, errors.New(result.Text)
+		}
+		text := result.Text
+		if result.StructuredContent != nil {
+			encoded, err := json.Marshal(result.StructuredContent)
+			if err != nil {
+				return nil, err
+			}
+			text = string(encoded)
+		}
+		return &ReadResourceResult{Contents: []ResourceContents{{
+			URI:      request.URI,
+			MIMEType: "application/json",
+			Text:     text,
+		}}}, nil
+	}
+}
+
+// uriArguments returns the variables of template bound by uri as the JSON
+// object protojson reads into a message of type md.
+func uriArguments(md protoreflect.MessageDescriptor, template, uri string) (map[string]any, error) {
+	vars, err := MatchURITemplate(template, uri)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]any{}
+	for path, v := range vars {
+		md := md
+		obj := fields
+		segments := strings.Split(path, ".")
+		for i, segment := range segments {
+			fd := md.Fields().ByName(protoreflect.Name(segment))
+			if fd == nil || fd.IsList() || fd.IsMap() {
+				return nil, fmt.Errorf("resource URI variable %s does not name a field of %s", path, md.FullName())
+			}
+			if i < len(segments)-1 {
+				if fd.Message() == nil {
+					return nil, fmt.Errorf("resource URI variable %s does not name a field of %s", path, md.FullName())
+				}
+				next, _ := obj[fd.JSONName()].(map[string]any)
+				if next == nil {
+					next = map[string]any{}
+					obj[fd.JSONName()] = next
+				}
+				obj, md = next, fd.Message()
+				continue
+			}
+			value, err := uriVariableValue(fd, v)
+			if err != nil {
+				return nil, fmt.Errorf("resource URI variable %s: %w", path, err)
+			}
+			obj[fd.JSONName()] = value
+		}
+	}
+	return fields, nil
+}
+
+// uriVariableValue converts the text of a URI variable into the JSON value
+// protojson expects for fd.
+func uriVariableValue(fd protoreflect.FieldDescriptor, v string) (any, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return strconv.ParseBool(v)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return strconv.ParseFloat(v, 64)
+	default:
+		// Strings, enums and integers (which protojson accepts quoted).
+		return v, nil
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ResourcesProperty is the tool argument that maps request fields to the
+// resource URIs to read them from.
+const ResourcesProperty = "resources"
+
+// MaxResourceInputSize is the largest resource ReadRootFile reads.
+const MaxResourceInputSize = 16 << 20
+
+// ErrRootsUnsupported is returned by ReadRootFile when the MCP library or
+// the client does not share roots.
+var ErrRootsUnsupported = errors.New("the MCP client does not share roots")
+
+// ResourceReader reads the content of the resource at uri for a tool call.
+type ResourceReader func(ctx context.Context, uri string) ([]byte, error)
+
+// RootsLister lists the root URIs the MCP client shares, e.g. the
+// directories of its workspace. Adapters put one in the handler context,
+// via WithRootsLister, when the client supports roots.
+type RootsLister interface {
+	ListRoots(ctx context.Context) ([]string, error)
+}
+
+type rootsListerKey struct{}
+
+// WithRootsLister returns a copy of ctx carrying l.
+func WithRootsLister(ctx context.Context, l RootsLister) context.Context {
+	return context.WithValue(ctx, rootsListerKey{}, l)
+}
+
+// RootsListerFromContext returns the RootsLister in ctx, or nil if the MCP
+// library or the client does not support roots.
+func RootsListerFromContext(ctx context.Context) RootsLister {
+	l, _ := ctx.Value(rootsListerKey{}).(RootsLister)
+	return l
+}
+
+// WithResourceInputs adds an optional "resources" object to every tool with
+// top-level string or bytes fields, mapping field names to resource URIs.
+// The handler reads each resource with read and sets the field to its
+// content, so the model can pass a file by reference instead of inlining it,
+// base64 encoded for bytes. A nil read means ReadRootFile.
+func WithResourceInputs(read ResourceReader) Option {
+	if read == nil {
+		read = ReadRootFile
+	}
+	return func(c *config) {
+		c.ResourceReader = read
+	}
+}
+
+// ReadRootFile reads a file:// URI that lies within one of the roots the
+// MCP client of ctx shares, and no larger than MaxResourceInputSize. Symbolic
+// links are resolved before the check. It is meant for servers running on
+// the client's machine, e.g. over stdio.
+func ReadRootFile(ctx context.Context, uri string) ([]byte, error) {
+	path, err := fileURIPath(uri)
+	if err != nil {
+		return nil, err
+	}
+	lister := RootsListerFromContext(ctx)
+	if lister == nil {
+		return nil, ErrRootsUnsupported
+	}
+	roots, err := lister.ListRoots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list roots: %w", err)
+	}
+	path, err = filepath.EvalSymlinks(path)
+	if err != nil {
+		return nil, fmt.Errorf("resource %q: %w", uri, err)
+	}
+	if !withinRoots(path, roots) {
+		return nil, fmt.Errorf("resource %q is outside the roots the client shares", uri)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("resource %q: %w", uri, err)
+	}
+	defer f.Close()
+	content, err := io.ReadAll(io.LimitReader(f, MaxResourceInputSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("resource %q: %w", uri, err)
+	}
+	if len(content) > MaxResourceInputSize {
+		return nil, fmt.Errorf("resource %q is larger than %d bytes", uri, MaxResourceInputSize)
+	}
+	return content, nil
+}
+
+// fileURIPath returns the local path of a file:// URI.
+func fileURIPath(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" || (u.Host != "" && u.Host != "localhost") || u.Path == "" {
+		return "", fmt.Errorf("resource %q is not a local file:// URI", uri)
+	}
+	return filepath.Clean(filepath.FromSlash(u.Path)), nil
+}
+
+// withinRoots reports whether path lies within one of the file:// roots.
+func withinRoots(path string, roots []string) bool {
+	for _, root := range roots {
+		rootPath, err := fileURIPath(root)
+		if err != nil {
+			continue
+		}
+		if resolved, err := filepath.EvalSymlinks(rootPath); err == nil {
+			rootPath = resolved
+		}
+		rel, err := filepath.Rel(rootPath, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddResourceInputsToTool adds the "resources" object to the tool's input
+// schema, with a property for each top-level string or bytes field. A tool
+// without such fields, or whose request already has a "resources" property,
+// is returned unchanged.
+func AddResourceInputsToTool(tool Tool) Tool {
+	var schema map[string]interface{}
+	if err := json.Unmarshal(tool.RawInputSchema, &schema); err != nil {
+		return tool
+	}
+	props, _ := schema["properties"].(map[string]interface{})
+	if props == nil || props[ResourcesProperty] != nil {
+		return tool
+	}
+	var names []string
+	for name, prop := range props {
+		if p, ok := prop.(map[string]interface{}); ok && resourceInputSchema(p) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return tool
+	}
+	sort.Strings(names)
+
+	strict := schema["additionalProperties"] == false
+	uriProps := make(map[string]interface{}, len(names))
+	required := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		if strict {
+			uriProps[name] = map[string]interface{}{"type": []interface{}{"string", "null"}, "format": "uri"}
+			required = append(required, name)
+		} else {
+			uriProps[name] = map[string]interface{}{"type": "string", "format": "uri"}
+		}
+	}
+	resources := map[string]interface{}{
+		"type":                 "object",
+		"description":          "Optional resource URIs, such as file:// URIs of the user's files, to read fields from instead of passing their content.",
+		"properties":           uriProps,
+		"additionalProperties": false,
+	}
+	if strict {
+		resources["type"] = []interface{}{"object", "null"}
+		resources["required"] = required
+		req, _ := schema["required"].([]interface{})
+		schema["required"] = append(req, ResourcesProperty)
+	}
+	props[ResourcesProperty] = resources
+
+	modified, err := json.Marshal(schema)
+	if err != nil {
+		return tool
+	}
+	tool.RawInputSchema = json.RawMessage(modified)
+	return tool
+}
+
+// resourceInputSchema reports whether prop is the schema of a string field
+// without a fixed format or values, or of a bytes field.
+func resourceInputSchema(prop map[string]interface{}) bool {
+	isString := prop["type"] == "string"
+	if types, ok := prop["type"].([]interface{}); ok {
+		for _, t := range types {
+			isString = isString || t == "string"
+		}
+	}
+	format, hasFormat := prop["format"]
+	_, hasEnum := prop["enum"]
+	return isString && !hasEnum && (!hasFormat || format == "byte")
+}
+
+// ExtractResourceInputs removes the "resources" argument from args, reads
+// each resource it names with read and sets the field to the content. It
+// does nothing if read is nil or the request has a "resources" field itself.
+func ExtractResourceInputs(ctx context.Context, md protoreflect.MessageDescriptor, args map[string]any, read ResourceReader) error {
+	if read == nil || md.Fields().ByName(ResourcesProperty) != nil || md.Fields().ByJSONName(ResourcesProperty) != nil {
+		return nil
+	}
+	raw, ok := args[ResourcesProperty]
+	delete(args, ResourcesProperty)
+	if !ok || raw == nil {
+		return nil
+	}
+	resources, ok := raw.(map[string]any)
+	if !ok {
+		return fmt.Errorf("argument %q must be an object of field names to resource URIs", ResourcesProperty)
+	}
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if resources[name] == nil {
+			continue
+		}
+		uri, ok := resources[name].(string)
+		if !ok {
+			return fmt.Errorf("resource of %q must be a URI string", name)
+		}
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = md.Fields().ByJSONName(name)
+		}
+		if fd == nil || fd.IsList() || fd.IsMap() || (fd.Kind() != protoreflect.StringKind && fd.Kind() != protoreflect.BytesKind) {
+			return fmt.Errorf("%q is not a string or bytes field and cannot be read from a resource", name)
+		}
+		content, err := read(ctx, uri)
+		if err != nil {
+			return err
+		}
+		if fd.Kind() == protoreflect.BytesKind {
+			args[name] = base64.StdEncoding.EncodeToString(content)
+			continue
+		}
+		if !utf8.Valid(content) {
+			return fmt.Errorf("resource %q of %q is not UTF-8 text", uri, name)
+		}
+		args[name] = string(content)
+	}
+	return nil
+}
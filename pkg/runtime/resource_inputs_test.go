@@ -0,0 +1,150 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestAddResourceInputsToTool(t *testing.T) {
+	input := `{"type":"object","properties":{
+		"name":{"type":"string"},
+		"thumbnail":{"type":"string","format":"byte","contentEncoding":"base64"},
+		"created_at":{"type":"string","format":"date-time"},
+		"status":{"type":"string","enum":["A","B"]},
+		"count":{"type":"integer"}
+	}%s}`
+
+	t.Run("plain schema", func(t *testing.T) {
+		g := NewWithT(t)
+		tool := runtime.AddResourceInputsToTool(runtime.Tool{Name: "t", RawInputSchema: json.RawMessage(fmt.Sprintf(input, ""))})
+		var schema map[string]any
+		g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+		resources := schema["properties"].(map[string]any)["resources"].(map[string]any)
+		g.Expect(resources["properties"]).To(Equal(map[string]any{
+			"name":      map[string]any{"type": "string", "format": "uri"},
+			"thumbnail": map[string]any{"type": "string", "format": "uri"},
+		}))
+		g.Expect(schema).ToNot(HaveKey("required"))
+	})
+
+	t.Run("strict schema", func(t *testing.T) {
+		g := NewWithT(t)
+		tool := runtime.AddResourceInputsToTool(runtime.Tool{Name: "t", RawInputSchema: json.RawMessage(fmt.Sprintf(input, `,"required":["name"],"additionalProperties":false`))})
+		var schema map[string]any
+		g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+		g.Expect(schema["required"]).To(Equal([]any{"name", "resources"}))
+		resources := schema["properties"].(map[string]any)["resources"].(map[string]any)
+		g.Expect(resources["type"]).To(Equal([]any{"object", "null"}))
+		g.Expect(resources["required"]).To(Equal([]any{"name", "thumbnail"}))
+	})
+
+	t.Run("no string fields", func(t *testing.T) {
+		g := NewWithT(t)
+		tool := runtime.Tool{Name: "t", RawInputSchema: json.RawMessage(`{"type":"object","properties":{"count":{"type":"integer"}}}`)}
+		g.Expect(runtime.AddResourceInputsToTool(tool)).To(Equal(tool))
+	})
+}
+
+func TestExtractResourceInputs(t *testing.T) {
+	md := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor()
+	read := func(_ context.Context, uri string) ([]byte, error) {
+		if uri == "mem://missing" {
+			return nil, errors.New("no such resource")
+		}
+		return []byte("content of " + uri), nil
+	}
+
+	t.Run("fills fields", func(t *testing.T) {
+		g := NewWithT(t)
+		args := map[string]any{"name": "w", "resources": map[string]any{"description": "mem://d", "thumbnail": "mem://t", "name": nil}}
+		g.Expect(runtime.ExtractResourceInputs(context.Background(), md, args, read)).To(Succeed())
+		g.Expect(args).To(Equal(map[string]any{
+			"name":        "w",
+			"description": "content of mem://d",
+			"thumbnail":   "Y29udGVudCBvZiBtZW06Ly90",
+		}))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		g := NewWithT(t)
+		args := map[string]any{"resources": map[string]any{"description": "mem://d"}}
+		g.Expect(runtime.ExtractResourceInputs(context.Background(), md, args, nil)).To(Succeed())
+		g.Expect(args).To(HaveKey("resources"))
+	})
+
+	for name, resources := range map[string]any{
+		"not an object":      "mem://d",
+		"not a URI":          map[string]any{"description": 1},
+		"not a string field": map[string]any{"tags": "mem://d"},
+		"unknown field":      map[string]any{"nope": "mem://d"},
+		"read error":         map[string]any{"description": "mem://missing"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+			args := map[string]any{"resources": resources}
+			g.Expect(runtime.ExtractResourceInputs(context.Background(), md, args, read)).ToNot(Succeed())
+			g.Expect(args).ToNot(HaveKey("resources"))
+		})
+	}
+}
+
+// staticRoots lists fixed roots.
+type staticRoots []string
+
+func (r staticRoots) ListRoots(context.Context) ([]string, error) {
+	return r, nil
+}
+
+func TestReadRootFile(t *testing.T) {
+	g := NewWithT(t)
+	root := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(root, "in.txt"), []byte("inside"), 0o600)).To(Succeed())
+	outside := filepath.Join(t.TempDir(), "out.txt")
+	g.Expect(os.WriteFile(outside, []byte("outside"), 0o600)).To(Succeed())
+	g.Expect(os.Symlink(outside, filepath.Join(root, "link.txt"))).To(Succeed())
+	ctx := runtime.WithRootsLister(context.Background(), staticRoots{"file://" + filepath.ToSlash(root)})
+
+	content, err := runtime.ReadRootFile(ctx, "file://"+filepath.ToSlash(filepath.Join(root, "in.txt")))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(content)).To(Equal("inside"))
+
+	_, err = runtime.ReadRootFile(ctx, "file://"+filepath.ToSlash(outside))
+	g.Expect(err).To(MatchError(ContainSubstring("outside the roots")))
+
+	// A link inside a root to a file outside of it is refused.
+	_, err = runtime.ReadRootFile(ctx, "file://"+filepath.ToSlash(filepath.Join(root, "link.txt")))
+	g.Expect(err).To(MatchError(ContainSubstring("outside the roots")))
+
+	_, err = runtime.ReadRootFile(ctx, "file://"+filepath.ToSlash(root)+"/../out.txt")
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = runtime.ReadRootFile(ctx, "https://example.com/in.txt")
+	g.Expect(err).To(MatchError(ContainSubstring("not a local file:// URI")))
+
+	_, err = runtime.ReadRootFile(context.Background(), "file://"+filepath.ToSlash(filepath.Join(root, "in.txt")))
+	g.Expect(err).To(MatchError(runtime.ErrRootsUnsupported))
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestMatchURITemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		uri      string
+		want     map[string]string
+		err      string
+	}{
+		{"literal", "configs://list", "configs://list", map[string]string{}, ""},
+		{"segment", "items://{id}", "items://a%20b", map[string]string{"id": "a b"}, ""},
+		{"segment rejects slash", "items://{id}", "items://a/b", nil, "does not match"},
+		{"reserved spans slashes", "items://v1/{+name}", "items://v1/configs/prod", map[string]string{"name": "configs/prod"}, ""},
+		{"nested and literal suffix", "items://{parent.id}/details", "items://7/details", map[string]string{"parent.id": "7"}, ""},
+		{"query is not matched", "items://{id}", "items://a?x=1", nil, "does not match"},
+		{"other scheme", "items://{id}", "other://a", nil, "does not match"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := runtime.MatchURITemplate(tt.template, tt.uri)
+			if tt.err != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.err)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestSetURIVariables(t *testing.T) {
+	g := NewWithT(t)
+
+	req := &testdata.CreateItemRequest{}
+	err := runtime.SetURIVariables(req, "items://{name}/service/{service.recurring}", "items://widget/service/true")
+	g.Expect(err).ToNot(HaveOccurred())
+	want := &testdata.CreateItemRequest{
+		Name:     "widget",
+		ItemType: &testdata.CreateItemRequest_Service{Service: &testdata.ServiceDetails{Recurring: true}},
+	}
+	if diff := cmp.Diff(want, req, protocmp.Transform()); diff != "" {
+		t.Errorf("request mismatch (-want +got):\n%s", diff)
+	}
+
+	product := &testdata.CreateItemRequest{}
+	err = runtime.SetURIVariables(product, "items://{product.price}/{product.quantity}", "items://9.5/3")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(product.GetProduct().GetPrice()).To(Equal(9.5))
+	g.Expect(product.GetProduct().GetQuantity()).To(Equal(int32(3)))
+
+	err = runtime.SetURIVariables(&testdata.CreateItemRequest{}, "items://{service.recurring}", "items://maybe")
+	g.Expect(err).To(MatchError(ContainSubstring("resource URI variable service.recurring")))
+
+	err = runtime.SetURIVariables(&testdata.CreateItemRequest{}, "items://{tags}", "items://a")
+	g.Expect(err).To(MatchError(ContainSubstring("does not name a field")))
+}
+
+func TestToolResource(t *testing.T) {
+	g := NewWithT(t)
+
+	md := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor()
+	var got map[string]any
+	read := runtime.ToolResource("items://{name}/service/{service.recurring}", md, "request", func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		got = request.Arguments
+		if got["request"].(map[string]any)["name"] == "missing" {
+			return runtime.NewToolResultError("item not found"), nil
+		}
+		return runtime.NewToolResultJSON([]byte(`{"id":"1"}`)), nil
+	})
+
+	// The URI variables are the arguments of the tool, nested like its
+	// input schema.
+	result, err := read(context.Background(), &runtime.ReadResourceRequest{URI: "items://widget/service/true"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got).To(Equal(map[string]any{"request": map[string]any{
+		"name":    "widget",
+		"service": map[string]any{"recurring": true},
+	}}))
+	g.Expect(result.Contents).To(Equal([]runtime.ResourceContents{{
+		URI:      "items://widget/service/true",
+		MIMEType: "application/json",
+		Text:     `{"id":"1"}`,
+	}}))
+
+	// A tool error fails the read.
+	_, err = read(context.Background(), &runtime.ReadResourceRequest{URI: "items://missing/service/true"})
+	g.Expect(err).To(MatchError("item not found"))
+
+	_, err = read(context.Background(), &runtime.ReadResourceRequest{URI: "other://widget"})
+	g.Expect(err).To(MatchError(ContainSubstring("does not match")))
+}
+
+func TestAddResource_Unsupported(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(runtime.AddResource(toolOnlyServer{}, runtime.Resource{URI: "items://list"}, nil)).To(BeFalse())
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// stripResultFields deletes the fields of md annotated with
+// (mcp.field).exclude_from_result from obj, its protojson encoding with proto
+// names, and from every message nested in it.
+func stripResultFields(md protoreflect.MessageDescriptor, obj map[string]any) {
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		name := string(fd.Name())
+		v, ok := obj[name]
+		if !ok {
+			continue
+		}
+		if fieldOptions(fd).GetExcludeFromResult() {
+			delete(obj, name)
+			continue
+		}
+		child := fd
+		if fd.IsMap() {
+			child = fd.MapValue()
+		}
+		if child.Message() == nil || isWellKnown(child.Message()) {
+			continue
+		}
+		switch v := v.(type) {
+		case map[string]any:
+			if !fd.IsMap() {
+				stripResultFields(child.Message(), v)
+				continue
+			}
+			for _, value := range v {
+				if m, ok := value.(map[string]any); ok {
+					stripResultFields(child.Message(), m)
+				}
+			}
+		case []any:
+			for _, value := range v {
+				if m, ok := value.(map[string]any); ok {
+					stripResultFields(child.Message(), m)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestEncodeMessage_ExcludeFromResult(t *testing.T) {
+	t.Run("top-level field", func(t *testing.T) {
+		g := NewWithT(t)
+		msg := &testdata.ApplyConfigResponse{Applied: true, DebugDump: "plan: ..."}
+		encoded, err := runtime.EncodeMessage(msg)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(encoded)).To(MatchJSON(`{"applied":true}`))
+		// The caller's message is left alone.
+		g.Expect(msg.DebugDump).To(Equal("plan: ..."))
+	})
+
+	t.Run("repeated messages", func(t *testing.T) {
+		g := NewWithT(t)
+		msg := &testdata.ListConfigsResponse{Configs: []*testdata.Config{
+			{Name: "configs/a", InternalId: "1"},
+			{Name: "configs/b"},
+			{Name: "configs/c", InternalId: "3"},
+		}}
+		encoded, err := runtime.EncodeMessage(msg)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(encoded)).To(MatchJSON(`{"configs":[{"name":"configs/a"},{"name":"configs/b"},{"name":"configs/c"}],"next_page_token":""}`))
+		g.Expect(msg.Configs[2].InternalId).To(Equal("3"))
+	})
+
+	t.Run("result helpers", func(t *testing.T) {
+		g := NewWithT(t)
+		result, err := runtime.MarshalResult(&testdata.Config{Name: "configs/a", InternalId: "1"}, runtime.CodecOptions{TextOnly: true})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Text).To(MatchJSON(`{"name":"configs/a"}`))
+
+		result, err = runtime.DryRunResult(&testdata.ApplyConfigResponse{DebugDump: "x"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Text).ToNot(ContainSubstring("debug_dump"))
+	})
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrSamplingUnsupported is returned by Sample when the MCP library or the
+// client does not support sampling.
+var ErrSamplingUnsupported = errors.New("the MCP client does not support sampling")
+
+// SamplingRequest asks the MCP client to run its LLM on a conversation, e.g.
+// to summarize a large backend response before returning it.
+type SamplingRequest struct {
+	Messages     []SamplingMessage
+	SystemPrompt string
+	// MaxTokens is the most tokens the client may sample.
+	MaxTokens int
+	// Temperature is left to the client when zero.
+	Temperature   float64
+	StopSequences []string
+	// ModelHints name preferred models, most preferred first. The client
+	// may ignore them.
+	ModelHints []string
+}
+
+// SamplingMessage is a text message of a sampled conversation.
+type SamplingMessage struct {
+	// Role is "user" or "assistant".
+	Role string
+	Text string
+}
+
+// SamplingResult is the message the client's LLM generated.
+type SamplingResult struct {
+	Role string
+	Text string
+	// Model is the name of the model that generated the message.
+	Model string
+	// StopReason is why sampling stopped, if known.
+	StopReason string
+}
+
+// Sampler sends sampling requests to the MCP client. Adapters put one in the
+// handler context, via WithSampler, when the client supports sampling, so a
+// <Service>Server implementation can call Sample with the ctx it is given.
+type Sampler interface {
+	CreateMessage(ctx context.Context, request *SamplingRequest) (*SamplingResult, error)
+}
+
+type samplerKey struct{}
+
+// WithSampler returns a copy of ctx carrying s.
+func WithSampler(ctx context.Context, s Sampler) context.Context {
+	return context.WithValue(ctx, samplerKey{}, s)
+}
+
+// SamplerFromContext returns the Sampler in ctx, or nil if the MCP library
+// or the client does not support sampling.
+func SamplerFromContext(ctx context.Context) Sampler {
+	s, _ := ctx.Value(samplerKey{}).(Sampler)
+	return s
+}
+
+// Sample sends request to the client of the tool call handled with ctx. It
+// returns ErrSamplingUnsupported if the client cannot sample.
+func Sample(ctx context.Context, request *SamplingRequest) (*SamplingResult, error) {
+	s := SamplerFromContext(ctx)
+	if s == nil {
+		return nil, ErrSamplingUnsupported
+	}
+	return s.CreateMessage(ctx, request)
+}
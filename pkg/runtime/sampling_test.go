@@ -0,0 +1,59 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+// fakeSampler records the request and answers with a fixed result.
+type fakeSampler struct {
+	request *runtime.SamplingRequest
+	result  *runtime.SamplingResult
+}
+
+func (f *fakeSampler) CreateMessage(_ context.Context, request *runtime.SamplingRequest) (*runtime.SamplingResult, error) {
+	f.request = request
+	return f.result, nil
+}
+
+func TestSample(t *testing.T) {
+	request := &runtime.SamplingRequest{
+		Messages:  []runtime.SamplingMessage{{Role: "user", Text: "Summarize this"}},
+		MaxTokens: 100,
+	}
+
+	t.Run("no sampler", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := runtime.Sample(context.Background(), request)
+		g.Expect(err).To(MatchError(runtime.ErrSamplingUnsupported))
+	})
+
+	t.Run("forwards to sampler", func(t *testing.T) {
+		g := NewWithT(t)
+		s := &fakeSampler{result: &runtime.SamplingResult{Role: "assistant", Text: "Short"}}
+		ctx := runtime.WithSampler(context.Background(), s)
+		g.Expect(runtime.SamplerFromContext(ctx)).To(BeIdenticalTo(s))
+
+		got, err := runtime.Sample(ctx, request)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got.Text).To(Equal("Short"))
+		g.Expect(s.request).To(BeIdenticalTo(request))
+	})
+}
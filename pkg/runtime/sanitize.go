@@ -0,0 +1,179 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"golang.org/x/text/unicode/norm"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// StringSanitizer cleans up the value of a string field fd the model sent,
+// before it is decoded into the request. An error fails the call; it is
+// returned to the model, so it should say what to fix.
+type StringSanitizer func(fd protoreflect.FieldDescriptor, value string) (string, error)
+
+// WithStringSanitizers makes the generated handlers run every string value
+// of the arguments through sanitizers, in order: singular, repeated and map
+// values of string fields, at any depth. Fields annotated with
+// (mcp.field).skip_sanitizers, and the messages nested in them, are left
+// alone.
+func WithStringSanitizers(sanitizers ...StringSanitizer) Option {
+	return func(c *config) {
+		c.StringSanitizers = append(c.StringSanitizers, sanitizers...)
+	}
+}
+
+// StripControlCharacters is a StringSanitizer removing control characters
+// other than tab, newline and carriage return, which models sometimes emit
+// and downstream systems choke on.
+func StripControlCharacters(_ protoreflect.FieldDescriptor, value string) (string, error) {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) && r != '\t' && r != '\n' && r != '\r' {
+			return -1
+		}
+		return r
+	}, value), nil
+}
+
+// NormalizeUnicode is a StringSanitizer bringing values into Unicode
+// normalization form C, so that visually identical strings compare equal
+// downstream.
+func NormalizeUnicode(_ protoreflect.FieldDescriptor, value string) (string, error) {
+	return norm.NFC.String(value), nil
+}
+
+// patterns caches the compiled buf.validate patterns of EnforcePatterns.
+var patterns sync.Map // string -> *regexp.Regexp
+
+// EnforcePatterns is a StringSanitizer failing values that do not match the
+// (buf.validate.field).string.pattern of their field, or of the items or
+// values of a repeated or map field, before the backend sees them. Values of
+// fields without a pattern pass unchanged.
+func EnforcePatterns(fd protoreflect.FieldDescriptor, value string) (string, error) {
+	pattern := validatePattern(fd)
+	if pattern == "" {
+		return value, nil
+	}
+	re, ok := patterns.Load(pattern)
+	if !ok {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return "", fmt.Errorf("invalid buf.validate pattern %q: %w", pattern, err)
+		}
+		re, _ = patterns.LoadOrStore(pattern, compiled)
+	}
+	if !re.(*regexp.Regexp).MatchString(value) {
+		return "", fmt.Errorf("value %q does not match the pattern %q", value, pattern)
+	}
+	return value, nil
+}
+
+// validatePattern returns the buf.validate string pattern that applies to
+// the string values of fd, or "".
+func validatePattern(fd protoreflect.FieldDescriptor) string {
+	rules, _ := proto.GetExtension(fd.Options(), validate.E_Field).(*validate.FieldRules)
+	switch {
+	case fd.IsMap():
+		return rules.GetMap().GetValues().GetString().GetPattern()
+	case fd.IsList():
+		return rules.GetRepeated().GetItems().GetString().GetPattern()
+	default:
+		return rules.GetString().GetPattern()
+	}
+}
+
+// SanitizeStrings runs the string values of args, the arguments for md in
+// the shape DecodeArguments produces, through sanitizers as
+// WithStringSanitizers describes, replacing them in place.
+func SanitizeStrings(md protoreflect.MessageDescriptor, args map[string]any, sanitizers []StringSanitizer) error {
+	if len(sanitizers) == 0 {
+		return nil
+	}
+	return sanitizeMessage(md, args, sanitizers, "")
+}
+
+func sanitizeMessage(md protoreflect.MessageDescriptor, obj map[string]any, sanitizers []StringSanitizer, prefix string) error {
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if fieldOptions(fd).GetSkipSanitizers() {
+			continue
+		}
+		name := resolveFieldName(fd, obj)
+		if name == "" {
+			continue
+		}
+		path := prefix + name
+		value := fd
+		if fd.IsMap() {
+			value = fd.MapValue()
+		}
+		// sanitize replaces *v, the value at path, if it is a string or a
+		// message to descend into.
+		sanitize := func(v *any, path string) error {
+			switch t := (*v).(type) {
+			case string:
+				if value.Kind() != protoreflect.StringKind {
+					return nil
+				}
+				for _, s := range sanitizers {
+					var err error
+					if t, err = s(fd, t); err != nil {
+						return fmt.Errorf("argument %q: %w", path, err)
+					}
+				}
+				*v = t
+			case map[string]any:
+				if value.Message() != nil && !isWellKnown(value.Message()) {
+					return sanitizeMessage(value.Message(), t, sanitizers, path+".")
+				}
+			}
+			return nil
+		}
+		v := obj[name]
+		if list, ok := v.([]any); ok {
+			for idx := range list {
+				if err := sanitize(&list[idx], fmt.Sprintf("%s[%d]", path, idx)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if m, ok := v.(map[string]any); ok && fd.IsMap() {
+			for _, k := range slices.Sorted(maps.Keys(m)) {
+				e := m[k]
+				if err := sanitize(&e, fmt.Sprintf("%s[%q]", path, k)); err != nil {
+					return err
+				}
+				m[k] = e
+			}
+			continue
+		}
+		if err := sanitize(&v, path); err != nil {
+			return err
+		}
+		obj[name] = v
+	}
+	return nil
+}
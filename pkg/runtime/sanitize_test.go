@@ -0,0 +1,97 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestSanitizeStrings(t *testing.T) {
+	t.Run("strings at any depth", func(t *testing.T) {
+		g := NewWithT(t)
+		md := (&testdata.DeepNestingRequest{}).ProtoReflect().Descriptor()
+		args := map[string]any{
+			"middle": map[string]any{
+				"inner":       map[string]any{"id": "a\x00b", "tags": map[string]any{"k": "v\x1b"}},
+				"named_items": map[string]any{"x": map[string]any{"id": "\x07c"}},
+			},
+			"middles": []any{map[string]any{"items": []any{map[string]any{"id": "d\u0085"}}}},
+		}
+		g.Expect(runtime.SanitizeStrings(md, args, []runtime.StringSanitizer{runtime.StripControlCharacters})).To(Succeed())
+		g.Expect(args).To(Equal(map[string]any{
+			"middle": map[string]any{
+				"inner":       map[string]any{"id": "ab", "tags": map[string]any{"k": "v"}},
+				"named_items": map[string]any{"x": map[string]any{"id": "c"}},
+			},
+			"middles": []any{map[string]any{"items": []any{map[string]any{"id": "d"}}}},
+		}))
+	})
+
+	t.Run("keeps whitespace controls and non-string fields", func(t *testing.T) {
+		g := NewWithT(t)
+		md := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor()
+		args := map[string]any{"name": "a\tb\r\nc", "thumbnail": "AA\x00A", "tags": []any{"x\x01"}}
+		g.Expect(runtime.SanitizeStrings(md, args, []runtime.StringSanitizer{runtime.StripControlCharacters})).To(Succeed())
+		g.Expect(args).To(Equal(map[string]any{"name": "a\tb\r\nc", "thumbnail": "AA\x00A", "tags": []any{"x"}}))
+	})
+
+	t.Run("skip_sanitizers opts out", func(t *testing.T) {
+		g := NewWithT(t)
+		md := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor()
+		args := map[string]any{"pipelineYaml": "raw\x00", "name": "p\x00"}
+		g.Expect(runtime.SanitizeStrings(md, args, []runtime.StringSanitizer{runtime.StripControlCharacters})).To(Succeed())
+		g.Expect(args).To(Equal(map[string]any{"pipelineYaml": "raw\x00", "name": "p"}))
+	})
+
+	t.Run("sanitizers run in order and errors name the argument", func(t *testing.T) {
+		g := NewWithT(t)
+		md := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor()
+		upper := func(_ protoreflect.FieldDescriptor, v string) (string, error) { return strings.ToUpper(v), nil }
+		reject := func(_ protoreflect.FieldDescriptor, v string) (string, error) {
+			if v == "BAD" {
+				return "", errors.New("not allowed")
+			}
+			return v, nil
+		}
+		args := map[string]any{"name": "ok"}
+		g.Expect(runtime.SanitizeStrings(md, args, []runtime.StringSanitizer{upper, reject})).To(Succeed())
+		g.Expect(args["name"]).To(Equal("OK"))
+		g.Expect(runtime.SanitizeStrings(md, map[string]any{"labels": map[string]any{"k": "bad"}}, []runtime.StringSanitizer{upper, reject})).
+			To(MatchError(`argument "labels[\"k\"]": not allowed`))
+	})
+}
+
+func TestNormalizeUnicode(t *testing.T) {
+	g := NewWithT(t)
+	v, err := runtime.NormalizeUnicode(nil, "é")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(v).To(Equal("é"))
+}
+
+func TestEnforcePatterns(t *testing.T) {
+	g := NewWithT(t)
+	md := (&testdata.TestValidationRequest{}).ProtoReflect().Descriptor()
+	sanitizers := []runtime.StringSanitizer{runtime.EnforcePatterns}
+	g.Expect(runtime.SanitizeStrings(md, map[string]any{"username": "alice_1", "email": "anything"}, sanitizers)).To(Succeed())
+	g.Expect(runtime.SanitizeStrings(md, map[string]any{"username": "1alice"}, sanitizers)).
+		To(MatchError(`argument "username": value "1alice" does not match the pattern "^[a-zA-Z][a-zA-Z0-9_]{2,19}$"`))
+}
@@ -0,0 +1,46 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import "encoding/json"
+
+// SchemaOverride rewrites the input schema of the tool toolName at
+// registration, see WithSchemaOverride. Returning nil keeps the schema.
+type SchemaOverride func(toolName string, schema json.RawMessage) json.RawMessage
+
+// WithSchemaOverride passes the input schema of every tool through
+// override as it is registered, so a deployment can tighten enums, drop
+// fields or adjust descriptions without regenerating code. toolName is the
+// registered name, prefix included, and schema has the extra properties
+// and other arguments the options add. Overrides apply in order.
+//
+// The handlers decode arguments as before: dropping a field only keeps the
+// model from setting it, and a schema the arguments do not match does not
+// reject them.
+func WithSchemaOverride(override SchemaOverride) Option {
+	return func(c *config) {
+		c.SchemaOverrides = append(c.SchemaOverrides, override)
+	}
+}
+
+// overrideSchema applies overrides to the input schema of tool.
+func overrideSchema(tool Tool, overrides []SchemaOverride) Tool {
+	for _, override := range overrides {
+		if schema := override(tool.Name, tool.RawInputSchema); schema != nil {
+			tool.RawInputSchema = schema
+		}
+	}
+	return tool
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func TestWithSchemaOverride(t *testing.T) {
+	g := NewWithT(t)
+	tool := runtime.Tool{
+		Name:           "Svc_Delete",
+		RawInputSchema: json.RawMessage(`{"type":"object","properties":{"name":{"type":"string"},"force":{"type":"boolean"}}}`),
+	}
+
+	var seen []string
+	config := runtime.NewConfig()
+	runtime.WithNamePrefix("prod")(config)
+	runtime.WithExtraProperties(runtime.ExtraProperty{Name: "cluster"})(config)
+	// Drop "force".
+	runtime.WithSchemaOverride(func(name string, schema json.RawMessage) json.RawMessage {
+		seen = append(seen, name)
+		var s map[string]any
+		g.Expect(json.Unmarshal(schema, &s)).To(Succeed())
+		g.Expect(s["properties"]).To(HaveKey("cluster"))
+		delete(s["properties"].(map[string]any), "force")
+		out, err := json.Marshal(s)
+		g.Expect(err).ToNot(HaveOccurred())
+		return out
+	})(config)
+	// Returning nil keeps the schema.
+	runtime.WithSchemaOverride(func(string, json.RawMessage) json.RawMessage { return nil })(config)
+
+	got := runtime.ApplyConfig(tool, config)
+	g.Expect(seen).To(Equal([]string{"prod_Svc_Delete"}))
+	var schema map[string]any
+	g.Expect(json.Unmarshal(got.RawInputSchema, &schema)).To(Succeed())
+	g.Expect(schema["properties"]).To(HaveKey("name"))
+	g.Expect(schema["properties"]).To(HaveKey("cluster"))
+	g.Expect(schema["properties"]).ToNot(HaveKey("force"))
+}
@@ -17,6 +17,7 @@ package runtime
 import (
 	"context"
 	"encoding/json"
+	"sync"
 )
 
 // MCPServer is the abstraction that both mark3labs/mcp-go and
@@ -34,6 +35,123 @@ type Tool struct {
 	Description     string
 	RawInputSchema  json.RawMessage
 	RawOutputSchema json.RawMessage
+
+	// Title is an optional human-readable display name for clients that
+	// render tools in a UI. Empty means clients fall back to Name.
+	Title string
+
+	// Tags categorize the tool, e.g. "topics", for clients that group
+	// tools. Adapters list them in the _meta of the tool under
+	// TagsMetaKey.
+	Tags []string
+
+	// LoadSchemas, when set, returns the input and output schemas of a tool
+	// whose RawInputSchema and RawOutputSchema are left empty until needed,
+	// see CompressedSchemas. The bundled adapters call it on the first
+	// tools/list; Loaded calls it right away.
+	LoadSchemas func() (input, output json.RawMessage)
+}
+
+// Loaded returns t with the schemas of LoadSchemas filled in. It returns t
+// unchanged if LoadSchemas is not set.
+func (t Tool) Loaded() Tool {
+	if t.LoadSchemas != nil {
+		t.RawInputSchema, t.RawOutputSchema = t.LoadSchemas()
+		t.LoadSchemas = nil
+	}
+	return t
+}
+
+// LazySchemas keeps the tools an MCP library adapter registered without
+// loading their schemas, see Tool.LoadSchemas, until they are first listed.
+// The zero value is ready to use.
+type LazySchemas struct {
+	mu    sync.Mutex
+	tools map[string]Tool
+}
+
+// Add keeps tool until its schemas are loaded.
+func (l *LazySchemas) Add(tool Tool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.tools == nil {
+		l.tools = map[string]Tool{}
+	}
+	l.tools[tool.Name] = tool
+}
+
+// Remove forgets the named tools.
+func (l *LazySchemas) Remove(names ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, name := range names {
+		delete(l.tools, name)
+	}
+}
+
+// Load returns the named tool with its schemas loaded, and whether it was
+// added. The schemas are loaded once.
+func (l *LazySchemas) Load(name string) (Tool, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	tool, ok := l.tools[name]
+	if ok && tool.LoadSchemas != nil {
+		tool = tool.Loaded()
+		l.tools[name] = tool
+	}
+	return tool, ok
+}
+
+// ToolRemover is implemented by MCPServer adapters whose MCP library can
+// remove tools. Both bundled adapters do, and notify clients with
+// notifications/tools/list_changed.
+type ToolRemover interface {
+	RemoveTools(names ...string)
+}
+
+// RemoveTools removes the named tools from s if its MCP library supports it,
+// and reports whether it did.
+func RemoveTools(s MCPServer, names ...string) bool {
+	tr, ok := s.(ToolRemover)
+	if !ok {
+		return false
+	}
+	tr.RemoveTools(names...)
+	return true
+}
+
+// ServerTool is a tool and its handler.
+type ServerTool struct {
+	Tool    Tool
+	Handler ToolHandler
+}
+
+// ToolAdder is implemented by MCPServer adapters that can add several tools
+// as one change, so clients get a single
+// notifications/tools/list_changed. Both bundled adapters do.
+type ToolAdder interface {
+	AddTools(tools ...ServerTool)
+}
+
+// AddTools adds tools to s as one change if its adapter supports it, and
+// one by one otherwise.
+func AddTools(s MCPServer, tools ...ServerTool) {
+	if ta, ok := s.(ToolAdder); ok {
+		ta.AddTools(tools...)
+		return
+	}
+	for _, t := range tools {
+		s.AddTool(t.Tool, t.Handler)
+	}
+}
+
+// AddToolFunc adapts a function to an MCPServer that supports tools only,
+// e.g. to collect the handlers a Register function adds.
+type AddToolFunc func(tool Tool, handler ToolHandler)
+
+// AddTool calls f(tool, handler).
+func (f AddToolFunc) AddTool(tool Tool, handler ToolHandler) {
+	f(tool, handler)
 }
 
 // ToolHandler is the callback invoked when an MCP client calls a tool.
@@ -42,6 +160,8 @@ type ToolHandler func(ctx context.Context, request *CallToolRequest) (*CallToolR
 // CallToolRequest carries the decoded arguments from an MCP tool call.
 type CallToolRequest struct {
 	Arguments map[string]any
+	// Meta holds the _meta fields the client sent with the call.
+	Meta map[string]any
 }
 
 // CallToolResult is the response from a tool handler.
@@ -49,6 +169,18 @@ type CallToolResult struct {
 	Text              string
 	StructuredContent any
 	IsError           bool
+
+	// Parts, if set, replace Text as the text content of the result, one
+	// content item each. Text still holds the whole result. See
+	// WithSplitResults.
+	Parts []string
+
+	// Meta holds the _meta fields sent with the result.
+	Meta map[string]any
+
+	// Resources are files returned as embedded resources after the text
+	// content. See NewToolResultFiles.
+	Resources []EmbeddedResource
 }
 
 // NewToolResultText creates a successful text result.
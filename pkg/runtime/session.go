@@ -0,0 +1,148 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type sessionIDKey struct{}
+
+// WithSessionID returns a copy of ctx carrying the ID of the MCP session a
+// request arrived on. Adapters call it for every tool call.
+func WithSessionID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, sessionIDKey{}, id)
+}
+
+// SessionIDFromContext returns the MCP session ID in ctx, and whether the
+// request had a session at all. Transports without session IDs, such as
+// stdio, report an empty ID.
+func SessionIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(sessionIDKey{}).(string)
+	return id, ok
+}
+
+// SessionStore keeps per-session state, e.g. the cluster a conversation
+// selected or a pagination cursor, keyed by the session ID in ctx. A session's
+// state expires when it has not been used for the store's TTL. A SessionStore
+// is safe for concurrent use.
+//
+// Requests without a session are not stored: Get reports no value and Set
+// does nothing. Transports without session IDs share a single session.
+type SessionStore struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu        sync.Mutex
+	sessions  map[string]*sessionState
+	lastSweep time.Time
+}
+
+type sessionState struct {
+	values   map[string]any
+	lastUsed time.Time
+}
+
+// NewSessionStore returns a SessionStore whose sessions expire after ttl
+// without use. A ttl of zero keeps them until Clear is called.
+func NewSessionStore(ttl time.Duration) *SessionStore {
+	return &SessionStore{
+		ttl:      ttl,
+		now:      time.Now,
+		sessions: make(map[string]*sessionState),
+	}
+}
+
+// Get returns the value stored under key for the session of ctx.
+func (s *SessionStore) Get(ctx context.Context, key string) (any, bool) {
+	id, ok := SessionIDFromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state := s.session(id, false)
+	if state == nil {
+		return nil, false
+	}
+	v, ok := state.values[key]
+	return v, ok
+}
+
+// Set stores value under key for the session of ctx.
+func (s *SessionStore) Set(ctx context.Context, key string, value any) {
+	id, ok := SessionIDFromContext(ctx)
+	if !ok {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.session(id, true).values[key] = value
+}
+
+// Delete removes the value stored under key for the session of ctx.
+func (s *SessionStore) Delete(ctx context.Context, key string) {
+	id, ok := SessionIDFromContext(ctx)
+	if !ok {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if state := s.session(id, false); state != nil {
+		delete(state.values, key)
+	}
+}
+
+// Clear removes all state of the session of ctx.
+func (s *SessionStore) Clear(ctx context.Context) {
+	id, ok := SessionIDFromContext(ctx)
+	if !ok {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, id)
+}
+
+// session returns the live state of session id, creating it if create is
+// set, and marks it used. Expired sessions are swept at most once per TTL.
+// s.mu must be held.
+func (s *SessionStore) session(id string, create bool) *sessionState {
+	now := s.now()
+	if s.ttl > 0 && now.Sub(s.lastSweep) >= s.ttl {
+		for sid, state := range s.sessions {
+			if now.Sub(state.lastUsed) >= s.ttl {
+				delete(s.sessions, sid)
+			}
+		}
+		s.lastSweep = now
+	}
+	state := s.sessions[id]
+	if state != nil && s.ttl > 0 && now.Sub(state.lastUsed) >= s.ttl {
+		delete(s.sessions, id)
+		state = nil
+	}
+	if state == nil {
+		if !create {
+			return nil
+		}
+		state = &sessionState{values: make(map[string]any)}
+		s.sessions[id] = state
+	}
+	state.lastUsed = now
+	return state
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSessionStore(t *testing.T) {
+	alice := WithSessionID(context.Background(), "alice")
+	bob := WithSessionID(context.Background(), "bob")
+
+	t.Run("sessions are separate", func(t *testing.T) {
+		g := NewWithT(t)
+		s := NewSessionStore(time.Hour)
+		s.Set(alice, "cluster", "prod")
+		s.Set(bob, "cluster", "dev")
+
+		v, ok := s.Get(alice, "cluster")
+		g.Expect(ok).To(BeTrue())
+		g.Expect(v).To(Equal("prod"))
+		v, _ = s.Get(bob, "cluster")
+		g.Expect(v).To(Equal("dev"))
+
+		s.Delete(alice, "cluster")
+		_, ok = s.Get(alice, "cluster")
+		g.Expect(ok).To(BeFalse())
+		s.Clear(bob)
+		_, ok = s.Get(bob, "cluster")
+		g.Expect(ok).To(BeFalse())
+	})
+
+	t.Run("no session", func(t *testing.T) {
+		g := NewWithT(t)
+		s := NewSessionStore(time.Hour)
+		s.Set(context.Background(), "cluster", "prod")
+		_, ok := s.Get(context.Background(), "cluster")
+		g.Expect(ok).To(BeFalse())
+		g.Expect(s.sessions).To(BeEmpty())
+
+		// An empty ID is a session without an ID, not a missing one.
+		stdio := WithSessionID(context.Background(), "")
+		s.Set(stdio, "cluster", "prod")
+		_, ok = s.Get(stdio, "cluster")
+		g.Expect(ok).To(BeTrue())
+	})
+
+	t.Run("idle sessions expire", func(t *testing.T) {
+		g := NewWithT(t)
+		now := time.Unix(0, 0)
+		s := NewSessionStore(time.Minute)
+		s.now = func() time.Time { return now }
+
+		s.Set(alice, "cluster", "prod")
+		s.Set(bob, "cluster", "dev")
+		now = now.Add(50 * time.Second)
+		_, ok := s.Get(alice, "cluster")
+		g.Expect(ok).To(BeTrue())
+
+		// Alice was used 20s ago, bob 70s ago.
+		now = now.Add(20 * time.Second)
+		_, ok = s.Get(bob, "cluster")
+		g.Expect(ok).To(BeFalse())
+		g.Expect(s.sessions).To(HaveKey("alice"))
+
+		// The sweep removes idle sessions nobody asks for again.
+		now = now.Add(2 * time.Minute)
+		s.Set(bob, "cluster", "dev")
+		g.Expect(s.sessions).To(HaveLen(1))
+		g.Expect(s.sessions).To(HaveKey("bob"))
+	})
+
+	t.Run("zero ttl never expires", func(t *testing.T) {
+		g := NewWithT(t)
+		now := time.Unix(0, 0)
+		s := NewSessionStore(0)
+		s.now = func() time.Time { return now }
+		s.Set(alice, "cluster", "prod")
+		now = now.Add(24 * time.Hour)
+		_, ok := s.Get(alice, "cluster")
+		g.Expect(ok).To(BeTrue())
+	})
+}
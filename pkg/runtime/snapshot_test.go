@@ -0,0 +1,46 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func TestToolSnapshot(t *testing.T) {
+	g := NewWithT(t)
+	got, err := runtime.ToolSnapshot(runtime.Tool{
+		Name:           "svc_Get",
+		Description:    "Get an item.",
+		RawInputSchema: json.RawMessage(`{"type":"object","properties":{"id":{"type":"string"}}}`),
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(got)).To(Equal(`{
+  "name": "svc_Get",
+  "description": "Get an item.",
+  "inputSchema": {
+    "type": "object",
+    "properties": {
+      "id": {
+        "type": "string"
+      }
+    }
+  }
+}
+`))
+}
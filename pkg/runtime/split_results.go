@@ -0,0 +1,107 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// WithSplitResults returns the elements of a top-level repeated field of a
+// tool's response as separate content items, instead of a single JSON text,
+// for clients and models that truncate large content items. Each element
+// comes after an index header, e.g. "items[2] (3 of 10):". The other fields
+// of the response, if any, come first, as a JSON object. The field split is
+// the first of fields the response has elements in or, without fields, the
+// only repeated field with elements. Structured content is left whole, and
+// responses without such a field are returned as they are.
+func WithSplitResults(fields ...string) Option {
+	return func(c *config) {
+		c.SplitResults = true
+		c.SplitFields = append(c.SplitFields, fields...)
+	}
+}
+
+// splitResults returns handler, with its results split as WithSplitResults
+// describes.
+func splitResults(handler ToolHandler, fields []string) ToolHandler {
+	return func(ctx context.Context, request *CallToolRequest) (*CallToolResult, error) {
+		result, err := handler(ctx, request)
+		if err != nil || result == nil || result.IsError {
+			return result, err
+		}
+		if parts := SplitResultParts(result.Text, fields...); parts != nil {
+			result.Parts = parts
+		}
+		return result, nil
+	}
+}
+
+// SplitResultParts splits the JSON object text into the content items of
+// WithSplitResults. It returns nil if text has no field to split.
+func SplitResultParts(text string, fields ...string) []string {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(text), &object); err != nil {
+		return nil
+	}
+	field, elements := splitField(object, fields)
+	if elements == nil {
+		return nil
+	}
+	var parts []string
+	delete(object, field)
+	if len(object) > 0 {
+		rest, err := json.Marshal(object)
+		if err != nil {
+			return nil
+		}
+		parts = append(parts, string(rest))
+	}
+	for i, element := range elements {
+		parts = append(parts, fmt.Sprintf("%s[%d] (%d of %d):\n%s", field, i, i+1, len(elements), element))
+	}
+	return parts
+}
+
+// splitField returns the field of object to split and its elements.
+func splitField(object map[string]json.RawMessage, fields []string) (string, []json.RawMessage) {
+	elements := func(name string) []json.RawMessage {
+		var list []json.RawMessage
+		if raw, ok := object[name]; !ok || json.Unmarshal(raw, &list) != nil || len(list) == 0 {
+			return nil
+		}
+		return list
+	}
+	for _, name := range fields {
+		if list := elements(name); list != nil {
+			return name, list
+		}
+	}
+	if len(fields) > 0 {
+		return "", nil
+	}
+	var found string
+	var list []json.RawMessage
+	for name := range object {
+		if l := elements(name); l != nil {
+			if list != nil {
+				return "", nil
+			}
+			found, list = name, l
+		}
+	}
+	return found, list
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func TestSplitResultParts(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		fields []string
+		want   []string
+	}{
+		{
+			name: "only repeated field",
+			text: `{"items":[{"id":"a"},{"id":"b"}],"next_page_token":"t"}`,
+			want: []string{`{"next_page_token":"t"}`, "items[0] (1 of 2):\n{\"id\":\"a\"}", "items[1] (2 of 2):\n{\"id\":\"b\"}"},
+		},
+		{
+			name: "nothing else",
+			text: `{"items":[1]}`,
+			want: []string{"items[0] (1 of 1):\n1"},
+		},
+		{
+			name: "several repeated fields",
+			text: `{"items":[1],"tags":["x"]}`,
+		},
+		{
+			name:   "named field",
+			text:   `{"items":[1],"tags":["x"]}`,
+			fields: []string{"missing", "tags"},
+			want:   []string{`{"items":[1]}`, "tags[0] (1 of 1):\n\"x\""},
+		},
+		{
+			name:   "named field without elements",
+			text:   `{"items":[1],"tags":[]}`,
+			fields: []string{"tags"},
+		},
+		{
+			name: "empty",
+			text: `{"items":[]}`,
+		},
+		{
+			name: "not an object",
+			text: `[1,2]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(runtime.SplitResultParts(tt.text, tt.fields...)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestWithSplitResults(t *testing.T) {
+	g := NewWithT(t)
+	config := runtime.NewConfig()
+	runtime.WithSplitResults()(config)
+	handler := runtime.ApplyHandlerConfig("tool", config, func(context.Context, *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		return runtime.NewToolResultJSON([]byte(`{"items":[1,2]}`)), nil
+	})
+
+	result, err := handler(context.Background(), &runtime.CallToolRequest{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Parts).To(Equal([]string{"items[0] (1 of 2):\n1", "items[1] (2 of 2):\n2"}))
+	g.Expect(result.Text).To(Equal(`{"items":[1,2]}`))
+
+	// Errors are never split.
+	handler = runtime.ApplyHandlerConfig("tool", config, func(context.Context, *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		return &runtime.CallToolResult{Text: `{"items":[1,2]}`, IsError: true}, nil
+	})
+	result, err = handler(context.Background(), &runtime.CallToolRequest{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Parts).To(BeNil())
+}
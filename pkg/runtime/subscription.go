@@ -0,0 +1,291 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// ResourceUpdate receives a message of a watch stream.
+type ResourceUpdate func(msg proto.Message)
+
+// Watcher streams the state of the resource at uri, usually by calling a
+// server-streaming "Watch" RPC. It calls update with every message it
+// receives and returns when the stream ends or ctx is cancelled.
+type Watcher func(ctx context.Context, uri string, update ResourceUpdate) error
+
+// SubscriptionRegistry runs the watch streams of subscribed resources.
+// Generated Register and ForwardTo functions add the watchers of their
+// resources to the registry passed with WithSubscriptions. The first
+// subscriber of a URI starts its stream and the last one to unsubscribe
+// stops it. Every message the stream receives is reported to the callback
+// set with OnUpdate, which the MCP library adapter turns into a
+// notifications/resources/updated event.
+type SubscriptionRegistry struct {
+	mu       sync.Mutex
+	watchers []watchedResource
+	active   map[string]*subscription
+	notify   func(ctx context.Context, uri string)
+}
+
+type watchedResource struct {
+	template string
+	watch    Watcher
+}
+
+type subscription struct {
+	cancel      context.CancelFunc
+	subscribers map[any]bool
+	latest      proto.Message
+}
+
+// NewSubscriptionRegistry returns an empty subscription registry.
+func NewSubscriptionRegistry() *SubscriptionRegistry {
+	return &SubscriptionRegistry{active: map[string]*subscription{}}
+}
+
+// WithSubscriptions makes the generated registration functions add the
+// watchers of their resources to r.
+func WithSubscriptions(r *SubscriptionRegistry) Option {
+	return func(c *config) {
+		c.Subscriptions = r
+	}
+}
+
+// Add registers w as the watcher of the resources matching the URI template.
+// Adding to a nil registry does nothing, so generated code can call it
+// whether or not subscriptions are enabled.
+func (r *SubscriptionRegistry) Add(template string, w Watcher) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.watchers = append(r.watchers, watchedResource{template: template, watch: w})
+}
+
+// OnUpdate sets the function called with the URI of a subscribed resource
+// whenever its stream receives a message.
+func (r *SubscriptionRegistry) OnUpdate(notify func(ctx context.Context, uri string)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.notify = notify
+}
+
+// Subscribe adds subscriber, typically the MCP session, to the subscribers
+// of uri and starts its watch stream if it is the first one. The stream
+// keeps the values of ctx but not its cancellation.
+func (r *SubscriptionRegistry) Subscribe(ctx context.Context, uri string, subscriber any) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if sub, ok := r.active[uri]; ok {
+		sub.subscribers[subscriber] = true
+		return nil
+	}
+	var watch Watcher
+	for _, w := range r.watchers {
+		if _, err := MatchURITemplate(w.template, uri); err == nil {
+			watch = w.watch
+			break
+		}
+	}
+	if watch == nil {
+		return fmt.Errorf("resource %q cannot be subscribed to", uri)
+	}
+
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	sub := &subscription{cancel: cancel, subscribers: map[any]bool{subscriber: true}}
+	r.active[uri] = sub
+	go func() {
+		_ = watch(ctx, uri, func(msg proto.Message) {
+			r.mu.Lock()
+			sub.latest = msg
+			notify := r.notify
+			r.mu.Unlock()
+			if notify != nil {
+				notify(ctx, uri)
+			}
+		})
+		// Subscribing again after the stream ended starts a new one.
+		r.mu.Lock()
+		if r.active[uri] == sub {
+			delete(r.active, uri)
+		}
+		r.mu.Unlock()
+		cancel()
+	}()
+	return nil
+}
+
+// Unsubscribe removes subscriber from the subscribers of uri and stops the
+// watch stream when none are left.
+func (r *SubscriptionRegistry) Unsubscribe(uri string, subscriber any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sub, ok := r.active[uri]
+	if !ok {
+		return
+	}
+	delete(sub.subscribers, subscriber)
+	if len(sub.subscribers) == 0 {
+		sub.cancel()
+		delete(r.active, uri)
+	}
+}
+
+// UnsubscribeAll removes subscriber from the subscribers of every URI, e.g.
+// when its session ends, and stops the watch streams nobody is left
+// subscribed to.
+func (r *SubscriptionRegistry) UnsubscribeAll(subscriber any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for uri, sub := range r.active {
+		if !sub.subscribers[subscriber] {
+			continue
+		}
+		delete(sub.subscribers, subscriber)
+		if len(sub.subscribers) == 0 {
+			sub.cancel()
+			delete(r.active, uri)
+		}
+	}
+}
+
+// Close stops every watch stream.
+func (r *SubscriptionRegistry) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for uri, sub := range r.active {
+		sub.cancel()
+		delete(r.active, uri)
+	}
+}
+
+// Latest returns the last message the watch stream of uri received, if it
+// is subscribed and received one.
+func (r *SubscriptionRegistry) Latest(uri string) (proto.Message, bool) {
+	if r == nil {
+		return nil, false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sub, ok := r.active[uri]
+	if !ok || sub.latest == nil {
+		return nil, false
+	}
+	return sub.latest, true
+}
+
+// AddWatchedResource registers a resource whose state is streamed by watch.
+// Reading it returns the latest message of its subscription in r, or else
+// the first message of a new stream. The watcher is added to r for
+// subscriptions.
+func AddWatchedResource(s MCPServer, resource Resource, r *SubscriptionRegistry, watch Watcher) bool {
+	r.Add(resource.URI, watch)
+	return AddResource(s, resource, func(ctx context.Context, request *ReadResourceRequest) (*ReadResourceResult, error) {
+		if latest, ok := r.Latest(request.URI); ok {
+			return NewResourceResultJSON(request.URI, latest)
+		}
+		first, err := FirstMessage(ctx, request.URI, watch)
+		if err != nil {
+			return nil, err
+		}
+		return NewResourceResultJSON(request.URI, first)
+	})
+}
+
+// FirstMessage starts watch and returns the first message it receives.
+func FirstMessage(ctx context.Context, uri string, watch Watcher) (proto.Message, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var first proto.Message
+	err := watch(ctx, uri, func(msg proto.Message) {
+		if first == nil {
+			first = msg
+			cancel()
+		}
+	})
+	if first != nil {
+		return first, nil
+	}
+	if err == nil {
+		err = fmt.Errorf("resource %q: stream ended without a message", uri)
+	}
+	return nil, err
+}
+
+// RecvAll calls update with every message recv returns until the stream
+// ends. It adapts the Recv method of a grpc-go stream client.
+func RecvAll[T proto.Message](recv func() (T, error), update ResourceUpdate) error {
+	for {
+		msg, err := recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		update(msg)
+	}
+}
+
+// NewServerStream returns a grpc-go server stream that passes the messages
+// a server implementation sends to update, so a server-streaming RPC of a
+// <Service>Server can back a watched resource in-process. Sending fails once
+// ctx is cancelled.
+func NewServerStream[T any, PT interface {
+	*T
+	proto.Message
+}](ctx context.Context, update ResourceUpdate) grpc.ServerStreamingServer[T] {
+	return &serverStream[T, PT]{ctx: ctx, update: update}
+}
+
+type serverStream[T any, PT interface {
+	*T
+	proto.Message
+}] struct {
+	ctx    context.Context
+	update ResourceUpdate
+}
+
+func (s *serverStream[T, PT]) Send(msg *T) error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+	s.update(PT(msg))
+	return nil
+}
+
+func (s *serverStream[T, PT]) SendMsg(m any) error {
+	msg, ok := m.(PT)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+	return s.Send(msg)
+}
+
+func (s *serverStream[T, PT]) RecvMsg(any) error            { return io.EOF }
+func (s *serverStream[T, PT]) Context() context.Context     { return s.ctx }
+func (s *serverStream[T, PT]) SetHeader(metadata.MD) error  { return nil }
+func (s *serverStream[T, PT]) SendHeader(metadata.MD) error { return nil }
+func (s *serverStream[T, PT]) SetTrailer(metadata.MD)       {}
@@ -0,0 +1,146 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSubscriptionRegistry(t *testing.T) {
+	g := NewWithT(t)
+
+	started := make(chan string, 4)
+	stopped := make(chan string, 4)
+	send := make(chan string)
+	r := runtime.NewSubscriptionRegistry()
+	r.Add("configs://watch/{+name}", func(ctx context.Context, uri string, update runtime.ResourceUpdate) error {
+		started <- uri
+		defer func() { stopped <- uri }()
+		for {
+			select {
+			case name := <-send:
+				update(&testdata.Config{Name: name})
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	})
+	updated := make(chan string, 4)
+	r.OnUpdate(func(_ context.Context, uri string) { updated <- uri })
+
+	uri := "configs://watch/configs/prod"
+	err := r.Subscribe(context.Background(), "configs://other", "a")
+	g.Expect(err).To(MatchError(`resource "configs://other" cannot be subscribed to`))
+
+	// Both subscribers share one stream.
+	g.Expect(r.Subscribe(context.Background(), uri, "a")).To(Succeed())
+	g.Expect(r.Subscribe(context.Background(), uri, "b")).To(Succeed())
+	g.Eventually(started).Should(Receive(Equal(uri)))
+	g.Consistently(started).ShouldNot(Receive())
+
+	_, ok := r.Latest(uri)
+	g.Expect(ok).To(BeFalse())
+	send <- "v1"
+	g.Eventually(updated).Should(Receive(Equal(uri)))
+	latest, ok := r.Latest(uri)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(proto.Equal(latest, &testdata.Config{Name: "v1"})).To(BeTrue())
+
+	// The stream stops with the last subscriber.
+	r.Unsubscribe(uri, "a")
+	g.Consistently(stopped).ShouldNot(Receive())
+	r.Unsubscribe(uri, "b")
+	g.Eventually(stopped).Should(Receive(Equal(uri)))
+	_, ok = r.Latest(uri)
+	g.Expect(ok).To(BeFalse())
+
+	// Dropping a subscriber, e.g. when its session ends, stops the streams
+	// only it was subscribed to.
+	other := "configs://watch/configs/dev"
+	g.Expect(r.Subscribe(context.Background(), uri, "a")).To(Succeed())
+	g.Expect(r.Subscribe(context.Background(), uri, "b")).To(Succeed())
+	g.Expect(r.Subscribe(context.Background(), other, "a")).To(Succeed())
+	g.Eventually(started).Should(Receive())
+	g.Eventually(started).Should(Receive())
+	r.UnsubscribeAll("a")
+	g.Eventually(stopped).Should(Receive(Equal(other)))
+	g.Consistently(stopped).ShouldNot(Receive())
+
+	r.Close()
+	g.Eventually(stopped).Should(Receive(Equal(uri)))
+}
+
+func TestFirstMessage(t *testing.T) {
+	g := NewWithT(t)
+
+	msg, err := runtime.FirstMessage(context.Background(), "configs://watch/a", func(ctx context.Context, _ string, update runtime.ResourceUpdate) error {
+		update(&testdata.Config{Name: "first"})
+		update(&testdata.Config{Name: "second"})
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(proto.Equal(msg, &testdata.Config{Name: "first"})).To(BeTrue())
+
+	_, err = runtime.FirstMessage(context.Background(), "configs://watch/a", func(context.Context, string, runtime.ResourceUpdate) error {
+		return nil
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("stream ended without a message")))
+}
+
+func TestRecvAll(t *testing.T) {
+	g := NewWithT(t)
+	msgs := []*testdata.Config{{Name: "a"}, {Name: "b"}}
+	recv := func() (*testdata.Config, error) {
+		if len(msgs) == 0 {
+			return nil, io.EOF
+		}
+		msg := msgs[0]
+		msgs = msgs[1:]
+		return msg, nil
+	}
+	var names []string
+	err := runtime.RecvAll(recv, func(msg proto.Message) {
+		names = append(names, msg.(*testdata.Config).GetName())
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(names).To(Equal([]string{"a", "b"}))
+
+	failing := func() (*testdata.Config, error) { return nil, errors.New("broken") }
+	g.Expect(runtime.RecvAll(failing, func(proto.Message) {})).To(MatchError("broken"))
+}
+
+func TestNewServerStream(t *testing.T) {
+	g := NewWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	var got []proto.Message
+	stream := runtime.NewServerStream[testdata.Config](ctx, func(msg proto.Message) { got = append(got, msg) })
+
+	g.Expect(stream.Context()).To(Equal(ctx))
+	g.Expect(stream.Send(&testdata.Config{Name: "a"})).To(Succeed())
+	g.Expect(stream.SendMsg(&testdata.Config{Name: "b"})).To(Succeed())
+	g.Expect(got).To(HaveLen(2))
+
+	cancel()
+	g.Expect(stream.Send(&testdata.Config{Name: "c"})).To(MatchError(context.Canceled))
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import "slices"
+
+// TagsMetaKey is the key of Tool.Tags in the _meta of a tool.
+const TagsMetaKey = "tags"
+
+// WithTags registers only the tools carrying at least one of tags, declared
+// with (mcp.service).tag or (mcp.method).tag, to curate a toolset. The
+// resources of the methods filtered out are left out too; prompts and
+// watched resources are registered regardless.
+func WithTags(tags ...string) Option {
+	return func(c *config) {
+		c.Tags = append(c.Tags, tags...)
+	}
+}
+
+// MatchesTags reports whether tool carries at least one of tags. Every tool
+// matches an empty list.
+func MatchesTags(tool Tool, tags []string) bool {
+	if len(tags) == 0 {
+		return true
+	}
+	for _, tag := range tool.Tags {
+		if slices.Contains(tags, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddTool adds tool to s unless WithTags filters it out, and reports whether
+// it did.
+func AddTool(s MCPServer, config *config, tool Tool, handler ToolHandler) bool {
+	if !MatchesTags(tool, config.Tags) {
+		return false
+	}
+	s.AddTool(tool, handler)
+	return true
+}
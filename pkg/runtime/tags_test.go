@@ -0,0 +1,49 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func TestMatchesTags(t *testing.T) {
+	g := NewWithT(t)
+	tool := runtime.Tool{Name: "list_topics", Tags: []string{"topics", "read"}}
+
+	g.Expect(runtime.MatchesTags(tool, nil)).To(BeTrue())
+	g.Expect(runtime.MatchesTags(tool, []string{"acl", "topics"})).To(BeTrue())
+	g.Expect(runtime.MatchesTags(tool, []string{"billing"})).To(BeFalse())
+	g.Expect(runtime.MatchesTags(runtime.Tool{Name: "untagged"}, []string{"topics"})).To(BeFalse())
+}
+
+func TestAddTool_WithTags(t *testing.T) {
+	g := NewWithT(t)
+	var added []string
+	s := runtime.AddToolFunc(func(tool runtime.Tool, _ runtime.ToolHandler) { added = append(added, tool.Name) })
+	handler := func(context.Context, *runtime.CallToolRequest) (*runtime.CallToolResult, error) { return nil, nil }
+
+	config := runtime.NewConfig()
+	g.Expect(runtime.AddTool(s, config, runtime.Tool{Name: "untagged"}, handler)).To(BeTrue())
+
+	runtime.WithTags("acl")(config)
+	g.Expect(runtime.AddTool(s, config, runtime.Tool{Name: "list_acls", Tags: []string{"acl"}}, handler)).To(BeTrue())
+	g.Expect(runtime.AddTool(s, config, runtime.Tool{Name: "list_topics", Tags: []string{"topics"}}, handler)).To(BeFalse())
+	g.Expect(added).To(Equal([]string{"untagged", "list_acls"}))
+}
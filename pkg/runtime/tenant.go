@@ -0,0 +1,227 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Tenant derives the tenant of every tool call, e.g. the organization a
+// session belongs to, once per call and propagates it to everything that
+// needs it, instead of per-tool plumbing: the handler context (see
+// TenantFromContext), the outgoing metadata of the backend call and the
+// request fields annotated with (mcp.field).from_context = ContextField.
+// Resource reads and completions that call the backend resolve it the same
+// way.
+type Tenant struct {
+	// Resolve derives the tenant of a call, see TenantFromSession and
+	// TenantFromArgument. Calls whose tenant is empty or fails to resolve
+	// fail with a tool error and never reach the backend.
+	Resolve func(ctx context.Context, request *CallToolRequest) (string, error)
+
+	// Metadata is the gRPC metadata key the tenant is sent under, e.g.
+	// "x-tenant-id"; the Connect and HTTP forwarders send it as a header.
+	// Empty sends nothing.
+	Metadata string
+
+	// ContextField is the (mcp.field).from_context name of the request
+	// fields the tenant fills, e.g. "tenant". Empty fills none.
+	ContextField string
+}
+
+type tenantKey struct{}
+
+// WithTenant makes the generated handlers resolve and propagate the tenant
+// of every call as t describes. It registers the ContextField of t, so it
+// needs no WithContextFields of its own. Watched resources cannot be
+// subscribed to under a tenant, see Tenant.Subscriptions.
+func WithTenant(t Tenant) Option {
+	return func(c *config) {
+		c.Tenant = &t
+		c.ContextFields = append(c.ContextFields, c.Tenant.ContextFields()...)
+	}
+}
+
+// TenantFromContext returns the tenant resolved for the tool call of ctx.
+func TenantFromContext(ctx context.Context) (string, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(string)
+	return tenant, ok
+}
+
+// TenantFromSession resolves the tenant stored as a string under key in
+// store for the session of the call, e.g. by the authentication of the
+// transport.
+func TenantFromSession(store *SessionStore, key string) func(context.Context, *CallToolRequest) (string, error) {
+	return func(ctx context.Context, _ *CallToolRequest) (string, error) {
+		v, _ := store.Get(ctx, key)
+		tenant, _ := v.(string)
+		return tenant, nil
+	}
+}
+
+// TenantFromArgument resolves the tenant from the string argument name, e.g.
+// an ExtraProperty registered with WithExtraProperties so the model is asked
+// for it.
+func TenantFromArgument(name string) func(context.Context, *CallToolRequest) (string, error) {
+	return func(_ context.Context, request *CallToolRequest) (string, error) {
+		v, ok := request.Arguments[name]
+		if !ok || v == nil {
+			return "", nil
+		}
+		tenant, ok := v.(string)
+		if !ok {
+			return "", fmt.Errorf("argument %q must be a string", name)
+		}
+		return tenant, nil
+	}
+}
+
+// ContextFields returns the ContextField backing the ContextField name of
+// t, if any. It returns nil for a nil t.
+func (t *Tenant) ContextFields() []ContextField {
+	if t == nil || t.ContextField == "" {
+		return nil
+	}
+	return []ContextField{{Name: t.ContextField, ContextKey: tenantKey{}}}
+}
+
+// Propagate returns a handler that resolves the tenant of each call and
+// passes it on to handler, as Tenant describes. Propagating with a nil t
+// returns handler unchanged.
+func (t *Tenant) Propagate(handler ToolHandler) ToolHandler {
+	if t == nil {
+		return handler
+	}
+	return func(ctx context.Context, request *CallToolRequest) (*CallToolResult, error) {
+		if t.Resolve == nil {
+			return nil, errors.New("runtime.Tenant has no Resolve function")
+		}
+		ctx, err := t.resolve(ctx, request)
+		if errors.Is(err, errNoTenant) {
+			return NewToolResultError("Could not determine the tenant of this call."), nil
+		}
+		if err != nil {
+			return NewToolResultError(fmt.Sprintf("Could not determine the tenant of this call: %v", err)), nil
+		}
+		return handler(ctx, request)
+	}
+}
+
+// PropagateResource returns a resource handler that resolves the tenant of
+// each read like Propagate does for tool calls, passing the variables of the
+// URI template as the arguments of the request given to Resolve. Reads whose
+// tenant does not resolve fail without calling handler. A nil t returns
+// handler unchanged.
+func (t *Tenant) PropagateResource(template string, handler ResourceHandler) ResourceHandler {
+	if t == nil {
+		return handler
+	}
+	return func(ctx context.Context, request *ReadResourceRequest) (*ReadResourceResult, error) {
+		ctx, err := t.resolveURI(ctx, template, request.URI)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, request)
+	}
+}
+
+// PropagateWatch is PropagateResource for the watcher of a watched resource.
+func (t *Tenant) PropagateWatch(template string, watch Watcher) Watcher {
+	if t == nil {
+		return watch
+	}
+	return func(ctx context.Context, uri string, update ResourceUpdate) error {
+		ctx, err := t.resolveURI(ctx, template, uri)
+		if err != nil {
+			return err
+		}
+		return watch(ctx, uri, update)
+	}
+}
+
+// PropagateCompleter returns a completer that resolves the tenant of each
+// completion request, such as one listing the resources of a tool argument,
+// passing the other arguments of the request to Resolve. A nil t returns c
+// unchanged.
+func (t *Tenant) PropagateCompleter(c Completer) Completer {
+	if t == nil {
+		return c
+	}
+	return func(ctx context.Context, value string, args map[string]string) ([]string, error) {
+		arguments := make(map[string]any, len(args))
+		for k, v := range args {
+			arguments[k] = v
+		}
+		ctx, err := t.resolve(ctx, &CallToolRequest{Arguments: arguments})
+		if err != nil {
+			return nil, fmt.Errorf("determining the tenant of the completion: %w", err)
+		}
+		return c(ctx, value, args)
+	}
+}
+
+// Subscriptions returns the registry the watched resources of a tenant-aware
+// server subscribe with: nil, which disables subscriptions, for a non-nil t.
+// The registry shares one watch stream and its latest message between all
+// subscribers of a URI, whatever their tenant, so reads of watched resources
+// start a stream of their own instead.
+func (t *Tenant) Subscriptions(r *SubscriptionRegistry) *SubscriptionRegistry {
+	if t != nil {
+		return nil
+	}
+	return r
+}
+
+func (t *Tenant) resolveURI(ctx context.Context, template, uri string) (context.Context, error) {
+	vars, err := MatchURITemplate(template, uri)
+	if err != nil {
+		return nil, err
+	}
+	arguments := make(map[string]any, len(vars))
+	for k, v := range vars {
+		arguments[k] = v
+	}
+	ctx, err = t.resolve(ctx, &CallToolRequest{Arguments: arguments})
+	if err != nil {
+		return nil, fmt.Errorf("determining the tenant of %s: %w", uri, err)
+	}
+	return ctx, nil
+}
+
+var errNoTenant = errors.New("no tenant")
+
+// resolve resolves the tenant of request and returns ctx carrying it. A
+// tenant that resolves empty fails with errNoTenant.
+func (t *Tenant) resolve(ctx context.Context, request *CallToolRequest) (context.Context, error) {
+	if t.Resolve == nil {
+		return nil, errors.New("runtime.Tenant has no Resolve function")
+	}
+	tenant, err := t.Resolve(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if tenant == "" {
+		return nil, errNoTenant
+	}
+	ctx = context.WithValue(ctx, tenantKey{}, tenant)
+	if t.Metadata != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, t.Metadata, tenant)
+	}
+	return ctx, nil
+}
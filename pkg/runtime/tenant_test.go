@@ -0,0 +1,162 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTenant_Propagate(t *testing.T) {
+	sessions := runtime.NewSessionStore(time.Hour)
+	tenant := &runtime.Tenant{
+		Resolve:      runtime.TenantFromSession(sessions, "org"),
+		Metadata:     "x-tenant-id",
+		ContextField: "tenant",
+	}
+	ctx := runtime.WithSessionID(context.Background(), "s1")
+
+	t.Run("context and metadata", func(t *testing.T) {
+		g := NewWithT(t)
+		sessions.Set(ctx, "org", "org-1")
+		handler := tenant.Propagate(func(ctx context.Context, _ *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+			got, ok := runtime.TenantFromContext(ctx)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(got).To(Equal("org-1"))
+			md, _ := metadata.FromOutgoingContext(ctx)
+			g.Expect(md.Get("x-tenant-id")).To(Equal([]string{"org-1"}))
+
+			// The tenant fills the from_context fields of its ContextField.
+			args := map[string]any{"organization_id": "spoofed"}
+			request := (&testdata.ApplyConfigRequest{}).ProtoReflect().Descriptor()
+			g.Expect(runtime.PopulateFromContext(ctx, request, args, tenant.ContextFields())).To(Succeed())
+			g.Expect(args).To(Equal(map[string]any{"organization_id": "org-1"}))
+			return runtime.NewToolResultText("ok"), nil
+		})
+		result, err := handler(ctx, &runtime.CallToolRequest{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Text).To(Equal("ok"))
+	})
+
+	t.Run("unresolved tenant fails the call", func(t *testing.T) {
+		g := NewWithT(t)
+		called := false
+		handler := tenant.Propagate(func(context.Context, *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+			called = true
+			return nil, nil
+		})
+		result, err := handler(runtime.WithSessionID(context.Background(), "other"), &runtime.CallToolRequest{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.IsError).To(BeTrue())
+		g.Expect(result.Text).To(Equal("Could not determine the tenant of this call."))
+
+		failing := &runtime.Tenant{Resolve: func(context.Context, *runtime.CallToolRequest) (string, error) {
+			return "", errors.New("token expired")
+		}}
+		result, err = failing.Propagate(handler)(ctx, &runtime.CallToolRequest{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Text).To(Equal("Could not determine the tenant of this call: token expired"))
+		g.Expect(called).To(BeFalse())
+	})
+
+	t.Run("nil tenant", func(t *testing.T) {
+		g := NewWithT(t)
+		var none *runtime.Tenant
+		g.Expect(none.ContextFields()).To(BeNil())
+		handler := none.Propagate(func(ctx context.Context, _ *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+			_, ok := runtime.TenantFromContext(ctx)
+			g.Expect(ok).To(BeFalse())
+			return runtime.NewToolResultText("ok"), nil
+		})
+		_, err := handler(ctx, &runtime.CallToolRequest{})
+		g.Expect(err).ToNot(HaveOccurred())
+	})
+}
+
+func TestTenantFromArgument(t *testing.T) {
+	g := NewWithT(t)
+	resolve := runtime.TenantFromArgument("org")
+	tenant, err := resolve(context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"org": "org-2"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(tenant).To(Equal("org-2"))
+
+	tenant, err = resolve(context.Background(), &runtime.CallToolRequest{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(tenant).To(BeEmpty())
+
+	_, err = resolve(context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"org": 1.0}})
+	g.Expect(err).To(MatchError(`argument "org" must be a string`))
+}
+
+func TestWithTenant(t *testing.T) {
+	g := NewWithT(t)
+	config := runtime.NewConfig()
+	runtime.WithTenant(runtime.Tenant{
+		Resolve:      runtime.TenantFromArgument("org"),
+		ContextField: "tenant",
+	})(config)
+	g.Expect(config.ContextFields).To(HaveLen(1))
+	g.Expect(config.ContextFields[0].Name).To(Equal("tenant"))
+
+	handler := runtime.ApplyHandlerConfig("tool", config, func(ctx context.Context, _ *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		tenant, _ := runtime.TenantFromContext(ctx)
+		return runtime.NewToolResultText(tenant), nil
+	})
+	result, err := handler(context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"org": "org-3"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Text).To(Equal("org-3"))
+}
+
+func TestTenant_PropagateResource(t *testing.T) {
+	g := NewWithT(t)
+	tenant := &runtime.Tenant{
+		Resolve:  runtime.TenantFromArgument("org"),
+		Metadata: "x-tenant-id",
+	}
+	var got []string
+	read := tenant.PropagateResource("orgs://{org}/configs", func(ctx context.Context, request *runtime.ReadResourceRequest) (*runtime.ReadResourceResult, error) {
+		org, _ := runtime.TenantFromContext(ctx)
+		md, _ := metadata.FromOutgoingContext(ctx)
+		got = append(got, org, md.Get("x-tenant-id")[0])
+		return &runtime.ReadResourceResult{}, nil
+	})
+
+	// The variables of the URI template are the arguments Resolve sees.
+	_, err := read(context.Background(), &runtime.ReadResourceRequest{URI: "orgs://org-1/configs"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got).To(Equal([]string{"org-1", "org-1"}))
+
+	complete := tenant.PropagateCompleter(func(ctx context.Context, _ string, _ map[string]string) ([]string, error) {
+		org, _ := runtime.TenantFromContext(ctx)
+		return []string{org}, nil
+	})
+	values, err := complete(context.Background(), "", map[string]string{"org": "org-2"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(values).To(Equal([]string{"org-2"}))
+	_, err = complete(context.Background(), "", nil)
+	g.Expect(err).To(MatchError(ContainSubstring("determining the tenant of the completion")))
+
+	// Subscriptions share one stream between tenants, so they are disabled.
+	g.Expect(tenant.Subscriptions(runtime.NewSubscriptionRegistry())).To(BeNil())
+	var none *runtime.Tenant
+	g.Expect(none.Subscriptions(runtime.NewSubscriptionRegistry())).ToNot(BeNil())
+}
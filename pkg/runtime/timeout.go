@@ -0,0 +1,57 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// WithRequestTimeout returns a copy of ctx that is cancelled when the
+// timeout a client sent in the _meta of a tool call runs out, so abandoned
+// calls stop consuming backend resources. Two hints are honored:
+//
+//   - "timeout": seconds as a number, or a duration string such as "1.5s"
+//   - "deadline": an RFC 3339 timestamp
+//
+// The earlier of the two wins. Malformed hints are ignored, as are timeouts
+// that are not positive or do not fit a time.Duration. Within a
+// DeadlineBudget, the reserve is taken off the deadline. The caller must
+// always call the returned cancel function.
+func WithRequestTimeout(ctx context.Context, meta map[string]any) (context.Context, context.CancelFunc) {
+	var deadline time.Time
+	switch v := meta["timeout"].(type) {
+	case float64:
+		// NaN fails both comparisons.
+		if d := v * float64(time.Second); d > 0 && d < math.MaxInt64 {
+			deadline = time.Now().Add(time.Duration(d))
+		}
+	case string:
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			deadline = time.Now().Add(d)
+		}
+	}
+	if v, ok := meta["deadline"].(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, v); err == nil && (deadline.IsZero() || t.Before(deadline)) {
+			deadline = t
+		}
+	}
+	deadline = reserveDeadline(ctx, deadline)
+	if deadline.IsZero() {
+		return context.WithCancel(ctx)
+	}
+	return context.WithDeadline(ctx, deadline)
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func TestWithRequestTimeout(t *testing.T) {
+	soon := time.Now().Add(time.Minute)
+	tests := []struct {
+		name string
+		meta map[string]any
+		// want is the expected deadline, zero for none.
+		want time.Duration
+	}{
+		{name: "no meta"},
+		{name: "seconds", meta: map[string]any{"timeout": 1.5}, want: 1500 * time.Millisecond},
+		{name: "duration string", meta: map[string]any{"timeout": "250ms"}, want: 250 * time.Millisecond},
+		{name: "deadline", meta: map[string]any{"deadline": soon.Format(time.RFC3339Nano)}, want: time.Minute},
+		{name: "earlier wins", meta: map[string]any{"timeout": "2s", "deadline": soon.Format(time.RFC3339Nano)}, want: 2 * time.Second},
+		{name: "malformed", meta: map[string]any{"timeout": "soon", "deadline": 5}},
+		{name: "not positive", meta: map[string]any{"timeout": -1.0}},
+		{name: "overflows duration", meta: map[string]any{"timeout": 1e10}},
+		{name: "not a number", meta: map[string]any{"timeout": math.NaN()}},
+		{name: "infinite", meta: map[string]any{"timeout": math.Inf(1)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			start := time.Now()
+			ctx, cancel := runtime.WithRequestTimeout(context.Background(), tt.meta)
+			deadline, ok := ctx.Deadline()
+			g.Expect(ok).To(Equal(tt.want != 0))
+			if ok {
+				g.Expect(deadline).To(BeTemporally("~", start.Add(tt.want), time.Second))
+			}
+			cancel()
+			g.Expect(ctx.Err()).To(MatchError(context.Canceled))
+		})
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// zonelessLayouts are the timestamp layouts accepted without a time zone,
+// read as UTC.
+var zonelessLayouts = []string{
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// durationUnits extends the units of time.ParseDuration with days and weeks.
+var durationUnits = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"µs": time.Microsecond,
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  24 * time.Hour,
+	"w":  7 * 24 * time.Hour,
+}
+
+var (
+	durationTermRE      = regexp.MustCompile(`([0-9]*\.?[0-9]+)(ns|us|µs|ms|s|m|h|d|w)`)
+	canonicalDurationRE = regexp.MustCompile(`^-?[0-9]+(\.[0-9]{1,9})?s$`)
+)
+
+// NormalizeTimes rewrites every google.protobuf.Timestamp and Duration value
+// in args, at any depth, from the forms the relative_times plugin option
+// accepts into the canonical protojson form; see NormalizeTimestamp and
+// NormalizeDuration. Generated handlers call it after DecodeArguments, which
+// already did so for fields with (mcp.field).relative_time. Errors are
+// model-readable.
+func NormalizeTimes(md protoreflect.MessageDescriptor, args map[string]any) error {
+	return normalizeTimes(md, args, true)
+}
+
+// normalizeTimes normalizes the Timestamp and Duration fields of obj, all of
+// them or only those with (mcp.field).relative_time. With all it also
+// descends into nested messages; DecodeArguments does that itself.
+func normalizeTimes(md protoreflect.MessageDescriptor, obj map[string]any, all bool) error {
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		value := fd
+		if fd.IsMap() {
+			value = fd.MapValue()
+		}
+		if value.Message() == nil {
+			continue
+		}
+		name := resolveFieldName(fd, obj)
+		if name == "" {
+			continue
+		}
+		var normalize func(v any) (any, error)
+		switch value.Message().FullName() {
+		case "google.protobuf.Timestamp":
+			if !all && !fieldOptions(fd).GetRelativeTime() {
+				continue
+			}
+			normalize = normalizeTimestampValue
+		case "google.protobuf.Duration":
+			if !all && !fieldOptions(fd).GetRelativeTime() {
+				continue
+			}
+			normalize = normalizeDurationValue
+		default:
+			if !all || isWellKnown(value.Message()) {
+				continue
+			}
+			child := value.Message()
+			normalize = func(v any) (any, error) {
+				if m, ok := v.(map[string]any); ok {
+					return m, normalizeTimes(child, m, true)
+				}
+				return v, nil
+			}
+		}
+		if err := eachValue(fd, obj, name, normalize); err != nil {
+			return fmt.Errorf("field %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// eachValue replaces each value of the field stored under name in obj, the
+// single value or every list element or map value, with fn's result.
+func eachValue(fd protoreflect.FieldDescriptor, obj map[string]any, name string, fn func(any) (any, error)) error {
+	switch {
+	case fd.IsMap():
+		m, _ := obj[name].(map[string]any)
+		for k, v := range m {
+			nv, err := fn(v)
+			if err != nil {
+				return err
+			}
+			m[k] = nv
+		}
+	case fd.IsList():
+		arr, _ := obj[name].([]any)
+		for i, v := range arr {
+			nv, err := fn(v)
+			if err != nil {
+				return err
+			}
+			arr[i] = nv
+		}
+	default:
+		nv, err := fn(obj[name])
+		if err != nil {
+			return err
+		}
+		obj[name] = nv
+	}
+	return nil
+}
+
+func normalizeTimestampValue(v any) (any, error) {
+	s, ok := v.(string)
+	if !ok {
+		return v, nil
+	}
+	return NormalizeTimestamp(s, time.Now())
+}
+
+func normalizeDurationValue(v any) (any, error) {
+	switch t := v.(type) {
+	case string:
+		return NormalizeDuration(t)
+	case float64:
+		// A bare number is a count of seconds.
+		return strconv.FormatFloat(t, 'f', -1, 64) + "s", nil
+	}
+	return v, nil
+}
+
+// NormalizeTimestamp returns s as an RFC 3339 timestamp in UTC, the form
+// protojson reads for google.protobuf.Timestamp. Besides RFC 3339 it accepts
+// "now", now plus or minus a duration such as "now-2h" or "now+1d15m", and
+// dates and times without a time zone, which are read as UTC.
+func NormalizeTimestamp(s string, now time.Time) (string, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t.UTC().Format(time.RFC3339Nano), nil
+	}
+	if rest, ok := strings.CutPrefix(strings.ToLower(s), "now"); ok {
+		rest = strings.ReplaceAll(rest, " ", "")
+		if rest == "" {
+			return now.UTC().Format(time.RFC3339Nano), nil
+		}
+		if rest[0] == '+' || rest[0] == '-' {
+			if d, err := parseDuration(rest[1:]); err == nil {
+				if rest[0] == '-' {
+					d = -d
+				}
+				return now.Add(d).UTC().Format(time.RFC3339Nano), nil
+			}
+		}
+	}
+	for _, layout := range zonelessLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return t.Format(time.RFC3339Nano), nil
+		}
+	}
+	return "", fmt.Errorf(`cannot read %q as a timestamp; use RFC 3339 such as "2026-01-02T15:04:05Z", a date, "now", or "now-2h"`, s)
+}
+
+// NormalizeDuration returns s in the form protojson reads for
+// google.protobuf.Duration, seconds with an "s" suffix such as "5400s". It
+// accepts that form, time.ParseDuration syntax such as "1h30m" or "500ms",
+// and days and weeks such as "2d" or "1w".
+func NormalizeDuration(s string) (string, error) {
+	s = strings.ReplaceAll(strings.TrimSpace(s), " ", "")
+	if canonicalDurationRE.MatchString(s) {
+		return s, nil
+	}
+	neg := false
+	rest := s
+	if r, ok := strings.CutPrefix(rest, "-"); ok {
+		neg, rest = true, r
+	} else {
+		rest = strings.TrimPrefix(rest, "+")
+	}
+	d, err := parseDuration(strings.ToLower(rest))
+	if err != nil {
+		return "", fmt.Errorf(`cannot read %q as a duration; use a form such as "90s", "15m", "1h30m" or "2d"`, s)
+	}
+	secs, nanos := int64(d/time.Second), int64(d%time.Second)
+	out := strconv.FormatInt(secs, 10)
+	if nanos != 0 {
+		out += strings.TrimRight(fmt.Sprintf(".%09d", nanos), "0")
+	}
+	if neg && d != 0 {
+		out = "-" + out
+	}
+	return out + "s", nil
+}
+
+// parseDuration parses an unsigned sequence of number-unit terms such as
+// "1h30m" or "1.5d".
+func parseDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty duration")
+	}
+	var total time.Duration
+	pos := 0
+	for _, m := range durationTermRE.FindAllStringSubmatchIndex(s, -1) {
+		if m[0] != pos {
+			break
+		}
+		n, err := strconv.ParseFloat(s[m[2]:m[3]], 64)
+		if err != nil {
+			return 0, err
+		}
+		total += time.Duration(n * float64(durationUnits[s[m[4]:m[5]]]))
+		pos = m[1]
+	}
+	if pos != len(s) {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	return total, nil
+}
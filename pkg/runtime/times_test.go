@@ -0,0 +1,99 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestNormalizeTimestamp(t *testing.T) {
+	now := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+	for in, want := range map[string]string{
+		"2026-01-02T15:04:05Z":      "2026-01-02T15:04:05Z",
+		"2026-01-02T17:04:05+02:00": "2026-01-02T15:04:05Z",
+		"2026-01-02T15:04:05.5":     "2026-01-02T15:04:05.5Z",
+		"2026-01-02 15:04":          "2026-01-02T15:04:00Z",
+		"2026-01-02":                "2026-01-02T00:00:00Z",
+		"now":                       "2026-01-02T15:04:05Z",
+		"NOW - 2h":                  "2026-01-02T13:04:05Z",
+		"now+1d15m":                 "2026-01-03T15:19:05Z",
+	} {
+		t.Run(in, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := runtime.NormalizeTimestamp(in, now)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(want))
+		})
+	}
+
+	g := NewWithT(t)
+	for _, in := range []string{"yesterday", "now-", "now*2h", "2026-13-01"} {
+		_, err := runtime.NormalizeTimestamp(in, now)
+		g.Expect(err).To(MatchError(ContainSubstring("as a timestamp")), in)
+	}
+}
+
+func TestNormalizeDuration(t *testing.T) {
+	for in, want := range map[string]string{
+		"90s":     "90s",
+		"-1.5s":   "-1.5s",
+		"15m":     "900s",
+		"1h30m":   "5400s",
+		"500ms":   "0.5s",
+		"2d":      "172800s",
+		"1w":      "604800s",
+		"-1m":     "-60s",
+		"1h 30m":  "5400s",
+		"1.5h":    "5400s",
+		"1m0.25s": "60.25s",
+	} {
+		t.Run(in, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := runtime.NormalizeDuration(in)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(want))
+		})
+	}
+
+	g := NewWithT(t)
+	for _, in := range []string{"", "15", "1 month", "h"} {
+		_, err := runtime.NormalizeDuration(in)
+		g.Expect(err).To(MatchError(ContainSubstring("as a duration")), in)
+	}
+}
+
+func TestNormalizeTimes(t *testing.T) {
+	g := NewWithT(t)
+	md := (&testdata.GetItemResponse{}).ProtoReflect().Descriptor()
+
+	args := map[string]any{"item": map[string]any{"id": "a", "created_at": "2026-01-02", "updated_at": nil}}
+	g.Expect(runtime.NormalizeTimes(md, args)).To(Succeed())
+	g.Expect(args["item"]).To(HaveKeyWithValue("created_at", "2026-01-02T00:00:00Z"))
+	g.Expect(args["item"]).To(HaveKeyWithValue("updated_at", BeNil()))
+
+	msg, err := runtime.UnmarshalArguments(md, map[string]any{"item": map[string]any{"updated_at": "now-1h"}}, runtime.CodecOptions{RelativeTimes: true})
+	g.Expect(err).ToNot(HaveOccurred())
+	updated := msg.(*testdata.GetItemResponse).GetItem().GetUpdatedAt().AsTime()
+	g.Expect(updated).To(BeTemporally("~", time.Now().Add(-time.Hour), time.Minute))
+
+	err = runtime.NormalizeTimes(md, map[string]any{"item": map[string]any{"created_at": "soon"}})
+	g.Expect(err).To(MatchError(ContainSubstring(`field "item": field "created_at": cannot read "soon" as a timestamp`)))
+}
@@ -17,7 +17,9 @@ package runtime
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"sort"
+	"strconv"
 	"strings"
 
 	"google.golang.org/protobuf/encoding/protojson"
@@ -37,6 +39,51 @@ const DiscriminatorKey = "which"
 // the schema the model was given. The gen package mirrors this constant.
 const DefaultMaxRecursionDepth = 3
 
+// UnwrapArguments returns the request object nested under the wrapper
+// property of a tool generated with a wrap_input option. The generated
+// handlers call it after extra properties and headers have been taken from
+// the top level. An empty wrapper returns args unchanged.
+func UnwrapArguments(args map[string]any, wrapper string) (map[string]any, error) {
+	if wrapper == "" {
+		return args, nil
+	}
+	switch inner := args[wrapper].(type) {
+	case map[string]any:
+		return inner, nil
+	case nil:
+		return nil, fmt.Errorf("missing argument %q: put the request fields inside a %q object", wrapper, wrapper)
+	default:
+		return nil, fmt.Errorf("argument %q must be an object holding the request fields, got %T", wrapper, inner)
+	}
+}
+
+// UnflattenArguments reverses the flatten_nested plugin option in place:
+// every "parent.child" argument moves into the object under "parent". Null
+// children are dropped without creating the parent, as strict tool calling
+// sends null for every field the model leaves unset. The generated handlers
+// call it after UnwrapArguments.
+func UnflattenArguments(args map[string]any) error {
+	for key, v := range args {
+		parent, child, ok := strings.Cut(key, ".")
+		if !ok {
+			continue
+		}
+		delete(args, key)
+		if v == nil {
+			continue
+		}
+		switch obj := args[parent].(type) {
+		case map[string]any:
+			obj[child] = v
+		case nil:
+			args[parent] = map[string]any{child: v}
+		default:
+			return fmt.Errorf("argument %q conflicts with %q: set either the object or its dotted fields, not both", key, parent)
+		}
+	}
+	return nil
+}
+
 // DecodeArguments rewrites model-supplied tool-call arguments in place so that
 // protojson can unmarshal them into a proto message. It is the inverse of the
 // two schema shapes the generator emits that protojson does not understand:
@@ -44,12 +91,23 @@ const DefaultMaxRecursionDepth = 3
 //   - oneof discriminated wrappers: a oneof renders as a nested object
 //     {"which":"<member>", "<member>":<value>, ...}. This lifts the named
 //     member to its native sibling key so protojson sees a normal oneof.
-//   - recursion-depth placeholders: a message nested beyond MaxRecursionDepth
-//     renders as a JSON-string. This parses that string back to an object.
+//   - recursion-depth placeholders: a message nested beyond MaxRecursionDepth,
+//     or annotated with (mcp.field).json_string, renders as a JSON-string.
+//     This parses that string back to an object.
+//   - map entry lists: a model may send a map as [{"key":k,"value":v}, ...].
+//     This folds it into an object, at any depth and for any value type.
+//
+// It also injects the (mcp.field).default of every field the model omitted,
+// clamps every field declaring an (mcp.field).max, and normalizes the
+// Timestamp and Duration fields declaring (mcp.field).relative_time, in the
+// top-level arguments and in every nested object it sent. It leaves an
+// idempotency key the model left out for FillIdempotencyKey, which knows the
+// session and tenant of the call.
 //
 // Everything else passes straight through to protojson untouched. Errors are
 // phrased to be model-readable: a failed tool call is returned to the model for
-// one-turn self-correction, so the message names the fix.
+// one-turn self-correction, so the message names the fix. args must not be
+// nil; a call without arguments decodes an empty map.
 func DecodeArguments(md protoreflect.MessageDescriptor, args map[string]any) error {
 	return decodeMessage(md, args)
 }
@@ -70,6 +128,14 @@ func decodeMessage(md protoreflect.MessageDescriptor, obj map[string]any) error
 	//    parsing recursion-depth string placeholders back to objects.
 	for i := 0; i < md.Fields().Len(); i++ {
 		fd := md.Fields().Get(i)
+		// A map may arrive as a list of {"key":..,"value":..} entries instead of
+		// an object. Fold it into the object shape first so every branch below,
+		// including the dynamic-WKT lift, sees a normal map.
+		if fd.IsMap() {
+			if err := liftMapEntries(fd, obj); err != nil {
+				return err
+			}
+		}
 		// Dynamic well-known types (Struct/Value/ListValue) cannot be expressed in
 		// the strict tool-schema subset OpenAI and Anthropic accept, so a client
 		// may downgrade them to a JSON-encoded string. Parse that string back to
@@ -147,7 +213,18 @@ func decodeMessage(md protoreflect.MessageDescriptor, obj map[string]any) error
 			obj[name] = child
 		}
 	}
-	return nil
+
+	// 3) Fill omitted fields with their declared defaults. Defaults are written
+	//    in protojson's own shape, so they bypass the rewrites above.
+	if err := injectDefaults(md, obj); err != nil {
+		return err
+	}
+
+	// 4) Clamp fields that declare a maximum, such as page sizes.
+	clampFields(md, obj)
+
+	// 5) Normalize relative and zone-less times where the field allows them.
+	return normalizeTimes(md, obj, false)
 }
 
 // liftOneof resolves a single oneof discriminated wrapper in obj into its
@@ -212,6 +289,68 @@ func liftOneof(oo protoreflect.OneofDescriptor, obj map[string]any) error {
 	}
 }
 
+// liftMapEntries rewrites a map field supplied as an array of
+// {"key":<key>,"value":<value>} entries into the JSON object protojson expects.
+// Strict-schema clients cannot describe an open-ended object, so models often
+// fall back to this entry-list form. Non-string keys are coerced to their
+// protojson string form, and entries with a null value are dropped since
+// protojson rejects null map values. It is a no-op for any other shape.
+func liftMapEntries(fd protoreflect.FieldDescriptor, obj map[string]any) error {
+	name := resolveFieldName(fd, obj)
+	if name == "" {
+		return nil
+	}
+	entries, ok := obj[name].([]any)
+	if !ok {
+		return nil
+	}
+	m := make(map[string]any, len(entries))
+	for idx, e := range entries {
+		entry, ok := e.(map[string]any)
+		if !ok {
+			return fmt.Errorf("field %q[%d]: map entries must be objects like {\"key\":<key>,\"value\":<value>}; got %T", name, idx, e)
+		}
+		rawKey, ok := entry["key"]
+		if !ok || rawKey == nil {
+			return fmt.Errorf("field %q[%d]: map entry is missing its \"key\"", name, idx)
+		}
+		key, err := mapKeyString(fd.MapKey(), rawKey)
+		if err != nil {
+			return fmt.Errorf("field %q[%d]: %w", name, idx, err)
+		}
+		if v := entry["value"]; v != nil {
+			m[key] = v
+		}
+	}
+	obj[name] = m
+	return nil
+}
+
+// mapKeyString converts a JSON map-entry key to the string form protojson uses
+// for keys of kd's kind: integers in decimal, bools as "true"/"false". A
+// string-keyed map accepts any scalar key verbatim.
+func mapKeyString(kd protoreflect.FieldDescriptor, v any) (string, error) {
+	switch k := v.(type) {
+	case string:
+		return k, nil
+	case bool:
+		if kd.Kind() != protoreflect.BoolKind && kd.Kind() != protoreflect.StringKind {
+			return "", fmt.Errorf("map key must be an integer, got %v", k)
+		}
+		return strconv.FormatBool(k), nil
+	case float64:
+		if kd.Kind() == protoreflect.BoolKind {
+			return "", fmt.Errorf("map key must be true or false, got %v", k)
+		}
+		if kd.Kind() != protoreflect.StringKind && k != math.Trunc(k) {
+			return "", fmt.Errorf("map key must be an integer, got %v", k)
+		}
+		return strconv.FormatFloat(k, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("map key must be a string, number or boolean; got %T", v)
+	}
+}
+
 // populatedMember returns the value of fd within the wrapper and whether it is
 // present and non-null.
 func populatedMember(wrapper map[string]any, fd protoreflect.FieldDescriptor) (any, bool) {
@@ -265,8 +404,9 @@ func asMessageObject(v any) (map[string]any, error) {
 // EncodeMessage marshals a proto message to the model-facing JSON shape: it
 // runs protojson, then rewraps each set oneof into its discriminated object and
 // stringifies any subtree nested beyond DefaultMaxRecursionDepth so the output
-// matches the tool's generated output schema. It is the encode-side inverse of
-// DecodeArguments.
+// matches the tool's generated output schema. Fields annotated with
+// (mcp.field).exclude_from_result are left out. It is the encode-side inverse
+// of DecodeArguments.
 func EncodeMessage(msg proto.Message) (json.RawMessage, error) {
 	marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(msg)
 	if err != nil {
@@ -277,6 +417,7 @@ func EncodeMessage(msg proto.Message) (json.RawMessage, error) {
 		return nil, err
 	}
 	m := msg.ProtoReflect()
+	stripResultFields(m.Descriptor(), obj)
 	seen := map[protoreflect.FullName]int{m.Descriptor().FullName(): 1}
 	if err := encodeMessage(m, obj, seen); err != nil {
 		return nil, err
@@ -305,6 +446,8 @@ func encodeMessage(m protoreflect.Message, obj map[string]any, seen map[protoref
 		if _, ok := obj[name]; !ok {
 			continue
 		}
+		// (mcp.field).json_string fields render as JSON strings in the schema.
+		stringify := fieldOptions(fd).GetJsonString()
 
 		switch {
 		case fd.IsMap():
@@ -323,7 +466,7 @@ func encodeMessage(m protoreflect.Message, obj map[string]any, seen map[protoref
 				if !ok {
 					return true
 				}
-				newVal, err := encodeChild(fd.MapValue().Message(), v.Message(), child, seen)
+				newVal, err := encodeChild(fd.MapValue().Message(), v.Message(), child, seen, stringify)
 				if err != nil {
 					rangeErr = err
 					return false
@@ -345,7 +488,7 @@ func encodeMessage(m protoreflect.Message, obj map[string]any, seen map[protoref
 				if !ok {
 					continue
 				}
-				newVal, err := encodeChild(fd.Message(), list.Get(idx).Message(), child, seen)
+				newVal, err := encodeChild(fd.Message(), list.Get(idx).Message(), child, seen, stringify)
 				if err != nil {
 					return err
 				}
@@ -356,7 +499,7 @@ func encodeMessage(m protoreflect.Message, obj map[string]any, seen map[protoref
 			if !ok {
 				continue
 			}
-			newVal, err := encodeChild(fd.Message(), m.Get(fd).Message(), child, seen)
+			newVal, err := encodeChild(fd.Message(), m.Get(fd).Message(), child, seen, stringify)
 			if err != nil {
 				return err
 			}
@@ -422,11 +565,11 @@ func wrapOneof(memberName string, memberVal any) (json.RawMessage, error) {
 	return json.RawMessage(b.String()), nil
 }
 
-// encodeChild transforms a single nested message value. If expanding child's
-// type would exceed the depth budget, it stringifies the subtree to match the
-// schema's placeholder; otherwise it recurses.
-func encodeChild(childType protoreflect.MessageDescriptor, childMsg protoreflect.Message, child map[string]any, seen map[protoreflect.FullName]int) (any, error) {
-	if seen[childType.FullName()] >= DefaultMaxRecursionDepth {
+// encodeChild transforms a single nested message value. If stringify is set
+// or expanding child's type would exceed the depth budget, it stringifies the
+// subtree to match the schema's placeholder; otherwise it recurses.
+func encodeChild(childType protoreflect.MessageDescriptor, childMsg protoreflect.Message, child map[string]any, seen map[protoreflect.FullName]int, stringify bool) (any, error) {
+	if stringify || seen[childType.FullName()] >= DefaultMaxRecursionDepth {
 		// Beyond the depth boundary the schema is an opaque JSON-string. Emit
 		// the protojson-native subtree as a string (no oneof rewrapping inside,
 		// matching what the decode side parses back).
@@ -465,16 +608,17 @@ func resolveFieldName(fd protoreflect.FieldDescriptor, obj map[string]any) strin
 func isDynamicWKTField(fd protoreflect.FieldDescriptor) bool {
 	if fd.IsMap() {
 		mv := fd.MapValue()
-		return mv.Kind() == protoreflect.MessageKind && isDynamicWKT(mv.Message())
+		return mv.Kind() == protoreflect.MessageKind && IsDynamicWellKnownType(mv.Message())
 	}
-	return fd.Kind() == protoreflect.MessageKind && isDynamicWKT(fd.Message())
+	return fd.Kind() == protoreflect.MessageKind && IsDynamicWellKnownType(fd.Message())
 }
 
-// isDynamicWKT reports whether md is a protobuf well-known type that renders as
-// open-ended/dynamic JSON (no fixed type). A strict-schema client collapses
-// these to a JSON-encoded string, which DecodeArguments parses back. Any is
-// excluded: it keeps a typed wrapper shape and is not stringified wholesale.
-func isDynamicWKT(md protoreflect.MessageDescriptor) bool {
+// IsDynamicWellKnownType reports whether md is a protobuf well-known type that
+// renders as open-ended/dynamic JSON (no fixed type). A strict-schema client
+// collapses these to a JSON-encoded string, which DecodeArguments parses back.
+// Any is excluded: it keeps a typed wrapper shape and is not stringified
+// wholesale.
+func IsDynamicWellKnownType(md protoreflect.MessageDescriptor) bool {
 	switch string(md.FullName()) {
 	case "google.protobuf.Struct",
 		"google.protobuf.Value",
@@ -199,6 +199,173 @@ func TestDecode_RecursionPlaceholderString(t *testing.T) {
 	}
 }
 
+// --- decode: map entry lists -------------------------------------------------
+
+func TestDecode_MapEntries_ScalarKeys(t *testing.T) {
+	// Strict-schema models send maps as [{key, value}] lists. Non-string keys
+	// arrive as JSON numbers/bools and must be coerced to protojson's key form.
+	var req testdata.MapVariantsRequest
+	args := mustJSON(t, `{
+		"string_to_string":[{"key":"env","value":"prod"}],
+		"int_to_string":[{"key":-7,"value":"neg"},{"key":"3","value":"str-key"}],
+		"bool_to_string":[{"key":true,"value":"yes"},{"key":"false","value":"no"}],
+		"uint64_to_string":[{"key":"18446744073709551615","value":"max"},{"key":42,"value":"n"}],
+		"string_to_double":[{"key":"pi","value":3.14}],
+		"string_to_bool":[{"key":"on","value":false}]
+	}`)
+	if err := decodeInto(t, &req, args); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	want := &testdata.MapVariantsRequest{
+		StringToString: map[string]string{"env": "prod"},
+		IntToString:    map[int32]string{-7: "neg", 3: "str-key"},
+		BoolToString:   map[bool]string{true: "yes", false: "no"},
+		Uint64ToString: map[uint64]string{18446744073709551615: "max", 42: "n"},
+		StringToDouble: map[string]float64{"pi": 3.14},
+		StringToBool:   map[string]bool{"on": false},
+	}
+	if diff := cmp.Diff(want, &req, protocmp.Transform()); diff != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDecode_MapEntries_EnumValues(t *testing.T) {
+	// Enum values may be names or numbers; both pass through to protojson.
+	var req testdata.MapVariantsRequest
+	args := mustJSON(t, `{"string_to_enum":[{"key":"a","value":"PRIORITY_HIGH"},{"key":"b","value":1}]}`)
+	if err := decodeInto(t, &req, args); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	want := map[string]testdata.Priority{"a": testdata.Priority_PRIORITY_HIGH, "b": testdata.Priority_PRIORITY_LOW}
+	if diff := cmp.Diff(want, req.GetStringToEnum()); diff != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDecode_MapEntries_MessageValuesRecurse(t *testing.T) {
+	// Entry lists nest: a message value carries its own map (named_items), whose
+	// values carry another map (tags), alongside repeated messages (items).
+	var req testdata.MapVariantsRequest
+	args := mustJSON(t, `{"string_to_middle":[{"key":"m","value":{
+		"items":[{"id":"i0","tags":[{"key":"k","value":"v"}]}],
+		"named_items":[{"key":"n","value":{"id":"n0","tags":[{"key":"x","value":"y"}]}}]
+	}}]}`)
+	if err := decodeInto(t, &req, args); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	want := &testdata.MapVariantsRequest{StringToMiddle: map[string]*testdata.MiddleMessage{
+		"m": {
+			Items:      []*testdata.InnerMessage{{Id: "i0", Tags: map[string]string{"k": "v"}}},
+			NamedItems: map[string]*testdata.InnerMessage{"n": {Id: "n0", Tags: map[string]string{"x": "y"}}},
+		},
+	}}
+	if diff := cmp.Diff(want, &req, protocmp.Transform()); diff != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDecode_MapEntries_DynamicWKTValues(t *testing.T) {
+	// Struct values inside an entry list still get their stringified form lifted.
+	var req testdata.MapVariantsRequest
+	args := mustJSON(t, `{"string_to_message":[{"key":"a","value":{"metadata":"{\"k\":1}"}}]}`)
+	if err := decodeInto(t, &req, args); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	got := req.GetStringToMessage()["a"].GetMetadata().GetFields()["k"].GetNumberValue()
+	if got != 1 {
+		t.Fatalf("want metadata.k=1, got %v", req.GetStringToMessage())
+	}
+}
+
+func TestDecode_MapEntries_NullValueDropped(t *testing.T) {
+	var req testdata.MapVariantsRequest
+	args := mustJSON(t, `{"string_to_string":[{"key":"a","value":null},{"key":"b","value":"B"}]}`)
+	if err := decodeInto(t, &req, args); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if diff := cmp.Diff(map[string]string{"b": "B"}, req.GetStringToString()); diff != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDecode_MapEntries_Errors(t *testing.T) {
+	md := (&testdata.MapVariantsRequest{}).ProtoReflect().Descriptor()
+	cases := map[string]struct{ args, sub string }{
+		"entry not object": {`{"string_to_string":["a"]}`, "must be objects"},
+		"missing key":      {`{"string_to_string":[{"value":"v"}]}`, `missing its "key"`},
+		"fractional key":   {`{"int_to_string":[{"key":1.5,"value":"v"}]}`, "must be an integer"},
+		"number bool key":  {`{"bool_to_string":[{"key":1,"value":"v"}]}`, "true or false"},
+		"bad nested value": {`{"string_to_middle":[{"key":"m","value":{"named_items":[{"key":"n"},7]}}]}`, "named_items"},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			requireErrContains(t, runtime.DecodeArguments(md, mustJSON(t, c.args)), c.sub)
+		})
+	}
+}
+
+// --- decode: field defaults --------------------------------------------------
+
+func TestDecode_Defaults_InjectedWhenOmitted(t *testing.T) {
+	var req testdata.ApplyConfigRequest
+	if err := decodeInto(t, &req, mustJSON(t, `{"name":"p"}`)); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if req.GetLogLevel() != "info" || req.GetMaxRetries() != 5 {
+		t.Fatalf("want defaults injected, got log_level=%q max_retries=%d", req.GetLogLevel(), req.GetMaxRetries())
+	}
+}
+
+func TestDecode_Defaults_ExplicitValueWins(t *testing.T) {
+	// Either protojson name counts as set, including the zero value.
+	var req testdata.ApplyConfigRequest
+	if err := decodeInto(t, &req, mustJSON(t, `{"log_level":"debug","maxRetries":0}`)); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if req.GetLogLevel() != "debug" || req.GetMaxRetries() != 0 {
+		t.Fatalf("want explicit values kept, got log_level=%q max_retries=%d", req.GetLogLevel(), req.GetMaxRetries())
+	}
+}
+
+func TestDecode_Defaults_NullIsOmitted(t *testing.T) {
+	var req testdata.ApplyConfigRequest
+	args := mustJSON(t, `{"logLevel":null}`)
+	if err := decodeInto(t, &req, args); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if req.GetLogLevel() != "info" {
+		t.Fatalf("want default for null, got %q", req.GetLogLevel())
+	}
+	if _, ok := args["logLevel"]; ok {
+		t.Fatalf("null alias must be dropped so protojson sees one key: %#v", args)
+	}
+}
+
+// --- decode: field caps ------------------------------------------------------
+
+func TestDecode_Max_Clamps(t *testing.T) {
+	cases := map[string]struct {
+		args string
+		want int32
+	}{
+		"above max":    {`{"page_size":100000}`, 200},
+		"string value": {`{"pageSize":"100000"}`, 200},
+		"within max":   {`{"page_size":20}`, 20},
+		"omitted":      {`{}`, 50},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var req testdata.ListConfigsRequest
+			if err := decodeInto(t, &req, mustJSON(t, c.args)); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if req.GetPageSize() != c.want {
+				t.Fatalf("want page_size %d, got %d", c.want, req.GetPageSize())
+			}
+		})
+	}
+}
+
 // --- encode: oneof rewrap ----------------------------------------------------
 
 func TestEncode_Oneof_WhichFirstAndRewrapped(t *testing.T) {
@@ -396,3 +563,55 @@ func jsonEscape(s string) string {
 	// strip surrounding quotes; we embed inside an already-quoted string literal
 	return string(b[1 : len(b)-1])
 }
+
+// --- unwrap: wrap_input ------------------------------------------------------
+
+func TestUnwrapArguments(t *testing.T) {
+	inner := map[string]any{"name": "n"}
+	got, err := runtime.UnwrapArguments(map[string]any{"request": inner, "api_key": "k"}, "request")
+	if err != nil || !cmp.Equal(got, inner) {
+		t.Fatalf("want inner object, got %#v, %v", got, err)
+	}
+
+	args := map[string]any{"name": "n"}
+	if got, err := runtime.UnwrapArguments(args, ""); err != nil || !cmp.Equal(got, args) {
+		t.Fatalf("empty wrapper must pass args through, got %#v, %v", got, err)
+	}
+
+	if _, err := runtime.UnwrapArguments(args, "request"); err == nil || !strings.Contains(err.Error(), `missing argument "request"`) {
+		t.Fatalf("want missing-wrapper error, got %v", err)
+	}
+	if _, err := runtime.UnwrapArguments(map[string]any{"request": "{}"}, "request"); err == nil || !strings.Contains(err.Error(), "must be an object") {
+		t.Fatalf("want type error, got %v", err)
+	}
+}
+
+// --- unflatten: flatten_nested ----------------------------------------------
+
+func TestUnflattenArguments(t *testing.T) {
+	args := map[string]any{
+		"id":          "a",
+		"spec.name":   "n",
+		"spec.region": "eu",
+		"spec.zone":   nil,
+		"owner":       map[string]any{"team": "t"},
+		"owner.email": "e",
+		"limits.cpu":  nil,
+	}
+	if err := runtime.UnflattenArguments(args); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"id":    "a",
+		"spec":  map[string]any{"name": "n", "region": "eu"},
+		"owner": map[string]any{"team": "t", "email": "e"},
+	}
+	if diff := cmp.Diff(want, args); diff != "" {
+		t.Fatalf("unexpected arguments (-want +got):\n%s", diff)
+	}
+
+	err := runtime.UnflattenArguments(map[string]any{"spec": "x", "spec.name": "n"})
+	if err == nil || !strings.Contains(err.Error(), `argument "spec.name" conflicts with "spec"`) {
+		t.Fatalf("want conflict error, got %v", err)
+	}
+}
@@ -67,6 +67,19 @@ func TestDecode_WKT_ValueObjectFromString(t *testing.T) {
 	}
 }
 
+func TestDecode_WKT_ListValueFromString(t *testing.T) {
+	// Models send ListValue JSON-encoded as a string too.
+	var msg testdata.WktTestMessage
+	args := mustJSON(t, `{"list_value":"[1,\"two\"]"}`)
+	if err := decodeInto(t, &msg, args); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	values := msg.GetListValue().GetValues()
+	if len(values) != 2 || values[0].GetNumberValue() != 1 || values[1].GetStringValue() != "two" {
+		t.Fatalf("list value not parsed from string: %#v", msg.GetListValue())
+	}
+}
+
 func TestDecode_WKT_ValueStringifiedScalar(t *testing.T) {
 	// A downgraded string Value "hello" arrives JSON-encoded as "\"hello\"" and
 	// must be lifted back to the bare string "hello" (the common strict path).
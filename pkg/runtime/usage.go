@@ -0,0 +1,170 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// Usage describes one tool call for metering and chargeback.
+type Usage struct {
+	// Principal is who made the call: the tenant resolved by WithTenant,
+	// or else the MCP session ID. It is empty for calls without either.
+	Principal string
+
+	// Tool is the tool name as registered.
+	Tool string
+
+	// BytesIn is the size of the arguments the client sent, encoded as JSON.
+	BytesIn int
+
+	// Arguments are the arguments the client sent, with Sensitive extra
+	// properties replaced by RedactedValue. They are nil for calls that
+	// failed before ExtractExtraProperties ran, which redacts them, and for
+	// handlers that don't call it.
+	Arguments map[string]any
+
+	// BytesOut is the size of the text content and embedded resources of
+	// the result.
+	BytesOut int
+
+	// Duration is how long the call took, including any wait for a worker.
+	Duration time.Duration
+
+	// Error is set for calls failing with a tool error or a Go error.
+	Error bool
+}
+
+// UsageRecorder records the Usage of every tool call, e.g. to meter agent
+// traffic per tenant. RecordUsage runs after the call on its goroutine and
+// gets the handler context; it should hand heavy work off.
+type UsageRecorder interface {
+	RecordUsage(ctx context.Context, usage Usage)
+}
+
+// UsageRecorderFunc adapts a function to the UsageRecorder interface.
+type UsageRecorderFunc func(ctx context.Context, usage Usage)
+
+// RecordUsage calls f(ctx, usage).
+func (f UsageRecorderFunc) RecordUsage(ctx context.Context, usage Usage) {
+	f(ctx, usage)
+}
+
+// WithUsageRecorder makes the generated handlers report the Usage of every
+// tool call to r. Calls rejected by WithArgumentLimits or failing to resolve
+// their tenant are not recorded.
+func WithUsageRecorder(r UsageRecorder) Option {
+	return func(c *config) {
+		c.UsageRecorder = r
+	}
+}
+
+// RecordUsage returns a handler that reports the Usage of every call of
+// handler, the handler of the tool name, to r. Recording with a nil r
+// returns handler unchanged.
+func RecordUsage(name string, r UsageRecorder, handler ToolHandler) ToolHandler {
+	if r == nil {
+		return handler
+	}
+	return func(ctx context.Context, request *CallToolRequest) (*CallToolResult, error) {
+		// Measure the arguments as sent: the handler fills in and removes
+		// arguments in place.
+		arguments := &usageArguments{}
+		if encoded, err := json.Marshal(request.Arguments); err == nil {
+			arguments.encoded = encoded
+		}
+		start := time.Now()
+		result, err := handler(context.WithValue(ctx, usageArgumentsKey{}, arguments), request)
+		usage := Usage{
+			Principal: principal(ctx),
+			Tool:      name,
+			BytesIn:   len(arguments.encoded),
+			Arguments: arguments.get(),
+			Duration:  time.Since(start),
+			Error:     err != nil || result == nil || result.IsError,
+		}
+		if result != nil {
+			usage.BytesOut = resultBytes(result)
+		}
+		r.RecordUsage(ctx, usage)
+		return result, err
+	}
+}
+
+// usageArguments holds the arguments of a recorded call until
+// ExtractExtraProperties knows which of them to redact. A fan-out extracts
+// them once per target, concurrently.
+type usageArguments struct {
+	encoded []byte
+
+	once     sync.Once
+	mu       sync.Mutex
+	redacted map[string]any
+}
+
+type usageArgumentsKey struct{}
+
+// redactUsageArguments records the arguments of the recorded call of ctx,
+// if any, for its Usage, with the Sensitive properties redacted.
+func redactUsageArguments(ctx context.Context, properties []ExtraProperty) {
+	a, ok := ctx.Value(usageArgumentsKey{}).(*usageArguments)
+	if !ok {
+		return
+	}
+	a.once.Do(func() {
+		// Decode a copy, as the handler changes the arguments in place.
+		var args map[string]any
+		if json.Unmarshal(a.encoded, &args) != nil {
+			return
+		}
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		a.redacted = RedactArguments(args, properties)
+	})
+}
+
+// get returns the redacted arguments, or nil if they were never redacted.
+func (a *usageArguments) get() map[string]any {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.redacted
+}
+
+// principal returns the Usage.Principal of the call of ctx.
+func principal(ctx context.Context) string {
+	if tenant, ok := TenantFromContext(ctx); ok {
+		return tenant
+	}
+	id, _ := SessionIDFromContext(ctx)
+	return id
+}
+
+// resultBytes returns the size of the content of result as it is sent.
+func resultBytes(result *CallToolResult) int {
+	n := len(result.Text)
+	if result.Parts != nil {
+		n = 0
+		for _, part := range result.Parts {
+			n += len(part)
+		}
+	}
+	for _, r := range result.Resources {
+		n += len(r.Text) + len(r.Blob)
+	}
+	return n
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func TestWithUsageRecorder(t *testing.T) {
+	var recorded []runtime.Usage
+	recorder := runtime.UsageRecorderFunc(func(_ context.Context, usage runtime.Usage) {
+		recorded = append(recorded, usage)
+	})
+
+	t.Run("records every call", func(t *testing.T) {
+		g := NewWithT(t)
+		recorded = nil
+		config := runtime.NewConfig()
+		runtime.WithUsageRecorder(recorder)(config)
+		handler := runtime.ApplyHandlerConfig("svc_Get", config, func(_ context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+			if request.Arguments["fail"] == true {
+				return nil, errors.New("backend down")
+			}
+			return runtime.NewToolResultText("hello"), nil
+		})
+
+		ctx := runtime.WithSessionID(context.Background(), "s1")
+		_, err := handler(ctx, &runtime.CallToolRequest{Arguments: map[string]any{"id": "a"}})
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = handler(ctx, &runtime.CallToolRequest{Arguments: map[string]any{"fail": true}})
+		g.Expect(err).To(HaveOccurred())
+
+		g.Expect(recorded).To(HaveLen(2))
+		g.Expect(recorded[0].Principal).To(Equal("s1"))
+		g.Expect(recorded[0].Tool).To(Equal("svc_Get"))
+		g.Expect(recorded[0].BytesIn).To(Equal(len(`{"id":"a"}`)))
+		g.Expect(recorded[0].BytesOut).To(Equal(len("hello")))
+		g.Expect(recorded[0].Error).To(BeFalse())
+		g.Expect(recorded[1].Error).To(BeTrue())
+		g.Expect(recorded[1].BytesOut).To(BeZero())
+	})
+
+	t.Run("principal is the tenant", func(t *testing.T) {
+		g := NewWithT(t)
+		recorded = nil
+		config := runtime.NewConfig()
+		runtime.WithUsageRecorder(recorder)(config)
+		runtime.WithTenant(runtime.Tenant{Resolve: runtime.TenantFromArgument("org")})(config)
+		handler := runtime.ApplyHandlerConfig("svc_Get", config, func(context.Context, *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+			return &runtime.CallToolResult{Text: "abcdef", Parts: []string{"abc", "def"}, Resources: []runtime.EmbeddedResource{{Blob: []byte{1, 2}}}}, nil
+		})
+		_, err := handler(context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"org": "org-1"}})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(recorded).To(HaveLen(1))
+		g.Expect(recorded[0].Principal).To(Equal("org-1"))
+		g.Expect(recorded[0].BytesOut).To(Equal(8))
+
+		// Calls whose tenant does not resolve are not recorded.
+		_, err = handler(context.Background(), &runtime.CallToolRequest{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(recorded).To(HaveLen(1))
+	})
+	t.Run("arguments are measured as sent", func(t *testing.T) {
+		g := NewWithT(t)
+		recorded = nil
+		config := runtime.NewConfig()
+		runtime.WithUsageRecorder(recorder)(config)
+		handler := runtime.ApplyHandlerConfig("svc_Get", config, func(_ context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+			// Generated handlers fill in defaults and strip extra properties
+			// in place.
+			delete(request.Arguments, "region")
+			request.Arguments["page_size"] = 50
+			return runtime.NewToolResultText("ok"), nil
+		})
+		_, err := handler(context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"region": "eu"}})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(recorded).To(HaveLen(1))
+		g.Expect(recorded[0].BytesIn).To(Equal(len(`{"region":"eu"}`)))
+		// The handler didn't extract extra properties, so the arguments
+		// could hold secrets.
+		g.Expect(recorded[0].Arguments).To(BeNil())
+	})
+
+	t.Run("arguments are redacted", func(t *testing.T) {
+		g := NewWithT(t)
+		recorded = nil
+		config := runtime.NewConfig()
+		runtime.WithUsageRecorder(recorder)(config)
+		properties := []runtime.ExtraProperty{
+			{Name: "token", ContextKey: runtime.ExtraPropertyKey("token"), Sensitive: true},
+			{Name: "region", ContextKey: runtime.ExtraPropertyKey("region")},
+		}
+		handler := runtime.ApplyHandlerConfig("svc_Get", config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+			if _, err := runtime.ExtractExtraProperties(ctx, request.Arguments, properties); err != nil {
+				return nil, err
+			}
+			request.Arguments["filter"].(map[string]any)["name"] = "changed"
+			return runtime.NewToolResultText("ok"), nil
+		})
+		_, err := handler(context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{
+			"token":  "s3cr3t",
+			"region": "eu",
+			"filter": map[string]any{"name": "a"},
+		}})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(recorded).To(HaveLen(1))
+		g.Expect(recorded[0].Arguments).To(Equal(map[string]any{
+			"token":  runtime.RedactedValue,
+			"region": "eu",
+			"filter": map[string]any{"name": "a"},
+		}))
+	})
+}
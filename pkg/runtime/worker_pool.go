@@ -0,0 +1,203 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// WorkerPool runs expensive tool calls, e.g. cluster provisioning, with
+// bounded concurrency, protecting backends that can only handle a few of
+// them at a time. Calls beyond the number of workers wait in a FIFO queue;
+// calls beyond the queue length fail with a tool error asking the model to
+// retry later. While a call waits, its queue position is sent as a progress
+// notification if the client asked for progress. A WorkerPool is safe for
+// concurrent use and can be shared between tools and registrations.
+type WorkerPool struct {
+	workers int
+	queue   int
+
+	mu      sync.Mutex
+	running int
+	waiting []*poolWaiter
+}
+
+type poolWaiter struct {
+	ready chan struct{}
+	moved chan struct{}
+}
+
+// NewWorkerPool returns a WorkerPool that runs up to workers calls at once
+// and lets up to queue more wait for a free worker. A queue of zero rejects
+// calls while all workers are busy.
+func NewWorkerPool(workers, queue int) *WorkerPool {
+	return &WorkerPool{workers: max(workers, 1), queue: queue}
+}
+
+// WithWorkerPool makes the generated registration functions run the named
+// tools through p. Tools are named as registered, including the prefix of
+// WithNamePrefix.
+func WithWorkerPool(p *WorkerPool, tools ...string) Option {
+	return func(c *config) {
+		if c.WorkerPools == nil {
+			c.WorkerPools = make(map[string]*WorkerPool)
+		}
+		for _, name := range tools {
+			c.WorkerPools[name] = p
+		}
+	}
+}
+
+// WithServiceWorkerPool makes the generated registration functions run every
+// tool they register through p, on top of the pools of WithWorkerPool. Give
+// each service its own pool to wall it off from the others registered on the
+// same server: a flood of calls to one service's tools then queues up in its
+// own pool instead of starving the rest. A call waits for a worker of its
+// tool's pool before it takes one of the service's.
+func WithServiceWorkerPool(p *WorkerPool) Option {
+	return func(c *config) {
+		c.ServiceWorkerPool = p
+	}
+}
+
+// Run returns a handler that runs handler on one of the pool's workers.
+// A call that is cancelled while it waits leaves the queue.
+//
+// Progress the handler reports is shifted past the queue positions reported
+// before, so it keeps increasing as MCP requires.
+func (p *WorkerPool) Run(handler ToolHandler) ToolHandler {
+	return p.run(handler, "this tool")
+}
+
+// RunService is Run for a pool shared by all tools of a service, as with
+// WithServiceWorkerPool. Only the tool error of a full queue differs.
+func (p *WorkerPool) RunService(handler ToolHandler) ToolHandler {
+	return p.run(handler, "this service's tools")
+}
+
+// run is Run, naming the calls the pool bounds as calls of what.
+func (p *WorkerPool) run(handler ToolHandler, what string) ToolHandler {
+	return func(ctx context.Context, request *CallToolRequest) (*CallToolResult, error) {
+		offset, err := p.acquire(ctx)
+		if err == errQueueFull {
+			return NewToolResultError(fmt.Sprintf("Too many calls of %s are running (%d) or waiting (%d). Try again later.", what, p.workers, p.queue)), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		defer p.release()
+		if r := ProgressReporterFromContext(ctx); r != nil && offset > 0 {
+			ctx = WithProgressReporter(ctx, offsetProgress{r: r, offset: offset})
+		}
+		return handler(ctx, request)
+	}
+}
+
+var errQueueFull = errors.New("worker pool queue is full")
+
+// acquire waits for a free worker. It returns the number of progress
+// notifications reserved for the queue positions: the handler's progress is
+// offset by it.
+func (p *WorkerPool) acquire(ctx context.Context) (float64, error) {
+	p.mu.Lock()
+	if p.running < p.workers && len(p.waiting) == 0 {
+		p.running++
+		p.mu.Unlock()
+		return 0, nil
+	}
+	if len(p.waiting) >= p.queue {
+		p.mu.Unlock()
+		return 0, errQueueFull
+	}
+	w := &poolWaiter{ready: make(chan struct{}), moved: make(chan struct{}, 1)}
+	p.waiting = append(p.waiting, w)
+	first := len(p.waiting)
+	p.mu.Unlock()
+
+	reportPosition := func(position int) {
+		_ = ReportProgress(ctx, float64(first-position), 0, fmt.Sprintf("Waiting for a free worker: position %d in the queue.", position))
+	}
+	reportPosition(first)
+	for {
+		select {
+		case <-w.ready:
+			return float64(first), nil
+		case <-w.moved:
+			p.mu.Lock()
+			position := slices.Index(p.waiting, w) + 1
+			p.mu.Unlock()
+			if position > 0 {
+				reportPosition(position)
+			}
+		case <-ctx.Done():
+			p.mu.Lock()
+			i := slices.Index(p.waiting, w)
+			if i < 0 {
+				// A worker was handed over just now; pass it on.
+				p.mu.Unlock()
+				p.release()
+				return 0, ctx.Err()
+			}
+			p.waiting = slices.Delete(p.waiting, i, i+1)
+			p.notifyMoved(i)
+			p.mu.Unlock()
+			return 0, ctx.Err()
+		}
+	}
+}
+
+// release hands the worker of a finished call to the first waiting call, or
+// frees it.
+func (p *WorkerPool) release() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.waiting) == 0 {
+		p.running--
+		return
+	}
+	w := p.waiting[0]
+	p.waiting = p.waiting[1:]
+	close(w.ready)
+	p.notifyMoved(0)
+}
+
+// notifyMoved tells the waiting calls from index i on that they moved up.
+// p.mu must be held.
+func (p *WorkerPool) notifyMoved(i int) {
+	for _, w := range p.waiting[i:] {
+		select {
+		case w.moved <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// offsetProgress shifts the progress of a handler that waited in a worker
+// pool queue past the queue positions reported before.
+type offsetProgress struct {
+	r      ProgressReporter
+	offset float64
+}
+
+func (o offsetProgress) Report(ctx context.Context, progress, total float64, message string) error {
+	if total > 0 {
+		total += o.offset
+	}
+	return o.r.Report(ctx, progress+o.offset, total, message)
+}
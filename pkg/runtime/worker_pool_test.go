@@ -0,0 +1,175 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+// recordedProgress is a ProgressReporter that keeps the notifications sent.
+type recordedProgress struct {
+	mu      sync.Mutex
+	reports []float64
+	totals  []float64
+}
+
+func (r *recordedProgress) Report(_ context.Context, progress, total float64, _ string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reports = append(r.reports, progress)
+	r.totals = append(r.totals, total)
+	return nil
+}
+
+func (r *recordedProgress) get() ([]float64, []float64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]float64(nil), r.reports...), append([]float64(nil), r.totals...)
+}
+
+// blockingHandler returns a handler that signals started and then waits for
+// release before reporting progress 1 of 2.
+func blockingHandler(started chan<- string, release <-chan struct{}) runtime.ToolHandler {
+	return func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		name, _ := request.Arguments["name"].(string)
+		started <- name
+		<-release
+		_ = runtime.ReportProgress(ctx, 1, 2, "halfway")
+		return runtime.NewToolResultText(name), nil
+	}
+}
+
+func TestWorkerPool(t *testing.T) {
+	g := NewWithT(t)
+	started := make(chan string, 3)
+	release := make(chan struct{})
+	h := runtime.NewWorkerPool(1, 2).Run(blockingHandler(started, release))
+
+	results := make(chan string, 4)
+	call := func(ctx context.Context, name string) {
+		result, err := h(ctx, &runtime.CallToolRequest{Arguments: map[string]any{"name": name}})
+		switch {
+		case err != nil:
+			results <- err.Error()
+		case result.IsError:
+			results <- "rejected"
+		default:
+			results <- result.Text
+		}
+	}
+
+	go call(context.Background(), "a")
+	g.Expect(<-started).To(Equal("a"))
+
+	// b and c wait; c is told its position and moves up when b leaves.
+	progressB := &recordedProgress{}
+	ctxB, cancelB := context.WithCancel(runtime.WithProgressReporter(context.Background(), progressB))
+	go call(ctxB, "b")
+	g.Eventually(func() []float64 { r, _ := progressB.get(); return r }).Should(HaveLen(1))
+	progressC := &recordedProgress{}
+	go call(runtime.WithProgressReporter(context.Background(), progressC), "c")
+	g.Eventually(func() []float64 { r, _ := progressC.get(); return r }).Should(HaveLen(1))
+
+	// The queue is full.
+	call(context.Background(), "d")
+	g.Expect(<-results).To(Equal("rejected"))
+
+	cancelB()
+	g.Expect(<-results).To(Equal("context canceled"))
+	g.Eventually(func() []float64 { r, _ := progressC.get(); return r }).Should(HaveLen(2))
+
+	close(release)
+	g.Expect(<-results).To(Equal("a"))
+	g.Expect(<-started).To(Equal("c"))
+	g.Expect(<-results).To(Equal("c"))
+	g.Consistently(started).ShouldNot(Receive())
+
+	// c waited at positions 2 and 1; its own progress comes after them.
+	reports, totals := progressC.get()
+	g.Expect(reports).To(Equal([]float64{0, 1, 3}))
+	g.Expect(totals).To(Equal([]float64{0, 0, 4}))
+}
+
+func TestApplyHandlerConfig(t *testing.T) {
+	g := NewWithT(t)
+	config := runtime.NewConfig()
+	runtime.WithWorkerPool(runtime.NewWorkerPool(1, 0), "svc_Provision")(config)
+
+	started := make(chan string, 2)
+	release := make(chan struct{})
+	provision := runtime.ApplyHandlerConfig("svc_Provision", config, blockingHandler(started, release))
+	get := runtime.ApplyHandlerConfig("svc_Get", config, blockingHandler(started, release))
+
+	done := make(chan struct{})
+	go func() {
+		_, _ = provision(context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"name": "first"}})
+		close(done)
+	}()
+	g.Expect(<-started).To(Equal("first"))
+
+	// The pool is busy and has no queue; other tools are not pooled.
+	result, err := provision(context.Background(), &runtime.CallToolRequest{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeTrue())
+	go func() {
+		_, _ = get(context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"name": "get"}})
+	}()
+	g.Expect(<-started).To(Equal("get"))
+
+	close(release)
+	<-done
+}
+
+func TestWithServiceWorkerPool(t *testing.T) {
+	g := NewWithT(t)
+	clusters := runtime.NewConfig()
+	runtime.WithServiceWorkerPool(runtime.NewWorkerPool(1, 0))(clusters)
+	users := runtime.NewConfig()
+	runtime.WithServiceWorkerPool(runtime.NewWorkerPool(1, 0))(users)
+
+	started := make(chan string, 2)
+	release := make(chan struct{})
+	create := runtime.ApplyHandlerConfig("clusters_Create", clusters, blockingHandler(started, release))
+	list := runtime.ApplyHandlerConfig("clusters_List", clusters, blockingHandler(started, release))
+	get := runtime.ApplyHandlerConfig("users_Get", users, blockingHandler(started, release))
+
+	done := make(chan struct{})
+	go func() {
+		_, _ = create(context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"name": "create"}})
+		close(done)
+	}()
+	g.Expect(<-started).To(Equal("create"))
+
+	// The budget is shared by all tools of the service...
+	result, err := list(context.Background(), &runtime.CallToolRequest{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeTrue())
+	g.Expect(result.Text).To(Equal("Too many calls of this service's tools are running (1) or waiting (0). Try again later."))
+
+	// ...but not with other services.
+	go func() {
+		_, _ = get(context.Background(), &runtime.CallToolRequest{Arguments: map[string]any{"name": "get"}})
+	}()
+	g.Expect(<-started).To(Equal("get"))
+
+	close(release)
+	<-done
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schema renders the JSON Schemas of protobuf messages and methods
+// exactly as protoc-gen-go-mcp generates them for tools. Gateways, doc
+// generators and dynamic proxies can use it to produce identical schemas
+// from descriptors, without going through code generation.
+package schema
+
+import (
+	"encoding/json"
+
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/gen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Profile selects how schemas are rendered. Its fields are the schema
+// related plugin options; the zero value renders like the plugin defaults.
+type Profile = gen.SchemaOptions
+
+// JSON Schema dialects a Profile can declare.
+const (
+	Draft07     = gen.Draft07
+	Draft202012 = gen.Draft202012
+)
+
+// ForMessage returns the JSON Schema of a message, as used wherever the
+// message appears in a tool schema.
+func ForMessage(md protoreflect.MessageDescriptor, p Profile) map[string]any {
+	return gen.MessageSchema(md, p)
+}
+
+// ForField returns the JSON Schema of a single field.
+func ForField(fd protoreflect.FieldDescriptor, p Profile) map[string]any {
+	return gen.FieldSchema(fd, p)
+}
+
+// ForMethod returns the input and output schema of the tool generated for
+// an RPC. Unlike ForMessage, the input schema reflects the tool level
+// options of the method: context fields are removed, declared extra
+// properties and the dry_run flag are added, and WrapInput applies.
+func ForMethod(md protoreflect.MethodDescriptor, p Profile) (input, output json.RawMessage) {
+	tool := gen.ToolForMethodWithOptions(md, "", p)
+	return tool.RawInputSchema, tool.RawOutputSchema
+}
+
+// ValidateOpenAIStrict checks a tool input schema against the restrictions
+// of OpenAI strict function calling. Profile.OpenAIStrict renders schemas
+// that pass it unless they contain free-form JSON.
+func ValidateOpenAIStrict(schema json.RawMessage) error {
+	return gen.ValidateOpenAIStrict(schema)
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/schema"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestForMethodMatchesGeneratedTools(t *testing.T) {
+	g := NewWithT(t)
+
+	methods := testdata.File_testdata_test_service_proto.Services().ByName("TestService").Methods()
+	for method, tool := range map[protoreflect.Name]runtime.Tool{
+		"CreateItem":            testdatamcp.TestService_CreateItemTool,
+		"GetItem":               testdatamcp.TestService_GetItemTool,
+		"ProcessWellKnownTypes": testdatamcp.TestService_ProcessWellKnownTypesTool,
+		"TestValidation":        testdatamcp.TestService_TestValidationTool,
+	} {
+		input, output := schema.ForMethod(methods.ByName(method), schema.Profile{})
+		g.Expect(input).To(MatchJSON(tool.RawInputSchema), tool.Name)
+		g.Expect(output).To(MatchJSON(tool.RawOutputSchema), tool.Name)
+	}
+}
+
+func TestForMessage(t *testing.T) {
+	g := NewWithT(t)
+
+	md := (&testdata.GetItemRequest{}).ProtoReflect().Descriptor()
+	s := schema.ForMessage(md, schema.Profile{})
+	g.Expect(s["properties"]).To(HaveKey("id"))
+
+	id := schema.ForField(md.Fields().ByName("id"), schema.Profile{Titles: true})
+	g.Expect(id).To(HaveKeyWithValue("type", "string"))
+	g.Expect(id).To(HaveKeyWithValue("title", "Id"))
+}
+
+func TestForMethodProfile(t *testing.T) {
+	g := NewWithT(t)
+
+	md := testdata.File_testdata_test_service_proto.Services().ByName("TestService").Methods().ByName("GetItem")
+	input, _ := schema.ForMethod(md, schema.Profile{Draft: schema.Draft07, WrapInput: "request"})
+	var s map[string]any
+	g.Expect(json.Unmarshal(input, &s)).To(Succeed())
+	g.Expect(s).To(HaveKeyWithValue("$schema", "http://json-schema.org/draft-07/schema#"))
+	g.Expect(s["properties"]).To(HaveKey("request"))
+}
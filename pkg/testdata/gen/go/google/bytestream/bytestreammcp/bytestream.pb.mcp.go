@@ -17,9 +17,48 @@ import (
 )
 
 var (
-	ByteStream_QueryWriteStatusTool = runtime.Tool{Name: "google_bytestream_ByteStream_QueryWriteStatus", Description: "`QueryWriteStatus()` is used to find the `committed_size` for a resource\nthat is being written, which can then be used as the `write_offset` for\nthe next `Write()` call.\n\nIf the resource does not exist (i.e., the resource has been deleted, or the\nfirst `Write()` has not yet reached the service), this method returns the\nerror `NOT_FOUND`.\n\nThe client **may** call `QueryWriteStatus()` at any time to determine how\nmuch data has been processed for this resource. This is useful if the\nclient is buffering data and needs to know which data can be safely\nevicted. For any sequence of `QueryWriteStatus()` calls for a given\nresource name, the sequence of returned `committed_size` values will be\nnon-decreasing.\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
+	ByteStream_QueryWriteStatusTool = runtime.Tool{
+		Name:            "google_bytestream_ByteStream_QueryWriteStatus",
+		Description:     "`QueryWriteStatus()` is used to find the `committed_size` for a resource\nthat is being written, which can then be used as the `write_offset` for\nthe next `Write()` call.\n\nIf the resource does not exist (i.e., the resource has been deleted, or the\nfirst `Write()` has not yet reached the service), this method returns the\nerror `NOT_FOUND`.\n\nThe client **may** call `QueryWriteStatus()` at any time to determine how\nmuch data has been processed for this resource. This is useful if the\nclient is buffering data and needs to know which data can be safely\nevicted. For any sequence of `QueryWriteStatus()` calls for a given\nresource name, the sequence of returned `committed_size` values will be\nnon-decreasing.\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"resource_name":{"type":"string"}},"required":[],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"committed_size":{"type":"string"},"complete":{"type":"boolean"}},"required":[],"type":"object"}`),
+	}
 )
 
+// File_google_bytestream_bytestream_proto_GenerationInfo records the
+// generator version and options of the tools above, see
+// runtime.CheckGenerationInfo.
+var File_google_bytestream_bytestream_proto_GenerationInfo = runtime.GenerationInfo{
+	Source:  "google/bytestream/bytestream.proto",
+	Version: "devel",
+	Options: []string{
+		"deprecated_fields=keep",
+		"dry_run=false",
+		"exclude_deprecated_methods=false",
+		"exclude_fields=",
+		"flatten_nested=false",
+		"openai_strict=false",
+		"relative_times=false",
+		"resources=false",
+		"schema_draft=",
+		"schema_mappings=",
+		"schema_patches=",
+		"wrap_input=",
+	},
+	Cosmetic: []string{
+		"comment_directives=",
+		"comment_markdown=keep",
+		"dedupe_descriptions=false",
+		"examples_in_description=false",
+		"field_comments=none",
+		"max_field_description_bytes=0",
+		"max_tool_description_bytes=0",
+		"minify_schemas=false",
+		"shared_definitions=false",
+		"titles=false",
+	},
+}
+
 // ByteStreamServer is compatible with the grpc-go server interface.
 type ByteStreamServer interface {
 	QueryWriteStatus(ctx context.Context, req *bytestream.QueryWriteStatusRequest) (*bytestream.QueryWriteStatusResponse, error)
@@ -34,7 +73,7 @@ func RegisterByteStreamHandler(s runtime.MCPServer, srv ByteStreamServer, opts .
 	QueryWriteStatusTool := ByteStream_QueryWriteStatusTool
 	QueryWriteStatusTool = runtime.ApplyConfig(QueryWriteStatusTool, config)
 
-	s.AddTool(QueryWriteStatusTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, QueryWriteStatusTool, runtime.ApplyMutatingHandlerConfig(QueryWriteStatusTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req bytestream.QueryWriteStatusRequest
 
 		message := request.Arguments
@@ -52,6 +91,11 @@ func RegisterByteStreamHandler(s runtime.MCPServer, srv ByteStreamServer, opts .
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -61,7 +105,9 @@ func RegisterByteStreamHandler(s runtime.MCPServer, srv ByteStreamServer, opts .
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.QueryWriteStatus(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -72,7 +118,7 @@ func RegisterByteStreamHandler(s runtime.MCPServer, srv ByteStreamServer, opts .
 		}
 
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 }
 
 // ByteStreamClient is compatible with the grpc-go client interface.
@@ -94,7 +140,7 @@ func ForwardToConnectByteStreamClient(s runtime.MCPServer, client ConnectByteStr
 	QueryWriteStatusTool := ByteStream_QueryWriteStatusTool
 	QueryWriteStatusTool = runtime.ApplyConfig(QueryWriteStatusTool, config)
 
-	s.AddTool(QueryWriteStatusTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, QueryWriteStatusTool, runtime.ApplyMutatingHandlerConfig(QueryWriteStatusTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req bytestream.QueryWriteStatusRequest
 
 		message := request.Arguments
@@ -110,6 +156,11 @@ func ForwardToConnectByteStreamClient(s runtime.MCPServer, client ConnectByteStr
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -119,7 +170,9 @@ func ForwardToConnectByteStreamClient(s runtime.MCPServer, client ConnectByteStr
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := client.QueryWriteStatus(ctx, connect.NewRequest(&req))
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -129,7 +182,51 @@ func ForwardToConnectByteStreamClient(s runtime.MCPServer, client ConnectByteStr
 			return nil, err
 		}
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
+}
+
+// ForwardToConnectByteStreamURL forwards MCP calls to the ByteStream at baseURL
+// with a connectrpc client. Select its wire protocol and codec with
+// runtime.WithConnectProtocol and runtime.WithConnectCodec.
+func ForwardToConnectByteStreamURL(s runtime.MCPServer, httpClient connect.HTTPClient, baseURL string, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	clientOpts := runtime.ConnectClientOptions(config)
+	ForwardToConnectByteStreamClient(s, connectByteStreamClient{
+		callQueryWriteStatus: connect.NewClient[bytestream.QueryWriteStatusRequest, bytestream.QueryWriteStatusResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/google.bytestream.ByteStream/QueryWriteStatus"), clientOpts...),
+	}, opts...)
+}
+
+// connectByteStreamClient is the ConnectByteStreamClient of
+// ForwardToConnectByteStreamURL.
+type connectByteStreamClient struct {
+	callQueryWriteStatus *connect.Client[bytestream.QueryWriteStatusRequest, bytestream.QueryWriteStatusResponse]
+}
+
+func (c connectByteStreamClient) QueryWriteStatus(ctx context.Context, req *connect.Request[bytestream.QueryWriteStatusRequest]) (*connect.Response[bytestream.QueryWriteStatusResponse], error) {
+	return c.callQueryWriteStatus.CallUnary(ctx, req)
+}
+
+// ConnectByteStreamHandler is compatible with the connectrpc-go handler interface.
+type ConnectByteStreamHandler interface {
+	QueryWriteStatus(ctx context.Context, req *connect.Request[bytestream.QueryWriteStatusRequest]) (*connect.Response[bytestream.QueryWriteStatusResponse], error)
+}
+
+// ForwardToConnectByteStreamHandler forwards MCP calls to a connectrpc handler
+// implementation in the same process by calling its methods directly, without
+// serializing requests. Handler interceptors do not run. Watched resources
+// need a stream, which only a mounted handler can serve: forward to it with
+// ForwardToConnectByteStreamURL and runtime.InProcessHTTPClient instead.
+func ForwardToConnectByteStreamHandler(s runtime.MCPServer, handler ConnectByteStreamHandler, opts ...runtime.Option) {
+	ForwardToConnectByteStreamClient(s, handlerByteStreamClient{handler}, opts...)
+}
+
+// handlerByteStreamClient is the ConnectByteStreamClient of
+// ForwardToConnectByteStreamHandler.
+type handlerByteStreamClient struct {
+	ConnectByteStreamHandler
 }
 
 // ForwardToByteStreamClient registers a gRPC client, to forward MCP calls to it.
@@ -141,7 +238,7 @@ func ForwardToByteStreamClient(s runtime.MCPServer, client ByteStreamClient, opt
 	QueryWriteStatusTool := ByteStream_QueryWriteStatusTool
 	QueryWriteStatusTool = runtime.ApplyConfig(QueryWriteStatusTool, config)
 
-	s.AddTool(QueryWriteStatusTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, QueryWriteStatusTool, runtime.ApplyMutatingHandlerConfig(QueryWriteStatusTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req bytestream.QueryWriteStatusRequest
 
 		message := request.Arguments
@@ -157,6 +254,11 @@ func ForwardToByteStreamClient(s runtime.MCPServer, client ByteStreamClient, opt
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -166,7 +268,9 @@ func ForwardToByteStreamClient(s runtime.MCPServer, client ByteStreamClient, opt
 			return nil, err
 		}
 
-		resp, err := client.QueryWriteStatus(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.QueryWriteStatus(ctx, &req, runtime.ApplyCallOptions(ctx, QueryWriteStatusTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -176,5 +280,26 @@ func ForwardToByteStreamClient(s runtime.MCPServer, client ByteStreamClient, opt
 			return nil, err
 		}
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
+}
+
+// ForwardToByteStreamConn forwards MCP calls to the ByteStream behind conn,
+// e.g. a *grpc.ClientConn with interceptors or a connection pool, without
+// building a typed gRPC client first.
+func ForwardToByteStreamConn(s runtime.MCPServer, conn grpc.ClientConnInterface, opts ...runtime.Option) {
+	ForwardToByteStreamClient(s, connByteStreamClient{conn}, opts...)
+}
+
+// connByteStreamClient is the ByteStreamClient of
+// ForwardToByteStreamConn.
+type connByteStreamClient struct {
+	conn grpc.ClientConnInterface
+}
+
+func (c connByteStreamClient) QueryWriteStatus(ctx context.Context, req *bytestream.QueryWriteStatusRequest, opts ...grpc.CallOption) (*bytestream.QueryWriteStatusResponse, error) {
+	resp := new(bytestream.QueryWriteStatusResponse)
+	if err := c.conn.Invoke(ctx, "/google.bytestream.ByteStream/QueryWriteStatus", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
@@ -17,11 +17,60 @@ import (
 )
 
 var (
-	IAMPolicy_GetIamPolicyTool       = runtime.Tool{Name: "google_iam_v1_IAMPolicy_GetIamPolicy", Description: "Gets the access control policy for a resource.\nReturns an empty policy if the resource exists and does not have a policy\nset.\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x6c, 0x6f, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0x3a, 0x5b, 0x22, 0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x22, 0x2c, 0x22, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x22, 0x2c, 0x22, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x22, 0x2c, 0x22, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x65, 0x74, 0x61, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x22, 0x2c, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3a, 0x22, 0x62, 0x79, 0x74, 0x65, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
-	IAMPolicy_SetIamPolicyTool       = runtime.Tool{Name: "google_iam_v1_IAMPolicy_SetIamPolicy", Description: "Sets the access control policy on the specified resource. Replaces any\nexisting policy.\n\nCan return `NOT_FOUND`, `INVALID_ARGUMENT`, and `PERMISSION_DENIED` errors.\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x6c, 0x6f, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0x3a, 0x5b, 0x22, 0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x22, 0x2c, 0x22, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x22, 0x2c, 0x22, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x22, 0x2c, 0x22, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x65, 0x74, 0x61, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x22, 0x2c, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3a, 0x22, 0x62, 0x79, 0x74, 0x65, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x2c, 0x22, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x6c, 0x6f, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0x3a, 0x5b, 0x22, 0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x22, 0x2c, 0x22, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x22, 0x2c, 0x22, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x22, 0x2c, 0x22, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x65, 0x74, 0x61, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x22, 0x2c, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3a, 0x22, 0x62, 0x79, 0x74, 0x65, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
-	IAMPolicy_TestIamPermissionsTool = runtime.Tool{Name: "google_iam_v1_IAMPolicy_TestIamPermissions", Description: "Returns permissions that a caller has on the specified resource.\nIf the resource does not exist, this will return an empty set of\npermissions, not a `NOT_FOUND` error.\n\nNote: This operation is designed to be used for building permission-aware\nUIs and command-line tools, not for authorization checking. This operation\nmay \"fail open\" without warning.\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x2c, 0x22, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
+	IAMPolicy_GetIamPolicyTool = runtime.Tool{
+		Name:            "google_iam_v1_IAMPolicy_GetIamPolicy",
+		Description:     "Gets the access control policy for a resource.\nReturns an empty policy if the resource exists and does not have a policy\nset.\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"options":{"properties":{"requested_policy_version":{"type":"integer"}},"required":[],"type":"object"},"resource":{"type":"string"}},"required":["resource"],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"audit_configs":{"items":{"properties":{"audit_log_configs":{"items":{"properties":{"exempted_members":{"items":{"type":"string"},"type":"array"},"log_type":{"enum":["LOG_TYPE_UNSPECIFIED","ADMIN_READ","DATA_WRITE","DATA_READ"],"type":"string"}},"required":[],"type":"object"},"type":"array"},"service":{"type":"string"}},"required":[],"type":"object"},"type":"array"},"bindings":{"items":{"properties":{"condition":{"properties":{"description":{"type":"string"},"expression":{"type":"string"},"location":{"type":"string"},"title":{"type":"string"}},"required":[],"type":"object"},"members":{"items":{"type":"string"},"type":"array"},"role":{"type":"string"}},"required":[],"type":"object"},"type":"array"},"etag":{"contentEncoding":"base64","format":"byte","type":"string"},"version":{"type":"integer"}},"required":[],"type":"object"}`),
+	}
+	IAMPolicy_SetIamPolicyTool = runtime.Tool{
+		Name:            "google_iam_v1_IAMPolicy_SetIamPolicy",
+		Description:     "Sets the access control policy on the specified resource. Replaces any\nexisting policy.\n\nCan return `NOT_FOUND`, `INVALID_ARGUMENT`, and `PERMISSION_DENIED` errors.\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"policy":{"properties":{"audit_configs":{"items":{"properties":{"audit_log_configs":{"items":{"properties":{"exempted_members":{"items":{"type":"string"},"type":"array"},"log_type":{"enum":["LOG_TYPE_UNSPECIFIED","ADMIN_READ","DATA_WRITE","DATA_READ"],"type":"string"}},"required":[],"type":"object"},"type":"array"},"service":{"type":"string"}},"required":[],"type":"object"},"type":"array"},"bindings":{"items":{"properties":{"condition":{"properties":{"description":{"type":"string"},"expression":{"type":"string"},"location":{"type":"string"},"title":{"type":"string"}},"required":[],"type":"object"},"members":{"items":{"type":"string"},"type":"array"},"role":{"type":"string"}},"required":[],"type":"object"},"type":"array"},"etag":{"contentEncoding":"base64","format":"byte","type":"string"},"version":{"type":"integer"}},"required":[],"type":"object"},"resource":{"type":"string"},"update_mask":{"type":"string"}},"required":["resource","policy"],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"audit_configs":{"items":{"properties":{"audit_log_configs":{"items":{"properties":{"exempted_members":{"items":{"type":"string"},"type":"array"},"log_type":{"enum":["LOG_TYPE_UNSPECIFIED","ADMIN_READ","DATA_WRITE","DATA_READ"],"type":"string"}},"required":[],"type":"object"},"type":"array"},"service":{"type":"string"}},"required":[],"type":"object"},"type":"array"},"bindings":{"items":{"properties":{"condition":{"properties":{"description":{"type":"string"},"expression":{"type":"string"},"location":{"type":"string"},"title":{"type":"string"}},"required":[],"type":"object"},"members":{"items":{"type":"string"},"type":"array"},"role":{"type":"string"}},"required":[],"type":"object"},"type":"array"},"etag":{"contentEncoding":"base64","format":"byte","type":"string"},"version":{"type":"integer"}},"required":[],"type":"object"}`),
+	}
+	IAMPolicy_TestIamPermissionsTool = runtime.Tool{
+		Name:            "google_iam_v1_IAMPolicy_TestIamPermissions",
+		Description:     "Returns permissions that a caller has on the specified resource.\nIf the resource does not exist, this will return an empty set of\npermissions, not a `NOT_FOUND` error.\n\nNote: This operation is designed to be used for building permission-aware\nUIs and command-line tools, not for authorization checking. This operation\nmay \"fail open\" without warning.\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"permissions":{"items":{"type":"string"},"type":"array"},"resource":{"type":"string"}},"required":["resource","permissions"],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"permissions":{"items":{"type":"string"},"type":"array"}},"required":[],"type":"object"}`),
+	}
 )
 
+// File_google_iam_v1_iam_policy_proto_GenerationInfo records the
+// generator version and options of the tools above, see
+// runtime.CheckGenerationInfo.
+var File_google_iam_v1_iam_policy_proto_GenerationInfo = runtime.GenerationInfo{
+	Source:  "google/iam/v1/iam_policy.proto",
+	Version: "devel",
+	Options: []string{
+		"deprecated_fields=keep",
+		"dry_run=false",
+		"exclude_deprecated_methods=false",
+		"exclude_fields=",
+		"flatten_nested=false",
+		"openai_strict=false",
+		"relative_times=false",
+		"resources=false",
+		"schema_draft=",
+		"schema_mappings=",
+		"schema_patches=",
+		"wrap_input=",
+	},
+	Cosmetic: []string{
+		"comment_directives=",
+		"comment_markdown=keep",
+		"dedupe_descriptions=false",
+		"examples_in_description=false",
+		"field_comments=none",
+		"max_field_description_bytes=0",
+		"max_tool_description_bytes=0",
+		"minify_schemas=false",
+		"shared_definitions=false",
+		"titles=false",
+	},
+}
+
 // IAMPolicyServer is compatible with the grpc-go server interface.
 type IAMPolicyServer interface {
 	GetIamPolicy(ctx context.Context, req *iampb.GetIamPolicyRequest) (*iampb.Policy, error)
@@ -38,7 +87,7 @@ func RegisterIAMPolicyHandler(s runtime.MCPServer, srv IAMPolicyServer, opts ...
 	GetIamPolicyTool := IAMPolicy_GetIamPolicyTool
 	GetIamPolicyTool = runtime.ApplyConfig(GetIamPolicyTool, config)
 
-	s.AddTool(GetIamPolicyTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, GetIamPolicyTool, runtime.ApplyMutatingHandlerConfig(GetIamPolicyTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req iampb.GetIamPolicyRequest
 
 		message := request.Arguments
@@ -56,6 +105,11 @@ func RegisterIAMPolicyHandler(s runtime.MCPServer, srv IAMPolicyServer, opts ...
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -65,7 +119,9 @@ func RegisterIAMPolicyHandler(s runtime.MCPServer, srv IAMPolicyServer, opts ...
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.GetIamPolicy(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -76,11 +132,11 @@ func RegisterIAMPolicyHandler(s runtime.MCPServer, srv IAMPolicyServer, opts ...
 		}
 
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 	SetIamPolicyTool := IAMPolicy_SetIamPolicyTool
 	SetIamPolicyTool = runtime.ApplyConfig(SetIamPolicyTool, config)
 
-	s.AddTool(SetIamPolicyTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, SetIamPolicyTool, runtime.ApplyMutatingHandlerConfig(SetIamPolicyTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req iampb.SetIamPolicyRequest
 
 		message := request.Arguments
@@ -98,6 +154,11 @@ func RegisterIAMPolicyHandler(s runtime.MCPServer, srv IAMPolicyServer, opts ...
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -107,7 +168,9 @@ func RegisterIAMPolicyHandler(s runtime.MCPServer, srv IAMPolicyServer, opts ...
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.SetIamPolicy(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -118,11 +181,11 @@ func RegisterIAMPolicyHandler(s runtime.MCPServer, srv IAMPolicyServer, opts ...
 		}
 
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 	TestIamPermissionsTool := IAMPolicy_TestIamPermissionsTool
 	TestIamPermissionsTool = runtime.ApplyConfig(TestIamPermissionsTool, config)
 
-	s.AddTool(TestIamPermissionsTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, TestIamPermissionsTool, runtime.ApplyMutatingHandlerConfig(TestIamPermissionsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req iampb.TestIamPermissionsRequest
 
 		message := request.Arguments
@@ -140,6 +203,11 @@ func RegisterIAMPolicyHandler(s runtime.MCPServer, srv IAMPolicyServer, opts ...
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -149,7 +217,9 @@ func RegisterIAMPolicyHandler(s runtime.MCPServer, srv IAMPolicyServer, opts ...
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.TestIamPermissions(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -160,7 +230,7 @@ func RegisterIAMPolicyHandler(s runtime.MCPServer, srv IAMPolicyServer, opts ...
 		}
 
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 }
 
 // IAMPolicyClient is compatible with the grpc-go client interface.
@@ -186,7 +256,7 @@ func ForwardToConnectIAMPolicyClient(s runtime.MCPServer, client ConnectIAMPolic
 	GetIamPolicyTool := IAMPolicy_GetIamPolicyTool
 	GetIamPolicyTool = runtime.ApplyConfig(GetIamPolicyTool, config)
 
-	s.AddTool(GetIamPolicyTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, GetIamPolicyTool, runtime.ApplyMutatingHandlerConfig(GetIamPolicyTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req iampb.GetIamPolicyRequest
 
 		message := request.Arguments
@@ -202,6 +272,11 @@ func ForwardToConnectIAMPolicyClient(s runtime.MCPServer, client ConnectIAMPolic
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -211,7 +286,9 @@ func ForwardToConnectIAMPolicyClient(s runtime.MCPServer, client ConnectIAMPolic
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := client.GetIamPolicy(ctx, connect.NewRequest(&req))
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -221,11 +298,11 @@ func ForwardToConnectIAMPolicyClient(s runtime.MCPServer, client ConnectIAMPolic
 			return nil, err
 		}
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 	SetIamPolicyTool := IAMPolicy_SetIamPolicyTool
 	SetIamPolicyTool = runtime.ApplyConfig(SetIamPolicyTool, config)
 
-	s.AddTool(SetIamPolicyTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, SetIamPolicyTool, runtime.ApplyMutatingHandlerConfig(SetIamPolicyTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req iampb.SetIamPolicyRequest
 
 		message := request.Arguments
@@ -241,6 +318,11 @@ func ForwardToConnectIAMPolicyClient(s runtime.MCPServer, client ConnectIAMPolic
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -250,7 +332,9 @@ func ForwardToConnectIAMPolicyClient(s runtime.MCPServer, client ConnectIAMPolic
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := client.SetIamPolicy(ctx, connect.NewRequest(&req))
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -260,11 +344,11 @@ func ForwardToConnectIAMPolicyClient(s runtime.MCPServer, client ConnectIAMPolic
 			return nil, err
 		}
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 	TestIamPermissionsTool := IAMPolicy_TestIamPermissionsTool
 	TestIamPermissionsTool = runtime.ApplyConfig(TestIamPermissionsTool, config)
 
-	s.AddTool(TestIamPermissionsTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, TestIamPermissionsTool, runtime.ApplyMutatingHandlerConfig(TestIamPermissionsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req iampb.TestIamPermissionsRequest
 
 		message := request.Arguments
@@ -280,6 +364,11 @@ func ForwardToConnectIAMPolicyClient(s runtime.MCPServer, client ConnectIAMPolic
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -289,7 +378,9 @@ func ForwardToConnectIAMPolicyClient(s runtime.MCPServer, client ConnectIAMPolic
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := client.TestIamPermissions(ctx, connect.NewRequest(&req))
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -299,7 +390,65 @@ func ForwardToConnectIAMPolicyClient(s runtime.MCPServer, client ConnectIAMPolic
 			return nil, err
 		}
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
+}
+
+// ForwardToConnectIAMPolicyURL forwards MCP calls to the IAMPolicy at baseURL
+// with a connectrpc client. Select its wire protocol and codec with
+// runtime.WithConnectProtocol and runtime.WithConnectCodec.
+func ForwardToConnectIAMPolicyURL(s runtime.MCPServer, httpClient connect.HTTPClient, baseURL string, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	clientOpts := runtime.ConnectClientOptions(config)
+	ForwardToConnectIAMPolicyClient(s, connectIAMPolicyClient{
+		callGetIamPolicy:       connect.NewClient[iampb.GetIamPolicyRequest, iampb.Policy](httpClient, runtime.ConnectProcedureURL(baseURL, "/google.iam.v1.IAMPolicy/GetIamPolicy"), clientOpts...),
+		callSetIamPolicy:       connect.NewClient[iampb.SetIamPolicyRequest, iampb.Policy](httpClient, runtime.ConnectProcedureURL(baseURL, "/google.iam.v1.IAMPolicy/SetIamPolicy"), clientOpts...),
+		callTestIamPermissions: connect.NewClient[iampb.TestIamPermissionsRequest, iampb.TestIamPermissionsResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/google.iam.v1.IAMPolicy/TestIamPermissions"), clientOpts...),
+	}, opts...)
+}
+
+// connectIAMPolicyClient is the ConnectIAMPolicyClient of
+// ForwardToConnectIAMPolicyURL.
+type connectIAMPolicyClient struct {
+	callGetIamPolicy       *connect.Client[iampb.GetIamPolicyRequest, iampb.Policy]
+	callSetIamPolicy       *connect.Client[iampb.SetIamPolicyRequest, iampb.Policy]
+	callTestIamPermissions *connect.Client[iampb.TestIamPermissionsRequest, iampb.TestIamPermissionsResponse]
+}
+
+func (c connectIAMPolicyClient) GetIamPolicy(ctx context.Context, req *connect.Request[iampb.GetIamPolicyRequest]) (*connect.Response[iampb.Policy], error) {
+	return c.callGetIamPolicy.CallUnary(ctx, req)
+}
+
+func (c connectIAMPolicyClient) SetIamPolicy(ctx context.Context, req *connect.Request[iampb.SetIamPolicyRequest]) (*connect.Response[iampb.Policy], error) {
+	return c.callSetIamPolicy.CallUnary(ctx, req)
+}
+
+func (c connectIAMPolicyClient) TestIamPermissions(ctx context.Context, req *connect.Request[iampb.TestIamPermissionsRequest]) (*connect.Response[iampb.TestIamPermissionsResponse], error) {
+	return c.callTestIamPermissions.CallUnary(ctx, req)
+}
+
+// ConnectIAMPolicyHandler is compatible with the connectrpc-go handler interface.
+type ConnectIAMPolicyHandler interface {
+	GetIamPolicy(ctx context.Context, req *connect.Request[iampb.GetIamPolicyRequest]) (*connect.Response[iampb.Policy], error)
+	SetIamPolicy(ctx context.Context, req *connect.Request[iampb.SetIamPolicyRequest]) (*connect.Response[iampb.Policy], error)
+	TestIamPermissions(ctx context.Context, req *connect.Request[iampb.TestIamPermissionsRequest]) (*connect.Response[iampb.TestIamPermissionsResponse], error)
+}
+
+// ForwardToConnectIAMPolicyHandler forwards MCP calls to a connectrpc handler
+// implementation in the same process by calling its methods directly, without
+// serializing requests. Handler interceptors do not run. Watched resources
+// need a stream, which only a mounted handler can serve: forward to it with
+// ForwardToConnectIAMPolicyURL and runtime.InProcessHTTPClient instead.
+func ForwardToConnectIAMPolicyHandler(s runtime.MCPServer, handler ConnectIAMPolicyHandler, opts ...runtime.Option) {
+	ForwardToConnectIAMPolicyClient(s, handlerIAMPolicyClient{handler}, opts...)
+}
+
+// handlerIAMPolicyClient is the ConnectIAMPolicyClient of
+// ForwardToConnectIAMPolicyHandler.
+type handlerIAMPolicyClient struct {
+	ConnectIAMPolicyHandler
 }
 
 // ForwardToIAMPolicyClient registers a gRPC client, to forward MCP calls to it.
@@ -311,7 +460,7 @@ func ForwardToIAMPolicyClient(s runtime.MCPServer, client IAMPolicyClient, opts
 	GetIamPolicyTool := IAMPolicy_GetIamPolicyTool
 	GetIamPolicyTool = runtime.ApplyConfig(GetIamPolicyTool, config)
 
-	s.AddTool(GetIamPolicyTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, GetIamPolicyTool, runtime.ApplyMutatingHandlerConfig(GetIamPolicyTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req iampb.GetIamPolicyRequest
 
 		message := request.Arguments
@@ -327,6 +476,11 @@ func ForwardToIAMPolicyClient(s runtime.MCPServer, client IAMPolicyClient, opts
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -336,7 +490,9 @@ func ForwardToIAMPolicyClient(s runtime.MCPServer, client IAMPolicyClient, opts
 			return nil, err
 		}
 
-		resp, err := client.GetIamPolicy(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.GetIamPolicy(ctx, &req, runtime.ApplyCallOptions(ctx, GetIamPolicyTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -346,11 +502,11 @@ func ForwardToIAMPolicyClient(s runtime.MCPServer, client IAMPolicyClient, opts
 			return nil, err
 		}
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 	SetIamPolicyTool := IAMPolicy_SetIamPolicyTool
 	SetIamPolicyTool = runtime.ApplyConfig(SetIamPolicyTool, config)
 
-	s.AddTool(SetIamPolicyTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, SetIamPolicyTool, runtime.ApplyMutatingHandlerConfig(SetIamPolicyTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req iampb.SetIamPolicyRequest
 
 		message := request.Arguments
@@ -366,6 +522,11 @@ func ForwardToIAMPolicyClient(s runtime.MCPServer, client IAMPolicyClient, opts
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -375,7 +536,9 @@ func ForwardToIAMPolicyClient(s runtime.MCPServer, client IAMPolicyClient, opts
 			return nil, err
 		}
 
-		resp, err := client.SetIamPolicy(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.SetIamPolicy(ctx, &req, runtime.ApplyCallOptions(ctx, SetIamPolicyTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -385,11 +548,11 @@ func ForwardToIAMPolicyClient(s runtime.MCPServer, client IAMPolicyClient, opts
 			return nil, err
 		}
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 	TestIamPermissionsTool := IAMPolicy_TestIamPermissionsTool
 	TestIamPermissionsTool = runtime.ApplyConfig(TestIamPermissionsTool, config)
 
-	s.AddTool(TestIamPermissionsTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, TestIamPermissionsTool, runtime.ApplyMutatingHandlerConfig(TestIamPermissionsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req iampb.TestIamPermissionsRequest
 
 		message := request.Arguments
@@ -405,6 +568,11 @@ func ForwardToIAMPolicyClient(s runtime.MCPServer, client IAMPolicyClient, opts
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -414,7 +582,9 @@ func ForwardToIAMPolicyClient(s runtime.MCPServer, client IAMPolicyClient, opts
 			return nil, err
 		}
 
-		resp, err := client.TestIamPermissions(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.TestIamPermissions(ctx, &req, runtime.ApplyCallOptions(ctx, TestIamPermissionsTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -424,5 +594,80 @@ func ForwardToIAMPolicyClient(s runtime.MCPServer, client IAMPolicyClient, opts
 			return nil, err
 		}
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
+}
+
+// ForwardToIAMPolicyConn forwards MCP calls to the IAMPolicy behind conn,
+// e.g. a *grpc.ClientConn with interceptors or a connection pool, without
+// building a typed gRPC client first.
+func ForwardToIAMPolicyConn(s runtime.MCPServer, conn grpc.ClientConnInterface, opts ...runtime.Option) {
+	ForwardToIAMPolicyClient(s, connIAMPolicyClient{conn}, opts...)
+}
+
+// connIAMPolicyClient is the IAMPolicyClient of
+// ForwardToIAMPolicyConn.
+type connIAMPolicyClient struct {
+	conn grpc.ClientConnInterface
+}
+
+func (c connIAMPolicyClient) GetIamPolicy(ctx context.Context, req *iampb.GetIamPolicyRequest, opts ...grpc.CallOption) (*iampb.Policy, error) {
+	resp := new(iampb.Policy)
+	if err := c.conn.Invoke(ctx, "/google.iam.v1.IAMPolicy/GetIamPolicy", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connIAMPolicyClient) SetIamPolicy(ctx context.Context, req *iampb.SetIamPolicyRequest, opts ...grpc.CallOption) (*iampb.Policy, error) {
+	resp := new(iampb.Policy)
+	if err := c.conn.Invoke(ctx, "/google.iam.v1.IAMPolicy/SetIamPolicy", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connIAMPolicyClient) TestIamPermissions(ctx context.Context, req *iampb.TestIamPermissionsRequest, opts ...grpc.CallOption) (*iampb.TestIamPermissionsResponse, error) {
+	resp := new(iampb.TestIamPermissionsResponse)
+	if err := c.conn.Invoke(ctx, "/google.iam.v1.IAMPolicy/TestIamPermissions", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ForwardToIAMPolicyHTTP forwards MCP calls to the JSON gateway of IAMPolicy
+// at baseURL, through the google.api.http bindings of its RPCs. RPCs without
+// a binding fail with UNIMPLEMENTED. See runtime.CallHTTP.
+func ForwardToIAMPolicyHTTP(s runtime.MCPServer, client runtime.HTTPClient, baseURL string, opts ...runtime.Option) {
+	ForwardToIAMPolicyClient(s, httpIAMPolicyClient{client: client, baseURL: baseURL}, opts...)
+}
+
+// httpIAMPolicyClient is the IAMPolicyClient of
+// ForwardToIAMPolicyHTTP.
+type httpIAMPolicyClient struct {
+	client  runtime.HTTPClient
+	baseURL string
+}
+
+func (c httpIAMPolicyClient) GetIamPolicy(ctx context.Context, req *iampb.GetIamPolicyRequest, _ ...grpc.CallOption) (*iampb.Policy, error) {
+	var resp iampb.Policy
+	if err := runtime.CallHTTP(ctx, c.client, c.baseURL, runtime.HTTPBinding{Method: "POST", Path: "/v1/{resource=**}:getIamPolicy", Body: "*", ResponseBody: ""}, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c httpIAMPolicyClient) SetIamPolicy(ctx context.Context, req *iampb.SetIamPolicyRequest, _ ...grpc.CallOption) (*iampb.Policy, error) {
+	var resp iampb.Policy
+	if err := runtime.CallHTTP(ctx, c.client, c.baseURL, runtime.HTTPBinding{Method: "POST", Path: "/v1/{resource=**}:setIamPolicy", Body: "*", ResponseBody: ""}, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c httpIAMPolicyClient) TestIamPermissions(ctx context.Context, req *iampb.TestIamPermissionsRequest, _ ...grpc.CallOption) (*iampb.TestIamPermissionsResponse, error) {
+	var resp iampb.TestIamPermissionsResponse
+	if err := runtime.CallHTTP(ctx, c.client, c.baseURL, runtime.HTTPBinding{Method: "POST", Path: "/v1/{resource=**}:testIamPermissions", Body: "*", ResponseBody: ""}, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
 }
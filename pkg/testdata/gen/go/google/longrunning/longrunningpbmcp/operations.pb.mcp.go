@@ -18,13 +18,72 @@ import (
 )
 
 var (
-	Operations_CancelOperationTool = runtime.Tool{Name: "google_longrunning_Operations_CancelOperation", Description: "Starts asynchronous cancellation on a long-running operation.  The server\nmakes a best effort to cancel the operation, but success is not\nguaranteed.  If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.  Clients can use\n[Operations.GetOperation][google.longrunning.Operations.GetOperation] or\nother methods to check whether the cancellation succeeded or whether the\noperation completed despite cancellation. On successful cancellation,\nthe operation is not deleted; instead, it becomes an operation with\nan [Operation.error][google.longrunning.Operation.error] value with a\n[google.rpc.Status.code][google.rpc.Status.code] of `1`, corresponding to\n`Code.CANCELLED`.\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
-	Operations_DeleteOperationTool = runtime.Tool{Name: "google_longrunning_Operations_DeleteOperation", Description: "Deletes a long-running operation. This method indicates that the client is\nno longer interested in the operation result. It does not cancel the\noperation. If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
-	Operations_GetOperationTool    = runtime.Tool{Name: "google_longrunning_Operations_GetOperation", Description: "Gets the latest state of a long-running operation.  Clients can use this\nmethod to poll the operation result at intervals as recommended by the API\nservice.\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x6f, 0x6e, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x45, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x5c, 0x22, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5c, 0x22, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x20, 0x53, 0x65, 0x74, 0x20, 0x5c, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x5c, 0x22, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x65, 0x74, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x57, 0x68, 0x69, 0x63, 0x68, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x5c, 0x22, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5c, 0x22, 0x20, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x20, 0x69, 0x73, 0x20, 0x73, 0x65, 0x74, 0x2e, 0x22, 0x2c, 0x22, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0x3a, 0x5b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2c, 0x22, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x3d, 0x5c, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5c, 0x22, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x3d, 0x5c, 0x22, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5c, 0x22, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
-	Operations_ListOperationsTool  = runtime.Tool{Name: "google_longrunning_Operations_ListOperations", Description: "Lists operations that match the specified filter in the request. If the\nserver doesn't support this method, it returns `UNIMPLEMENTED`.\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x6f, 0x6e, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x45, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x5c, 0x22, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5c, 0x22, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x20, 0x53, 0x65, 0x74, 0x20, 0x5c, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x5c, 0x22, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x65, 0x74, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x57, 0x68, 0x69, 0x63, 0x68, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x5c, 0x22, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5c, 0x22, 0x20, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x20, 0x69, 0x73, 0x20, 0x73, 0x65, 0x74, 0x2e, 0x22, 0x2c, 0x22, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0x3a, 0x5b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2c, 0x22, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x3d, 0x5c, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5c, 0x22, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x3d, 0x5c, 0x22, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5c, 0x22, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
-	Operations_WaitOperationTool   = runtime.Tool{Name: "google_longrunning_Operations_WaitOperation", Description: "Waits until the specified long-running operation is done or reaches at most\na specified timeout, returning the latest state.  If the operation is\nalready done, the latest state is immediately returned.  If the timeout\nspecified is greater than the default HTTP/RPC timeout, the HTTP/RPC\ntimeout is used.  If the server does not support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\nNote that this method is on a best-effort basis.  It may return the latest\nstate before the specified timeout (including immediately), meaning even an\nimmediate response is no guarantee that the operation is done.\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x3a, 0x22, 0x5e, 0x2d, 0x3f, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x28, 0x5c, 0x5c, 0x2e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x29, 0x3f, 0x73, 0x24, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x6f, 0x6e, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x45, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x5c, 0x22, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5c, 0x22, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x20, 0x53, 0x65, 0x74, 0x20, 0x5c, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x5c, 0x22, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x65, 0x74, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x57, 0x68, 0x69, 0x63, 0x68, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x5c, 0x22, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5c, 0x22, 0x20, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x20, 0x69, 0x73, 0x20, 0x73, 0x65, 0x74, 0x2e, 0x22, 0x2c, 0x22, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0x3a, 0x5b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2c, 0x22, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x3d, 0x5c, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5c, 0x22, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x3d, 0x5c, 0x22, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5c, 0x22, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
+	Operations_CancelOperationTool = runtime.Tool{
+		Name:            "google_longrunning_Operations_CancelOperation",
+		Description:     "Starts asynchronous cancellation on a long-running operation.  The server\nmakes a best effort to cancel the operation, but success is not\nguaranteed.  If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.  Clients can use\n[Operations.GetOperation][google.longrunning.Operations.GetOperation] or\nother methods to check whether the cancellation succeeded or whether the\noperation completed despite cancellation. On successful cancellation,\nthe operation is not deleted; instead, it becomes an operation with\nan [Operation.error][google.longrunning.Operation.error] value with a\n[google.rpc.Status.code][google.rpc.Status.code] of `1`, corresponding to\n`Code.CANCELLED`.\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"name":{"type":"string"}},"required":[],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{},"required":[],"type":"object"}`),
+	}
+	Operations_DeleteOperationTool = runtime.Tool{
+		Name:            "google_longrunning_Operations_DeleteOperation",
+		Description:     "Deletes a long-running operation. This method indicates that the client is\nno longer interested in the operation result. It does not cancel the\noperation. If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"name":{"type":"string"}},"required":[],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{},"required":[],"type":"object"}`),
+	}
+	Operations_GetOperationTool = runtime.Tool{
+		Name:            "google_longrunning_Operations_GetOperation",
+		Description:     "Gets the latest state of a long-running operation.  Clients can use this\nmethod to poll the operation result at intervals as recommended by the API\nservice.\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"name":{"type":"string"}},"required":[],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"done":{"type":"boolean"},"metadata":{"properties":{"@type":{"type":"string"},"value":{}},"required":["@type"],"type":["object","null"]},"name":{"type":"string"},"result":{"description":"Exactly one of the \"result\" group. Set \"which\" to the chosen field name, then set only that field.","properties":{"which":{"description":"Which field of the \"result\" oneof is set.","enum":["error","response"],"type":"string"},"error":{"description":"The value when which=\"error\".","properties":{"code":{"type":"integer"},"details":{"items":{"properties":{"@type":{"type":"string"},"value":{}},"required":["@type"],"type":["object","null"]},"type":"array"},"message":{"type":"string"}},"required":[],"type":"object"},"response":{"description":"The value when which=\"response\".","properties":{"@type":{"type":"string"},"value":{}},"required":["@type"],"type":["object","null"]}},"required":["which"],"type":"object"}},"required":[],"type":"object"}`),
+	}
+	Operations_ListOperationsTool = runtime.Tool{
+		Name:            "google_longrunning_Operations_ListOperations",
+		Description:     "Lists operations that match the specified filter in the request. If the\nserver doesn't support this method, it returns `UNIMPLEMENTED`.\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"filter":{"type":"string"},"name":{"type":"string"},"page_size":{"type":"integer"},"page_token":{"type":"string"},"return_partial_success":{"type":"boolean"}},"required":[],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"next_page_token":{"type":"string"},"operations":{"items":{"properties":{"done":{"type":"boolean"},"metadata":{"properties":{"@type":{"type":"string"},"value":{}},"required":["@type"],"type":["object","null"]},"name":{"type":"string"},"result":{"description":"Exactly one of the \"result\" group. Set \"which\" to the chosen field name, then set only that field.","properties":{"which":{"description":"Which field of the \"result\" oneof is set.","enum":["error","response"],"type":"string"},"error":{"description":"The value when which=\"error\".","properties":{"code":{"type":"integer"},"details":{"items":{"properties":{"@type":{"type":"string"},"value":{}},"required":["@type"],"type":["object","null"]},"type":"array"},"message":{"type":"string"}},"required":[],"type":"object"},"response":{"description":"The value when which=\"response\".","properties":{"@type":{"type":"string"},"value":{}},"required":["@type"],"type":["object","null"]}},"required":["which"],"type":"object"}},"required":[],"type":"object"},"type":"array"},"unreachable":{"items":{"type":"string"},"type":"array"}},"required":[],"type":"object"}`),
+	}
+	Operations_WaitOperationTool = runtime.Tool{
+		Name:            "google_longrunning_Operations_WaitOperation",
+		Description:     "Waits until the specified long-running operation is done or reaches at most\na specified timeout, returning the latest state.  If the operation is\nalready done, the latest state is immediately returned.  If the timeout\nspecified is greater than the default HTTP/RPC timeout, the HTTP/RPC\ntimeout is used.  If the server does not support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\nNote that this method is on a best-effort basis.  It may return the latest\nstate before the specified timeout (including immediately), meaning even an\nimmediate response is no guarantee that the operation is done.\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"name":{"type":"string"},"timeout":{"pattern":"^-?[0-9]+(\\.[0-9]+)?s$","type":["string","null"]}},"required":[],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"done":{"type":"boolean"},"metadata":{"properties":{"@type":{"type":"string"},"value":{}},"required":["@type"],"type":["object","null"]},"name":{"type":"string"},"result":{"description":"Exactly one of the \"result\" group. Set \"which\" to the chosen field name, then set only that field.","properties":{"which":{"description":"Which field of the \"result\" oneof is set.","enum":["error","response"],"type":"string"},"error":{"description":"The value when which=\"error\".","properties":{"code":{"type":"integer"},"details":{"items":{"properties":{"@type":{"type":"string"},"value":{}},"required":["@type"],"type":["object","null"]},"type":"array"},"message":{"type":"string"}},"required":[],"type":"object"},"response":{"description":"The value when which=\"response\".","properties":{"@type":{"type":"string"},"value":{}},"required":["@type"],"type":["object","null"]}},"required":["which"],"type":"object"}},"required":[],"type":"object"}`),
+	}
 )
 
+// File_google_longrunning_operations_proto_GenerationInfo records the
+// generator version and options of the tools above, see
+// runtime.CheckGenerationInfo.
+var File_google_longrunning_operations_proto_GenerationInfo = runtime.GenerationInfo{
+	Source:  "google/longrunning/operations.proto",
+	Version: "devel",
+	Options: []string{
+		"deprecated_fields=keep",
+		"dry_run=false",
+		"exclude_deprecated_methods=false",
+		"exclude_fields=",
+		"flatten_nested=false",
+		"openai_strict=false",
+		"relative_times=false",
+		"resources=false",
+		"schema_draft=",
+		"schema_mappings=",
+		"schema_patches=",
+		"wrap_input=",
+	},
+	Cosmetic: []string{
+		"comment_directives=",
+		"comment_markdown=keep",
+		"dedupe_descriptions=false",
+		"examples_in_description=false",
+		"field_comments=none",
+		"max_field_description_bytes=0",
+		"max_tool_description_bytes=0",
+		"minify_schemas=false",
+		"shared_definitions=false",
+		"titles=false",
+	},
+}
+
 // OperationsServer is compatible with the grpc-go server interface.
 type OperationsServer interface {
 	CancelOperation(ctx context.Context, req *longrunningpb.CancelOperationRequest) (*emptypb.Empty, error)
@@ -43,7 +102,7 @@ func RegisterOperationsHandler(s runtime.MCPServer, srv OperationsServer, opts .
 	CancelOperationTool := Operations_CancelOperationTool
 	CancelOperationTool = runtime.ApplyConfig(CancelOperationTool, config)
 
-	s.AddTool(CancelOperationTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, CancelOperationTool, runtime.ApplyMutatingHandlerConfig(CancelOperationTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req longrunningpb.CancelOperationRequest
 
 		message := request.Arguments
@@ -61,6 +120,11 @@ func RegisterOperationsHandler(s runtime.MCPServer, srv OperationsServer, opts .
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -70,7 +134,9 @@ func RegisterOperationsHandler(s runtime.MCPServer, srv OperationsServer, opts .
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.CancelOperation(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -81,11 +147,11 @@ func RegisterOperationsHandler(s runtime.MCPServer, srv OperationsServer, opts .
 		}
 
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 	DeleteOperationTool := Operations_DeleteOperationTool
 	DeleteOperationTool = runtime.ApplyConfig(DeleteOperationTool, config)
 
-	s.AddTool(DeleteOperationTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, DeleteOperationTool, runtime.ApplyMutatingHandlerConfig(DeleteOperationTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req longrunningpb.DeleteOperationRequest
 
 		message := request.Arguments
@@ -103,6 +169,11 @@ func RegisterOperationsHandler(s runtime.MCPServer, srv OperationsServer, opts .
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -112,7 +183,9 @@ func RegisterOperationsHandler(s runtime.MCPServer, srv OperationsServer, opts .
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.DeleteOperation(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -123,11 +196,11 @@ func RegisterOperationsHandler(s runtime.MCPServer, srv OperationsServer, opts .
 		}
 
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 	GetOperationTool := Operations_GetOperationTool
 	GetOperationTool = runtime.ApplyConfig(GetOperationTool, config)
 
-	s.AddTool(GetOperationTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, GetOperationTool, runtime.ApplyMutatingHandlerConfig(GetOperationTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req longrunningpb.GetOperationRequest
 
 		message := request.Arguments
@@ -145,6 +218,11 @@ func RegisterOperationsHandler(s runtime.MCPServer, srv OperationsServer, opts .
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -154,7 +232,9 @@ func RegisterOperationsHandler(s runtime.MCPServer, srv OperationsServer, opts .
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.GetOperation(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -165,11 +245,11 @@ func RegisterOperationsHandler(s runtime.MCPServer, srv OperationsServer, opts .
 		}
 
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 	ListOperationsTool := Operations_ListOperationsTool
 	ListOperationsTool = runtime.ApplyConfig(ListOperationsTool, config)
 
-	s.AddTool(ListOperationsTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, ListOperationsTool, runtime.ApplyMutatingHandlerConfig(ListOperationsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req longrunningpb.ListOperationsRequest
 
 		message := request.Arguments
@@ -187,6 +267,11 @@ func RegisterOperationsHandler(s runtime.MCPServer, srv OperationsServer, opts .
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -196,7 +281,9 @@ func RegisterOperationsHandler(s runtime.MCPServer, srv OperationsServer, opts .
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.ListOperations(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -207,11 +294,11 @@ func RegisterOperationsHandler(s runtime.MCPServer, srv OperationsServer, opts .
 		}
 
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 	WaitOperationTool := Operations_WaitOperationTool
 	WaitOperationTool = runtime.ApplyConfig(WaitOperationTool, config)
 
-	s.AddTool(WaitOperationTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, WaitOperationTool, runtime.ApplyMutatingHandlerConfig(WaitOperationTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req longrunningpb.WaitOperationRequest
 
 		message := request.Arguments
@@ -229,6 +316,11 @@ func RegisterOperationsHandler(s runtime.MCPServer, srv OperationsServer, opts .
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -238,7 +330,9 @@ func RegisterOperationsHandler(s runtime.MCPServer, srv OperationsServer, opts .
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.WaitOperation(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -249,7 +343,7 @@ func RegisterOperationsHandler(s runtime.MCPServer, srv OperationsServer, opts .
 		}
 
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 }
 
 // OperationsClient is compatible with the grpc-go client interface.
@@ -279,7 +373,7 @@ func ForwardToConnectOperationsClient(s runtime.MCPServer, client ConnectOperati
 	CancelOperationTool := Operations_CancelOperationTool
 	CancelOperationTool = runtime.ApplyConfig(CancelOperationTool, config)
 
-	s.AddTool(CancelOperationTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, CancelOperationTool, runtime.ApplyMutatingHandlerConfig(CancelOperationTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req longrunningpb.CancelOperationRequest
 
 		message := request.Arguments
@@ -295,6 +389,11 @@ func ForwardToConnectOperationsClient(s runtime.MCPServer, client ConnectOperati
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -304,7 +403,9 @@ func ForwardToConnectOperationsClient(s runtime.MCPServer, client ConnectOperati
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := client.CancelOperation(ctx, connect.NewRequest(&req))
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -314,11 +415,11 @@ func ForwardToConnectOperationsClient(s runtime.MCPServer, client ConnectOperati
 			return nil, err
 		}
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 	DeleteOperationTool := Operations_DeleteOperationTool
 	DeleteOperationTool = runtime.ApplyConfig(DeleteOperationTool, config)
 
-	s.AddTool(DeleteOperationTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, DeleteOperationTool, runtime.ApplyMutatingHandlerConfig(DeleteOperationTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req longrunningpb.DeleteOperationRequest
 
 		message := request.Arguments
@@ -334,6 +435,11 @@ func ForwardToConnectOperationsClient(s runtime.MCPServer, client ConnectOperati
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -343,7 +449,9 @@ func ForwardToConnectOperationsClient(s runtime.MCPServer, client ConnectOperati
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := client.DeleteOperation(ctx, connect.NewRequest(&req))
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -353,11 +461,11 @@ func ForwardToConnectOperationsClient(s runtime.MCPServer, client ConnectOperati
 			return nil, err
 		}
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 	GetOperationTool := Operations_GetOperationTool
 	GetOperationTool = runtime.ApplyConfig(GetOperationTool, config)
 
-	s.AddTool(GetOperationTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, GetOperationTool, runtime.ApplyMutatingHandlerConfig(GetOperationTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req longrunningpb.GetOperationRequest
 
 		message := request.Arguments
@@ -373,6 +481,11 @@ func ForwardToConnectOperationsClient(s runtime.MCPServer, client ConnectOperati
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -382,7 +495,9 @@ func ForwardToConnectOperationsClient(s runtime.MCPServer, client ConnectOperati
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := client.GetOperation(ctx, connect.NewRequest(&req))
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -392,11 +507,11 @@ func ForwardToConnectOperationsClient(s runtime.MCPServer, client ConnectOperati
 			return nil, err
 		}
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 	ListOperationsTool := Operations_ListOperationsTool
 	ListOperationsTool = runtime.ApplyConfig(ListOperationsTool, config)
 
-	s.AddTool(ListOperationsTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, ListOperationsTool, runtime.ApplyMutatingHandlerConfig(ListOperationsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req longrunningpb.ListOperationsRequest
 
 		message := request.Arguments
@@ -412,6 +527,11 @@ func ForwardToConnectOperationsClient(s runtime.MCPServer, client ConnectOperati
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -421,7 +541,9 @@ func ForwardToConnectOperationsClient(s runtime.MCPServer, client ConnectOperati
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := client.ListOperations(ctx, connect.NewRequest(&req))
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -431,11 +553,11 @@ func ForwardToConnectOperationsClient(s runtime.MCPServer, client ConnectOperati
 			return nil, err
 		}
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 	WaitOperationTool := Operations_WaitOperationTool
 	WaitOperationTool = runtime.ApplyConfig(WaitOperationTool, config)
 
-	s.AddTool(WaitOperationTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, WaitOperationTool, runtime.ApplyMutatingHandlerConfig(WaitOperationTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req longrunningpb.WaitOperationRequest
 
 		message := request.Arguments
@@ -451,6 +573,11 @@ func ForwardToConnectOperationsClient(s runtime.MCPServer, client ConnectOperati
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -460,7 +587,9 @@ func ForwardToConnectOperationsClient(s runtime.MCPServer, client ConnectOperati
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := client.WaitOperation(ctx, connect.NewRequest(&req))
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -470,7 +599,79 @@ func ForwardToConnectOperationsClient(s runtime.MCPServer, client ConnectOperati
 			return nil, err
 		}
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
+}
+
+// ForwardToConnectOperationsURL forwards MCP calls to the Operations at baseURL
+// with a connectrpc client. Select its wire protocol and codec with
+// runtime.WithConnectProtocol and runtime.WithConnectCodec.
+func ForwardToConnectOperationsURL(s runtime.MCPServer, httpClient connect.HTTPClient, baseURL string, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	clientOpts := runtime.ConnectClientOptions(config)
+	ForwardToConnectOperationsClient(s, connectOperationsClient{
+		callCancelOperation: connect.NewClient[longrunningpb.CancelOperationRequest, emptypb.Empty](httpClient, runtime.ConnectProcedureURL(baseURL, "/google.longrunning.Operations/CancelOperation"), clientOpts...),
+		callDeleteOperation: connect.NewClient[longrunningpb.DeleteOperationRequest, emptypb.Empty](httpClient, runtime.ConnectProcedureURL(baseURL, "/google.longrunning.Operations/DeleteOperation"), clientOpts...),
+		callGetOperation:    connect.NewClient[longrunningpb.GetOperationRequest, longrunningpb.Operation](httpClient, runtime.ConnectProcedureURL(baseURL, "/google.longrunning.Operations/GetOperation"), clientOpts...),
+		callListOperations:  connect.NewClient[longrunningpb.ListOperationsRequest, longrunningpb.ListOperationsResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/google.longrunning.Operations/ListOperations"), clientOpts...),
+		callWaitOperation:   connect.NewClient[longrunningpb.WaitOperationRequest, longrunningpb.Operation](httpClient, runtime.ConnectProcedureURL(baseURL, "/google.longrunning.Operations/WaitOperation"), clientOpts...),
+	}, opts...)
+}
+
+// connectOperationsClient is the ConnectOperationsClient of
+// ForwardToConnectOperationsURL.
+type connectOperationsClient struct {
+	callCancelOperation *connect.Client[longrunningpb.CancelOperationRequest, emptypb.Empty]
+	callDeleteOperation *connect.Client[longrunningpb.DeleteOperationRequest, emptypb.Empty]
+	callGetOperation    *connect.Client[longrunningpb.GetOperationRequest, longrunningpb.Operation]
+	callListOperations  *connect.Client[longrunningpb.ListOperationsRequest, longrunningpb.ListOperationsResponse]
+	callWaitOperation   *connect.Client[longrunningpb.WaitOperationRequest, longrunningpb.Operation]
+}
+
+func (c connectOperationsClient) CancelOperation(ctx context.Context, req *connect.Request[longrunningpb.CancelOperationRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.callCancelOperation.CallUnary(ctx, req)
+}
+
+func (c connectOperationsClient) DeleteOperation(ctx context.Context, req *connect.Request[longrunningpb.DeleteOperationRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.callDeleteOperation.CallUnary(ctx, req)
+}
+
+func (c connectOperationsClient) GetOperation(ctx context.Context, req *connect.Request[longrunningpb.GetOperationRequest]) (*connect.Response[longrunningpb.Operation], error) {
+	return c.callGetOperation.CallUnary(ctx, req)
+}
+
+func (c connectOperationsClient) ListOperations(ctx context.Context, req *connect.Request[longrunningpb.ListOperationsRequest]) (*connect.Response[longrunningpb.ListOperationsResponse], error) {
+	return c.callListOperations.CallUnary(ctx, req)
+}
+
+func (c connectOperationsClient) WaitOperation(ctx context.Context, req *connect.Request[longrunningpb.WaitOperationRequest]) (*connect.Response[longrunningpb.Operation], error) {
+	return c.callWaitOperation.CallUnary(ctx, req)
+}
+
+// ConnectOperationsHandler is compatible with the connectrpc-go handler interface.
+type ConnectOperationsHandler interface {
+	CancelOperation(ctx context.Context, req *connect.Request[longrunningpb.CancelOperationRequest]) (*connect.Response[emptypb.Empty], error)
+	DeleteOperation(ctx context.Context, req *connect.Request[longrunningpb.DeleteOperationRequest]) (*connect.Response[emptypb.Empty], error)
+	GetOperation(ctx context.Context, req *connect.Request[longrunningpb.GetOperationRequest]) (*connect.Response[longrunningpb.Operation], error)
+	ListOperations(ctx context.Context, req *connect.Request[longrunningpb.ListOperationsRequest]) (*connect.Response[longrunningpb.ListOperationsResponse], error)
+	WaitOperation(ctx context.Context, req *connect.Request[longrunningpb.WaitOperationRequest]) (*connect.Response[longrunningpb.Operation], error)
+}
+
+// ForwardToConnectOperationsHandler forwards MCP calls to a connectrpc handler
+// implementation in the same process by calling its methods directly, without
+// serializing requests. Handler interceptors do not run. Watched resources
+// need a stream, which only a mounted handler can serve: forward to it with
+// ForwardToConnectOperationsURL and runtime.InProcessHTTPClient instead.
+func ForwardToConnectOperationsHandler(s runtime.MCPServer, handler ConnectOperationsHandler, opts ...runtime.Option) {
+	ForwardToConnectOperationsClient(s, handlerOperationsClient{handler}, opts...)
+}
+
+// handlerOperationsClient is the ConnectOperationsClient of
+// ForwardToConnectOperationsHandler.
+type handlerOperationsClient struct {
+	ConnectOperationsHandler
 }
 
 // ForwardToOperationsClient registers a gRPC client, to forward MCP calls to it.
@@ -482,7 +683,7 @@ func ForwardToOperationsClient(s runtime.MCPServer, client OperationsClient, opt
 	CancelOperationTool := Operations_CancelOperationTool
 	CancelOperationTool = runtime.ApplyConfig(CancelOperationTool, config)
 
-	s.AddTool(CancelOperationTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, CancelOperationTool, runtime.ApplyMutatingHandlerConfig(CancelOperationTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req longrunningpb.CancelOperationRequest
 
 		message := request.Arguments
@@ -498,6 +699,11 @@ func ForwardToOperationsClient(s runtime.MCPServer, client OperationsClient, opt
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -507,7 +713,9 @@ func ForwardToOperationsClient(s runtime.MCPServer, client OperationsClient, opt
 			return nil, err
 		}
 
-		resp, err := client.CancelOperation(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.CancelOperation(ctx, &req, runtime.ApplyCallOptions(ctx, CancelOperationTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -517,11 +725,11 @@ func ForwardToOperationsClient(s runtime.MCPServer, client OperationsClient, opt
 			return nil, err
 		}
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 	DeleteOperationTool := Operations_DeleteOperationTool
 	DeleteOperationTool = runtime.ApplyConfig(DeleteOperationTool, config)
 
-	s.AddTool(DeleteOperationTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, DeleteOperationTool, runtime.ApplyMutatingHandlerConfig(DeleteOperationTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req longrunningpb.DeleteOperationRequest
 
 		message := request.Arguments
@@ -537,6 +745,11 @@ func ForwardToOperationsClient(s runtime.MCPServer, client OperationsClient, opt
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -546,7 +759,9 @@ func ForwardToOperationsClient(s runtime.MCPServer, client OperationsClient, opt
 			return nil, err
 		}
 
-		resp, err := client.DeleteOperation(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.DeleteOperation(ctx, &req, runtime.ApplyCallOptions(ctx, DeleteOperationTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -556,11 +771,11 @@ func ForwardToOperationsClient(s runtime.MCPServer, client OperationsClient, opt
 			return nil, err
 		}
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 	GetOperationTool := Operations_GetOperationTool
 	GetOperationTool = runtime.ApplyConfig(GetOperationTool, config)
 
-	s.AddTool(GetOperationTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, GetOperationTool, runtime.ApplyMutatingHandlerConfig(GetOperationTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req longrunningpb.GetOperationRequest
 
 		message := request.Arguments
@@ -576,6 +791,11 @@ func ForwardToOperationsClient(s runtime.MCPServer, client OperationsClient, opt
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -585,7 +805,9 @@ func ForwardToOperationsClient(s runtime.MCPServer, client OperationsClient, opt
 			return nil, err
 		}
 
-		resp, err := client.GetOperation(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.GetOperation(ctx, &req, runtime.ApplyCallOptions(ctx, GetOperationTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -595,11 +817,11 @@ func ForwardToOperationsClient(s runtime.MCPServer, client OperationsClient, opt
 			return nil, err
 		}
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 	ListOperationsTool := Operations_ListOperationsTool
 	ListOperationsTool = runtime.ApplyConfig(ListOperationsTool, config)
 
-	s.AddTool(ListOperationsTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, ListOperationsTool, runtime.ApplyMutatingHandlerConfig(ListOperationsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req longrunningpb.ListOperationsRequest
 
 		message := request.Arguments
@@ -615,6 +837,11 @@ func ForwardToOperationsClient(s runtime.MCPServer, client OperationsClient, opt
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -624,7 +851,9 @@ func ForwardToOperationsClient(s runtime.MCPServer, client OperationsClient, opt
 			return nil, err
 		}
 
-		resp, err := client.ListOperations(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.ListOperations(ctx, &req, runtime.ApplyCallOptions(ctx, ListOperationsTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -634,11 +863,11 @@ func ForwardToOperationsClient(s runtime.MCPServer, client OperationsClient, opt
 			return nil, err
 		}
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
 	WaitOperationTool := Operations_WaitOperationTool
 	WaitOperationTool = runtime.ApplyConfig(WaitOperationTool, config)
 
-	s.AddTool(WaitOperationTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	runtime.AddTool(s, config, WaitOperationTool, runtime.ApplyMutatingHandlerConfig(WaitOperationTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req longrunningpb.WaitOperationRequest
 
 		message := request.Arguments
@@ -654,6 +883,11 @@ func ForwardToOperationsClient(s runtime.MCPServer, client OperationsClient, opt
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -663,7 +897,9 @@ func ForwardToOperationsClient(s runtime.MCPServer, client OperationsClient, opt
 			return nil, err
 		}
 
-		resp, err := client.WaitOperation(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.WaitOperation(ctx, &req, runtime.ApplyCallOptions(ctx, WaitOperationTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -673,5 +909,108 @@ func ForwardToOperationsClient(s runtime.MCPServer, client OperationsClient, opt
 			return nil, err
 		}
 		return runtime.NewToolResultJSON(structured), nil
-	})
+	}))
+}
+
+// ForwardToOperationsConn forwards MCP calls to the Operations behind conn,
+// e.g. a *grpc.ClientConn with interceptors or a connection pool, without
+// building a typed gRPC client first.
+func ForwardToOperationsConn(s runtime.MCPServer, conn grpc.ClientConnInterface, opts ...runtime.Option) {
+	ForwardToOperationsClient(s, connOperationsClient{conn}, opts...)
+}
+
+// connOperationsClient is the OperationsClient of
+// ForwardToOperationsConn.
+type connOperationsClient struct {
+	conn grpc.ClientConnInterface
+}
+
+func (c connOperationsClient) CancelOperation(ctx context.Context, req *longrunningpb.CancelOperationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	resp := new(emptypb.Empty)
+	if err := c.conn.Invoke(ctx, "/google.longrunning.Operations/CancelOperation", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connOperationsClient) DeleteOperation(ctx context.Context, req *longrunningpb.DeleteOperationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	resp := new(emptypb.Empty)
+	if err := c.conn.Invoke(ctx, "/google.longrunning.Operations/DeleteOperation", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connOperationsClient) GetOperation(ctx context.Context, req *longrunningpb.GetOperationRequest, opts ...grpc.CallOption) (*longrunningpb.Operation, error) {
+	resp := new(longrunningpb.Operation)
+	if err := c.conn.Invoke(ctx, "/google.longrunning.Operations/GetOperation", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connOperationsClient) ListOperations(ctx context.Context, req *longrunningpb.ListOperationsRequest, opts ...grpc.CallOption) (*longrunningpb.ListOperationsResponse, error) {
+	resp := new(longrunningpb.ListOperationsResponse)
+	if err := c.conn.Invoke(ctx, "/google.longrunning.Operations/ListOperations", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connOperationsClient) WaitOperation(ctx context.Context, req *longrunningpb.WaitOperationRequest, opts ...grpc.CallOption) (*longrunningpb.Operation, error) {
+	resp := new(longrunningpb.Operation)
+	if err := c.conn.Invoke(ctx, "/google.longrunning.Operations/WaitOperation", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ForwardToOperationsHTTP forwards MCP calls to the JSON gateway of Operations
+// at baseURL, through the google.api.http bindings of its RPCs. RPCs without
+// a binding fail with UNIMPLEMENTED. See runtime.CallHTTP.
+func ForwardToOperationsHTTP(s runtime.MCPServer, client runtime.HTTPClient, baseURL string, opts ...runtime.Option) {
+	ForwardToOperationsClient(s, httpOperationsClient{client: client, baseURL: baseURL}, opts...)
+}
+
+// httpOperationsClient is the OperationsClient of
+// ForwardToOperationsHTTP.
+type httpOperationsClient struct {
+	client  runtime.HTTPClient
+	baseURL string
+}
+
+func (c httpOperationsClient) CancelOperation(ctx context.Context, req *longrunningpb.CancelOperationRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	var resp emptypb.Empty
+	if err := runtime.CallHTTP(ctx, c.client, c.baseURL, runtime.HTTPBinding{Method: "POST", Path: "/v1/{name=operations/**}:cancel", Body: "*", ResponseBody: ""}, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c httpOperationsClient) DeleteOperation(ctx context.Context, req *longrunningpb.DeleteOperationRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	var resp emptypb.Empty
+	if err := runtime.CallHTTP(ctx, c.client, c.baseURL, runtime.HTTPBinding{Method: "DELETE", Path: "/v1/{name=operations/**}", Body: "", ResponseBody: ""}, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c httpOperationsClient) GetOperation(ctx context.Context, req *longrunningpb.GetOperationRequest, _ ...grpc.CallOption) (*longrunningpb.Operation, error) {
+	var resp longrunningpb.Operation
+	if err := runtime.CallHTTP(ctx, c.client, c.baseURL, runtime.HTTPBinding{Method: "GET", Path: "/v1/{name=operations/**}", Body: "", ResponseBody: ""}, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c httpOperationsClient) ListOperations(ctx context.Context, req *longrunningpb.ListOperationsRequest, _ ...grpc.CallOption) (*longrunningpb.ListOperationsResponse, error) {
+	var resp longrunningpb.ListOperationsResponse
+	if err := runtime.CallHTTP(ctx, c.client, c.baseURL, runtime.HTTPBinding{Method: "GET", Path: "/v1/{name=operations}", Body: "", ResponseBody: ""}, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c httpOperationsClient) WaitOperation(context.Context, *longrunningpb.WaitOperationRequest, ...grpc.CallOption) (*longrunningpb.Operation, error) {
+	return nil, runtime.ErrNoHTTPBinding("/google.longrunning.Operations/WaitOperation")
 }
@@ -0,0 +1,706 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: testdata/annotations.proto
+
+package testdata
+
+import (
+	_ "github.com/redpanda-data/protoc-gen-go-mcp/pkg/mcpoptions"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ApplyConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A YAML document; the override tells the model what structure it holds.
+	PipelineYaml string               `protobuf:"bytes,1,opt,name=pipeline_yaml,json=pipelineYaml,proto3" json:"pipeline_yaml,omitempty"`
+	Labels       []string             `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+	Threshold    *Threshold           `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Name         string               `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Timeout      *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Replicas     int32                `protobuf:"varint,6,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	// Deprecated: Marked as deprecated in testdata/annotations.proto.
+	LegacyName string `protobuf:"bytes,7,opt,name=legacy_name,json=legacyName,proto3" json:"legacy_name,omitempty"`
+	// Deprecated: Marked as deprecated in testdata/annotations.proto.
+	OldOwner string `protobuf:"bytes,8,opt,name=old_owner,json=oldOwner,proto3" json:"old_owner,omitempty"`
+	// Log level of the pipeline.
+	LogLevel   string `protobuf:"bytes,9,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
+	MaxRetries int32  `protobuf:"varint,10,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Set by the server from the caller's session, never by the model.
+	OrganizationId string `protobuf:"bytes,11,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Validate the config without applying it.
+	ValidateOnly bool `protobuf:"varint,12,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	// Config to start from; completes through ListConfigs.
+	BaseConfig string `protobuf:"bytes,13,opt,name=base_config,json=baseConfig,proto3" json:"base_config,omitempty"`
+	// Tracing plumbing set by the gateway; hidden from the model.
+	InternalTraceId string `protobuf:"bytes,14,opt,name=internal_trace_id,json=internalTraceId,proto3" json:"internal_trace_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApplyConfigRequest) Reset() {
+	*x = ApplyConfigRequest{}
+	mi := &file_testdata_annotations_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyConfigRequest) ProtoMessage() {}
+
+func (x *ApplyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_annotations_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyConfigRequest.ProtoReflect.Descriptor instead.
+func (*ApplyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_annotations_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApplyConfigRequest) GetPipelineYaml() string {
+	if x != nil {
+		return x.PipelineYaml
+	}
+	return ""
+}
+
+func (x *ApplyConfigRequest) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ApplyConfigRequest) GetThreshold() *Threshold {
+	if x != nil {
+		return x.Threshold
+	}
+	return nil
+}
+
+func (x *ApplyConfigRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplyConfigRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *ApplyConfigRequest) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in testdata/annotations.proto.
+func (x *ApplyConfigRequest) GetLegacyName() string {
+	if x != nil {
+		return x.LegacyName
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in testdata/annotations.proto.
+func (x *ApplyConfigRequest) GetOldOwner() string {
+	if x != nil {
+		return x.OldOwner
+	}
+	return ""
+}
+
+func (x *ApplyConfigRequest) GetLogLevel() string {
+	if x != nil {
+		return x.LogLevel
+	}
+	return ""
+}
+
+func (x *ApplyConfigRequest) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *ApplyConfigRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ApplyConfigRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+func (x *ApplyConfigRequest) GetBaseConfig() string {
+	if x != nil {
+		return x.BaseConfig
+	}
+	return ""
+}
+
+func (x *ApplyConfigRequest) GetInternalTraceId() string {
+	if x != nil {
+		return x.InternalTraceId
+	}
+	return ""
+}
+
+// Threshold is rendered from its (mcp.message).schema wherever it appears.
+type Threshold struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Threshold) Reset() {
+	*x = Threshold{}
+	mi := &file_testdata_annotations_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Threshold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Threshold) ProtoMessage() {}
+
+func (x *Threshold) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_annotations_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Threshold.ProtoReflect.Descriptor instead.
+func (*Threshold) Descriptor() ([]byte, []int) {
+	return file_testdata_annotations_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Threshold) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type ApplyConfigResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Applied bool                   `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
+	// Raw planner output for operators; stripped from tool results.
+	DebugDump     string `protobuf:"bytes,2,opt,name=debug_dump,json=debugDump,proto3" json:"debug_dump,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyConfigResponse) Reset() {
+	*x = ApplyConfigResponse{}
+	mi := &file_testdata_annotations_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyConfigResponse) ProtoMessage() {}
+
+func (x *ApplyConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_annotations_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyConfigResponse.ProtoReflect.Descriptor instead.
+func (*ApplyConfigResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_annotations_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ApplyConfigResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *ApplyConfigResponse) GetDebugDump() string {
+	if x != nil {
+		return x.DebugDump
+	}
+	return ""
+}
+
+type ListConfigsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of configs to return.
+	PageSize      int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigsRequest) Reset() {
+	*x = ListConfigsRequest{}
+	mi := &file_testdata_annotations_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigsRequest) ProtoMessage() {}
+
+func (x *ListConfigsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_annotations_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigsRequest.ProtoReflect.Descriptor instead.
+func (*ListConfigsRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_annotations_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListConfigsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListConfigsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListConfigsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Configs       []*Config              `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigsResponse) Reset() {
+	*x = ListConfigsResponse{}
+	mi := &file_testdata_annotations_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigsResponse) ProtoMessage() {}
+
+func (x *ListConfigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_annotations_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigsResponse.ProtoReflect.Descriptor instead.
+func (*ListConfigsResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_annotations_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListConfigsResponse) GetConfigs() []*Config {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+func (x *ListConfigsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigRequest) Reset() {
+	*x = GetConfigRequest{}
+	mi := &file_testdata_annotations_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigRequest) ProtoMessage() {}
+
+func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_annotations_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_annotations_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetConfigRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Config struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Storage key of the config; never shown to the model.
+	InternalId    string `protobuf:"bytes,2,opt,name=internal_id,json=internalId,proto3" json:"internal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	mi := &file_testdata_annotations_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_annotations_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_testdata_annotations_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Config) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Config) GetInternalId() string {
+	if x != nil {
+		return x.InternalId
+	}
+	return ""
+}
+
+type ExportConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The config as a YAML document.
+	Document      []byte `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	ContentType   string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportConfigResponse) Reset() {
+	*x = ExportConfigResponse{}
+	mi := &file_testdata_annotations_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportConfigResponse) ProtoMessage() {}
+
+func (x *ExportConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_annotations_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportConfigResponse.ProtoReflect.Descriptor instead.
+func (*ExportConfigResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_annotations_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExportConfigResponse) GetDocument() []byte {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *ExportConfigResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportConfigResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+var File_testdata_annotations_proto protoreflect.FileDescriptor
+
+const file_testdata_annotations_proto_rawDesc = "" +
+	"\n" +
+	"\x1atestdata/annotations.proto\x12\btestdata\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x11mcp/options.proto\"\x96\a\n" +
+	"\x12ApplyConfigRequest\x12\xc1\x01\n" +
+	"\rpipeline_yaml\x18\x01 \x01(\tB\x9b\x01\xaa\xe3\x18\x96\x01\n" +
+	"\x91\x01{\"type\":\"string\",\"contentMediaType\":\"application/yaml\",\"description\":\"A pipeline config as YAML with top-level input, pipeline and output keys.\"}h\x01R\fpipelineYaml\x12q\n" +
+	"\x06labels\x18\x02 \x03(\tBY\xaa\xe3\x18U\n" +
+	"S{\"type\":\"array\",\"items\":{\"type\":\"string\",\"pattern\":\"^[a-z]+=[a-z]+$\"},\"maxItems\":8}R\x06labels\x121\n" +
+	"\tthreshold\x18\x03 \x01(\v2\x13.testdata.ThresholdR\tthreshold\x12'\n" +
+	"\x04name\x18\x04 \x01(\tB\x13\xaa\xe3\x18\x0f\x1a\rPipeline nameR\x04name\x12B\n" +
+	"\atimeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationB\r\xaa\xe3\x18\t\x12\x0330s\x12\x025mR\atimeout\x12#\n" +
+	"\breplicas\x18\x06 \x01(\x05B\a\xaa\xe3\x18\x03\x12\x013R\breplicas\x12:\n" +
+	"\vlegacy_name\x18\a \x01(\tB\x19\xaa\xe3\x18\x13\"\x11Use name instead.\x18\x01R\n" +
+	"legacyName\x12\x1f\n" +
+	"\told_owner\x18\b \x01(\tB\x02\x18\x01R\boldOwner\x12'\n" +
+	"\tlog_level\x18\t \x01(\tB\n" +
+	"\xaa\xe3\x18\x06*\x04infoR\blogLevel\x12(\n" +
+	"\vmax_retries\x18\n" +
+	" \x01(\x05B\a\xaa\xe3\x18\x03*\x015R\n" +
+	"maxRetries\x128\n" +
+	"\x0forganization_id\x18\v \x01(\tB\x0f\xe0A\x02\xaa\xe3\x18\b2\x06tenantR\x0eorganizationId\x12#\n" +
+	"\rvalidate_only\x18\f \x01(\bR\fvalidateOnly\x12A\n" +
+	"\vbase_config\x18\r \x01(\tB \xfaA\x1d\n" +
+	"\x1btestdata.example.com/ConfigR\n" +
+	"baseConfig\x122\n" +
+	"\x11internal_trace_id\x18\x0e \x01(\tB\x06\xaa\xe3\x18\x02X\x01R\x0finternalTraceId\"\x90\x01\n" +
+	"\tThreshold\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value:m\xaa\xe3\x18i\n" +
+	"g{\"type\":\"object\",\"properties\":{\"value\":{\"type\":\"number\",\"minimum\":0,\"maximum\":1}},\"required\":[\"value\"]}\"V\n" +
+	"\x13ApplyConfigResponse\x12\x18\n" +
+	"\aapplied\x18\x01 \x01(\bR\aapplied\x12%\n" +
+	"\n" +
+	"debug_dump\x18\x02 \x01(\tB\x06\xaa\xe3\x18\x02`\x01R\tdebugDump\"]\n" +
+	"\x12ListConfigsRequest\x12(\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\v\xaa\xe3\x18\a*\x02508\xc8\x01R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"i\n" +
+	"\x13ListConfigsResponse\x12*\n" +
+	"\aconfigs\x18\x01 \x03(\v2\x10.testdata.ConfigR\aconfigs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"H\n" +
+	"\x10GetConfigRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xfaA\x1d\n" +
+	"\x1btestdata.example.com/ConfigR\x04name\"y\n" +
+	"\x06Config\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12'\n" +
+	"\vinternal_id\x18\x02 \x01(\tB\x06\xaa\xe3\x18\x02`\x01R\n" +
+	"internalId:2\xeaA/\n" +
+	"\x1btestdata.example.com/Config\x12\x10configs/{config}\"y\n" +
+	"\x14ExportConfigResponse\x12\"\n" +
+	"\bdocument\x18\x01 \x01(\fB\x06\xaa\xe3\x18\x02P\x01R\bdocument\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename2\xd3\t\n" +
+	"\x10AnnotatedService\x12\xeb\x02\n" +
+	"\vApplyConfig\x12\x1c.testdata.ApplyConfigRequest\x1a\x1d.testdata.ApplyConfigResponse\"\x9e\x02\xaa\xe3\x18\x99\x02\n" +
+	"\x15Apply pipeline config2\x06deploy\x12=\n" +
+	"\x06region\"\rdeploy_region*${\"type\":\"string\",\"enum\":[\"eu\",\"us\"]}\x1a\xb8\x01\x1a5Apply a pipeline config derived from an existing one.\"&\n" +
+	"\vbase_config\x12\x15Config to start from.\x18\x01\"\a\n" +
+	"\x05notes*BUse {{tool}} to apply a config based on {{base_config}}. {{notes}}\n" +
+	"\n" +
+	"apply_from\x12O\n" +
+	"\vLegacyApply\x12\x1c.testdata.ApplyConfigRequest\x1a\x1d.testdata.ApplyConfigResponse\"\x03\x88\x02\x01\x12c\n" +
+	"\vListConfigs\x12\x1c.testdata.ListConfigsRequest\x1a\x1d.testdata.ListConfigsResponse\"\x17\x90\x02\x01\xaa\xe3\x18\x10\"\x0econfigs://list\x12\x94\x01\n" +
+	"\tGetConfig\x12\x1a.testdata.GetConfigRequest\x1a\x10.testdata.Config\"Y\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/{name=configs/*}\x90\x02\x01\xaa\xe3\x186*4\n" +
+	"\x19Get the config named prod\x12\x17{\"name\":\"configs/prod\"}\x12O\n" +
+	"\fExportConfig\x12\x1a.testdata.GetConfigRequest\x1a\x1e.testdata.ExportConfigResponse\"\x03\x90\x02\x01\x12\\\n" +
+	"\vWatchConfig\x12\x1a.testdata.GetConfigRequest\x1a\x10.testdata.Config\"\x1d\xaa\xe3\x18\x19\"\x17configs://watch/{+name}0\x01\x1a\xd4\x02\xaa\xe3\x18\xcf\x02\n" +
+	"/\n" +
+	"\n" +
+	"cluster_id\x12\x1fCluster to apply the config to.\x18\x01\n" +
+	".\n" +
+	"\tapi_token\x12\x1fToken used to call the cluster.0\x01\x12\xe2\x01*cList the configs with {{tool:ListConfigs}}, then apply pipeline {{name}} with {{tool:ApplyConfig}}.\n" +
+	"\arollout\x12\x11Roll out a config\x1a2Review the existing configs, then apply a new one.\"+\n" +
+	"\x04name\x12!Name of the pipeline to roll out.\x18\x01\x1a\aconfigsB\xa9\x01\n" +
+	"\fcom.testdataB\x10AnnotationsProtoP\x01ZGgithub.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_annotations_proto_rawDescOnce sync.Once
+	file_testdata_annotations_proto_rawDescData []byte
+)
+
+func file_testdata_annotations_proto_rawDescGZIP() []byte {
+	file_testdata_annotations_proto_rawDescOnce.Do(func() {
+		file_testdata_annotations_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_annotations_proto_rawDesc), len(file_testdata_annotations_proto_rawDesc)))
+	})
+	return file_testdata_annotations_proto_rawDescData
+}
+
+var file_testdata_annotations_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_testdata_annotations_proto_goTypes = []any{
+	(*ApplyConfigRequest)(nil),   // 0: testdata.ApplyConfigRequest
+	(*Threshold)(nil),            // 1: testdata.Threshold
+	(*ApplyConfigResponse)(nil),  // 2: testdata.ApplyConfigResponse
+	(*ListConfigsRequest)(nil),   // 3: testdata.ListConfigsRequest
+	(*ListConfigsResponse)(nil),  // 4: testdata.ListConfigsResponse
+	(*GetConfigRequest)(nil),     // 5: testdata.GetConfigRequest
+	(*Config)(nil),               // 6: testdata.Config
+	(*ExportConfigResponse)(nil), // 7: testdata.ExportConfigResponse
+	(*durationpb.Duration)(nil),  // 8: google.protobuf.Duration
+}
+var file_testdata_annotations_proto_depIdxs = []int32{
+	1, // 0: testdata.ApplyConfigRequest.threshold:type_name -> testdata.Threshold
+	8, // 1: testdata.ApplyConfigRequest.timeout:type_name -> google.protobuf.Duration
+	6, // 2: testdata.ListConfigsResponse.configs:type_name -> testdata.Config
+	0, // 3: testdata.AnnotatedService.ApplyConfig:input_type -> testdata.ApplyConfigRequest
+	0, // 4: testdata.AnnotatedService.LegacyApply:input_type -> testdata.ApplyConfigRequest
+	3, // 5: testdata.AnnotatedService.ListConfigs:input_type -> testdata.ListConfigsRequest
+	5, // 6: testdata.AnnotatedService.GetConfig:input_type -> testdata.GetConfigRequest
+	5, // 7: testdata.AnnotatedService.ExportConfig:input_type -> testdata.GetConfigRequest
+	5, // 8: testdata.AnnotatedService.WatchConfig:input_type -> testdata.GetConfigRequest
+	2, // 9: testdata.AnnotatedService.ApplyConfig:output_type -> testdata.ApplyConfigResponse
+	2, // 10: testdata.AnnotatedService.LegacyApply:output_type -> testdata.ApplyConfigResponse
+	4, // 11: testdata.AnnotatedService.ListConfigs:output_type -> testdata.ListConfigsResponse
+	6, // 12: testdata.AnnotatedService.GetConfig:output_type -> testdata.Config
+	7, // 13: testdata.AnnotatedService.ExportConfig:output_type -> testdata.ExportConfigResponse
+	6, // 14: testdata.AnnotatedService.WatchConfig:output_type -> testdata.Config
+	9, // [9:15] is the sub-list for method output_type
+	3, // [3:9] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_testdata_annotations_proto_init() }
+func file_testdata_annotations_proto_init() {
+	if File_testdata_annotations_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_annotations_proto_rawDesc), len(file_testdata_annotations_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_annotations_proto_goTypes,
+		DependencyIndexes: file_testdata_annotations_proto_depIdxs,
+		MessageInfos:      file_testdata_annotations_proto_msgTypes,
+	}.Build()
+	File_testdata_annotations_proto = out.File
+	file_testdata_annotations_proto_goTypes = nil
+	file_testdata_annotations_proto_depIdxs = nil
+}
@@ -0,0 +1,348 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/annotations.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AnnotatedService_ApplyConfig_FullMethodName  = "/testdata.AnnotatedService/ApplyConfig"
+	AnnotatedService_LegacyApply_FullMethodName  = "/testdata.AnnotatedService/LegacyApply"
+	AnnotatedService_ListConfigs_FullMethodName  = "/testdata.AnnotatedService/ListConfigs"
+	AnnotatedService_GetConfig_FullMethodName    = "/testdata.AnnotatedService/GetConfig"
+	AnnotatedService_ExportConfig_FullMethodName = "/testdata.AnnotatedService/ExportConfig"
+	AnnotatedService_WatchConfig_FullMethodName  = "/testdata.AnnotatedService/WatchConfig"
+)
+
+// AnnotatedServiceClient is the client API for AnnotatedService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AnnotatedService exercises the (mcp.*) custom options.
+type AnnotatedServiceClient interface {
+	// ApplyConfig tests literal schema overrides on fields and messages
+	ApplyConfig(ctx context.Context, in *ApplyConfigRequest, opts ...grpc.CallOption) (*ApplyConfigResponse, error)
+	// Deprecated: Do not use.
+	// LegacyApply tests deprecated method handling
+	LegacyApply(ctx context.Context, in *ApplyConfigRequest, opts ...grpc.CallOption) (*ApplyConfigResponse, error)
+	// ListConfigs tests page size defaults and caps
+	ListConfigs(ctx context.Context, in *ListConfigsRequest, opts ...grpc.CallOption) (*ListConfigsResponse, error)
+	// GetConfig tests resources derived from HTTP bindings
+	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*Config, error)
+	// ExportConfig tests file responses returned as embedded resources
+	ExportConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*ExportConfigResponse, error)
+	// WatchConfig tests resource subscriptions backed by a watch stream
+	WatchConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Config], error)
+}
+
+type annotatedServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnnotatedServiceClient(cc grpc.ClientConnInterface) AnnotatedServiceClient {
+	return &annotatedServiceClient{cc}
+}
+
+func (c *annotatedServiceClient) ApplyConfig(ctx context.Context, in *ApplyConfigRequest, opts ...grpc.CallOption) (*ApplyConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyConfigResponse)
+	err := c.cc.Invoke(ctx, AnnotatedService_ApplyConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *annotatedServiceClient) LegacyApply(ctx context.Context, in *ApplyConfigRequest, opts ...grpc.CallOption) (*ApplyConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyConfigResponse)
+	err := c.cc.Invoke(ctx, AnnotatedService_LegacyApply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *annotatedServiceClient) ListConfigs(ctx context.Context, in *ListConfigsRequest, opts ...grpc.CallOption) (*ListConfigsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConfigsResponse)
+	err := c.cc.Invoke(ctx, AnnotatedService_ListConfigs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *annotatedServiceClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*Config, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Config)
+	err := c.cc.Invoke(ctx, AnnotatedService_GetConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *annotatedServiceClient) ExportConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*ExportConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportConfigResponse)
+	err := c.cc.Invoke(ctx, AnnotatedService_ExportConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *annotatedServiceClient) WatchConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Config], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AnnotatedService_ServiceDesc.Streams[0], AnnotatedService_WatchConfig_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetConfigRequest, Config]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AnnotatedService_WatchConfigClient = grpc.ServerStreamingClient[Config]
+
+// AnnotatedServiceServer is the server API for AnnotatedService service.
+// All implementations must embed UnimplementedAnnotatedServiceServer
+// for forward compatibility.
+//
+// AnnotatedService exercises the (mcp.*) custom options.
+type AnnotatedServiceServer interface {
+	// ApplyConfig tests literal schema overrides on fields and messages
+	ApplyConfig(context.Context, *ApplyConfigRequest) (*ApplyConfigResponse, error)
+	// Deprecated: Do not use.
+	// LegacyApply tests deprecated method handling
+	LegacyApply(context.Context, *ApplyConfigRequest) (*ApplyConfigResponse, error)
+	// ListConfigs tests page size defaults and caps
+	ListConfigs(context.Context, *ListConfigsRequest) (*ListConfigsResponse, error)
+	// GetConfig tests resources derived from HTTP bindings
+	GetConfig(context.Context, *GetConfigRequest) (*Config, error)
+	// ExportConfig tests file responses returned as embedded resources
+	ExportConfig(context.Context, *GetConfigRequest) (*ExportConfigResponse, error)
+	// WatchConfig tests resource subscriptions backed by a watch stream
+	WatchConfig(*GetConfigRequest, grpc.ServerStreamingServer[Config]) error
+	mustEmbedUnimplementedAnnotatedServiceServer()
+}
+
+// UnimplementedAnnotatedServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAnnotatedServiceServer struct{}
+
+func (UnimplementedAnnotatedServiceServer) ApplyConfig(context.Context, *ApplyConfigRequest) (*ApplyConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyConfig not implemented")
+}
+func (UnimplementedAnnotatedServiceServer) LegacyApply(context.Context, *ApplyConfigRequest) (*ApplyConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LegacyApply not implemented")
+}
+func (UnimplementedAnnotatedServiceServer) ListConfigs(context.Context, *ListConfigsRequest) (*ListConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigs not implemented")
+}
+func (UnimplementedAnnotatedServiceServer) GetConfig(context.Context, *GetConfigRequest) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (UnimplementedAnnotatedServiceServer) ExportConfig(context.Context, *GetConfigRequest) (*ExportConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportConfig not implemented")
+}
+func (UnimplementedAnnotatedServiceServer) WatchConfig(*GetConfigRequest, grpc.ServerStreamingServer[Config]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConfig not implemented")
+}
+func (UnimplementedAnnotatedServiceServer) mustEmbedUnimplementedAnnotatedServiceServer() {}
+func (UnimplementedAnnotatedServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeAnnotatedServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AnnotatedServiceServer will
+// result in compilation errors.
+type UnsafeAnnotatedServiceServer interface {
+	mustEmbedUnimplementedAnnotatedServiceServer()
+}
+
+func RegisterAnnotatedServiceServer(s grpc.ServiceRegistrar, srv AnnotatedServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAnnotatedServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AnnotatedService_ServiceDesc, srv)
+}
+
+func _AnnotatedService_ApplyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnotatedServiceServer).ApplyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnotatedService_ApplyConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnotatedServiceServer).ApplyConfig(ctx, req.(*ApplyConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnotatedService_LegacyApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnotatedServiceServer).LegacyApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnotatedService_LegacyApply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnotatedServiceServer).LegacyApply(ctx, req.(*ApplyConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnotatedService_ListConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnotatedServiceServer).ListConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnotatedService_ListConfigs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnotatedServiceServer).ListConfigs(ctx, req.(*ListConfigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnotatedService_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnotatedServiceServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnotatedService_GetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnotatedServiceServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnotatedService_ExportConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnotatedServiceServer).ExportConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnotatedService_ExportConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnotatedServiceServer).ExportConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnotatedService_WatchConfig_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetConfigRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AnnotatedServiceServer).WatchConfig(m, &grpc.GenericServerStream[GetConfigRequest, Config]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AnnotatedService_WatchConfigServer = grpc.ServerStreamingServer[Config]
+
+// AnnotatedService_ServiceDesc is the grpc.ServiceDesc for AnnotatedService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AnnotatedService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.AnnotatedService",
+	HandlerType: (*AnnotatedServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ApplyConfig",
+			Handler:    _AnnotatedService_ApplyConfig_Handler,
+		},
+		{
+			MethodName: "LegacyApply",
+			Handler:    _AnnotatedService_LegacyApply_Handler,
+		},
+		{
+			MethodName: "ListConfigs",
+			Handler:    _AnnotatedService_ListConfigs_Handler,
+		},
+		{
+			MethodName: "GetConfig",
+			Handler:    _AnnotatedService_GetConfig_Handler,
+		},
+		{
+			MethodName: "ExportConfig",
+			Handler:    _AnnotatedService_ExportConfig_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchConfig",
+			Handler:       _AnnotatedService_WatchConfig_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "testdata/annotations.proto",
+}
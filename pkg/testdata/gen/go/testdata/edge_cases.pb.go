@@ -27,6 +27,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	_ "google.golang.org/protobuf/types/known/anypb"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	_ "google.golang.org/protobuf/types/known/wrapperspb"
@@ -691,14 +692,16 @@ func (x *RepeatedMessagesResponse) GetSuccess() bool {
 
 // Map with various key and value types
 type MapVariantsRequest struct {
-	state           protoimpl.MessageState   `protogen:"open.v1"`
-	StringToString  map[string]string        `protobuf:"bytes,1,rep,name=string_to_string,json=stringToString,proto3" json:"string_to_string,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	IntToString     map[int32]string         `protobuf:"bytes,2,rep,name=int_to_string,json=intToString,proto3" json:"int_to_string,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	BoolToString    map[bool]string          `protobuf:"bytes,3,rep,name=bool_to_string,json=boolToString,proto3" json:"bool_to_string,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Uint64ToString  map[uint64]string        `protobuf:"bytes,4,rep,name=uint64_to_string,json=uint64ToString,proto3" json:"uint64_to_string,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	StringToMessage map[string]*InnerMessage `protobuf:"bytes,5,rep,name=string_to_message,json=stringToMessage,proto3" json:"string_to_message,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	StringToDouble  map[string]float64       `protobuf:"bytes,6,rep,name=string_to_double,json=stringToDouble,proto3" json:"string_to_double,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
-	StringToBool    map[string]bool          `protobuf:"bytes,7,rep,name=string_to_bool,json=stringToBool,proto3" json:"string_to_bool,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	state           protoimpl.MessageState    `protogen:"open.v1"`
+	StringToString  map[string]string         `protobuf:"bytes,1,rep,name=string_to_string,json=stringToString,proto3" json:"string_to_string,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	IntToString     map[int32]string          `protobuf:"bytes,2,rep,name=int_to_string,json=intToString,proto3" json:"int_to_string,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	BoolToString    map[bool]string           `protobuf:"bytes,3,rep,name=bool_to_string,json=boolToString,proto3" json:"bool_to_string,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Uint64ToString  map[uint64]string         `protobuf:"bytes,4,rep,name=uint64_to_string,json=uint64ToString,proto3" json:"uint64_to_string,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StringToMessage map[string]*InnerMessage  `protobuf:"bytes,5,rep,name=string_to_message,json=stringToMessage,proto3" json:"string_to_message,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StringToDouble  map[string]float64        `protobuf:"bytes,6,rep,name=string_to_double,json=stringToDouble,proto3" json:"string_to_double,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	StringToBool    map[string]bool           `protobuf:"bytes,7,rep,name=string_to_bool,json=stringToBool,proto3" json:"string_to_bool,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	StringToEnum    map[string]Priority       `protobuf:"bytes,8,rep,name=string_to_enum,json=stringToEnum,proto3" json:"string_to_enum,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=testdata.Priority"`
+	StringToMiddle  map[string]*MiddleMessage `protobuf:"bytes,9,rep,name=string_to_middle,json=stringToMiddle,proto3" json:"string_to_middle,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -782,6 +785,20 @@ func (x *MapVariantsRequest) GetStringToBool() map[string]bool {
 	return nil
 }
 
+func (x *MapVariantsRequest) GetStringToEnum() map[string]Priority {
+	if x != nil {
+		return x.StringToEnum
+	}
+	return nil
+}
+
+func (x *MapVariantsRequest) GetStringToMiddle() map[string]*MiddleMessage {
+	if x != nil {
+		return x.StringToMiddle
+	}
+	return nil
+}
+
 type MapVariantsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -1725,11 +1742,55 @@ func (x *NumericValidationResponse) GetSuccess() bool {
 	return false
 }
 
+type NoArgumentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoArgumentsResponse) Reset() {
+	*x = NoArgumentsResponse{}
+	mi := &file_testdata_edge_cases_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoArgumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoArgumentsResponse) ProtoMessage() {}
+
+func (x *NoArgumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_edge_cases_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoArgumentsResponse.ProtoReflect.Descriptor instead.
+func (*NoArgumentsResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_edge_cases_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *NoArgumentsResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 var File_testdata_edge_cases_proto protoreflect.FileDescriptor
 
 const file_testdata_edge_cases_proto_rawDesc = "" +
 	"\n" +
-	"\x19testdata/edge_cases.proto\x12\btestdata\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x19google/protobuf/any.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bbuf/validate/validate.proto\"\x81\x02\n" +
+	"\x19testdata/edge_cases.proto\x12\btestdata\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x19google/protobuf/any.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bbuf/validate/validate.proto\"\x81\x02\n" +
 	"\fInnerMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
 	"\x04tags\x18\x02 \x03(\v2 .testdata.InnerMessage.TagsEntryR\x04tags\x123\n" +
@@ -1789,7 +1850,7 @@ const file_testdata_edge_cases_proto_rawDesc = "" +
 	"timestamps\x18\x02 \x03(\v2\x1a.google.protobuf.TimestampR\n" +
 	"timestamps\"4\n" +
 	"\x18RepeatedMessagesResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xed\b\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd0\v\n" +
 	"\x12MapVariantsRequest\x12Z\n" +
 	"\x10string_to_string\x18\x01 \x03(\v20.testdata.MapVariantsRequest.StringToStringEntryR\x0estringToString\x12Q\n" +
 	"\rint_to_string\x18\x02 \x03(\v2-.testdata.MapVariantsRequest.IntToStringEntryR\vintToString\x12T\n" +
@@ -1797,7 +1858,9 @@ const file_testdata_edge_cases_proto_rawDesc = "" +
 	"\x10uint64_to_string\x18\x04 \x03(\v20.testdata.MapVariantsRequest.Uint64ToStringEntryR\x0euint64ToString\x12]\n" +
 	"\x11string_to_message\x18\x05 \x03(\v21.testdata.MapVariantsRequest.StringToMessageEntryR\x0fstringToMessage\x12Z\n" +
 	"\x10string_to_double\x18\x06 \x03(\v20.testdata.MapVariantsRequest.StringToDoubleEntryR\x0estringToDouble\x12T\n" +
-	"\x0estring_to_bool\x18\a \x03(\v2..testdata.MapVariantsRequest.StringToBoolEntryR\fstringToBool\x1aA\n" +
+	"\x0estring_to_bool\x18\a \x03(\v2..testdata.MapVariantsRequest.StringToBoolEntryR\fstringToBool\x12T\n" +
+	"\x0estring_to_enum\x18\b \x03(\v2..testdata.MapVariantsRequest.StringToEnumEntryR\fstringToEnum\x12Z\n" +
+	"\x10string_to_middle\x18\t \x03(\v20.testdata.MapVariantsRequest.StringToMiddleEntryR\x0estringToMiddle\x1aA\n" +
 	"\x13StringToStringEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
@@ -1818,7 +1881,13 @@ const file_testdata_edge_cases_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1a?\n" +
 	"\x11StringToBoolEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"/\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\x1aS\n" +
+	"\x11StringToEnumEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\x0e2\x12.testdata.PriorityR\x05value:\x028\x01\x1aZ\n" +
+	"\x13StringToMiddleEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
+	"\x05value\x18\x02 \x01(\v2\x17.testdata.MiddleMessageR\x05value:\x028\x01\"/\n" +
 	"\x13MapVariantsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"w\n" +
 	"\x11EnumFieldsRequest\x12.\n" +
@@ -1879,13 +1948,15 @@ const file_testdata_edge_cases_proto_rawDesc = "" +
 	"\x06choice\x12\x05\xbaH\x02\b\x01B\x11\n" +
 	"\x0foptional_choice\"5\n" +
 	"\x19NumericValidationResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess*u\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"-\n" +
+	"\x13NoArgumentsResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status*u\n" +
 	"\bPriority\x12\x18\n" +
 	"\x14PRIORITY_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fPRIORITY_LOW\x10\x01\x12\x13\n" +
 	"\x0fPRIORITY_MEDIUM\x10\x02\x12\x11\n" +
 	"\rPRIORITY_HIGH\x10\x03\x12\x15\n" +
-	"\x11PRIORITY_CRITICAL\x10\x042\xfc\x05\n" +
+	"\x11PRIORITY_CRITICAL\x10\x042\xc2\x06\n" +
 	"\x0fEdgeCaseService\x12J\n" +
 	"\vDeepNesting\x12\x1c.testdata.DeepNestingRequest\x1a\x1d.testdata.DeepNestingResponse\x12S\n" +
 	"\x0eAllScalarTypes\x12\x1f.testdata.AllScalarTypesRequest\x1a .testdata.AllScalarTypesResponse\x12Y\n" +
@@ -1896,7 +1967,8 @@ const file_testdata_edge_cases_proto_rawDesc = "" +
 	"\x0eMultipleOneofs\x12\x1f.testdata.MultipleOneofsRequest\x1a .testdata.MultipleOneofsResponse\x12\\\n" +
 	"\x11NumericValidation\x12\".testdata.NumericValidationRequest\x1a#.testdata.NumericValidationResponse\x12P\n" +
 	"\rRecursiveTree\x12\x1e.testdata.RecursiveTreeRequest\x1a\x1f.testdata.RecursiveTreeResponse\x12S\n" +
-	"\x0eOneofRecursive\x12\x1f.testdata.OneofRecursiveRequest\x1a .testdata.OneofRecursiveResponseB\xa7\x01\n" +
+	"\x0eOneofRecursive\x12\x1f.testdata.OneofRecursiveRequest\x1a .testdata.OneofRecursiveResponse\x12D\n" +
+	"\vNoArguments\x12\x16.google.protobuf.Empty\x1a\x1d.testdata.NoArgumentsResponseB\xa7\x01\n" +
 	"\fcom.testdataB\x0eEdgeCasesProtoP\x01ZGgithub.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
 
 var (
@@ -1912,7 +1984,7 @@ func file_testdata_edge_cases_proto_rawDescGZIP() []byte {
 }
 
 var file_testdata_edge_cases_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_testdata_edge_cases_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_testdata_edge_cases_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_testdata_edge_cases_proto_goTypes = []any{
 	(Priority)(0),                     // 0: testdata.Priority
 	(*InnerMessage)(nil),              // 1: testdata.InnerMessage
@@ -1938,72 +2010,82 @@ var file_testdata_edge_cases_proto_goTypes = []any{
 	(*OneofRecursiveResponse)(nil),    // 21: testdata.OneofRecursiveResponse
 	(*RequiredOneofRequest)(nil),      // 22: testdata.RequiredOneofRequest
 	(*NumericValidationResponse)(nil), // 23: testdata.NumericValidationResponse
-	nil,                               // 24: testdata.InnerMessage.TagsEntry
-	nil,                               // 25: testdata.MiddleMessage.NamedItemsEntry
-	nil,                               // 26: testdata.ItemWithMap.LabelsEntry
-	nil,                               // 27: testdata.MapVariantsRequest.StringToStringEntry
-	nil,                               // 28: testdata.MapVariantsRequest.IntToStringEntry
-	nil,                               // 29: testdata.MapVariantsRequest.BoolToStringEntry
-	nil,                               // 30: testdata.MapVariantsRequest.Uint64ToStringEntry
-	nil,                               // 31: testdata.MapVariantsRequest.StringToMessageEntry
-	nil,                               // 32: testdata.MapVariantsRequest.StringToDoubleEntry
-	nil,                               // 33: testdata.MapVariantsRequest.StringToBoolEntry
-	(*structpb.Struct)(nil),           // 34: google.protobuf.Struct
-	(*structpb.Value)(nil),            // 35: google.protobuf.Value
-	(*timestamppb.Timestamp)(nil),     // 36: google.protobuf.Timestamp
+	(*NoArgumentsResponse)(nil),       // 24: testdata.NoArgumentsResponse
+	nil,                               // 25: testdata.InnerMessage.TagsEntry
+	nil,                               // 26: testdata.MiddleMessage.NamedItemsEntry
+	nil,                               // 27: testdata.ItemWithMap.LabelsEntry
+	nil,                               // 28: testdata.MapVariantsRequest.StringToStringEntry
+	nil,                               // 29: testdata.MapVariantsRequest.IntToStringEntry
+	nil,                               // 30: testdata.MapVariantsRequest.BoolToStringEntry
+	nil,                               // 31: testdata.MapVariantsRequest.Uint64ToStringEntry
+	nil,                               // 32: testdata.MapVariantsRequest.StringToMessageEntry
+	nil,                               // 33: testdata.MapVariantsRequest.StringToDoubleEntry
+	nil,                               // 34: testdata.MapVariantsRequest.StringToBoolEntry
+	nil,                               // 35: testdata.MapVariantsRequest.StringToEnumEntry
+	nil,                               // 36: testdata.MapVariantsRequest.StringToMiddleEntry
+	(*structpb.Struct)(nil),           // 37: google.protobuf.Struct
+	(*structpb.Value)(nil),            // 38: google.protobuf.Value
+	(*timestamppb.Timestamp)(nil),     // 39: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),             // 40: google.protobuf.Empty
 }
 var file_testdata_edge_cases_proto_depIdxs = []int32{
-	24, // 0: testdata.InnerMessage.tags:type_name -> testdata.InnerMessage.TagsEntry
-	34, // 1: testdata.InnerMessage.metadata:type_name -> google.protobuf.Struct
-	35, // 2: testdata.InnerMessage.dynamic_config:type_name -> google.protobuf.Value
+	25, // 0: testdata.InnerMessage.tags:type_name -> testdata.InnerMessage.TagsEntry
+	37, // 1: testdata.InnerMessage.metadata:type_name -> google.protobuf.Struct
+	38, // 2: testdata.InnerMessage.dynamic_config:type_name -> google.protobuf.Value
 	1,  // 3: testdata.MiddleMessage.inner:type_name -> testdata.InnerMessage
 	1,  // 4: testdata.MiddleMessage.items:type_name -> testdata.InnerMessage
-	25, // 5: testdata.MiddleMessage.named_items:type_name -> testdata.MiddleMessage.NamedItemsEntry
+	26, // 5: testdata.MiddleMessage.named_items:type_name -> testdata.MiddleMessage.NamedItemsEntry
 	2,  // 6: testdata.DeepNestingRequest.middle:type_name -> testdata.MiddleMessage
 	2,  // 7: testdata.DeepNestingRequest.middles:type_name -> testdata.MiddleMessage
-	26, // 8: testdata.ItemWithMap.labels:type_name -> testdata.ItemWithMap.LabelsEntry
-	35, // 9: testdata.ItemWithMap.config:type_name -> google.protobuf.Value
-	34, // 10: testdata.ItemWithMap.extra:type_name -> google.protobuf.Struct
+	27, // 8: testdata.ItemWithMap.labels:type_name -> testdata.ItemWithMap.LabelsEntry
+	38, // 9: testdata.ItemWithMap.config:type_name -> google.protobuf.Value
+	37, // 10: testdata.ItemWithMap.extra:type_name -> google.protobuf.Struct
 	7,  // 11: testdata.RepeatedMessagesRequest.items:type_name -> testdata.ItemWithMap
-	36, // 12: testdata.RepeatedMessagesRequest.timestamps:type_name -> google.protobuf.Timestamp
-	27, // 13: testdata.MapVariantsRequest.string_to_string:type_name -> testdata.MapVariantsRequest.StringToStringEntry
-	28, // 14: testdata.MapVariantsRequest.int_to_string:type_name -> testdata.MapVariantsRequest.IntToStringEntry
-	29, // 15: testdata.MapVariantsRequest.bool_to_string:type_name -> testdata.MapVariantsRequest.BoolToStringEntry
-	30, // 16: testdata.MapVariantsRequest.uint64_to_string:type_name -> testdata.MapVariantsRequest.Uint64ToStringEntry
-	31, // 17: testdata.MapVariantsRequest.string_to_message:type_name -> testdata.MapVariantsRequest.StringToMessageEntry
-	32, // 18: testdata.MapVariantsRequest.string_to_double:type_name -> testdata.MapVariantsRequest.StringToDoubleEntry
-	33, // 19: testdata.MapVariantsRequest.string_to_bool:type_name -> testdata.MapVariantsRequest.StringToBoolEntry
-	0,  // 20: testdata.EnumFieldsRequest.priority:type_name -> testdata.Priority
-	0,  // 21: testdata.EnumFieldsRequest.priorities:type_name -> testdata.Priority
-	17, // 22: testdata.TreeNode.children:type_name -> testdata.TreeNode
-	17, // 23: testdata.RecursiveTreeRequest.root:type_name -> testdata.TreeNode
-	17, // 24: testdata.OneofRecursiveRequest.tree:type_name -> testdata.TreeNode
-	17, // 25: testdata.OneofRecursiveResponse.tree:type_name -> testdata.TreeNode
-	1,  // 26: testdata.MiddleMessage.NamedItemsEntry.value:type_name -> testdata.InnerMessage
-	1,  // 27: testdata.MapVariantsRequest.StringToMessageEntry.value:type_name -> testdata.InnerMessage
-	3,  // 28: testdata.EdgeCaseService.DeepNesting:input_type -> testdata.DeepNestingRequest
-	5,  // 29: testdata.EdgeCaseService.AllScalarTypes:input_type -> testdata.AllScalarTypesRequest
-	8,  // 30: testdata.EdgeCaseService.RepeatedMessages:input_type -> testdata.RepeatedMessagesRequest
-	10, // 31: testdata.EdgeCaseService.MapVariants:input_type -> testdata.MapVariantsRequest
-	12, // 32: testdata.EdgeCaseService.EnumFields:input_type -> testdata.EnumFieldsRequest
-	14, // 33: testdata.EdgeCaseService.MultipleOneofs:input_type -> testdata.MultipleOneofsRequest
-	16, // 34: testdata.EdgeCaseService.NumericValidation:input_type -> testdata.NumericValidationRequest
-	18, // 35: testdata.EdgeCaseService.RecursiveTree:input_type -> testdata.RecursiveTreeRequest
-	20, // 36: testdata.EdgeCaseService.OneofRecursive:input_type -> testdata.OneofRecursiveRequest
-	4,  // 37: testdata.EdgeCaseService.DeepNesting:output_type -> testdata.DeepNestingResponse
-	6,  // 38: testdata.EdgeCaseService.AllScalarTypes:output_type -> testdata.AllScalarTypesResponse
-	9,  // 39: testdata.EdgeCaseService.RepeatedMessages:output_type -> testdata.RepeatedMessagesResponse
-	11, // 40: testdata.EdgeCaseService.MapVariants:output_type -> testdata.MapVariantsResponse
-	13, // 41: testdata.EdgeCaseService.EnumFields:output_type -> testdata.EnumFieldsResponse
-	15, // 42: testdata.EdgeCaseService.MultipleOneofs:output_type -> testdata.MultipleOneofsResponse
-	23, // 43: testdata.EdgeCaseService.NumericValidation:output_type -> testdata.NumericValidationResponse
-	19, // 44: testdata.EdgeCaseService.RecursiveTree:output_type -> testdata.RecursiveTreeResponse
-	21, // 45: testdata.EdgeCaseService.OneofRecursive:output_type -> testdata.OneofRecursiveResponse
-	37, // [37:46] is the sub-list for method output_type
-	28, // [28:37] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	39, // 12: testdata.RepeatedMessagesRequest.timestamps:type_name -> google.protobuf.Timestamp
+	28, // 13: testdata.MapVariantsRequest.string_to_string:type_name -> testdata.MapVariantsRequest.StringToStringEntry
+	29, // 14: testdata.MapVariantsRequest.int_to_string:type_name -> testdata.MapVariantsRequest.IntToStringEntry
+	30, // 15: testdata.MapVariantsRequest.bool_to_string:type_name -> testdata.MapVariantsRequest.BoolToStringEntry
+	31, // 16: testdata.MapVariantsRequest.uint64_to_string:type_name -> testdata.MapVariantsRequest.Uint64ToStringEntry
+	32, // 17: testdata.MapVariantsRequest.string_to_message:type_name -> testdata.MapVariantsRequest.StringToMessageEntry
+	33, // 18: testdata.MapVariantsRequest.string_to_double:type_name -> testdata.MapVariantsRequest.StringToDoubleEntry
+	34, // 19: testdata.MapVariantsRequest.string_to_bool:type_name -> testdata.MapVariantsRequest.StringToBoolEntry
+	35, // 20: testdata.MapVariantsRequest.string_to_enum:type_name -> testdata.MapVariantsRequest.StringToEnumEntry
+	36, // 21: testdata.MapVariantsRequest.string_to_middle:type_name -> testdata.MapVariantsRequest.StringToMiddleEntry
+	0,  // 22: testdata.EnumFieldsRequest.priority:type_name -> testdata.Priority
+	0,  // 23: testdata.EnumFieldsRequest.priorities:type_name -> testdata.Priority
+	17, // 24: testdata.TreeNode.children:type_name -> testdata.TreeNode
+	17, // 25: testdata.RecursiveTreeRequest.root:type_name -> testdata.TreeNode
+	17, // 26: testdata.OneofRecursiveRequest.tree:type_name -> testdata.TreeNode
+	17, // 27: testdata.OneofRecursiveResponse.tree:type_name -> testdata.TreeNode
+	1,  // 28: testdata.MiddleMessage.NamedItemsEntry.value:type_name -> testdata.InnerMessage
+	1,  // 29: testdata.MapVariantsRequest.StringToMessageEntry.value:type_name -> testdata.InnerMessage
+	0,  // 30: testdata.MapVariantsRequest.StringToEnumEntry.value:type_name -> testdata.Priority
+	2,  // 31: testdata.MapVariantsRequest.StringToMiddleEntry.value:type_name -> testdata.MiddleMessage
+	3,  // 32: testdata.EdgeCaseService.DeepNesting:input_type -> testdata.DeepNestingRequest
+	5,  // 33: testdata.EdgeCaseService.AllScalarTypes:input_type -> testdata.AllScalarTypesRequest
+	8,  // 34: testdata.EdgeCaseService.RepeatedMessages:input_type -> testdata.RepeatedMessagesRequest
+	10, // 35: testdata.EdgeCaseService.MapVariants:input_type -> testdata.MapVariantsRequest
+	12, // 36: testdata.EdgeCaseService.EnumFields:input_type -> testdata.EnumFieldsRequest
+	14, // 37: testdata.EdgeCaseService.MultipleOneofs:input_type -> testdata.MultipleOneofsRequest
+	16, // 38: testdata.EdgeCaseService.NumericValidation:input_type -> testdata.NumericValidationRequest
+	18, // 39: testdata.EdgeCaseService.RecursiveTree:input_type -> testdata.RecursiveTreeRequest
+	20, // 40: testdata.EdgeCaseService.OneofRecursive:input_type -> testdata.OneofRecursiveRequest
+	40, // 41: testdata.EdgeCaseService.NoArguments:input_type -> google.protobuf.Empty
+	4,  // 42: testdata.EdgeCaseService.DeepNesting:output_type -> testdata.DeepNestingResponse
+	6,  // 43: testdata.EdgeCaseService.AllScalarTypes:output_type -> testdata.AllScalarTypesResponse
+	9,  // 44: testdata.EdgeCaseService.RepeatedMessages:output_type -> testdata.RepeatedMessagesResponse
+	11, // 45: testdata.EdgeCaseService.MapVariants:output_type -> testdata.MapVariantsResponse
+	13, // 46: testdata.EdgeCaseService.EnumFields:output_type -> testdata.EnumFieldsResponse
+	15, // 47: testdata.EdgeCaseService.MultipleOneofs:output_type -> testdata.MultipleOneofsResponse
+	23, // 48: testdata.EdgeCaseService.NumericValidation:output_type -> testdata.NumericValidationResponse
+	19, // 49: testdata.EdgeCaseService.RecursiveTree:output_type -> testdata.RecursiveTreeResponse
+	21, // 50: testdata.EdgeCaseService.OneofRecursive:output_type -> testdata.OneofRecursiveResponse
+	24, // 51: testdata.EdgeCaseService.NoArguments:output_type -> testdata.NoArgumentsResponse
+	42, // [42:52] is the sub-list for method output_type
+	32, // [32:42] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_testdata_edge_cases_proto_init() }
@@ -2039,7 +2121,7 @@ func file_testdata_edge_cases_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_edge_cases_proto_rawDesc), len(file_testdata_edge_cases_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   33,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
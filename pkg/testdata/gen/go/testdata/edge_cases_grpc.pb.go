@@ -25,6 +25,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -42,6 +43,7 @@ const (
 	EdgeCaseService_NumericValidation_FullMethodName = "/testdata.EdgeCaseService/NumericValidation"
 	EdgeCaseService_RecursiveTree_FullMethodName     = "/testdata.EdgeCaseService/RecursiveTree"
 	EdgeCaseService_OneofRecursive_FullMethodName    = "/testdata.EdgeCaseService/OneofRecursive"
+	EdgeCaseService_NoArguments_FullMethodName       = "/testdata.EdgeCaseService/NoArguments"
 )
 
 // EdgeCaseServiceClient is the client API for EdgeCaseService service.
@@ -70,6 +72,9 @@ type EdgeCaseServiceClient interface {
 	// the request (decode) and response (encode) sides. It exercises the oneof
 	// discriminated-wrapper transform combined with recursion-depth placeholders.
 	OneofRecursive(ctx context.Context, in *OneofRecursiveRequest, opts ...grpc.CallOption) (*OneofRecursiveResponse, error)
+	// NoArguments tests a method taking google.protobuf.Empty, whose tool
+	// accepts no arguments at all.
+	NoArguments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NoArgumentsResponse, error)
 }
 
 type edgeCaseServiceClient struct {
@@ -170,6 +175,16 @@ func (c *edgeCaseServiceClient) OneofRecursive(ctx context.Context, in *OneofRec
 	return out, nil
 }
 
+func (c *edgeCaseServiceClient) NoArguments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NoArgumentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NoArgumentsResponse)
+	err := c.cc.Invoke(ctx, EdgeCaseService_NoArguments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EdgeCaseServiceServer is the server API for EdgeCaseService service.
 // All implementations must embed UnimplementedEdgeCaseServiceServer
 // for forward compatibility.
@@ -196,6 +211,9 @@ type EdgeCaseServiceServer interface {
 	// the request (decode) and response (encode) sides. It exercises the oneof
 	// discriminated-wrapper transform combined with recursion-depth placeholders.
 	OneofRecursive(context.Context, *OneofRecursiveRequest) (*OneofRecursiveResponse, error)
+	// NoArguments tests a method taking google.protobuf.Empty, whose tool
+	// accepts no arguments at all.
+	NoArguments(context.Context, *emptypb.Empty) (*NoArgumentsResponse, error)
 	mustEmbedUnimplementedEdgeCaseServiceServer()
 }
 
@@ -233,6 +251,9 @@ func (UnimplementedEdgeCaseServiceServer) RecursiveTree(context.Context, *Recurs
 func (UnimplementedEdgeCaseServiceServer) OneofRecursive(context.Context, *OneofRecursiveRequest) (*OneofRecursiveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OneofRecursive not implemented")
 }
+func (UnimplementedEdgeCaseServiceServer) NoArguments(context.Context, *emptypb.Empty) (*NoArgumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NoArguments not implemented")
+}
 func (UnimplementedEdgeCaseServiceServer) mustEmbedUnimplementedEdgeCaseServiceServer() {}
 func (UnimplementedEdgeCaseServiceServer) testEmbeddedByValue()                         {}
 
@@ -416,6 +437,24 @@ func _EdgeCaseService_OneofRecursive_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EdgeCaseService_NoArguments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EdgeCaseServiceServer).NoArguments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EdgeCaseService_NoArguments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EdgeCaseServiceServer).NoArguments(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EdgeCaseService_ServiceDesc is the grpc.ServiceDesc for EdgeCaseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -459,6 +498,10 @@ var EdgeCaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OneofRecursive",
 			Handler:    _EdgeCaseService_OneofRecursive_Handler,
 		},
+		{
+			MethodName: "NoArguments",
+			Handler:    _EdgeCaseService_NoArguments_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "testdata/edge_cases.proto",
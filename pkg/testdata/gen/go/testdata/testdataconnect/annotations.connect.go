@@ -0,0 +1,292 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: testdata/annotations.proto
+
+package testdataconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AnnotatedServiceName is the fully-qualified name of the AnnotatedService service.
+	AnnotatedServiceName = "testdata.AnnotatedService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AnnotatedServiceApplyConfigProcedure is the fully-qualified name of the AnnotatedService's
+	// ApplyConfig RPC.
+	AnnotatedServiceApplyConfigProcedure = "/testdata.AnnotatedService/ApplyConfig"
+	// AnnotatedServiceLegacyApplyProcedure is the fully-qualified name of the AnnotatedService's
+	// LegacyApply RPC.
+	AnnotatedServiceLegacyApplyProcedure = "/testdata.AnnotatedService/LegacyApply"
+	// AnnotatedServiceListConfigsProcedure is the fully-qualified name of the AnnotatedService's
+	// ListConfigs RPC.
+	AnnotatedServiceListConfigsProcedure = "/testdata.AnnotatedService/ListConfigs"
+	// AnnotatedServiceGetConfigProcedure is the fully-qualified name of the AnnotatedService's
+	// GetConfig RPC.
+	AnnotatedServiceGetConfigProcedure = "/testdata.AnnotatedService/GetConfig"
+	// AnnotatedServiceExportConfigProcedure is the fully-qualified name of the AnnotatedService's
+	// ExportConfig RPC.
+	AnnotatedServiceExportConfigProcedure = "/testdata.AnnotatedService/ExportConfig"
+	// AnnotatedServiceWatchConfigProcedure is the fully-qualified name of the AnnotatedService's
+	// WatchConfig RPC.
+	AnnotatedServiceWatchConfigProcedure = "/testdata.AnnotatedService/WatchConfig"
+)
+
+// AnnotatedServiceClient is a client for the testdata.AnnotatedService service.
+type AnnotatedServiceClient interface {
+	// ApplyConfig tests literal schema overrides on fields and messages
+	ApplyConfig(context.Context, *connect.Request[testdata.ApplyConfigRequest]) (*connect.Response[testdata.ApplyConfigResponse], error)
+	// LegacyApply tests deprecated method handling
+	//
+	// Deprecated: do not use.
+	LegacyApply(context.Context, *connect.Request[testdata.ApplyConfigRequest]) (*connect.Response[testdata.ApplyConfigResponse], error)
+	// ListConfigs tests page size defaults and caps
+	ListConfigs(context.Context, *connect.Request[testdata.ListConfigsRequest]) (*connect.Response[testdata.ListConfigsResponse], error)
+	// GetConfig tests resources derived from HTTP bindings
+	GetConfig(context.Context, *connect.Request[testdata.GetConfigRequest]) (*connect.Response[testdata.Config], error)
+	// ExportConfig tests file responses returned as embedded resources
+	ExportConfig(context.Context, *connect.Request[testdata.GetConfigRequest]) (*connect.Response[testdata.ExportConfigResponse], error)
+	// WatchConfig tests resource subscriptions backed by a watch stream
+	WatchConfig(context.Context, *connect.Request[testdata.GetConfigRequest]) (*connect.ServerStreamForClient[testdata.Config], error)
+}
+
+// NewAnnotatedServiceClient constructs a client for the testdata.AnnotatedService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAnnotatedServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AnnotatedServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	annotatedServiceMethods := testdata.File_testdata_annotations_proto.Services().ByName("AnnotatedService").Methods()
+	return &annotatedServiceClient{
+		applyConfig: connect.NewClient[testdata.ApplyConfigRequest, testdata.ApplyConfigResponse](
+			httpClient,
+			baseURL+AnnotatedServiceApplyConfigProcedure,
+			connect.WithSchema(annotatedServiceMethods.ByName("ApplyConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		legacyApply: connect.NewClient[testdata.ApplyConfigRequest, testdata.ApplyConfigResponse](
+			httpClient,
+			baseURL+AnnotatedServiceLegacyApplyProcedure,
+			connect.WithSchema(annotatedServiceMethods.ByName("LegacyApply")),
+			connect.WithClientOptions(opts...),
+		),
+		listConfigs: connect.NewClient[testdata.ListConfigsRequest, testdata.ListConfigsResponse](
+			httpClient,
+			baseURL+AnnotatedServiceListConfigsProcedure,
+			connect.WithSchema(annotatedServiceMethods.ByName("ListConfigs")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getConfig: connect.NewClient[testdata.GetConfigRequest, testdata.Config](
+			httpClient,
+			baseURL+AnnotatedServiceGetConfigProcedure,
+			connect.WithSchema(annotatedServiceMethods.ByName("GetConfig")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		exportConfig: connect.NewClient[testdata.GetConfigRequest, testdata.ExportConfigResponse](
+			httpClient,
+			baseURL+AnnotatedServiceExportConfigProcedure,
+			connect.WithSchema(annotatedServiceMethods.ByName("ExportConfig")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		watchConfig: connect.NewClient[testdata.GetConfigRequest, testdata.Config](
+			httpClient,
+			baseURL+AnnotatedServiceWatchConfigProcedure,
+			connect.WithSchema(annotatedServiceMethods.ByName("WatchConfig")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// annotatedServiceClient implements AnnotatedServiceClient.
+type annotatedServiceClient struct {
+	applyConfig  *connect.Client[testdata.ApplyConfigRequest, testdata.ApplyConfigResponse]
+	legacyApply  *connect.Client[testdata.ApplyConfigRequest, testdata.ApplyConfigResponse]
+	listConfigs  *connect.Client[testdata.ListConfigsRequest, testdata.ListConfigsResponse]
+	getConfig    *connect.Client[testdata.GetConfigRequest, testdata.Config]
+	exportConfig *connect.Client[testdata.GetConfigRequest, testdata.ExportConfigResponse]
+	watchConfig  *connect.Client[testdata.GetConfigRequest, testdata.Config]
+}
+
+// ApplyConfig calls testdata.AnnotatedService.ApplyConfig.
+func (c *annotatedServiceClient) ApplyConfig(ctx context.Context, req *connect.Request[testdata.ApplyConfigRequest]) (*connect.Response[testdata.ApplyConfigResponse], error) {
+	return c.applyConfig.CallUnary(ctx, req)
+}
+
+// LegacyApply calls testdata.AnnotatedService.LegacyApply.
+//
+// Deprecated: do not use.
+func (c *annotatedServiceClient) LegacyApply(ctx context.Context, req *connect.Request[testdata.ApplyConfigRequest]) (*connect.Response[testdata.ApplyConfigResponse], error) {
+	return c.legacyApply.CallUnary(ctx, req)
+}
+
+// ListConfigs calls testdata.AnnotatedService.ListConfigs.
+func (c *annotatedServiceClient) ListConfigs(ctx context.Context, req *connect.Request[testdata.ListConfigsRequest]) (*connect.Response[testdata.ListConfigsResponse], error) {
+	return c.listConfigs.CallUnary(ctx, req)
+}
+
+// GetConfig calls testdata.AnnotatedService.GetConfig.
+func (c *annotatedServiceClient) GetConfig(ctx context.Context, req *connect.Request[testdata.GetConfigRequest]) (*connect.Response[testdata.Config], error) {
+	return c.getConfig.CallUnary(ctx, req)
+}
+
+// ExportConfig calls testdata.AnnotatedService.ExportConfig.
+func (c *annotatedServiceClient) ExportConfig(ctx context.Context, req *connect.Request[testdata.GetConfigRequest]) (*connect.Response[testdata.ExportConfigResponse], error) {
+	return c.exportConfig.CallUnary(ctx, req)
+}
+
+// WatchConfig calls testdata.AnnotatedService.WatchConfig.
+func (c *annotatedServiceClient) WatchConfig(ctx context.Context, req *connect.Request[testdata.GetConfigRequest]) (*connect.ServerStreamForClient[testdata.Config], error) {
+	return c.watchConfig.CallServerStream(ctx, req)
+}
+
+// AnnotatedServiceHandler is an implementation of the testdata.AnnotatedService service.
+type AnnotatedServiceHandler interface {
+	// ApplyConfig tests literal schema overrides on fields and messages
+	ApplyConfig(context.Context, *connect.Request[testdata.ApplyConfigRequest]) (*connect.Response[testdata.ApplyConfigResponse], error)
+	// LegacyApply tests deprecated method handling
+	//
+	// Deprecated: do not use.
+	LegacyApply(context.Context, *connect.Request[testdata.ApplyConfigRequest]) (*connect.Response[testdata.ApplyConfigResponse], error)
+	// ListConfigs tests page size defaults and caps
+	ListConfigs(context.Context, *connect.Request[testdata.ListConfigsRequest]) (*connect.Response[testdata.ListConfigsResponse], error)
+	// GetConfig tests resources derived from HTTP bindings
+	GetConfig(context.Context, *connect.Request[testdata.GetConfigRequest]) (*connect.Response[testdata.Config], error)
+	// ExportConfig tests file responses returned as embedded resources
+	ExportConfig(context.Context, *connect.Request[testdata.GetConfigRequest]) (*connect.Response[testdata.ExportConfigResponse], error)
+	// WatchConfig tests resource subscriptions backed by a watch stream
+	WatchConfig(context.Context, *connect.Request[testdata.GetConfigRequest], *connect.ServerStream[testdata.Config]) error
+}
+
+// NewAnnotatedServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAnnotatedServiceHandler(svc AnnotatedServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	annotatedServiceMethods := testdata.File_testdata_annotations_proto.Services().ByName("AnnotatedService").Methods()
+	annotatedServiceApplyConfigHandler := connect.NewUnaryHandler(
+		AnnotatedServiceApplyConfigProcedure,
+		svc.ApplyConfig,
+		connect.WithSchema(annotatedServiceMethods.ByName("ApplyConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	annotatedServiceLegacyApplyHandler := connect.NewUnaryHandler(
+		AnnotatedServiceLegacyApplyProcedure,
+		svc.LegacyApply,
+		connect.WithSchema(annotatedServiceMethods.ByName("LegacyApply")),
+		connect.WithHandlerOptions(opts...),
+	)
+	annotatedServiceListConfigsHandler := connect.NewUnaryHandler(
+		AnnotatedServiceListConfigsProcedure,
+		svc.ListConfigs,
+		connect.WithSchema(annotatedServiceMethods.ByName("ListConfigs")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	annotatedServiceGetConfigHandler := connect.NewUnaryHandler(
+		AnnotatedServiceGetConfigProcedure,
+		svc.GetConfig,
+		connect.WithSchema(annotatedServiceMethods.ByName("GetConfig")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	annotatedServiceExportConfigHandler := connect.NewUnaryHandler(
+		AnnotatedServiceExportConfigProcedure,
+		svc.ExportConfig,
+		connect.WithSchema(annotatedServiceMethods.ByName("ExportConfig")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	annotatedServiceWatchConfigHandler := connect.NewServerStreamHandler(
+		AnnotatedServiceWatchConfigProcedure,
+		svc.WatchConfig,
+		connect.WithSchema(annotatedServiceMethods.ByName("WatchConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/testdata.AnnotatedService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AnnotatedServiceApplyConfigProcedure:
+			annotatedServiceApplyConfigHandler.ServeHTTP(w, r)
+		case AnnotatedServiceLegacyApplyProcedure:
+			annotatedServiceLegacyApplyHandler.ServeHTTP(w, r)
+		case AnnotatedServiceListConfigsProcedure:
+			annotatedServiceListConfigsHandler.ServeHTTP(w, r)
+		case AnnotatedServiceGetConfigProcedure:
+			annotatedServiceGetConfigHandler.ServeHTTP(w, r)
+		case AnnotatedServiceExportConfigProcedure:
+			annotatedServiceExportConfigHandler.ServeHTTP(w, r)
+		case AnnotatedServiceWatchConfigProcedure:
+			annotatedServiceWatchConfigHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAnnotatedServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAnnotatedServiceHandler struct{}
+
+func (UnimplementedAnnotatedServiceHandler) ApplyConfig(context.Context, *connect.Request[testdata.ApplyConfigRequest]) (*connect.Response[testdata.ApplyConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.AnnotatedService.ApplyConfig is not implemented"))
+}
+
+func (UnimplementedAnnotatedServiceHandler) LegacyApply(context.Context, *connect.Request[testdata.ApplyConfigRequest]) (*connect.Response[testdata.ApplyConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.AnnotatedService.LegacyApply is not implemented"))
+}
+
+func (UnimplementedAnnotatedServiceHandler) ListConfigs(context.Context, *connect.Request[testdata.ListConfigsRequest]) (*connect.Response[testdata.ListConfigsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.AnnotatedService.ListConfigs is not implemented"))
+}
+
+func (UnimplementedAnnotatedServiceHandler) GetConfig(context.Context, *connect.Request[testdata.GetConfigRequest]) (*connect.Response[testdata.Config], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.AnnotatedService.GetConfig is not implemented"))
+}
+
+func (UnimplementedAnnotatedServiceHandler) ExportConfig(context.Context, *connect.Request[testdata.GetConfigRequest]) (*connect.Response[testdata.ExportConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.AnnotatedService.ExportConfig is not implemented"))
+}
+
+func (UnimplementedAnnotatedServiceHandler) WatchConfig(context.Context, *connect.Request[testdata.GetConfigRequest], *connect.ServerStream[testdata.Config]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("testdata.AnnotatedService.WatchConfig is not implemented"))
+}
@@ -23,6 +23,7 @@ import (
 	context "context"
 	errors "errors"
 	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	http "net/http"
 	strings "strings"
 )
@@ -74,6 +75,9 @@ const (
 	// EdgeCaseServiceOneofRecursiveProcedure is the fully-qualified name of the EdgeCaseService's
 	// OneofRecursive RPC.
 	EdgeCaseServiceOneofRecursiveProcedure = "/testdata.EdgeCaseService/OneofRecursive"
+	// EdgeCaseServiceNoArgumentsProcedure is the fully-qualified name of the EdgeCaseService's
+	// NoArguments RPC.
+	EdgeCaseServiceNoArgumentsProcedure = "/testdata.EdgeCaseService/NoArguments"
 )
 
 // EdgeCaseServiceClient is a client for the testdata.EdgeCaseService service.
@@ -98,6 +102,9 @@ type EdgeCaseServiceClient interface {
 	// the request (decode) and response (encode) sides. It exercises the oneof
 	// discriminated-wrapper transform combined with recursion-depth placeholders.
 	OneofRecursive(context.Context, *connect.Request[testdata.OneofRecursiveRequest]) (*connect.Response[testdata.OneofRecursiveResponse], error)
+	// NoArguments tests a method taking google.protobuf.Empty, whose tool
+	// accepts no arguments at all.
+	NoArguments(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[testdata.NoArgumentsResponse], error)
 }
 
 // NewEdgeCaseServiceClient constructs a client for the testdata.EdgeCaseService service. By
@@ -165,6 +172,12 @@ func NewEdgeCaseServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(edgeCaseServiceMethods.ByName("OneofRecursive")),
 			connect.WithClientOptions(opts...),
 		),
+		noArguments: connect.NewClient[emptypb.Empty, testdata.NoArgumentsResponse](
+			httpClient,
+			baseURL+EdgeCaseServiceNoArgumentsProcedure,
+			connect.WithSchema(edgeCaseServiceMethods.ByName("NoArguments")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -179,6 +192,7 @@ type edgeCaseServiceClient struct {
 	numericValidation *connect.Client[testdata.NumericValidationRequest, testdata.NumericValidationResponse]
 	recursiveTree     *connect.Client[testdata.RecursiveTreeRequest, testdata.RecursiveTreeResponse]
 	oneofRecursive    *connect.Client[testdata.OneofRecursiveRequest, testdata.OneofRecursiveResponse]
+	noArguments       *connect.Client[emptypb.Empty, testdata.NoArgumentsResponse]
 }
 
 // DeepNesting calls testdata.EdgeCaseService.DeepNesting.
@@ -226,6 +240,11 @@ func (c *edgeCaseServiceClient) OneofRecursive(ctx context.Context, req *connect
 	return c.oneofRecursive.CallUnary(ctx, req)
 }
 
+// NoArguments calls testdata.EdgeCaseService.NoArguments.
+func (c *edgeCaseServiceClient) NoArguments(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[testdata.NoArgumentsResponse], error) {
+	return c.noArguments.CallUnary(ctx, req)
+}
+
 // EdgeCaseServiceHandler is an implementation of the testdata.EdgeCaseService service.
 type EdgeCaseServiceHandler interface {
 	// DeepNesting tests deeply nested messages with maps and WKTs
@@ -248,6 +267,9 @@ type EdgeCaseServiceHandler interface {
 	// the request (decode) and response (encode) sides. It exercises the oneof
 	// discriminated-wrapper transform combined with recursion-depth placeholders.
 	OneofRecursive(context.Context, *connect.Request[testdata.OneofRecursiveRequest]) (*connect.Response[testdata.OneofRecursiveResponse], error)
+	// NoArguments tests a method taking google.protobuf.Empty, whose tool
+	// accepts no arguments at all.
+	NoArguments(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[testdata.NoArgumentsResponse], error)
 }
 
 // NewEdgeCaseServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -311,6 +333,12 @@ func NewEdgeCaseServiceHandler(svc EdgeCaseServiceHandler, opts ...connect.Handl
 		connect.WithSchema(edgeCaseServiceMethods.ByName("OneofRecursive")),
 		connect.WithHandlerOptions(opts...),
 	)
+	edgeCaseServiceNoArgumentsHandler := connect.NewUnaryHandler(
+		EdgeCaseServiceNoArgumentsProcedure,
+		svc.NoArguments,
+		connect.WithSchema(edgeCaseServiceMethods.ByName("NoArguments")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/testdata.EdgeCaseService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case EdgeCaseServiceDeepNestingProcedure:
@@ -331,6 +359,8 @@ func NewEdgeCaseServiceHandler(svc EdgeCaseServiceHandler, opts ...connect.Handl
 			edgeCaseServiceRecursiveTreeHandler.ServeHTTP(w, r)
 		case EdgeCaseServiceOneofRecursiveProcedure:
 			edgeCaseServiceOneofRecursiveHandler.ServeHTTP(w, r)
+		case EdgeCaseServiceNoArgumentsProcedure:
+			edgeCaseServiceNoArgumentsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -375,3 +405,7 @@ func (UnimplementedEdgeCaseServiceHandler) RecursiveTree(context.Context, *conne
 func (UnimplementedEdgeCaseServiceHandler) OneofRecursive(context.Context, *connect.Request[testdata.OneofRecursiveRequest]) (*connect.Response[testdata.OneofRecursiveResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.EdgeCaseService.OneofRecursive is not implemented"))
 }
+
+func (UnimplementedEdgeCaseServiceHandler) NoArguments(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[testdata.NoArgumentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.EdgeCaseService.NoArguments is not implemented"))
+}
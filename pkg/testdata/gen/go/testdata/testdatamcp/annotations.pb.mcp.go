@@ -0,0 +1,1754 @@
+// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
+// source: testdata/annotations.proto
+
+package testdatamcp
+
+import (
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+import (
+	"context"
+	"encoding/json"
+	"google.golang.org/protobuf/encoding/protojson"
+	"connectrpc.com/connect"
+	grpc "google.golang.org/grpc"
+	"github.com/redpanda-data/protoc-gen-go-mcp/pkg/runtime"
+)
+
+var (
+	AnnotatedService_ApplyConfigTool = runtime.Tool{
+		Name:            "testdata_AnnotatedService_ApplyConfig",
+		Description:     "ApplyConfig tests literal schema overrides on fields and messages\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"api_token":{"description":"Token used to call the cluster.","type":"string","writeOnly":true},"base_config":{"type":"string"},"cluster_id":{"description":"Cluster to apply the config to.","type":"string"},"labels":{"items":{"pattern":"^[a-z]+=[a-z]+$","type":"string"},"maxItems":8,"type":"array"},"legacy_name":{"type":"string"},"log_level":{"default":"info","type":"string"},"max_retries":{"default":5,"type":"integer"},"name":{"title":"Pipeline name","type":"string"},"old_owner":{"type":"string"},"pipeline_yaml":{"contentMediaType":"application/yaml","description":"A pipeline config as YAML with top-level input, pipeline and output keys.","type":"string"},"region":{"enum":["eu","us"],"type":"string"},"replicas":{"examples":[3],"type":"integer"},"threshold":{"properties":{"value":{"maximum":1,"minimum":0,"type":"number"}},"required":["value"],"type":"object"},"timeout":{"examples":["30s","5m"],"pattern":"^-?[0-9]+(\\.[0-9]+)?s$","type":["string","null"]},"validate_only":{"type":"boolean"}},"required":["cluster_id"],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"applied":{"type":"boolean"}},"required":[],"type":"object"}`),
+		Title:           "Apply pipeline config",
+		Tags:            []string{"configs", "deploy"},
+	}
+	AnnotatedService_ExportConfigTool = runtime.Tool{
+		Name:            "testdata_AnnotatedService_ExportConfig",
+		Description:     "ExportConfig tests file responses returned as embedded resources\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"api_token":{"description":"Token used to call the cluster.","type":"string","writeOnly":true},"cluster_id":{"description":"Cluster to apply the config to.","type":"string"},"name":{"type":"string"}},"required":["cluster_id"],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"content_type":{"type":"string"},"document":{"contentEncoding":"base64","format":"byte","type":"string"},"filename":{"type":"string"}},"required":[],"type":"object"}`),
+		Tags:            []string{"configs"},
+	}
+	AnnotatedService_GetConfigTool = runtime.Tool{
+		Name:            "testdata_AnnotatedService_GetConfig",
+		Description:     "GetConfig tests resources derived from HTTP bindings\n\nExamples:\n- Get the config named prod: {\"name\":\"configs/prod\"}",
+		RawInputSchema:  json.RawMessage(`{"properties":{"api_token":{"description":"Token used to call the cluster.","type":"string","writeOnly":true},"cluster_id":{"description":"Cluster to apply the config to.","type":"string"},"name":{"type":"string"}},"required":["cluster_id"],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"name":{"type":"string"}},"required":[],"type":"object"}`),
+		Tags:            []string{"configs"},
+	}
+	AnnotatedService_LegacyApplyTool = runtime.Tool{
+		Name:            "testdata_AnnotatedService_LegacyApply",
+		Description:     "LegacyApply tests deprecated method handling\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"api_token":{"description":"Token used to call the cluster.","type":"string","writeOnly":true},"base_config":{"type":"string"},"cluster_id":{"description":"Cluster to apply the config to.","type":"string"},"labels":{"items":{"pattern":"^[a-z]+=[a-z]+$","type":"string"},"maxItems":8,"type":"array"},"legacy_name":{"type":"string"},"log_level":{"default":"info","type":"string"},"max_retries":{"default":5,"type":"integer"},"name":{"title":"Pipeline name","type":"string"},"old_owner":{"type":"string"},"pipeline_yaml":{"contentMediaType":"application/yaml","description":"A pipeline config as YAML with top-level input, pipeline and output keys.","type":"string"},"replicas":{"examples":[3],"type":"integer"},"threshold":{"properties":{"value":{"maximum":1,"minimum":0,"type":"number"}},"required":["value"],"type":"object"},"timeout":{"examples":["30s","5m"],"pattern":"^-?[0-9]+(\\.[0-9]+)?s$","type":["string","null"]},"validate_only":{"type":"boolean"}},"required":["cluster_id"],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"applied":{"type":"boolean"}},"required":[],"type":"object"}`),
+		Tags:            []string{"configs"},
+	}
+	AnnotatedService_ListConfigsTool = runtime.Tool{
+		Name:            "testdata_AnnotatedService_ListConfigs",
+		Description:     "ListConfigs tests page size defaults and caps\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"api_token":{"description":"Token used to call the cluster.","type":"string","writeOnly":true},"cluster_id":{"description":"Cluster to apply the config to.","type":"string"},"page_size":{"default":50,"maximum":200,"type":"integer"},"page_token":{"type":"string"}},"required":["cluster_id"],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"configs":{"items":{"properties":{"name":{"type":"string"}},"required":[],"type":"object"},"type":"array"},"next_page_token":{"type":"string"}},"required":[],"type":"object"}`),
+		Tags:            []string{"configs"},
+	}
+	AnnotatedService_ApplyConfigExtraProperties = []runtime.ExtraProperty{
+		{Name: "cluster_id", Description: "Cluster to apply the config to.", Required: true, ContextKey: runtime.ExtraPropertyKey("cluster_id")},
+		{Name: "api_token", Description: "Token used to call the cluster.", ContextKey: runtime.ExtraPropertyKey("api_token"), Sensitive: true},
+		{Name: "region", ContextKey: runtime.ExtraPropertyKey("deploy_region"), Schema: json.RawMessage("{\"type\":\"string\",\"enum\":[\"eu\",\"us\"]}")},
+	}
+	AnnotatedService_ExportConfigExtraProperties = []runtime.ExtraProperty{
+		{Name: "cluster_id", Description: "Cluster to apply the config to.", Required: true, ContextKey: runtime.ExtraPropertyKey("cluster_id")},
+		{Name: "api_token", Description: "Token used to call the cluster.", ContextKey: runtime.ExtraPropertyKey("api_token"), Sensitive: true},
+	}
+	AnnotatedService_GetConfigExtraProperties = []runtime.ExtraProperty{
+		{Name: "cluster_id", Description: "Cluster to apply the config to.", Required: true, ContextKey: runtime.ExtraPropertyKey("cluster_id")},
+		{Name: "api_token", Description: "Token used to call the cluster.", ContextKey: runtime.ExtraPropertyKey("api_token"), Sensitive: true},
+	}
+	AnnotatedService_LegacyApplyExtraProperties = []runtime.ExtraProperty{
+		{Name: "cluster_id", Description: "Cluster to apply the config to.", Required: true, ContextKey: runtime.ExtraPropertyKey("cluster_id")},
+		{Name: "api_token", Description: "Token used to call the cluster.", ContextKey: runtime.ExtraPropertyKey("api_token"), Sensitive: true},
+	}
+	AnnotatedService_ListConfigsExtraProperties = []runtime.ExtraProperty{
+		{Name: "cluster_id", Description: "Cluster to apply the config to.", Required: true, ContextKey: runtime.ExtraPropertyKey("cluster_id")},
+		{Name: "api_token", Description: "Token used to call the cluster.", ContextKey: runtime.ExtraPropertyKey("api_token"), Sensitive: true},
+	}
+)
+
+// File_testdata_annotations_proto_GenerationInfo records the
+// generator version and options of the tools above, see
+// runtime.CheckGenerationInfo.
+var File_testdata_annotations_proto_GenerationInfo = runtime.GenerationInfo{
+	Source:  "testdata/annotations.proto",
+	Version: "devel",
+	Options: []string{
+		"deprecated_fields=keep",
+		"dry_run=false",
+		"exclude_deprecated_methods=false",
+		"exclude_fields=",
+		"flatten_nested=false",
+		"openai_strict=false",
+		"relative_times=false",
+		"resources=false",
+		"schema_draft=",
+		"schema_mappings=",
+		"schema_patches=",
+		"wrap_input=",
+	},
+	Cosmetic: []string{
+		"comment_directives=",
+		"comment_markdown=keep",
+		"dedupe_descriptions=false",
+		"examples_in_description=false",
+		"field_comments=none",
+		"max_field_description_bytes=0",
+		"max_tool_description_bytes=0",
+		"minify_schemas=false",
+		"shared_definitions=false",
+		"titles=false",
+	},
+}
+
+// AnnotatedServiceServer is compatible with the grpc-go server interface.
+type AnnotatedServiceServer interface {
+	ApplyConfig(ctx context.Context, req *testdata.ApplyConfigRequest) (*testdata.ApplyConfigResponse, error)
+	ExportConfig(ctx context.Context, req *testdata.GetConfigRequest) (*testdata.ExportConfigResponse, error)
+	GetConfig(ctx context.Context, req *testdata.GetConfigRequest) (*testdata.Config, error)
+	LegacyApply(ctx context.Context, req *testdata.ApplyConfigRequest) (*testdata.ApplyConfigResponse, error)
+	ListConfigs(ctx context.Context, req *testdata.ListConfigsRequest) (*testdata.ListConfigsResponse, error)
+	WatchConfig(req *testdata.GetConfigRequest, stream grpc.ServerStreamingServer[testdata.Config]) error
+}
+
+// RegisterAnnotatedServiceHandler registers standard MCP handlers for AnnotatedService
+func RegisterAnnotatedServiceHandler(s runtime.MCPServer, srv AnnotatedServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	ApplyConfigTool := AnnotatedService_ApplyConfigTool
+	ApplyConfigTool = runtime.ApplyConfig(ApplyConfigTool, config)
+	config.Completions.Add(ApplyConfigTool.Name, "base_config", config.Tenant.PropagateCompleter(runtime.ResourceCompleter(srv.ListConfigs)))
+
+	ApplyConfigHandler := runtime.ApplyMutatingHandlerConfig(ApplyConfigTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		var req testdata.ApplyConfigRequest
+
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := append(AnnotatedService_ApplyConfigExtraProperties, config.ExtraProperties...)
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, ApplyConfigTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Excluded fields are not in the schema; refuse them.
+		if err := runtime.RejectFields(req.ProtoReflect().Descriptor(), message, []string{"testdata.ApplyConfigRequest.internal_trace_id"}); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
+		}
+
+		// Rewrite oneof discriminated wrappers and recursion placeholders into the
+		// protojson-native shape. Errors are model-readable for self-correction.
+		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		marshaled, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+			return nil, err
+		}
+
+		done := runtime.StartBackendCall(ctx)
+		resp, err := srv.ApplyConfig(ctx, &req)
+		done(resp, err)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		structured, err := runtime.EncodeMessage(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.NewToolResultJSON(structured), nil
+	})
+	runtime.AddTool(s, config, ApplyConfigTool, ApplyConfigHandler)
+	ExportConfigTool := AnnotatedService_ExportConfigTool
+	ExportConfigTool = runtime.ApplyConfig(ExportConfigTool, config)
+	config.Completions.Add(ExportConfigTool.Name, "name", config.Tenant.PropagateCompleter(runtime.ResourceCompleter(srv.ListConfigs)))
+
+	ExportConfigHandler := runtime.ApplyHandlerConfig(ExportConfigTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		var req testdata.GetConfigRequest
+
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := append(AnnotatedService_ExportConfigExtraProperties, config.ExtraProperties...)
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, ExportConfigTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
+		}
+
+		// Rewrite oneof discriminated wrappers and recursion placeholders into the
+		// protojson-native shape. Errors are model-readable for self-correction.
+		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		marshaled, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+			return nil, err
+		}
+
+		done := runtime.StartBackendCall(ctx)
+		resp, err := srv.ExportConfig(ctx, &req)
+		done(resp, err)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		return runtime.NewToolResultFiles(ExportConfigTool.Name, resp)
+	})
+	runtime.AddTool(s, config, ExportConfigTool, ExportConfigHandler)
+	GetConfigTool := AnnotatedService_GetConfigTool
+	GetConfigTool = runtime.ApplyConfig(GetConfigTool, config)
+	config.Completions.Add(GetConfigTool.Name, "name", config.Tenant.PropagateCompleter(runtime.ResourceCompleter(srv.ListConfigs)))
+
+	GetConfigHandler := runtime.ApplyHandlerConfig(GetConfigTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		var req testdata.GetConfigRequest
+
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := append(AnnotatedService_GetConfigExtraProperties, config.ExtraProperties...)
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, GetConfigTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
+		}
+
+		// Rewrite oneof discriminated wrappers and recursion placeholders into the
+		// protojson-native shape. Errors are model-readable for self-correction.
+		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		marshaled, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+			return nil, err
+		}
+
+		done := runtime.StartBackendCall(ctx)
+		resp, err := srv.GetConfig(ctx, &req)
+		done(resp, err)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		structured, err := runtime.EncodeMessage(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.NewToolResultJSON(structured), nil
+	})
+	runtime.AddTool(s, config, GetConfigTool, GetConfigHandler)
+	LegacyApplyTool := AnnotatedService_LegacyApplyTool
+	LegacyApplyTool = runtime.ApplyConfig(LegacyApplyTool, config)
+	config.Completions.Add(LegacyApplyTool.Name, "base_config", config.Tenant.PropagateCompleter(runtime.ResourceCompleter(srv.ListConfigs)))
+
+	LegacyApplyHandler := runtime.ApplyMutatingHandlerConfig(LegacyApplyTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		var req testdata.ApplyConfigRequest
+
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := append(AnnotatedService_LegacyApplyExtraProperties, config.ExtraProperties...)
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, LegacyApplyTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Excluded fields are not in the schema; refuse them.
+		if err := runtime.RejectFields(req.ProtoReflect().Descriptor(), message, []string{"testdata.ApplyConfigRequest.internal_trace_id"}); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
+		}
+
+		// Rewrite oneof discriminated wrappers and recursion placeholders into the
+		// protojson-native shape. Errors are model-readable for self-correction.
+		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		marshaled, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+			return nil, err
+		}
+
+		done := runtime.StartBackendCall(ctx)
+		resp, err := srv.LegacyApply(ctx, &req)
+		done(resp, err)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		structured, err := runtime.EncodeMessage(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.NewToolResultJSON(structured), nil
+	})
+	runtime.AddTool(s, config, LegacyApplyTool, LegacyApplyHandler)
+	ListConfigsTool := AnnotatedService_ListConfigsTool
+	ListConfigsTool = runtime.ApplyConfig(ListConfigsTool, config)
+
+	ListConfigsHandler := runtime.ApplyHandlerConfig(ListConfigsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		var req testdata.ListConfigsRequest
+
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := append(AnnotatedService_ListConfigsExtraProperties, config.ExtraProperties...)
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, ListConfigsTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
+		}
+
+		// Rewrite oneof discriminated wrappers and recursion placeholders into the
+		// protojson-native shape. Errors are model-readable for self-correction.
+		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		marshaled, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+			return nil, err
+		}
+
+		done := runtime.StartBackendCall(ctx)
+		resp, err := srv.ListConfigs(ctx, &req)
+		done(resp, err)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		structured, err := runtime.EncodeMessage(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.NewToolResultJSON(structured), nil
+	})
+	runtime.AddTool(s, config, ListConfigsTool, ListConfigsHandler)
+
+	if runtime.MatchesTags(ListConfigsTool, config.Tags) {
+		// Reads call the tool, so they share its arguments pipeline and middleware.
+		runtime.AddResource(s, runtime.ApplyResourceConfig(runtime.Resource{URI: "configs://list", Name: "testdata_AnnotatedService_ListConfigs", Title: "", Description: "ListConfigs tests page size defaults and caps\n", MIMEType: "application/json"}, config), runtime.ToolResource("configs://list", (&testdata.ListConfigsRequest{}).ProtoReflect().Descriptor(), "", ListConfigsHandler))
+	}
+
+	runtime.AddWatchedResource(s, runtime.ApplyResourceConfig(runtime.Resource{URI: "configs://watch/{+name}", Name: "testdata_AnnotatedService_WatchConfig", Title: "", Description: "WatchConfig tests resource subscriptions backed by a watch stream\n", MIMEType: "application/json"}, config), config.Tenant.Subscriptions(config.Subscriptions), config.Tenant.PropagateWatch("configs://watch/{+name}", func(ctx context.Context, uri string, update runtime.ResourceUpdate) error {
+		var req testdata.GetConfigRequest
+		if err := runtime.SetURIVariables(&req, "configs://watch/{+name}", uri); err != nil {
+			return err
+		}
+		return srv.WatchConfig(&req, runtime.NewServerStream[testdata.Config](ctx, update))
+	}))
+	{
+		prompt := runtime.ApplyPromptConfig(runtime.Prompt{Name: "rollout", Title: "Roll out a config", Description: "Review the existing configs, then apply a new one.", Arguments: []runtime.PromptArgument{runtime.PromptArgument{Name: "name", Description: "Name of the pipeline to roll out.", Required: true}}}, config)
+		runtime.AddPrompt(s, prompt, runtime.TemplatePromptHandler(prompt, "List the configs with {{tool:ListConfigs}}, then apply pipeline {{name}} with {{tool:ApplyConfig}}.", map[string]string{
+			"tool:ApplyConfig": ApplyConfigTool.Name,
+			"tool:ListConfigs": ListConfigsTool.Name,
+		}))
+	}
+	{
+		prompt := runtime.ApplyPromptConfig(runtime.Prompt{Name: "apply_from", Title: "", Description: "Apply a pipeline config derived from an existing one.", Arguments: []runtime.PromptArgument{runtime.PromptArgument{Name: "base_config", Description: "Config to start from.", Required: true}, runtime.PromptArgument{Name: "notes", Description: "", Required: false}}}, config)
+		runtime.AddPrompt(s, prompt, runtime.TemplatePromptHandler(prompt, "Use {{tool}} to apply a config based on {{base_config}}. {{notes}}", map[string]string{
+			"tool": ApplyConfigTool.Name,
+		}))
+		config.Completions.Inherit(prompt.Name, ApplyConfigTool.Name, "base_config")
+	}
+}
+
+// AnnotatedServiceClient is compatible with the grpc-go client interface.
+type AnnotatedServiceClient interface {
+	ApplyConfig(ctx context.Context, req *testdata.ApplyConfigRequest, opts ...grpc.CallOption) (*testdata.ApplyConfigResponse, error)
+	ExportConfig(ctx context.Context, req *testdata.GetConfigRequest, opts ...grpc.CallOption) (*testdata.ExportConfigResponse, error)
+	GetConfig(ctx context.Context, req *testdata.GetConfigRequest, opts ...grpc.CallOption) (*testdata.Config, error)
+	LegacyApply(ctx context.Context, req *testdata.ApplyConfigRequest, opts ...grpc.CallOption) (*testdata.ApplyConfigResponse, error)
+	ListConfigs(ctx context.Context, req *testdata.ListConfigsRequest, opts ...grpc.CallOption) (*testdata.ListConfigsResponse, error)
+	WatchConfig(ctx context.Context, req *testdata.GetConfigRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[testdata.Config], error)
+}
+
+// ConnectAnnotatedServiceClient is compatible with the connectrpc-go client interface.
+type ConnectAnnotatedServiceClient interface {
+	ApplyConfig(ctx context.Context, req *connect.Request[testdata.ApplyConfigRequest]) (*connect.Response[testdata.ApplyConfigResponse], error)
+	ExportConfig(ctx context.Context, req *connect.Request[testdata.GetConfigRequest]) (*connect.Response[testdata.ExportConfigResponse], error)
+	GetConfig(ctx context.Context, req *connect.Request[testdata.GetConfigRequest]) (*connect.Response[testdata.Config], error)
+	LegacyApply(ctx context.Context, req *connect.Request[testdata.ApplyConfigRequest]) (*connect.Response[testdata.ApplyConfigResponse], error)
+	ListConfigs(ctx context.Context, req *connect.Request[testdata.ListConfigsRequest]) (*connect.Response[testdata.ListConfigsResponse], error)
+	WatchConfig(ctx context.Context, req *connect.Request[testdata.GetConfigRequest]) (*connect.ServerStreamForClient[testdata.Config], error)
+}
+
+// ForwardToConnectAnnotatedServiceClient registers a connectrpc client, to forward MCP calls to it.
+func ForwardToConnectAnnotatedServiceClient(s runtime.MCPServer, client ConnectAnnotatedServiceClient, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	ApplyConfigTool := AnnotatedService_ApplyConfigTool
+	ApplyConfigTool = runtime.ApplyConfig(ApplyConfigTool, config)
+	config.Completions.Add(ApplyConfigTool.Name, "base_config", config.Tenant.PropagateCompleter(runtime.ResourceCompleter(func(ctx context.Context, req *testdata.ListConfigsRequest) (*testdata.ListConfigsResponse, error) {
+		resp, err := client.ListConfigs(ctx, connect.NewRequest(req))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	})))
+
+	ApplyConfigHandler := runtime.ApplyMutatingHandlerConfig(ApplyConfigTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		var req testdata.ApplyConfigRequest
+
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := append(AnnotatedService_ApplyConfigExtraProperties, config.ExtraProperties...)
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, ApplyConfigTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Excluded fields are not in the schema; refuse them.
+		if err := runtime.RejectFields(req.ProtoReflect().Descriptor(), message, []string{"testdata.ApplyConfigRequest.internal_trace_id"}); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
+		}
+
+		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		marshaled, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+			return nil, err
+		}
+
+		creq := connect.NewRequest(&req)
+		runtime.SetOutgoingHeaders(ctx, creq.Header())
+		runtime.ApplyConnectHeaders(ctx, ApplyConfigTool.Name, config, creq.Header())
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.ApplyConfig(ctx, creq)
+		done(resp, err)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		structured, err := runtime.EncodeMessage(resp.Msg)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.NewToolResultJSON(structured), nil
+	})
+	runtime.AddTool(s, config, ApplyConfigTool, ApplyConfigHandler)
+	ExportConfigTool := AnnotatedService_ExportConfigTool
+	ExportConfigTool = runtime.ApplyConfig(ExportConfigTool, config)
+	config.Completions.Add(ExportConfigTool.Name, "name", config.Tenant.PropagateCompleter(runtime.ResourceCompleter(func(ctx context.Context, req *testdata.ListConfigsRequest) (*testdata.ListConfigsResponse, error) {
+		resp, err := client.ListConfigs(ctx, connect.NewRequest(req))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	})))
+
+	ExportConfigHandler := runtime.ApplyHandlerConfig(ExportConfigTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		var req testdata.GetConfigRequest
+
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := append(AnnotatedService_ExportConfigExtraProperties, config.ExtraProperties...)
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, ExportConfigTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
+		}
+
+		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		marshaled, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+			return nil, err
+		}
+
+		done := runtime.StartBackendCall(ctx)
+		resp, err := runtime.Hedge(ctx, config, func(ctx context.Context) (*connect.Response[testdata.ExportConfigResponse], error) {
+			creq := connect.NewRequest(&req)
+			runtime.SetOutgoingHeaders(ctx, creq.Header())
+			runtime.ApplyConnectHeaders(ctx, ExportConfigTool.Name, config, creq.Header())
+			return client.ExportConfig(ctx, creq)
+		})
+		done(resp, err)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		return runtime.NewToolResultFiles(ExportConfigTool.Name, resp.Msg)
+	})
+	runtime.AddTool(s, config, ExportConfigTool, ExportConfigHandler)
+	GetConfigTool := AnnotatedService_GetConfigTool
+	GetConfigTool = runtime.ApplyConfig(GetConfigTool, config)
+	config.Completions.Add(GetConfigTool.Name, "name", config.Tenant.PropagateCompleter(runtime.ResourceCompleter(func(ctx context.Context, req *testdata.ListConfigsRequest) (*testdata.ListConfigsResponse, error) {
+		resp, err := client.ListConfigs(ctx, connect.NewRequest(req))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	})))
+
+	GetConfigHandler := runtime.ApplyHandlerConfig(GetConfigTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		var req testdata.GetConfigRequest
+
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := append(AnnotatedService_GetConfigExtraProperties, config.ExtraProperties...)
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, GetConfigTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
+		}
+
+		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		marshaled, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+			return nil, err
+		}
+
+		done := runtime.StartBackendCall(ctx)
+		resp, err := runtime.Hedge(ctx, config, func(ctx context.Context) (*connect.Response[testdata.Config], error) {
+			creq := connect.NewRequest(&req)
+			runtime.SetOutgoingHeaders(ctx, creq.Header())
+			runtime.ApplyConnectHeaders(ctx, GetConfigTool.Name, config, creq.Header())
+			return client.GetConfig(ctx, creq)
+		})
+		done(resp, err)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		structured, err := runtime.EncodeMessage(resp.Msg)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.NewToolResultJSON(structured), nil
+	})
+	runtime.AddTool(s, config, GetConfigTool, GetConfigHandler)
+	LegacyApplyTool := AnnotatedService_LegacyApplyTool
+	LegacyApplyTool = runtime.ApplyConfig(LegacyApplyTool, config)
+	config.Completions.Add(LegacyApplyTool.Name, "base_config", config.Tenant.PropagateCompleter(runtime.ResourceCompleter(func(ctx context.Context, req *testdata.ListConfigsRequest) (*testdata.ListConfigsResponse, error) {
+		resp, err := client.ListConfigs(ctx, connect.NewRequest(req))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	})))
+
+	LegacyApplyHandler := runtime.ApplyMutatingHandlerConfig(LegacyApplyTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		var req testdata.ApplyConfigRequest
+
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := append(AnnotatedService_LegacyApplyExtraProperties, config.ExtraProperties...)
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, LegacyApplyTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Excluded fields are not in the schema; refuse them.
+		if err := runtime.RejectFields(req.ProtoReflect().Descriptor(), message, []string{"testdata.ApplyConfigRequest.internal_trace_id"}); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
+		}
+
+		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		marshaled, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+			return nil, err
+		}
+
+		creq := connect.NewRequest(&req)
+		runtime.SetOutgoingHeaders(ctx, creq.Header())
+		runtime.ApplyConnectHeaders(ctx, LegacyApplyTool.Name, config, creq.Header())
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.LegacyApply(ctx, creq)
+		done(resp, err)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		structured, err := runtime.EncodeMessage(resp.Msg)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.NewToolResultJSON(structured), nil
+	})
+	runtime.AddTool(s, config, LegacyApplyTool, LegacyApplyHandler)
+	ListConfigsTool := AnnotatedService_ListConfigsTool
+	ListConfigsTool = runtime.ApplyConfig(ListConfigsTool, config)
+
+	ListConfigsHandler := runtime.ApplyHandlerConfig(ListConfigsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		var req testdata.ListConfigsRequest
+
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := append(AnnotatedService_ListConfigsExtraProperties, config.ExtraProperties...)
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, ListConfigsTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
+		}
+
+		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		marshaled, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+			return nil, err
+		}
+
+		done := runtime.StartBackendCall(ctx)
+		resp, err := runtime.Hedge(ctx, config, func(ctx context.Context) (*connect.Response[testdata.ListConfigsResponse], error) {
+			creq := connect.NewRequest(&req)
+			runtime.SetOutgoingHeaders(ctx, creq.Header())
+			runtime.ApplyConnectHeaders(ctx, ListConfigsTool.Name, config, creq.Header())
+			return client.ListConfigs(ctx, creq)
+		})
+		done(resp, err)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		structured, err := runtime.EncodeMessage(resp.Msg)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.NewToolResultJSON(structured), nil
+	})
+	runtime.AddTool(s, config, ListConfigsTool, ListConfigsHandler)
+
+	if runtime.MatchesTags(ListConfigsTool, config.Tags) {
+		// Reads call the tool, so they share its arguments pipeline and middleware.
+		runtime.AddResource(s, runtime.ApplyResourceConfig(runtime.Resource{URI: "configs://list", Name: "testdata_AnnotatedService_ListConfigs", Title: "", Description: "ListConfigs tests page size defaults and caps\n", MIMEType: "application/json"}, config), runtime.ToolResource("configs://list", (&testdata.ListConfigsRequest{}).ProtoReflect().Descriptor(), "", ListConfigsHandler))
+	}
+
+	runtime.AddWatchedResource(s, runtime.ApplyResourceConfig(runtime.Resource{URI: "configs://watch/{+name}", Name: "testdata_AnnotatedService_WatchConfig", Title: "", Description: "WatchConfig tests resource subscriptions backed by a watch stream\n", MIMEType: "application/json"}, config), config.Tenant.Subscriptions(config.Subscriptions), config.Tenant.PropagateWatch("configs://watch/{+name}", func(ctx context.Context, uri string, update runtime.ResourceUpdate) error {
+		var req testdata.GetConfigRequest
+		if err := runtime.SetURIVariables(&req, "configs://watch/{+name}", uri); err != nil {
+			return err
+		}
+		stream, err := client.WatchConfig(ctx, connect.NewRequest(&req))
+		if err != nil {
+			return err
+		}
+		defer stream.Close()
+		for stream.Receive() {
+			update(stream.Msg())
+		}
+		return stream.Err()
+	}))
+	{
+		prompt := runtime.ApplyPromptConfig(runtime.Prompt{Name: "rollout", Title: "Roll out a config", Description: "Review the existing configs, then apply a new one.", Arguments: []runtime.PromptArgument{runtime.PromptArgument{Name: "name", Description: "Name of the pipeline to roll out.", Required: true}}}, config)
+		runtime.AddPrompt(s, prompt, runtime.TemplatePromptHandler(prompt, "List the configs with {{tool:ListConfigs}}, then apply pipeline {{name}} with {{tool:ApplyConfig}}.", map[string]string{
+			"tool:ApplyConfig": ApplyConfigTool.Name,
+			"tool:ListConfigs": ListConfigsTool.Name,
+		}))
+	}
+	{
+		prompt := runtime.ApplyPromptConfig(runtime.Prompt{Name: "apply_from", Title: "", Description: "Apply a pipeline config derived from an existing one.", Arguments: []runtime.PromptArgument{runtime.PromptArgument{Name: "base_config", Description: "Config to start from.", Required: true}, runtime.PromptArgument{Name: "notes", Description: "", Required: false}}}, config)
+		runtime.AddPrompt(s, prompt, runtime.TemplatePromptHandler(prompt, "Use {{tool}} to apply a config based on {{base_config}}. {{notes}}", map[string]string{
+			"tool": ApplyConfigTool.Name,
+		}))
+		config.Completions.Inherit(prompt.Name, ApplyConfigTool.Name, "base_config")
+	}
+}
+
+// ForwardToConnectAnnotatedServiceURL forwards MCP calls to the AnnotatedService at baseURL
+// with a connectrpc client. Select its wire protocol and codec with
+// runtime.WithConnectProtocol and runtime.WithConnectCodec.
+func ForwardToConnectAnnotatedServiceURL(s runtime.MCPServer, httpClient connect.HTTPClient, baseURL string, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	clientOpts := runtime.ConnectClientOptions(config)
+	ForwardToConnectAnnotatedServiceClient(s, connectAnnotatedServiceClient{
+		callApplyConfig:  connect.NewClient[testdata.ApplyConfigRequest, testdata.ApplyConfigResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.AnnotatedService/ApplyConfig"), clientOpts...),
+		callExportConfig: connect.NewClient[testdata.GetConfigRequest, testdata.ExportConfigResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.AnnotatedService/ExportConfig"), clientOpts...),
+		callGetConfig:    connect.NewClient[testdata.GetConfigRequest, testdata.Config](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.AnnotatedService/GetConfig"), clientOpts...),
+		callLegacyApply:  connect.NewClient[testdata.ApplyConfigRequest, testdata.ApplyConfigResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.AnnotatedService/LegacyApply"), clientOpts...),
+		callListConfigs:  connect.NewClient[testdata.ListConfigsRequest, testdata.ListConfigsResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.AnnotatedService/ListConfigs"), clientOpts...),
+		callWatchConfig:  connect.NewClient[testdata.GetConfigRequest, testdata.Config](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.AnnotatedService/WatchConfig"), clientOpts...),
+	}, opts...)
+}
+
+// connectAnnotatedServiceClient is the ConnectAnnotatedServiceClient of
+// ForwardToConnectAnnotatedServiceURL.
+type connectAnnotatedServiceClient struct {
+	callApplyConfig  *connect.Client[testdata.ApplyConfigRequest, testdata.ApplyConfigResponse]
+	callExportConfig *connect.Client[testdata.GetConfigRequest, testdata.ExportConfigResponse]
+	callGetConfig    *connect.Client[testdata.GetConfigRequest, testdata.Config]
+	callLegacyApply  *connect.Client[testdata.ApplyConfigRequest, testdata.ApplyConfigResponse]
+	callListConfigs  *connect.Client[testdata.ListConfigsRequest, testdata.ListConfigsResponse]
+	callWatchConfig  *connect.Client[testdata.GetConfigRequest, testdata.Config]
+}
+
+func (c connectAnnotatedServiceClient) ApplyConfig(ctx context.Context, req *connect.Request[testdata.ApplyConfigRequest]) (*connect.Response[testdata.ApplyConfigResponse], error) {
+	return c.callApplyConfig.CallUnary(ctx, req)
+}
+
+func (c connectAnnotatedServiceClient) ExportConfig(ctx context.Context, req *connect.Request[testdata.GetConfigRequest]) (*connect.Response[testdata.ExportConfigResponse], error) {
+	return c.callExportConfig.CallUnary(ctx, req)
+}
+
+func (c connectAnnotatedServiceClient) GetConfig(ctx context.Context, req *connect.Request[testdata.GetConfigRequest]) (*connect.Response[testdata.Config], error) {
+	return c.callGetConfig.CallUnary(ctx, req)
+}
+
+func (c connectAnnotatedServiceClient) LegacyApply(ctx context.Context, req *connect.Request[testdata.ApplyConfigRequest]) (*connect.Response[testdata.ApplyConfigResponse], error) {
+	return c.callLegacyApply.CallUnary(ctx, req)
+}
+
+func (c connectAnnotatedServiceClient) ListConfigs(ctx context.Context, req *connect.Request[testdata.ListConfigsRequest]) (*connect.Response[testdata.ListConfigsResponse], error) {
+	return c.callListConfigs.CallUnary(ctx, req)
+}
+
+func (c connectAnnotatedServiceClient) WatchConfig(ctx context.Context, req *connect.Request[testdata.GetConfigRequest]) (*connect.ServerStreamForClient[testdata.Config], error) {
+	return c.callWatchConfig.CallServerStream(ctx, req)
+}
+
+// ConnectAnnotatedServiceHandler is compatible with the connectrpc-go handler interface.
+type ConnectAnnotatedServiceHandler interface {
+	ApplyConfig(ctx context.Context, req *connect.Request[testdata.ApplyConfigRequest]) (*connect.Response[testdata.ApplyConfigResponse], error)
+	ExportConfig(ctx context.Context, req *connect.Request[testdata.GetConfigRequest]) (*connect.Response[testdata.ExportConfigResponse], error)
+	GetConfig(ctx context.Context, req *connect.Request[testdata.GetConfigRequest]) (*connect.Response[testdata.Config], error)
+	LegacyApply(ctx context.Context, req *connect.Request[testdata.ApplyConfigRequest]) (*connect.Response[testdata.ApplyConfigResponse], error)
+	ListConfigs(ctx context.Context, req *connect.Request[testdata.ListConfigsRequest]) (*connect.Response[testdata.ListConfigsResponse], error)
+	WatchConfig(ctx context.Context, req *connect.Request[testdata.GetConfigRequest], stream *connect.ServerStream[testdata.Config]) error
+}
+
+// ForwardToConnectAnnotatedServiceHandler forwards MCP calls to a connectrpc handler
+// implementation in the same process by calling its methods directly, without
+// serializing requests. Handler interceptors do not run. Watched resources
+// need a stream, which only a mounted handler can serve: forward to it with
+// ForwardToConnectAnnotatedServiceURL and runtime.InProcessHTTPClient instead.
+func ForwardToConnectAnnotatedServiceHandler(s runtime.MCPServer, handler ConnectAnnotatedServiceHandler, opts ...runtime.Option) {
+	ForwardToConnectAnnotatedServiceClient(s, handlerAnnotatedServiceClient{handler}, opts...)
+}
+
+// handlerAnnotatedServiceClient is the ConnectAnnotatedServiceClient of
+// ForwardToConnectAnnotatedServiceHandler.
+type handlerAnnotatedServiceClient struct {
+	ConnectAnnotatedServiceHandler
+}
+
+func (c handlerAnnotatedServiceClient) WatchConfig(ctx context.Context, req *connect.Request[testdata.GetConfigRequest]) (*connect.ServerStreamForClient[testdata.Config], error) {
+	return nil, runtime.ErrInProcessStream("/testdata.AnnotatedService/WatchConfig")
+}
+
+// ForwardToAnnotatedServiceClient registers a gRPC client, to forward MCP calls to it.
+func ForwardToAnnotatedServiceClient(s runtime.MCPServer, client AnnotatedServiceClient, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	ApplyConfigTool := AnnotatedService_ApplyConfigTool
+	ApplyConfigTool = runtime.ApplyConfig(ApplyConfigTool, config)
+	config.Completions.Add(ApplyConfigTool.Name, "base_config", config.Tenant.PropagateCompleter(runtime.ResourceCompleter(func(ctx context.Context, req *testdata.ListConfigsRequest) (*testdata.ListConfigsResponse, error) {
+		return client.ListConfigs(ctx, req)
+	})))
+
+	ApplyConfigHandler := runtime.ApplyMutatingHandlerConfig(ApplyConfigTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		var req testdata.ApplyConfigRequest
+
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := append(AnnotatedService_ApplyConfigExtraProperties, config.ExtraProperties...)
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, ApplyConfigTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Excluded fields are not in the schema; refuse them.
+		if err := runtime.RejectFields(req.ProtoReflect().Descriptor(), message, []string{"testdata.ApplyConfigRequest.internal_trace_id"}); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
+		}
+
+		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		marshaled, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+			return nil, err
+		}
+
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.ApplyConfig(ctx, &req, runtime.ApplyCallOptions(ctx, ApplyConfigTool.Name, config)...)
+		done(resp, err)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		structured, err := runtime.EncodeMessage(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.NewToolResultJSON(structured), nil
+	})
+	runtime.AddTool(s, config, ApplyConfigTool, ApplyConfigHandler)
+	ExportConfigTool := AnnotatedService_ExportConfigTool
+	ExportConfigTool = runtime.ApplyConfig(ExportConfigTool, config)
+	config.Completions.Add(ExportConfigTool.Name, "name", config.Tenant.PropagateCompleter(runtime.ResourceCompleter(func(ctx context.Context, req *testdata.ListConfigsRequest) (*testdata.ListConfigsResponse, error) {
+		return client.ListConfigs(ctx, req)
+	})))
+
+	ExportConfigHandler := runtime.ApplyHandlerConfig(ExportConfigTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		var req testdata.GetConfigRequest
+
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := append(AnnotatedService_ExportConfigExtraProperties, config.ExtraProperties...)
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, ExportConfigTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
+		}
+
+		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		marshaled, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+			return nil, err
+		}
+
+		done := runtime.StartBackendCall(ctx)
+		resp, err := runtime.Hedge(ctx, config, func(ctx context.Context) (*testdata.ExportConfigResponse, error) {
+			return client.ExportConfig(ctx, &req, runtime.ApplyCallOptions(ctx, ExportConfigTool.Name, config)...)
+		})
+		done(resp, err)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		return runtime.NewToolResultFiles(ExportConfigTool.Name, resp)
+	})
+	runtime.AddTool(s, config, ExportConfigTool, ExportConfigHandler)
+	GetConfigTool := AnnotatedService_GetConfigTool
+	GetConfigTool = runtime.ApplyConfig(GetConfigTool, config)
+	config.Completions.Add(GetConfigTool.Name, "name", config.Tenant.PropagateCompleter(runtime.ResourceCompleter(func(ctx context.Context, req *testdata.ListConfigsRequest) (*testdata.ListConfigsResponse, error) {
+		return client.ListConfigs(ctx, req)
+	})))
+
+	GetConfigHandler := runtime.ApplyHandlerConfig(GetConfigTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		var req testdata.GetConfigRequest
+
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := append(AnnotatedService_GetConfigExtraProperties, config.ExtraProperties...)
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, GetConfigTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
+		}
+
+		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		marshaled, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+			return nil, err
+		}
+
+		done := runtime.StartBackendCall(ctx)
+		resp, err := runtime.Hedge(ctx, config, func(ctx context.Context) (*testdata.Config, error) {
+			return client.GetConfig(ctx, &req, runtime.ApplyCallOptions(ctx, GetConfigTool.Name, config)...)
+		})
+		done(resp, err)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		structured, err := runtime.EncodeMessage(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.NewToolResultJSON(structured), nil
+	})
+	runtime.AddTool(s, config, GetConfigTool, GetConfigHandler)
+	LegacyApplyTool := AnnotatedService_LegacyApplyTool
+	LegacyApplyTool = runtime.ApplyConfig(LegacyApplyTool, config)
+	config.Completions.Add(LegacyApplyTool.Name, "base_config", config.Tenant.PropagateCompleter(runtime.ResourceCompleter(func(ctx context.Context, req *testdata.ListConfigsRequest) (*testdata.ListConfigsResponse, error) {
+		return client.ListConfigs(ctx, req)
+	})))
+
+	LegacyApplyHandler := runtime.ApplyMutatingHandlerConfig(LegacyApplyTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		var req testdata.ApplyConfigRequest
+
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := append(AnnotatedService_LegacyApplyExtraProperties, config.ExtraProperties...)
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, LegacyApplyTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Excluded fields are not in the schema; refuse them.
+		if err := runtime.RejectFields(req.ProtoReflect().Descriptor(), message, []string{"testdata.ApplyConfigRequest.internal_trace_id"}); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
+		}
+
+		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		marshaled, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+			return nil, err
+		}
+
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.LegacyApply(ctx, &req, runtime.ApplyCallOptions(ctx, LegacyApplyTool.Name, config)...)
+		done(resp, err)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		structured, err := runtime.EncodeMessage(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.NewToolResultJSON(structured), nil
+	})
+	runtime.AddTool(s, config, LegacyApplyTool, LegacyApplyHandler)
+	ListConfigsTool := AnnotatedService_ListConfigsTool
+	ListConfigsTool = runtime.ApplyConfig(ListConfigsTool, config)
+
+	ListConfigsHandler := runtime.ApplyHandlerConfig(ListConfigsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		var req testdata.ListConfigsRequest
+
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := append(AnnotatedService_ListConfigsExtraProperties, config.ExtraProperties...)
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, ListConfigsTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
+		}
+
+		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		marshaled, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+			return nil, err
+		}
+
+		done := runtime.StartBackendCall(ctx)
+		resp, err := runtime.Hedge(ctx, config, func(ctx context.Context) (*testdata.ListConfigsResponse, error) {
+			return client.ListConfigs(ctx, &req, runtime.ApplyCallOptions(ctx, ListConfigsTool.Name, config)...)
+		})
+		done(resp, err)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		structured, err := runtime.EncodeMessage(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.NewToolResultJSON(structured), nil
+	})
+	runtime.AddTool(s, config, ListConfigsTool, ListConfigsHandler)
+
+	if runtime.MatchesTags(ListConfigsTool, config.Tags) {
+		// Reads call the tool, so they share its arguments pipeline and middleware.
+		runtime.AddResource(s, runtime.ApplyResourceConfig(runtime.Resource{URI: "configs://list", Name: "testdata_AnnotatedService_ListConfigs", Title: "", Description: "ListConfigs tests page size defaults and caps\n", MIMEType: "application/json"}, config), runtime.ToolResource("configs://list", (&testdata.ListConfigsRequest{}).ProtoReflect().Descriptor(), "", ListConfigsHandler))
+	}
+
+	runtime.AddWatchedResource(s, runtime.ApplyResourceConfig(runtime.Resource{URI: "configs://watch/{+name}", Name: "testdata_AnnotatedService_WatchConfig", Title: "", Description: "WatchConfig tests resource subscriptions backed by a watch stream\n", MIMEType: "application/json"}, config), config.Tenant.Subscriptions(config.Subscriptions), config.Tenant.PropagateWatch("configs://watch/{+name}", func(ctx context.Context, uri string, update runtime.ResourceUpdate) error {
+		var req testdata.GetConfigRequest
+		if err := runtime.SetURIVariables(&req, "configs://watch/{+name}", uri); err != nil {
+			return err
+		}
+		stream, err := client.WatchConfig(ctx, &req)
+		if err != nil {
+			return err
+		}
+		return runtime.RecvAll(stream.Recv, update)
+	}))
+	{
+		prompt := runtime.ApplyPromptConfig(runtime.Prompt{Name: "rollout", Title: "Roll out a config", Description: "Review the existing configs, then apply a new one.", Arguments: []runtime.PromptArgument{runtime.PromptArgument{Name: "name", Description: "Name of the pipeline to roll out.", Required: true}}}, config)
+		runtime.AddPrompt(s, prompt, runtime.TemplatePromptHandler(prompt, "List the configs with {{tool:ListConfigs}}, then apply pipeline {{name}} with {{tool:ApplyConfig}}.", map[string]string{
+			"tool:ApplyConfig": ApplyConfigTool.Name,
+			"tool:ListConfigs": ListConfigsTool.Name,
+		}))
+	}
+	{
+		prompt := runtime.ApplyPromptConfig(runtime.Prompt{Name: "apply_from", Title: "", Description: "Apply a pipeline config derived from an existing one.", Arguments: []runtime.PromptArgument{runtime.PromptArgument{Name: "base_config", Description: "Config to start from.", Required: true}, runtime.PromptArgument{Name: "notes", Description: "", Required: false}}}, config)
+		runtime.AddPrompt(s, prompt, runtime.TemplatePromptHandler(prompt, "Use {{tool}} to apply a config based on {{base_config}}. {{notes}}", map[string]string{
+			"tool": ApplyConfigTool.Name,
+		}))
+		config.Completions.Inherit(prompt.Name, ApplyConfigTool.Name, "base_config")
+	}
+}
+
+// ForwardToAnnotatedServiceConn forwards MCP calls to the AnnotatedService behind conn,
+// e.g. a *grpc.ClientConn with interceptors or a connection pool, without
+// building a typed gRPC client first.
+func ForwardToAnnotatedServiceConn(s runtime.MCPServer, conn grpc.ClientConnInterface, opts ...runtime.Option) {
+	ForwardToAnnotatedServiceClient(s, connAnnotatedServiceClient{conn}, opts...)
+}
+
+// connAnnotatedServiceClient is the AnnotatedServiceClient of
+// ForwardToAnnotatedServiceConn.
+type connAnnotatedServiceClient struct {
+	conn grpc.ClientConnInterface
+}
+
+func (c connAnnotatedServiceClient) ApplyConfig(ctx context.Context, req *testdata.ApplyConfigRequest, opts ...grpc.CallOption) (*testdata.ApplyConfigResponse, error) {
+	resp := new(testdata.ApplyConfigResponse)
+	if err := c.conn.Invoke(ctx, "/testdata.AnnotatedService/ApplyConfig", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connAnnotatedServiceClient) ExportConfig(ctx context.Context, req *testdata.GetConfigRequest, opts ...grpc.CallOption) (*testdata.ExportConfigResponse, error) {
+	resp := new(testdata.ExportConfigResponse)
+	if err := c.conn.Invoke(ctx, "/testdata.AnnotatedService/ExportConfig", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connAnnotatedServiceClient) GetConfig(ctx context.Context, req *testdata.GetConfigRequest, opts ...grpc.CallOption) (*testdata.Config, error) {
+	resp := new(testdata.Config)
+	if err := c.conn.Invoke(ctx, "/testdata.AnnotatedService/GetConfig", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connAnnotatedServiceClient) LegacyApply(ctx context.Context, req *testdata.ApplyConfigRequest, opts ...grpc.CallOption) (*testdata.ApplyConfigResponse, error) {
+	resp := new(testdata.ApplyConfigResponse)
+	if err := c.conn.Invoke(ctx, "/testdata.AnnotatedService/LegacyApply", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connAnnotatedServiceClient) ListConfigs(ctx context.Context, req *testdata.ListConfigsRequest, opts ...grpc.CallOption) (*testdata.ListConfigsResponse, error) {
+	resp := new(testdata.ListConfigsResponse)
+	if err := c.conn.Invoke(ctx, "/testdata.AnnotatedService/ListConfigs", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connAnnotatedServiceClient) WatchConfig(ctx context.Context, req *testdata.GetConfigRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[testdata.Config], error) {
+	stream, err := c.conn.NewStream(ctx, &grpc.StreamDesc{StreamName: "WatchConfig", ServerStreams: true}, "/testdata.AnnotatedService/WatchConfig", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[testdata.GetConfigRequest, testdata.Config]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(req); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// ForwardToAnnotatedServiceHTTP forwards MCP calls to the JSON gateway of AnnotatedService
+// at baseURL, through the google.api.http bindings of its RPCs. RPCs without
+// a binding fail with UNIMPLEMENTED. See runtime.CallHTTP.
+func ForwardToAnnotatedServiceHTTP(s runtime.MCPServer, client runtime.HTTPClient, baseURL string, opts ...runtime.Option) {
+	ForwardToAnnotatedServiceClient(s, httpAnnotatedServiceClient{client: client, baseURL: baseURL}, opts...)
+}
+
+// httpAnnotatedServiceClient is the AnnotatedServiceClient of
+// ForwardToAnnotatedServiceHTTP.
+type httpAnnotatedServiceClient struct {
+	client  runtime.HTTPClient
+	baseURL string
+}
+
+func (c httpAnnotatedServiceClient) ApplyConfig(context.Context, *testdata.ApplyConfigRequest, ...grpc.CallOption) (*testdata.ApplyConfigResponse, error) {
+	return nil, runtime.ErrNoHTTPBinding("/testdata.AnnotatedService/ApplyConfig")
+}
+
+func (c httpAnnotatedServiceClient) ExportConfig(context.Context, *testdata.GetConfigRequest, ...grpc.CallOption) (*testdata.ExportConfigResponse, error) {
+	return nil, runtime.ErrNoHTTPBinding("/testdata.AnnotatedService/ExportConfig")
+}
+
+func (c httpAnnotatedServiceClient) GetConfig(ctx context.Context, req *testdata.GetConfigRequest, _ ...grpc.CallOption) (*testdata.Config, error) {
+	var resp testdata.Config
+	if err := runtime.CallHTTP(ctx, c.client, c.baseURL, runtime.HTTPBinding{Method: "GET", Path: "/v1/{name=configs/*}", Body: "", ResponseBody: ""}, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c httpAnnotatedServiceClient) LegacyApply(context.Context, *testdata.ApplyConfigRequest, ...grpc.CallOption) (*testdata.ApplyConfigResponse, error) {
+	return nil, runtime.ErrNoHTTPBinding("/testdata.AnnotatedService/LegacyApply")
+}
+
+func (c httpAnnotatedServiceClient) ListConfigs(context.Context, *testdata.ListConfigsRequest, ...grpc.CallOption) (*testdata.ListConfigsResponse, error) {
+	return nil, runtime.ErrNoHTTPBinding("/testdata.AnnotatedService/ListConfigs")
+}
+
+func (c httpAnnotatedServiceClient) WatchConfig(context.Context, *testdata.GetConfigRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[testdata.Config], error) {
+	return nil, runtime.ErrNoHTTPBinding("/testdata.AnnotatedService/WatchConfig")
+}
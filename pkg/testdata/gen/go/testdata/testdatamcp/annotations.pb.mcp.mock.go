@@ -0,0 +1,184 @@
+// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
+// source: testdata/annotations.proto
+
+package testdatamcp
+
+import (
+	context "context"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	grpc "google.golang.org/grpc"
+	sync "sync"
+)
+
+// AnnotatedServiceServerMock is a AnnotatedServiceServer for testing MCP wiring and
+// interceptors without a backend. A method calls its <Method>Func when set,
+// and otherwise returns <Method>Err or <Method>Response, defaulting to an empty
+// response. Streaming methods send <Method>Responses instead. <Method>Calls
+// returns the requests a method got.
+type AnnotatedServiceServerMock struct {
+	ApplyConfigFunc      func(ctx context.Context, req *testdata.ApplyConfigRequest) (*testdata.ApplyConfigResponse, error)
+	ApplyConfigResponse  *testdata.ApplyConfigResponse
+	ApplyConfigErr       error
+	LegacyApplyFunc      func(ctx context.Context, req *testdata.ApplyConfigRequest) (*testdata.ApplyConfigResponse, error)
+	LegacyApplyResponse  *testdata.ApplyConfigResponse
+	LegacyApplyErr       error
+	ListConfigsFunc      func(ctx context.Context, req *testdata.ListConfigsRequest) (*testdata.ListConfigsResponse, error)
+	ListConfigsResponse  *testdata.ListConfigsResponse
+	ListConfigsErr       error
+	GetConfigFunc        func(ctx context.Context, req *testdata.GetConfigRequest) (*testdata.Config, error)
+	GetConfigResponse    *testdata.Config
+	GetConfigErr         error
+	ExportConfigFunc     func(ctx context.Context, req *testdata.GetConfigRequest) (*testdata.ExportConfigResponse, error)
+	ExportConfigResponse *testdata.ExportConfigResponse
+	ExportConfigErr      error
+	WatchConfigFunc      func(req *testdata.GetConfigRequest, stream grpc.ServerStreamingServer[testdata.Config]) error
+	WatchConfigResponses []*testdata.Config
+	WatchConfigErr       error
+
+	mu                sync.Mutex
+	callsApplyConfig  []*testdata.ApplyConfigRequest
+	callsLegacyApply  []*testdata.ApplyConfigRequest
+	callsListConfigs  []*testdata.ListConfigsRequest
+	callsGetConfig    []*testdata.GetConfigRequest
+	callsExportConfig []*testdata.GetConfigRequest
+	callsWatchConfig  []*testdata.GetConfigRequest
+}
+
+var _ AnnotatedServiceServer = (*AnnotatedServiceServerMock)(nil)
+
+func (m *AnnotatedServiceServerMock) ApplyConfig(ctx context.Context, req *testdata.ApplyConfigRequest) (*testdata.ApplyConfigResponse, error) {
+	m.mu.Lock()
+	m.callsApplyConfig = append(m.callsApplyConfig, req)
+	m.mu.Unlock()
+	if m.ApplyConfigFunc != nil {
+		return m.ApplyConfigFunc(ctx, req)
+	}
+	if m.ApplyConfigErr != nil {
+		return nil, m.ApplyConfigErr
+	}
+	if m.ApplyConfigResponse != nil {
+		return m.ApplyConfigResponse, nil
+	}
+	return &testdata.ApplyConfigResponse{}, nil
+}
+
+// ApplyConfigCalls returns the requests ApplyConfig was called with, in order.
+func (m *AnnotatedServiceServerMock) ApplyConfigCalls() []*testdata.ApplyConfigRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.ApplyConfigRequest(nil), m.callsApplyConfig...)
+}
+
+func (m *AnnotatedServiceServerMock) LegacyApply(ctx context.Context, req *testdata.ApplyConfigRequest) (*testdata.ApplyConfigResponse, error) {
+	m.mu.Lock()
+	m.callsLegacyApply = append(m.callsLegacyApply, req)
+	m.mu.Unlock()
+	if m.LegacyApplyFunc != nil {
+		return m.LegacyApplyFunc(ctx, req)
+	}
+	if m.LegacyApplyErr != nil {
+		return nil, m.LegacyApplyErr
+	}
+	if m.LegacyApplyResponse != nil {
+		return m.LegacyApplyResponse, nil
+	}
+	return &testdata.ApplyConfigResponse{}, nil
+}
+
+// LegacyApplyCalls returns the requests LegacyApply was called with, in order.
+func (m *AnnotatedServiceServerMock) LegacyApplyCalls() []*testdata.ApplyConfigRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.ApplyConfigRequest(nil), m.callsLegacyApply...)
+}
+
+func (m *AnnotatedServiceServerMock) ListConfigs(ctx context.Context, req *testdata.ListConfigsRequest) (*testdata.ListConfigsResponse, error) {
+	m.mu.Lock()
+	m.callsListConfigs = append(m.callsListConfigs, req)
+	m.mu.Unlock()
+	if m.ListConfigsFunc != nil {
+		return m.ListConfigsFunc(ctx, req)
+	}
+	if m.ListConfigsErr != nil {
+		return nil, m.ListConfigsErr
+	}
+	if m.ListConfigsResponse != nil {
+		return m.ListConfigsResponse, nil
+	}
+	return &testdata.ListConfigsResponse{}, nil
+}
+
+// ListConfigsCalls returns the requests ListConfigs was called with, in order.
+func (m *AnnotatedServiceServerMock) ListConfigsCalls() []*testdata.ListConfigsRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.ListConfigsRequest(nil), m.callsListConfigs...)
+}
+
+func (m *AnnotatedServiceServerMock) GetConfig(ctx context.Context, req *testdata.GetConfigRequest) (*testdata.Config, error) {
+	m.mu.Lock()
+	m.callsGetConfig = append(m.callsGetConfig, req)
+	m.mu.Unlock()
+	if m.GetConfigFunc != nil {
+		return m.GetConfigFunc(ctx, req)
+	}
+	if m.GetConfigErr != nil {
+		return nil, m.GetConfigErr
+	}
+	if m.GetConfigResponse != nil {
+		return m.GetConfigResponse, nil
+	}
+	return &testdata.Config{}, nil
+}
+
+// GetConfigCalls returns the requests GetConfig was called with, in order.
+func (m *AnnotatedServiceServerMock) GetConfigCalls() []*testdata.GetConfigRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.GetConfigRequest(nil), m.callsGetConfig...)
+}
+
+func (m *AnnotatedServiceServerMock) ExportConfig(ctx context.Context, req *testdata.GetConfigRequest) (*testdata.ExportConfigResponse, error) {
+	m.mu.Lock()
+	m.callsExportConfig = append(m.callsExportConfig, req)
+	m.mu.Unlock()
+	if m.ExportConfigFunc != nil {
+		return m.ExportConfigFunc(ctx, req)
+	}
+	if m.ExportConfigErr != nil {
+		return nil, m.ExportConfigErr
+	}
+	if m.ExportConfigResponse != nil {
+		return m.ExportConfigResponse, nil
+	}
+	return &testdata.ExportConfigResponse{}, nil
+}
+
+// ExportConfigCalls returns the requests ExportConfig was called with, in order.
+func (m *AnnotatedServiceServerMock) ExportConfigCalls() []*testdata.GetConfigRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.GetConfigRequest(nil), m.callsExportConfig...)
+}
+
+func (m *AnnotatedServiceServerMock) WatchConfig(req *testdata.GetConfigRequest, stream grpc.ServerStreamingServer[testdata.Config]) error {
+	m.mu.Lock()
+	m.callsWatchConfig = append(m.callsWatchConfig, req)
+	m.mu.Unlock()
+	if m.WatchConfigFunc != nil {
+		return m.WatchConfigFunc(req, stream)
+	}
+	for _, resp := range m.WatchConfigResponses {
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	return m.WatchConfigErr
+}
+
+// WatchConfigCalls returns the requests WatchConfig was called with, in order.
+func (m *AnnotatedServiceServerMock) WatchConfigCalls() []*testdata.GetConfigRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.GetConfigRequest(nil), m.callsWatchConfig...)
+}
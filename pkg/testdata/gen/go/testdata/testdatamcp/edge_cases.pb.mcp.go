@@ -5,6 +5,7 @@ package testdatamcp
 
 import (
 	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 import (
@@ -17,17 +18,102 @@ import (
 )
 
 var (
-	EdgeCaseService_AllScalarTypesTool    = runtime.Tool{Name: "testdata_EdgeCaseService_AllScalarTypes", Description: "AllScalarTypes tests all protobuf scalar types\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x2c, 0x22, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x22, 0x2c, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3a, 0x22, 0x62, 0x79, 0x74, 0x65, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
-	EdgeCaseService_DeepNestingTool       = runtime.Tool{Name: "testdata_EdgeCaseService_DeepNesting", Description: "DeepNesting tests deeply nested messages with maps and WKTs\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x61, 0x20, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2c, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x2c, 0x20, 0x61, 0x72, 0x72, 0x61, 0x79, 0x2c, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x29, 0x2e, 0x22, 0x7d, 0x2c, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x61, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x61, 0x20, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2c, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x2c, 0x20, 0x61, 0x72, 0x72, 0x61, 0x79, 0x2c, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x29, 0x2e, 0x22, 0x7d, 0x2c, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x61, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x61, 0x20, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2c, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x2c, 0x20, 0x61, 0x72, 0x72, 0x61, 0x79, 0x2c, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x29, 0x2e, 0x22, 0x7d, 0x2c, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x61, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x61, 0x20, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2c, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x2c, 0x20, 0x61, 0x72, 0x72, 0x61, 0x79, 0x2c, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x29, 0x2e, 0x22, 0x7d, 0x2c, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x61, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x61, 0x20, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2c, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x2c, 0x20, 0x61, 0x72, 0x72, 0x61, 0x79, 0x2c, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x29, 0x2e, 0x22, 0x7d, 0x2c, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x61, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x61, 0x20, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2c, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x2c, 0x20, 0x61, 0x72, 0x72, 0x61, 0x79, 0x2c, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x29, 0x2e, 0x22, 0x7d, 0x2c, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x61, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
-	EdgeCaseService_EnumFieldsTool        = runtime.Tool{Name: "testdata_EdgeCaseService_EnumFields", Description: "EnumFields tests enum handling\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0x3a, 0x5b, 0x22, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x22, 0x2c, 0x22, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x57, 0x22, 0x2c, 0x22, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x22, 0x2c, 0x22, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x22, 0x2c, 0x22, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x3a, 0x7b, 0x22, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0x3a, 0x5b, 0x22, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x22, 0x2c, 0x22, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x57, 0x22, 0x2c, 0x22, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x22, 0x2c, 0x22, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x22, 0x2c, 0x22, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
-	EdgeCaseService_MapVariantsTool       = runtime.Tool{Name: "testdata_EdgeCaseService_MapVariants", Description: "MapVariants tests all map key/value type combinations\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0x3a, 0x5b, 0x22, 0x74, 0x72, 0x75, 0x65, 0x22, 0x2c, 0x22, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x3a, 0x22, 0x5e, 0x2d, 0x3f, 0x28, 0x30, 0x7c, 0x5b, 0x31, 0x2d, 0x39, 0x5d, 0x5c, 0x5c, 0x64, 0x2a, 0x29, 0x24, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x61, 0x20, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2c, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x2c, 0x20, 0x61, 0x72, 0x72, 0x61, 0x79, 0x2c, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x29, 0x2e, 0x22, 0x7d, 0x2c, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x61, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x3a, 0x22, 0x5e, 0x28, 0x30, 0x7c, 0x5b, 0x31, 0x2d, 0x39, 0x5d, 0x5c, 0x5c, 0x64, 0x2a, 0x29, 0x24, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
-	EdgeCaseService_MultipleOneofsTool    = runtime.Tool{Name: "testdata_EdgeCaseService_MultipleOneofs", Description: "MultipleOneofs tests multiple oneof groups in a single message\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x45, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x5c, 0x22, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x5c, 0x22, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x20, 0x53, 0x65, 0x74, 0x20, 0x5c, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x5c, 0x22, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x65, 0x74, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x57, 0x68, 0x69, 0x63, 0x68, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x5c, 0x22, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x5c, 0x22, 0x20, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x20, 0x69, 0x73, 0x20, 0x73, 0x65, 0x74, 0x2e, 0x22, 0x2c, 0x22, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0x3a, 0x5b, 0x22, 0x61, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x2c, 0x22, 0x61, 0x73, 0x5f, 0x78, 0x6d, 0x6c, 0x22, 0x2c, 0x22, 0x61, 0x73, 0x5f, 0x63, 0x73, 0x76, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x61, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x3d, 0x5c, 0x22, 0x61, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5c, 0x22, 0x2e, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x2c, 0x22, 0x61, 0x73, 0x5f, 0x78, 0x6d, 0x6c, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x3d, 0x5c, 0x22, 0x61, 0x73, 0x5f, 0x78, 0x6d, 0x6c, 0x5c, 0x22, 0x2e, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x2c, 0x22, 0x61, 0x73, 0x5f, 0x63, 0x73, 0x76, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x3d, 0x5c, 0x22, 0x61, 0x73, 0x5f, 0x63, 0x73, 0x76, 0x5c, 0x22, 0x2e, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x45, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x5c, 0x22, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5c, 0x22, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x20, 0x53, 0x65, 0x74, 0x20, 0x5c, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x5c, 0x22, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x65, 0x74, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x57, 0x68, 0x69, 0x63, 0x68, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x5c, 0x22, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5c, 0x22, 0x20, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x20, 0x69, 0x73, 0x20, 0x73, 0x65, 0x74, 0x2e, 0x22, 0x2c, 0x22, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0x3a, 0x5b, 0x22, 0x75, 0x72, 0x6c, 0x22, 0x2c, 0x22, 0x72, 0x61, 0x77, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2c, 0x22, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x75, 0x72, 0x6c, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x3d, 0x5c, 0x22, 0x75, 0x72, 0x6c, 0x5c, 0x22, 0x2e, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x72, 0x61, 0x77, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x22, 0x2c, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x3d, 0x5c, 0x22, 0x72, 0x61, 0x77, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5c, 0x22, 0x2e, 0x22, 0x2c, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3a, 0x22, 0x62, 0x79, 0x74, 0x65, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x3d, 0x5c, 0x22, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5c, 0x22, 0x2e, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
-	EdgeCaseService_NumericValidationTool = runtime.Tool{Name: "testdata_EdgeCaseService_NumericValidation", Description: "NumericValidation tests all numeric validation constraint types\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x22, 0x3a, 0x31, 0x35, 0x30, 0x2c, 0x22, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x22, 0x3a, 0x30, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x62, 0x69, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x22, 0x3a, 0x31, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x3a, 0x31, 0x30, 0x2c, 0x22, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x3a, 0x32, 0x2c, 0x22, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x3a, 0x22, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x24, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x22, 0x3a, 0x31, 0x30, 0x30, 0x30, 0x2c, 0x22, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x22, 0x3a, 0x31, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x22, 0x3a, 0x31, 0x30, 0x30, 0x2c, 0x22, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x22, 0x3a, 0x30, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x22, 0x3a, 0x39, 0x39, 0x2c, 0x22, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x22, 0x3a, 0x31, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x22, 0x3a, 0x31, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x2c, 0x22, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x22, 0x3a, 0x2d, 0x32, 0x37, 0x33, 0x2e, 0x31, 0x35, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x22, 0x3a, 0x30, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
-	EdgeCaseService_OneofRecursiveTool    = runtime.Tool{Name: "testdata_EdgeCaseService_OneofRecursive", Description: "OneofRecursive tests a recursive message nested inside a oneof, on both\nthe request (decode) and response (encode) sides. It exercises the oneof\ndiscriminated-wrapper transform combined with recursion-depth placeholders.\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x45, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x5c, 0x22, 0x6e, 0x6f, 0x64, 0x65, 0x5c, 0x22, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x20, 0x53, 0x65, 0x74, 0x20, 0x5c, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x5c, 0x22, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x65, 0x74, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x57, 0x68, 0x69, 0x63, 0x68, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x5c, 0x22, 0x6e, 0x6f, 0x64, 0x65, 0x5c, 0x22, 0x20, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x20, 0x69, 0x73, 0x20, 0x73, 0x65, 0x74, 0x2e, 0x22, 0x2c, 0x22, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0x3a, 0x5b, 0x22, 0x74, 0x72, 0x65, 0x65, 0x22, 0x2c, 0x22, 0x6c, 0x65, 0x61, 0x66, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x72, 0x65, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x3d, 0x5c, 0x22, 0x74, 0x72, 0x65, 0x65, 0x5c, 0x22, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x4a, 0x53, 0x4f, 0x4e, 0x2d, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x20, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x20, 0x61, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x61, 0x73, 0x20, 0x61, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x6c, 0x65, 0x61, 0x66, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x3d, 0x5c, 0x22, 0x6c, 0x65, 0x61, 0x66, 0x5c, 0x22, 0x2e, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x45, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x5c, 0x22, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5c, 0x22, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x20, 0x53, 0x65, 0x74, 0x20, 0x5c, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x5c, 0x22, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x65, 0x74, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x57, 0x68, 0x69, 0x63, 0x68, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x5c, 0x22, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5c, 0x22, 0x20, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x20, 0x69, 0x73, 0x20, 0x73, 0x65, 0x74, 0x2e, 0x22, 0x2c, 0x22, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0x3a, 0x5b, 0x22, 0x74, 0x72, 0x65, 0x65, 0x22, 0x2c, 0x22, 0x6f, 0x6b, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x72, 0x65, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x3d, 0x5c, 0x22, 0x74, 0x72, 0x65, 0x65, 0x5c, 0x22, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x4a, 0x53, 0x4f, 0x4e, 0x2d, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x20, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x20, 0x61, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x61, 0x73, 0x20, 0x61, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x6f, 0x6b, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x3d, 0x5c, 0x22, 0x6f, 0x6b, 0x5c, 0x22, 0x2e, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
-	EdgeCaseService_RecursiveTreeTool     = runtime.Tool{Name: "testdata_EdgeCaseService_RecursiveTree", Description: "RecursiveTree tests self-referencing message schemas\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x4a, 0x53, 0x4f, 0x4e, 0x2d, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x20, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x20, 0x61, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x61, 0x73, 0x20, 0x61, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
-	EdgeCaseService_RepeatedMessagesTool  = runtime.Tool{Name: "testdata_EdgeCaseService_RepeatedMessages", Description: "RepeatedMessages tests repeated message fields with inner maps/WKTs\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x61, 0x20, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2c, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x2c, 0x20, 0x61, 0x72, 0x72, 0x61, 0x79, 0x2c, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x29, 0x2e, 0x22, 0x7d, 0x2c, 0x22, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3a, 0x22, 0x64, 0x61, 0x74, 0x65, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
+	EdgeCaseService_AllScalarTypesTool = runtime.Tool{
+		Name:            "testdata_EdgeCaseService_AllScalarTypes",
+		Description:     "AllScalarTypes tests all protobuf scalar types\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"bool_field":{"type":"boolean"},"bytes_field":{"contentEncoding":"base64","format":"byte","type":"string"},"double_field":{"type":"number"},"fixed32_field":{"type":"integer"},"fixed64_field":{"type":"string"},"float_field":{"type":"number"},"int32_field":{"type":"integer"},"int64_field":{"type":"string"},"sfixed32_field":{"type":"integer"},"sfixed64_field":{"type":"string"},"sint32_field":{"type":"integer"},"sint64_field":{"type":"string"},"string_field":{"type":"string"},"uint32_field":{"type":"integer"},"uint64_field":{"type":"string"}},"required":[],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"success":{"type":"boolean"}},"required":[],"type":"object"}`),
+	}
+	EdgeCaseService_DeepNestingTool = runtime.Tool{
+		Name:            "testdata_EdgeCaseService_DeepNesting",
+		Description:     "DeepNesting tests deeply nested messages with maps and WKTs\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"middle":{"properties":{"inner":{"properties":{"dynamic_config":{"description":"represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object). Arrays and objects JSON-encoded as a string are accepted too."},"id":{"type":"string"},"metadata":{"additionalProperties":true,"type":"object"},"tags":{"additionalProperties":{"type":"string"},"propertyNames":{"type":"string"},"type":"object"}},"required":[],"type":"object"},"items":{"items":{"properties":{"dynamic_config":{"description":"represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object). Arrays and objects JSON-encoded as a string are accepted too."},"id":{"type":"string"},"metadata":{"additionalProperties":true,"type":"object"},"tags":{"additionalProperties":{"type":"string"},"propertyNames":{"type":"string"},"type":"object"}},"required":[],"type":"object"},"type":"array"},"named_items":{"additionalProperties":{"properties":{"dynamic_config":{"description":"represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object). Arrays and objects JSON-encoded as a string are accepted too."},"id":{"type":"string"},"metadata":{"additionalProperties":true,"type":"object"},"tags":{"additionalProperties":{"type":"string"},"propertyNames":{"type":"string"},"type":"object"}},"required":[],"type":"object"},"propertyNames":{"type":"string"},"type":"object"}},"required":[],"type":"object"},"middles":{"items":{"properties":{"inner":{"properties":{"dynamic_config":{"description":"represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object). Arrays and objects JSON-encoded as a string are accepted too."},"id":{"type":"string"},"metadata":{"additionalProperties":true,"type":"object"},"tags":{"additionalProperties":{"type":"string"},"propertyNames":{"type":"string"},"type":"object"}},"required":[],"type":"object"},"items":{"items":{"properties":{"dynamic_config":{"description":"represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object). Arrays and objects JSON-encoded as a string are accepted too."},"id":{"type":"string"},"metadata":{"additionalProperties":true,"type":"object"},"tags":{"additionalProperties":{"type":"string"},"propertyNames":{"type":"string"},"type":"object"}},"required":[],"type":"object"},"type":"array"},"named_items":{"additionalProperties":{"properties":{"dynamic_config":{"description":"represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object). Arrays and objects JSON-encoded as a string are accepted too."},"id":{"type":"string"},"metadata":{"additionalProperties":true,"type":"object"},"tags":{"additionalProperties":{"type":"string"},"propertyNames":{"type":"string"},"type":"object"}},"required":[],"type":"object"},"propertyNames":{"type":"string"},"type":"object"}},"required":[],"type":"object"},"type":"array"}},"required":[],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"success":{"type":"boolean"}},"required":[],"type":"object"}`),
+	}
+	EdgeCaseService_EnumFieldsTool = runtime.Tool{
+		Name:            "testdata_EdgeCaseService_EnumFields",
+		Description:     "EnumFields tests enum handling\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"priorities":{"items":{"enum":["PRIORITY_UNSPECIFIED","PRIORITY_LOW","PRIORITY_MEDIUM","PRIORITY_HIGH","PRIORITY_CRITICAL"],"type":"string"},"type":"array"},"priority":{"enum":["PRIORITY_UNSPECIFIED","PRIORITY_LOW","PRIORITY_MEDIUM","PRIORITY_HIGH","PRIORITY_CRITICAL"],"type":"string"}},"required":[],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"success":{"type":"boolean"}},"required":[],"type":"object"}`),
+	}
+	EdgeCaseService_MapVariantsTool = runtime.Tool{
+		Name:            "testdata_EdgeCaseService_MapVariants",
+		Description:     "MapVariants tests all map key/value type combinations\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"bool_to_string":{"additionalProperties":{"type":"string"},"propertyNames":{"enum":["true","false"],"type":"string"},"type":"object"},"int_to_string":{"additionalProperties":{"type":"string"},"propertyNames":{"pattern":"^-?(0|[1-9]\\d*)$","type":"string"},"type":"object"},"string_to_bool":{"additionalProperties":{"type":"boolean"},"propertyNames":{"type":"string"},"type":"object"},"string_to_double":{"additionalProperties":{"type":"number"},"propertyNames":{"type":"string"},"type":"object"},"string_to_enum":{"additionalProperties":{"enum":["PRIORITY_UNSPECIFIED","PRIORITY_LOW","PRIORITY_MEDIUM","PRIORITY_HIGH","PRIORITY_CRITICAL"],"type":"string"},"propertyNames":{"type":"string"},"type":"object"},"string_to_message":{"additionalProperties":{"properties":{"dynamic_config":{"description":"represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object). Arrays and objects JSON-encoded as a string are accepted too."},"id":{"type":"string"},"metadata":{"additionalProperties":true,"type":"object"},"tags":{"additionalProperties":{"type":"string"},"propertyNames":{"type":"string"},"type":"object"}},"required":[],"type":"object"},"propertyNames":{"type":"string"},"type":"object"},"string_to_middle":{"additionalProperties":{"properties":{"inner":{"properties":{"dynamic_config":{"description":"represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object). Arrays and objects JSON-encoded as a string are accepted too."},"id":{"type":"string"},"metadata":{"additionalProperties":true,"type":"object"},"tags":{"additionalProperties":{"type":"string"},"propertyNames":{"type":"string"},"type":"object"}},"required":[],"type":"object"},"items":{"items":{"properties":{"dynamic_config":{"description":"represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object). Arrays and objects JSON-encoded as a string are accepted too."},"id":{"type":"string"},"metadata":{"additionalProperties":true,"type":"object"},"tags":{"additionalProperties":{"type":"string"},"propertyNames":{"type":"string"},"type":"object"}},"required":[],"type":"object"},"type":"array"},"named_items":{"additionalProperties":{"properties":{"dynamic_config":{"description":"represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object). Arrays and objects JSON-encoded as a string are accepted too."},"id":{"type":"string"},"metadata":{"additionalProperties":true,"type":"object"},"tags":{"additionalProperties":{"type":"string"},"propertyNames":{"type":"string"},"type":"object"}},"required":[],"type":"object"},"propertyNames":{"type":"string"},"type":"object"}},"required":[],"type":"object"},"propertyNames":{"type":"string"},"type":"object"},"string_to_string":{"additionalProperties":{"type":"string"},"propertyNames":{"type":"string"},"type":"object"},"uint64_to_string":{"additionalProperties":{"type":"string"},"propertyNames":{"pattern":"^(0|[1-9]\\d*)$","type":"string"},"type":"object"}},"required":[],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"success":{"type":"boolean"}},"required":[],"type":"object"}`),
+	}
+	EdgeCaseService_MultipleOneofsTool = runtime.Tool{
+		Name:            "testdata_EdgeCaseService_MultipleOneofs",
+		Description:     "MultipleOneofs tests multiple oneof groups in a single message\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"name":{"type":"string"},"output_format":{"description":"Exactly one of the \"output_format\" group. Set \"which\" to the chosen field name, then set only that field.","properties":{"which":{"description":"Which field of the \"output_format\" oneof is set.","enum":["as_json","as_xml","as_csv"],"type":"string"},"as_json":{"description":"The value when which=\"as_json\".","type":"boolean"},"as_xml":{"description":"The value when which=\"as_xml\".","type":"boolean"},"as_csv":{"description":"The value when which=\"as_csv\".","type":"boolean"}},"required":["which"],"type":"object"},"source":{"description":"Exactly one of the \"source\" group. Set \"which\" to the chosen field name, then set only that field.","properties":{"which":{"description":"Which field of the \"source\" oneof is set.","enum":["url","raw_data","file_path"],"type":"string"},"url":{"description":"The value when which=\"url\".","type":"string"},"raw_data":{"contentEncoding":"base64","description":"The value when which=\"raw_data\".","format":"byte","type":"string"},"file_path":{"description":"The value when which=\"file_path\".","type":"string"}},"required":["which"],"type":"object"}},"required":["name"],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"success":{"type":"boolean"}},"required":[],"type":"object"}`),
+	}
+	EdgeCaseService_NoArgumentsTool = runtime.Tool{
+		Name:            "testdata_EdgeCaseService_NoArguments",
+		Description:     "NoArguments tests a method taking google.protobuf.Empty, whose tool\naccepts no arguments at all.\n",
+		RawInputSchema:  json.RawMessage(`{"additionalProperties":false,"properties":{},"required":[],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"status":{"type":"string"}},"required":[],"type":"object"}`),
+	}
+	EdgeCaseService_NumericValidationTool = runtime.Tool{
+		Name:            "testdata_EdgeCaseService_NumericValidation",
+		Description:     "NumericValidation tests all numeric validation constraint types\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"age":{"maximum":150,"minimum":0,"type":"integer"},"big_count":{"minimum":1,"type":"string"},"code":{"maxLength":10,"minLength":2,"pattern":"^[A-Z0-9]+$","type":"string"},"count":{"maximum":1000,"minimum":1,"type":"integer"},"percentage":{"maximum":100,"minimum":0,"type":"number"},"score":{"maximum":99,"minimum":1,"type":"integer"},"temperature":{"exclusiveMaximum":1000000,"exclusiveMinimum":-273.15,"type":"number"},"timestamp_nanos":{"minimum":0,"type":"string"}},"required":[],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"success":{"type":"boolean"}},"required":[],"type":"object"}`),
+	}
+	EdgeCaseService_OneofRecursiveTool = runtime.Tool{
+		Name:            "testdata_EdgeCaseService_OneofRecursive",
+		Description:     "OneofRecursive tests a recursive message nested inside a oneof, on both\nthe request (decode) and response (encode) sides. It exercises the oneof\ndiscriminated-wrapper transform combined with recursion-depth placeholders.\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"node":{"description":"Exactly one of the \"node\" group. Set \"which\" to the chosen field name, then set only that field.","properties":{"which":{"description":"Which field of the \"node\" oneof is set.","enum":["tree","leaf"],"type":"string"},"tree":{"description":"The value when which=\"tree\".","properties":{"children":{"items":{"properties":{"children":{"items":{"properties":{"children":{"items":{"description":"JSON-encoded TreeNode. Provide a JSON object as a string.","type":"string"},"type":"array"},"value":{"type":"string"}},"required":[],"type":"object"},"type":"array"},"value":{"type":"string"}},"required":[],"type":"object"},"type":"array"},"value":{"type":"string"}},"required":[],"type":"object"},"leaf":{"description":"The value when which=\"leaf\".","type":"string"}},"required":["which"],"type":"object"}},"required":[],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"result":{"description":"Exactly one of the \"result\" group. Set \"which\" to the chosen field name, then set only that field.","properties":{"which":{"description":"Which field of the \"result\" oneof is set.","enum":["tree","ok"],"type":"string"},"tree":{"description":"The value when which=\"tree\".","properties":{"children":{"items":{"properties":{"children":{"items":{"properties":{"children":{"items":{"description":"JSON-encoded TreeNode. Provide a JSON object as a string.","type":"string"},"type":"array"},"value":{"type":"string"}},"required":[],"type":"object"},"type":"array"},"value":{"type":"string"}},"required":[],"type":"object"},"type":"array"},"value":{"type":"string"}},"required":[],"type":"object"},"ok":{"description":"The value when which=\"ok\".","type":"boolean"}},"required":["which"],"type":"object"}},"required":[],"type":"object"}`),
+	}
+	EdgeCaseService_RecursiveTreeTool = runtime.Tool{
+		Name:            "testdata_EdgeCaseService_RecursiveTree",
+		Description:     "RecursiveTree tests self-referencing message schemas\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"root":{"properties":{"children":{"items":{"properties":{"children":{"items":{"properties":{"children":{"items":{"description":"JSON-encoded TreeNode. Provide a JSON object as a string.","type":"string"},"type":"array"},"value":{"type":"string"}},"required":[],"type":"object"},"type":"array"},"value":{"type":"string"}},"required":[],"type":"object"},"type":"array"},"value":{"type":"string"}},"required":[],"type":"object"}},"required":[],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"success":{"type":"boolean"}},"required":[],"type":"object"}`),
+	}
+	EdgeCaseService_RepeatedMessagesTool = runtime.Tool{
+		Name:            "testdata_EdgeCaseService_RepeatedMessages",
+		Description:     "RepeatedMessages tests repeated message fields with inner maps/WKTs\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"items":{"items":{"properties":{"config":{"description":"represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object). Arrays and objects JSON-encoded as a string are accepted too."},"extra":{"additionalProperties":true,"type":"object"},"labels":{"additionalProperties":{"type":"string"},"propertyNames":{"type":"string"},"type":"object"},"name":{"type":"string"}},"required":[],"type":"object"},"type":"array"},"timestamps":{"items":{"format":"date-time","type":["string","null"]},"type":"array"}},"required":[],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"success":{"type":"boolean"}},"required":[],"type":"object"}`),
+	}
 )
 
+// File_testdata_edge_cases_proto_GenerationInfo records the
+// generator version and options of the tools above, see
+// runtime.CheckGenerationInfo.
+var File_testdata_edge_cases_proto_GenerationInfo = runtime.GenerationInfo{
+	Source:  "testdata/edge_cases.proto",
+	Version: "devel",
+	Options: []string{
+		"deprecated_fields=keep",
+		"dry_run=false",
+		"exclude_deprecated_methods=false",
+		"exclude_fields=",
+		"flatten_nested=false",
+		"openai_strict=false",
+		"relative_times=false",
+		"resources=false",
+		"schema_draft=",
+		"schema_mappings=",
+		"schema_patches=",
+		"wrap_input=",
+	},
+	Cosmetic: []string{
+		"comment_directives=",
+		"comment_markdown=keep",
+		"dedupe_descriptions=false",
+		"examples_in_description=false",
+		"field_comments=none",
+		"max_field_description_bytes=0",
+		"max_tool_description_bytes=0",
+		"minify_schemas=false",
+		"shared_definitions=false",
+		"titles=false",
+	},
+}
+
 // EdgeCaseServiceServer is compatible with the grpc-go server interface.
 type EdgeCaseServiceServer interface {
 	AllScalarTypes(ctx context.Context, req *testdata.AllScalarTypesRequest) (*testdata.AllScalarTypesResponse, error)
@@ -35,6 +121,7 @@ type EdgeCaseServiceServer interface {
 	EnumFields(ctx context.Context, req *testdata.EnumFieldsRequest) (*testdata.EnumFieldsResponse, error)
 	MapVariants(ctx context.Context, req *testdata.MapVariantsRequest) (*testdata.MapVariantsResponse, error)
 	MultipleOneofs(ctx context.Context, req *testdata.MultipleOneofsRequest) (*testdata.MultipleOneofsResponse, error)
+	NoArguments(ctx context.Context, req *emptypb.Empty) (*testdata.NoArgumentsResponse, error)
 	NumericValidation(ctx context.Context, req *testdata.NumericValidationRequest) (*testdata.NumericValidationResponse, error)
 	OneofRecursive(ctx context.Context, req *testdata.OneofRecursiveRequest) (*testdata.OneofRecursiveResponse, error)
 	RecursiveTree(ctx context.Context, req *testdata.RecursiveTreeRequest) (*testdata.RecursiveTreeResponse, error)
@@ -50,16 +137,49 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 	AllScalarTypesTool := EdgeCaseService_AllScalarTypesTool
 	AllScalarTypesTool = runtime.ApplyConfig(AllScalarTypesTool, config)
 
-	s.AddTool(AllScalarTypesTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	AllScalarTypesHandler := runtime.ApplyMutatingHandlerConfig(AllScalarTypesTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.AllScalarTypesRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, AllScalarTypesTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		// Rewrite oneof discriminated wrappers and recursion placeholders into the
@@ -68,6 +188,11 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -77,7 +202,9 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.AllScalarTypes(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -89,19 +216,53 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, AllScalarTypesTool, AllScalarTypesHandler)
 	DeepNestingTool := EdgeCaseService_DeepNestingTool
 	DeepNestingTool = runtime.ApplyConfig(DeepNestingTool, config)
 
-	s.AddTool(DeepNestingTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	DeepNestingHandler := runtime.ApplyMutatingHandlerConfig(DeepNestingTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.DeepNestingRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, DeepNestingTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		// Rewrite oneof discriminated wrappers and recursion placeholders into the
@@ -110,6 +271,11 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -119,7 +285,9 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.DeepNesting(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -131,19 +299,55 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, DeepNestingTool, DeepNestingHandler)
 	EnumFieldsTool := EdgeCaseService_EnumFieldsTool
 	EnumFieldsTool = runtime.ApplyConfig(EnumFieldsTool, config)
+	config.Completions.Add(EnumFieldsTool.Name, "priority", runtime.EnumCompleter("PRIORITY_UNSPECIFIED", "PRIORITY_LOW", "PRIORITY_MEDIUM", "PRIORITY_HIGH", "PRIORITY_CRITICAL"))
+	config.Completions.Add(EnumFieldsTool.Name, "priorities", runtime.EnumCompleter("PRIORITY_UNSPECIFIED", "PRIORITY_LOW", "PRIORITY_MEDIUM", "PRIORITY_HIGH", "PRIORITY_CRITICAL"))
 
-	s.AddTool(EnumFieldsTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	EnumFieldsHandler := runtime.ApplyMutatingHandlerConfig(EnumFieldsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.EnumFieldsRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, EnumFieldsTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		// Rewrite oneof discriminated wrappers and recursion placeholders into the
@@ -152,6 +356,11 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -161,7 +370,9 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.EnumFields(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -173,19 +384,53 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, EnumFieldsTool, EnumFieldsHandler)
 	MapVariantsTool := EdgeCaseService_MapVariantsTool
 	MapVariantsTool = runtime.ApplyConfig(MapVariantsTool, config)
 
-	s.AddTool(MapVariantsTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	MapVariantsHandler := runtime.ApplyMutatingHandlerConfig(MapVariantsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.MapVariantsRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, MapVariantsTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		// Rewrite oneof discriminated wrappers and recursion placeholders into the
@@ -194,6 +439,11 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -203,7 +453,9 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.MapVariants(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -215,19 +467,53 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, MapVariantsTool, MapVariantsHandler)
 	MultipleOneofsTool := EdgeCaseService_MultipleOneofsTool
 	MultipleOneofsTool = runtime.ApplyConfig(MultipleOneofsTool, config)
 
-	s.AddTool(MultipleOneofsTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	MultipleOneofsHandler := runtime.ApplyMutatingHandlerConfig(MultipleOneofsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.MultipleOneofsRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, MultipleOneofsTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		// Rewrite oneof discriminated wrappers and recursion placeholders into the
@@ -236,6 +522,11 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -245,7 +536,95 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.MultipleOneofs(ctx, &req)
+		done(resp, err)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		structured, err := runtime.EncodeMessage(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.NewToolResultJSON(structured), nil
+	})
+	runtime.AddTool(s, config, MultipleOneofsTool, MultipleOneofsHandler)
+	NoArgumentsTool := EdgeCaseService_NoArgumentsTool
+	NoArgumentsTool = runtime.ApplyConfig(NoArgumentsTool, config)
+
+	NoArgumentsHandler := runtime.ApplyMutatingHandlerConfig(NoArgumentsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		var req emptypb.Empty
+
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, NoArgumentsTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// google.protobuf.Empty takes no arguments; ignore whatever the model sent.
+		message = map[string]any{}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
+		}
+
+		// Rewrite oneof discriminated wrappers and recursion placeholders into the
+		// protojson-native shape. Errors are model-readable for self-correction.
+		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		marshaled, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+			return nil, err
+		}
+
+		done := runtime.StartBackendCall(ctx)
+		resp, err := srv.NoArguments(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -257,19 +636,53 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, NoArgumentsTool, NoArgumentsHandler)
 	NumericValidationTool := EdgeCaseService_NumericValidationTool
 	NumericValidationTool = runtime.ApplyConfig(NumericValidationTool, config)
 
-	s.AddTool(NumericValidationTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	NumericValidationHandler := runtime.ApplyMutatingHandlerConfig(NumericValidationTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.NumericValidationRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, NumericValidationTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		// Rewrite oneof discriminated wrappers and recursion placeholders into the
@@ -278,6 +691,11 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -287,7 +705,9 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.NumericValidation(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -299,19 +719,53 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, NumericValidationTool, NumericValidationHandler)
 	OneofRecursiveTool := EdgeCaseService_OneofRecursiveTool
 	OneofRecursiveTool = runtime.ApplyConfig(OneofRecursiveTool, config)
 
-	s.AddTool(OneofRecursiveTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	OneofRecursiveHandler := runtime.ApplyMutatingHandlerConfig(OneofRecursiveTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.OneofRecursiveRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, OneofRecursiveTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		// Rewrite oneof discriminated wrappers and recursion placeholders into the
@@ -320,6 +774,11 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -329,7 +788,9 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.OneofRecursive(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -341,19 +802,53 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, OneofRecursiveTool, OneofRecursiveHandler)
 	RecursiveTreeTool := EdgeCaseService_RecursiveTreeTool
 	RecursiveTreeTool = runtime.ApplyConfig(RecursiveTreeTool, config)
 
-	s.AddTool(RecursiveTreeTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	RecursiveTreeHandler := runtime.ApplyMutatingHandlerConfig(RecursiveTreeTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.RecursiveTreeRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, RecursiveTreeTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		// Rewrite oneof discriminated wrappers and recursion placeholders into the
@@ -362,6 +857,11 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -371,7 +871,9 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.RecursiveTree(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -383,19 +885,53 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, RecursiveTreeTool, RecursiveTreeHandler)
 	RepeatedMessagesTool := EdgeCaseService_RepeatedMessagesTool
 	RepeatedMessagesTool = runtime.ApplyConfig(RepeatedMessagesTool, config)
 
-	s.AddTool(RepeatedMessagesTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	RepeatedMessagesHandler := runtime.ApplyMutatingHandlerConfig(RepeatedMessagesTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.RepeatedMessagesRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, RepeatedMessagesTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		// Rewrite oneof discriminated wrappers and recursion placeholders into the
@@ -404,6 +940,11 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -413,7 +954,9 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.RepeatedMessages(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -425,6 +968,7 @@ func RegisterEdgeCaseServiceHandler(s runtime.MCPServer, srv EdgeCaseServiceServ
 
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, RepeatedMessagesTool, RepeatedMessagesHandler)
 }
 
 // EdgeCaseServiceClient is compatible with the grpc-go client interface.
@@ -434,6 +978,7 @@ type EdgeCaseServiceClient interface {
 	EnumFields(ctx context.Context, req *testdata.EnumFieldsRequest, opts ...grpc.CallOption) (*testdata.EnumFieldsResponse, error)
 	MapVariants(ctx context.Context, req *testdata.MapVariantsRequest, opts ...grpc.CallOption) (*testdata.MapVariantsResponse, error)
 	MultipleOneofs(ctx context.Context, req *testdata.MultipleOneofsRequest, opts ...grpc.CallOption) (*testdata.MultipleOneofsResponse, error)
+	NoArguments(ctx context.Context, req *emptypb.Empty, opts ...grpc.CallOption) (*testdata.NoArgumentsResponse, error)
 	NumericValidation(ctx context.Context, req *testdata.NumericValidationRequest, opts ...grpc.CallOption) (*testdata.NumericValidationResponse, error)
 	OneofRecursive(ctx context.Context, req *testdata.OneofRecursiveRequest, opts ...grpc.CallOption) (*testdata.OneofRecursiveResponse, error)
 	RecursiveTree(ctx context.Context, req *testdata.RecursiveTreeRequest, opts ...grpc.CallOption) (*testdata.RecursiveTreeResponse, error)
@@ -447,6 +992,7 @@ type ConnectEdgeCaseServiceClient interface {
 	EnumFields(ctx context.Context, req *connect.Request[testdata.EnumFieldsRequest]) (*connect.Response[testdata.EnumFieldsResponse], error)
 	MapVariants(ctx context.Context, req *connect.Request[testdata.MapVariantsRequest]) (*connect.Response[testdata.MapVariantsResponse], error)
 	MultipleOneofs(ctx context.Context, req *connect.Request[testdata.MultipleOneofsRequest]) (*connect.Response[testdata.MultipleOneofsResponse], error)
+	NoArguments(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[testdata.NoArgumentsResponse], error)
 	NumericValidation(ctx context.Context, req *connect.Request[testdata.NumericValidationRequest]) (*connect.Response[testdata.NumericValidationResponse], error)
 	OneofRecursive(ctx context.Context, req *connect.Request[testdata.OneofRecursiveRequest]) (*connect.Response[testdata.OneofRecursiveResponse], error)
 	RecursiveTree(ctx context.Context, req *connect.Request[testdata.RecursiveTreeRequest]) (*connect.Response[testdata.RecursiveTreeResponse], error)
@@ -462,22 +1008,60 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 	AllScalarTypesTool := EdgeCaseService_AllScalarTypesTool
 	AllScalarTypesTool = runtime.ApplyConfig(AllScalarTypesTool, config)
 
-	s.AddTool(AllScalarTypesTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	AllScalarTypesHandler := runtime.ApplyMutatingHandlerConfig(AllScalarTypesTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.AllScalarTypesRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, AllScalarTypesTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -487,7 +1071,12 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 			return nil, err
 		}
 
-		resp, err := client.AllScalarTypes(ctx, connect.NewRequest(&req))
+		creq := connect.NewRequest(&req)
+		runtime.SetOutgoingHeaders(ctx, creq.Header())
+		runtime.ApplyConnectHeaders(ctx, AllScalarTypesTool.Name, config, creq.Header())
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.AllScalarTypes(ctx, creq)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -498,25 +1087,64 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, AllScalarTypesTool, AllScalarTypesHandler)
 	DeepNestingTool := EdgeCaseService_DeepNestingTool
 	DeepNestingTool = runtime.ApplyConfig(DeepNestingTool, config)
 
-	s.AddTool(DeepNestingTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	DeepNestingHandler := runtime.ApplyMutatingHandlerConfig(DeepNestingTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.DeepNestingRequest
 
-		message := request.Arguments
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, DeepNestingTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -526,7 +1154,12 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 			return nil, err
 		}
 
-		resp, err := client.DeepNesting(ctx, connect.NewRequest(&req))
+		creq := connect.NewRequest(&req)
+		runtime.SetOutgoingHeaders(ctx, creq.Header())
+		runtime.ApplyConnectHeaders(ctx, DeepNestingTool.Name, config, creq.Header())
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.DeepNesting(ctx, creq)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -537,25 +1170,66 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, DeepNestingTool, DeepNestingHandler)
 	EnumFieldsTool := EdgeCaseService_EnumFieldsTool
 	EnumFieldsTool = runtime.ApplyConfig(EnumFieldsTool, config)
+	config.Completions.Add(EnumFieldsTool.Name, "priority", runtime.EnumCompleter("PRIORITY_UNSPECIFIED", "PRIORITY_LOW", "PRIORITY_MEDIUM", "PRIORITY_HIGH", "PRIORITY_CRITICAL"))
+	config.Completions.Add(EnumFieldsTool.Name, "priorities", runtime.EnumCompleter("PRIORITY_UNSPECIFIED", "PRIORITY_LOW", "PRIORITY_MEDIUM", "PRIORITY_HIGH", "PRIORITY_CRITICAL"))
 
-	s.AddTool(EnumFieldsTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	EnumFieldsHandler := runtime.ApplyMutatingHandlerConfig(EnumFieldsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.EnumFieldsRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, EnumFieldsTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -565,7 +1239,12 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 			return nil, err
 		}
 
-		resp, err := client.EnumFields(ctx, connect.NewRequest(&req))
+		creq := connect.NewRequest(&req)
+		runtime.SetOutgoingHeaders(ctx, creq.Header())
+		runtime.ApplyConnectHeaders(ctx, EnumFieldsTool.Name, config, creq.Header())
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.EnumFields(ctx, creq)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -576,25 +1255,64 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, EnumFieldsTool, EnumFieldsHandler)
 	MapVariantsTool := EdgeCaseService_MapVariantsTool
 	MapVariantsTool = runtime.ApplyConfig(MapVariantsTool, config)
 
-	s.AddTool(MapVariantsTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	MapVariantsHandler := runtime.ApplyMutatingHandlerConfig(MapVariantsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.MapVariantsRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, MapVariantsTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -604,7 +1322,12 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 			return nil, err
 		}
 
-		resp, err := client.MapVariants(ctx, connect.NewRequest(&req))
+		creq := connect.NewRequest(&req)
+		runtime.SetOutgoingHeaders(ctx, creq.Header())
+		runtime.ApplyConnectHeaders(ctx, MapVariantsTool.Name, config, creq.Header())
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.MapVariants(ctx, creq)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -615,25 +1338,64 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, MapVariantsTool, MapVariantsHandler)
 	MultipleOneofsTool := EdgeCaseService_MultipleOneofsTool
 	MultipleOneofsTool = runtime.ApplyConfig(MultipleOneofsTool, config)
 
-	s.AddTool(MultipleOneofsTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	MultipleOneofsHandler := runtime.ApplyMutatingHandlerConfig(MultipleOneofsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.MultipleOneofsRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, MultipleOneofsTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -643,7 +1405,12 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 			return nil, err
 		}
 
-		resp, err := client.MultipleOneofs(ctx, connect.NewRequest(&req))
+		creq := connect.NewRequest(&req)
+		runtime.SetOutgoingHeaders(ctx, creq.Header())
+		runtime.ApplyConnectHeaders(ctx, MultipleOneofsTool.Name, config, creq.Header())
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.MultipleOneofs(ctx, creq)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -654,25 +1421,150 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, MultipleOneofsTool, MultipleOneofsHandler)
+	NoArgumentsTool := EdgeCaseService_NoArgumentsTool
+	NoArgumentsTool = runtime.ApplyConfig(NoArgumentsTool, config)
+
+	NoArgumentsHandler := runtime.ApplyMutatingHandlerConfig(NoArgumentsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		var req emptypb.Empty
+
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, NoArgumentsTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// google.protobuf.Empty takes no arguments; ignore whatever the model sent.
+		message = map[string]any{}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
+		}
+
+		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		marshaled, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+			return nil, err
+		}
+
+		creq := connect.NewRequest(&req)
+		runtime.SetOutgoingHeaders(ctx, creq.Header())
+		runtime.ApplyConnectHeaders(ctx, NoArgumentsTool.Name, config, creq.Header())
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.NoArguments(ctx, creq)
+		done(resp, err)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		structured, err := runtime.EncodeMessage(resp.Msg)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.NewToolResultJSON(structured), nil
+	})
+	runtime.AddTool(s, config, NoArgumentsTool, NoArgumentsHandler)
 	NumericValidationTool := EdgeCaseService_NumericValidationTool
 	NumericValidationTool = runtime.ApplyConfig(NumericValidationTool, config)
 
-	s.AddTool(NumericValidationTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	NumericValidationHandler := runtime.ApplyMutatingHandlerConfig(NumericValidationTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.NumericValidationRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, NumericValidationTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -682,7 +1574,12 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 			return nil, err
 		}
 
-		resp, err := client.NumericValidation(ctx, connect.NewRequest(&req))
+		creq := connect.NewRequest(&req)
+		runtime.SetOutgoingHeaders(ctx, creq.Header())
+		runtime.ApplyConnectHeaders(ctx, NumericValidationTool.Name, config, creq.Header())
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.NumericValidation(ctx, creq)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -693,25 +1590,64 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, NumericValidationTool, NumericValidationHandler)
 	OneofRecursiveTool := EdgeCaseService_OneofRecursiveTool
 	OneofRecursiveTool = runtime.ApplyConfig(OneofRecursiveTool, config)
 
-	s.AddTool(OneofRecursiveTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	OneofRecursiveHandler := runtime.ApplyMutatingHandlerConfig(OneofRecursiveTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.OneofRecursiveRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, OneofRecursiveTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -721,7 +1657,12 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 			return nil, err
 		}
 
-		resp, err := client.OneofRecursive(ctx, connect.NewRequest(&req))
+		creq := connect.NewRequest(&req)
+		runtime.SetOutgoingHeaders(ctx, creq.Header())
+		runtime.ApplyConnectHeaders(ctx, OneofRecursiveTool.Name, config, creq.Header())
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.OneofRecursive(ctx, creq)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -732,25 +1673,64 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, OneofRecursiveTool, OneofRecursiveHandler)
 	RecursiveTreeTool := EdgeCaseService_RecursiveTreeTool
 	RecursiveTreeTool = runtime.ApplyConfig(RecursiveTreeTool, config)
 
-	s.AddTool(RecursiveTreeTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	RecursiveTreeHandler := runtime.ApplyMutatingHandlerConfig(RecursiveTreeTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.RecursiveTreeRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, RecursiveTreeTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -760,7 +1740,12 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 			return nil, err
 		}
 
-		resp, err := client.RecursiveTree(ctx, connect.NewRequest(&req))
+		creq := connect.NewRequest(&req)
+		runtime.SetOutgoingHeaders(ctx, creq.Header())
+		runtime.ApplyConnectHeaders(ctx, RecursiveTreeTool.Name, config, creq.Header())
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.RecursiveTree(ctx, creq)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -771,25 +1756,64 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, RecursiveTreeTool, RecursiveTreeHandler)
 	RepeatedMessagesTool := EdgeCaseService_RepeatedMessagesTool
 	RepeatedMessagesTool = runtime.ApplyConfig(RepeatedMessagesTool, config)
 
-	s.AddTool(RepeatedMessagesTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	RepeatedMessagesHandler := runtime.ApplyMutatingHandlerConfig(RepeatedMessagesTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.RepeatedMessagesRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, RepeatedMessagesTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -799,7 +1823,12 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 			return nil, err
 		}
 
-		resp, err := client.RepeatedMessages(ctx, connect.NewRequest(&req))
+		creq := connect.NewRequest(&req)
+		runtime.SetOutgoingHeaders(ctx, creq.Header())
+		runtime.ApplyConnectHeaders(ctx, RepeatedMessagesTool.Name, config, creq.Header())
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.RepeatedMessages(ctx, creq)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -810,6 +1839,114 @@ func ForwardToConnectEdgeCaseServiceClient(s runtime.MCPServer, client ConnectEd
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, RepeatedMessagesTool, RepeatedMessagesHandler)
+}
+
+// ForwardToConnectEdgeCaseServiceURL forwards MCP calls to the EdgeCaseService at baseURL
+// with a connectrpc client. Select its wire protocol and codec with
+// runtime.WithConnectProtocol and runtime.WithConnectCodec.
+func ForwardToConnectEdgeCaseServiceURL(s runtime.MCPServer, httpClient connect.HTTPClient, baseURL string, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	clientOpts := runtime.ConnectClientOptions(config)
+	ForwardToConnectEdgeCaseServiceClient(s, connectEdgeCaseServiceClient{
+		callAllScalarTypes:    connect.NewClient[testdata.AllScalarTypesRequest, testdata.AllScalarTypesResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.EdgeCaseService/AllScalarTypes"), clientOpts...),
+		callDeepNesting:       connect.NewClient[testdata.DeepNestingRequest, testdata.DeepNestingResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.EdgeCaseService/DeepNesting"), clientOpts...),
+		callEnumFields:        connect.NewClient[testdata.EnumFieldsRequest, testdata.EnumFieldsResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.EdgeCaseService/EnumFields"), clientOpts...),
+		callMapVariants:       connect.NewClient[testdata.MapVariantsRequest, testdata.MapVariantsResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.EdgeCaseService/MapVariants"), clientOpts...),
+		callMultipleOneofs:    connect.NewClient[testdata.MultipleOneofsRequest, testdata.MultipleOneofsResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.EdgeCaseService/MultipleOneofs"), clientOpts...),
+		callNoArguments:       connect.NewClient[emptypb.Empty, testdata.NoArgumentsResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.EdgeCaseService/NoArguments"), clientOpts...),
+		callNumericValidation: connect.NewClient[testdata.NumericValidationRequest, testdata.NumericValidationResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.EdgeCaseService/NumericValidation"), clientOpts...),
+		callOneofRecursive:    connect.NewClient[testdata.OneofRecursiveRequest, testdata.OneofRecursiveResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.EdgeCaseService/OneofRecursive"), clientOpts...),
+		callRecursiveTree:     connect.NewClient[testdata.RecursiveTreeRequest, testdata.RecursiveTreeResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.EdgeCaseService/RecursiveTree"), clientOpts...),
+		callRepeatedMessages:  connect.NewClient[testdata.RepeatedMessagesRequest, testdata.RepeatedMessagesResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.EdgeCaseService/RepeatedMessages"), clientOpts...),
+	}, opts...)
+}
+
+// connectEdgeCaseServiceClient is the ConnectEdgeCaseServiceClient of
+// ForwardToConnectEdgeCaseServiceURL.
+type connectEdgeCaseServiceClient struct {
+	callAllScalarTypes    *connect.Client[testdata.AllScalarTypesRequest, testdata.AllScalarTypesResponse]
+	callDeepNesting       *connect.Client[testdata.DeepNestingRequest, testdata.DeepNestingResponse]
+	callEnumFields        *connect.Client[testdata.EnumFieldsRequest, testdata.EnumFieldsResponse]
+	callMapVariants       *connect.Client[testdata.MapVariantsRequest, testdata.MapVariantsResponse]
+	callMultipleOneofs    *connect.Client[testdata.MultipleOneofsRequest, testdata.MultipleOneofsResponse]
+	callNoArguments       *connect.Client[emptypb.Empty, testdata.NoArgumentsResponse]
+	callNumericValidation *connect.Client[testdata.NumericValidationRequest, testdata.NumericValidationResponse]
+	callOneofRecursive    *connect.Client[testdata.OneofRecursiveRequest, testdata.OneofRecursiveResponse]
+	callRecursiveTree     *connect.Client[testdata.RecursiveTreeRequest, testdata.RecursiveTreeResponse]
+	callRepeatedMessages  *connect.Client[testdata.RepeatedMessagesRequest, testdata.RepeatedMessagesResponse]
+}
+
+func (c connectEdgeCaseServiceClient) AllScalarTypes(ctx context.Context, req *connect.Request[testdata.AllScalarTypesRequest]) (*connect.Response[testdata.AllScalarTypesResponse], error) {
+	return c.callAllScalarTypes.CallUnary(ctx, req)
+}
+
+func (c connectEdgeCaseServiceClient) DeepNesting(ctx context.Context, req *connect.Request[testdata.DeepNestingRequest]) (*connect.Response[testdata.DeepNestingResponse], error) {
+	return c.callDeepNesting.CallUnary(ctx, req)
+}
+
+func (c connectEdgeCaseServiceClient) EnumFields(ctx context.Context, req *connect.Request[testdata.EnumFieldsRequest]) (*connect.Response[testdata.EnumFieldsResponse], error) {
+	return c.callEnumFields.CallUnary(ctx, req)
+}
+
+func (c connectEdgeCaseServiceClient) MapVariants(ctx context.Context, req *connect.Request[testdata.MapVariantsRequest]) (*connect.Response[testdata.MapVariantsResponse], error) {
+	return c.callMapVariants.CallUnary(ctx, req)
+}
+
+func (c connectEdgeCaseServiceClient) MultipleOneofs(ctx context.Context, req *connect.Request[testdata.MultipleOneofsRequest]) (*connect.Response[testdata.MultipleOneofsResponse], error) {
+	return c.callMultipleOneofs.CallUnary(ctx, req)
+}
+
+func (c connectEdgeCaseServiceClient) NoArguments(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[testdata.NoArgumentsResponse], error) {
+	return c.callNoArguments.CallUnary(ctx, req)
+}
+
+func (c connectEdgeCaseServiceClient) NumericValidation(ctx context.Context, req *connect.Request[testdata.NumericValidationRequest]) (*connect.Response[testdata.NumericValidationResponse], error) {
+	return c.callNumericValidation.CallUnary(ctx, req)
+}
+
+func (c connectEdgeCaseServiceClient) OneofRecursive(ctx context.Context, req *connect.Request[testdata.OneofRecursiveRequest]) (*connect.Response[testdata.OneofRecursiveResponse], error) {
+	return c.callOneofRecursive.CallUnary(ctx, req)
+}
+
+func (c connectEdgeCaseServiceClient) RecursiveTree(ctx context.Context, req *connect.Request[testdata.RecursiveTreeRequest]) (*connect.Response[testdata.RecursiveTreeResponse], error) {
+	return c.callRecursiveTree.CallUnary(ctx, req)
+}
+
+func (c connectEdgeCaseServiceClient) RepeatedMessages(ctx context.Context, req *connect.Request[testdata.RepeatedMessagesRequest]) (*connect.Response[testdata.RepeatedMessagesResponse], error) {
+	return c.callRepeatedMessages.CallUnary(ctx, req)
+}
+
+// ConnectEdgeCaseServiceHandler is compatible with the connectrpc-go handler interface.
+type ConnectEdgeCaseServiceHandler interface {
+	AllScalarTypes(ctx context.Context, req *connect.Request[testdata.AllScalarTypesRequest]) (*connect.Response[testdata.AllScalarTypesResponse], error)
+	DeepNesting(ctx context.Context, req *connect.Request[testdata.DeepNestingRequest]) (*connect.Response[testdata.DeepNestingResponse], error)
+	EnumFields(ctx context.Context, req *connect.Request[testdata.EnumFieldsRequest]) (*connect.Response[testdata.EnumFieldsResponse], error)
+	MapVariants(ctx context.Context, req *connect.Request[testdata.MapVariantsRequest]) (*connect.Response[testdata.MapVariantsResponse], error)
+	MultipleOneofs(ctx context.Context, req *connect.Request[testdata.MultipleOneofsRequest]) (*connect.Response[testdata.MultipleOneofsResponse], error)
+	NoArguments(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[testdata.NoArgumentsResponse], error)
+	NumericValidation(ctx context.Context, req *connect.Request[testdata.NumericValidationRequest]) (*connect.Response[testdata.NumericValidationResponse], error)
+	OneofRecursive(ctx context.Context, req *connect.Request[testdata.OneofRecursiveRequest]) (*connect.Response[testdata.OneofRecursiveResponse], error)
+	RecursiveTree(ctx context.Context, req *connect.Request[testdata.RecursiveTreeRequest]) (*connect.Response[testdata.RecursiveTreeResponse], error)
+	RepeatedMessages(ctx context.Context, req *connect.Request[testdata.RepeatedMessagesRequest]) (*connect.Response[testdata.RepeatedMessagesResponse], error)
+}
+
+// ForwardToConnectEdgeCaseServiceHandler forwards MCP calls to a connectrpc handler
+// implementation in the same process by calling its methods directly, without
+// serializing requests. Handler interceptors do not run. Watched resources
+// need a stream, which only a mounted handler can serve: forward to it with
+// ForwardToConnectEdgeCaseServiceURL and runtime.InProcessHTTPClient instead.
+func ForwardToConnectEdgeCaseServiceHandler(s runtime.MCPServer, handler ConnectEdgeCaseServiceHandler, opts ...runtime.Option) {
+	ForwardToConnectEdgeCaseServiceClient(s, handlerEdgeCaseServiceClient{handler}, opts...)
+}
+
+// handlerEdgeCaseServiceClient is the ConnectEdgeCaseServiceClient of
+// ForwardToConnectEdgeCaseServiceHandler.
+type handlerEdgeCaseServiceClient struct {
+	ConnectEdgeCaseServiceHandler
 }
 
 // ForwardToEdgeCaseServiceClient registers a gRPC client, to forward MCP calls to it.
@@ -821,22 +1958,60 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 	AllScalarTypesTool := EdgeCaseService_AllScalarTypesTool
 	AllScalarTypesTool = runtime.ApplyConfig(AllScalarTypesTool, config)
 
-	s.AddTool(AllScalarTypesTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	AllScalarTypesHandler := runtime.ApplyMutatingHandlerConfig(AllScalarTypesTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.AllScalarTypesRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, AllScalarTypesTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -846,7 +2021,9 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 			return nil, err
 		}
 
-		resp, err := client.AllScalarTypes(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.AllScalarTypes(ctx, &req, runtime.ApplyCallOptions(ctx, AllScalarTypesTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -857,25 +2034,64 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, AllScalarTypesTool, AllScalarTypesHandler)
 	DeepNestingTool := EdgeCaseService_DeepNestingTool
 	DeepNestingTool = runtime.ApplyConfig(DeepNestingTool, config)
 
-	s.AddTool(DeepNestingTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	DeepNestingHandler := runtime.ApplyMutatingHandlerConfig(DeepNestingTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.DeepNestingRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, DeepNestingTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -885,7 +2101,9 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 			return nil, err
 		}
 
-		resp, err := client.DeepNesting(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.DeepNesting(ctx, &req, runtime.ApplyCallOptions(ctx, DeepNestingTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -896,25 +2114,66 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, DeepNestingTool, DeepNestingHandler)
 	EnumFieldsTool := EdgeCaseService_EnumFieldsTool
 	EnumFieldsTool = runtime.ApplyConfig(EnumFieldsTool, config)
+	config.Completions.Add(EnumFieldsTool.Name, "priority", runtime.EnumCompleter("PRIORITY_UNSPECIFIED", "PRIORITY_LOW", "PRIORITY_MEDIUM", "PRIORITY_HIGH", "PRIORITY_CRITICAL"))
+	config.Completions.Add(EnumFieldsTool.Name, "priorities", runtime.EnumCompleter("PRIORITY_UNSPECIFIED", "PRIORITY_LOW", "PRIORITY_MEDIUM", "PRIORITY_HIGH", "PRIORITY_CRITICAL"))
 
-	s.AddTool(EnumFieldsTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	EnumFieldsHandler := runtime.ApplyMutatingHandlerConfig(EnumFieldsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.EnumFieldsRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, EnumFieldsTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -924,7 +2183,9 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 			return nil, err
 		}
 
-		resp, err := client.EnumFields(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.EnumFields(ctx, &req, runtime.ApplyCallOptions(ctx, EnumFieldsTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -935,25 +2196,64 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, EnumFieldsTool, EnumFieldsHandler)
 	MapVariantsTool := EdgeCaseService_MapVariantsTool
 	MapVariantsTool = runtime.ApplyConfig(MapVariantsTool, config)
 
-	s.AddTool(MapVariantsTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	MapVariantsHandler := runtime.ApplyMutatingHandlerConfig(MapVariantsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.MapVariantsRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, MapVariantsTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -963,7 +2263,9 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 			return nil, err
 		}
 
-		resp, err := client.MapVariants(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.MapVariants(ctx, &req, runtime.ApplyCallOptions(ctx, MapVariantsTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -974,25 +2276,147 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, MapVariantsTool, MapVariantsHandler)
 	MultipleOneofsTool := EdgeCaseService_MultipleOneofsTool
 	MultipleOneofsTool = runtime.ApplyConfig(MultipleOneofsTool, config)
 
-	s.AddTool(MultipleOneofsTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	MultipleOneofsHandler := runtime.ApplyMutatingHandlerConfig(MultipleOneofsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.MultipleOneofsRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
+		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, MultipleOneofsTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
+			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
+		}
+
+		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		marshaled, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+			return nil, err
+		}
+
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.MultipleOneofs(ctx, &req, runtime.ApplyCallOptions(ctx, MultipleOneofsTool.Name, config)...)
+		done(resp, err)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		structured, err := runtime.EncodeMessage(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.NewToolResultJSON(structured), nil
+	})
+	runtime.AddTool(s, config, MultipleOneofsTool, MultipleOneofsHandler)
+	NoArgumentsTool := EdgeCaseService_NoArgumentsTool
+	NoArgumentsTool = runtime.ApplyConfig(NoArgumentsTool, config)
+
+	NoArgumentsHandler := runtime.ApplyMutatingHandlerConfig(NoArgumentsTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+		var req emptypb.Empty
+
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, NoArgumentsTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// google.protobuf.Empty takes no arguments; ignore whatever the model sent.
+		message = map[string]any{}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -1002,7 +2426,9 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 			return nil, err
 		}
 
-		resp, err := client.MultipleOneofs(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.NoArguments(ctx, &req, runtime.ApplyCallOptions(ctx, NoArgumentsTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -1013,25 +2439,64 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, NoArgumentsTool, NoArgumentsHandler)
 	NumericValidationTool := EdgeCaseService_NumericValidationTool
 	NumericValidationTool = runtime.ApplyConfig(NumericValidationTool, config)
 
-	s.AddTool(NumericValidationTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	NumericValidationHandler := runtime.ApplyMutatingHandlerConfig(NumericValidationTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.NumericValidationRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, NumericValidationTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -1041,7 +2506,9 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 			return nil, err
 		}
 
-		resp, err := client.NumericValidation(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.NumericValidation(ctx, &req, runtime.ApplyCallOptions(ctx, NumericValidationTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -1052,25 +2519,64 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, NumericValidationTool, NumericValidationHandler)
 	OneofRecursiveTool := EdgeCaseService_OneofRecursiveTool
 	OneofRecursiveTool = runtime.ApplyConfig(OneofRecursiveTool, config)
 
-	s.AddTool(OneofRecursiveTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	OneofRecursiveHandler := runtime.ApplyMutatingHandlerConfig(OneofRecursiveTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.OneofRecursiveRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, OneofRecursiveTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -1080,7 +2586,9 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 			return nil, err
 		}
 
-		resp, err := client.OneofRecursive(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.OneofRecursive(ctx, &req, runtime.ApplyCallOptions(ctx, OneofRecursiveTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -1091,25 +2599,64 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, OneofRecursiveTool, OneofRecursiveHandler)
 	RecursiveTreeTool := EdgeCaseService_RecursiveTreeTool
 	RecursiveTreeTool = runtime.ApplyConfig(RecursiveTreeTool, config)
 
-	s.AddTool(RecursiveTreeTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	RecursiveTreeHandler := runtime.ApplyMutatingHandlerConfig(RecursiveTreeTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.RecursiveTreeRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, RecursiveTreeTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -1119,7 +2666,9 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 			return nil, err
 		}
 
-		resp, err := client.RecursiveTree(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.RecursiveTree(ctx, &req, runtime.ApplyCallOptions(ctx, RecursiveTreeTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -1130,25 +2679,64 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, RecursiveTreeTool, RecursiveTreeHandler)
 	RepeatedMessagesTool := EdgeCaseService_RepeatedMessagesTool
 	RepeatedMessagesTool = runtime.ApplyConfig(RepeatedMessagesTool, config)
 
-	s.AddTool(RepeatedMessagesTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	RepeatedMessagesHandler := runtime.ApplyMutatingHandlerConfig(RepeatedMessagesTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.RepeatedMessagesRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, RepeatedMessagesTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -1158,7 +2746,9 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 			return nil, err
 		}
 
-		resp, err := client.RepeatedMessages(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.RepeatedMessages(ctx, &req, runtime.ApplyCallOptions(ctx, RepeatedMessagesTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -1169,4 +2759,98 @@ func ForwardToEdgeCaseServiceClient(s runtime.MCPServer, client EdgeCaseServiceC
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, RepeatedMessagesTool, RepeatedMessagesHandler)
+}
+
+// ForwardToEdgeCaseServiceConn forwards MCP calls to the EdgeCaseService behind conn,
+// e.g. a *grpc.ClientConn with interceptors or a connection pool, without
+// building a typed gRPC client first.
+func ForwardToEdgeCaseServiceConn(s runtime.MCPServer, conn grpc.ClientConnInterface, opts ...runtime.Option) {
+	ForwardToEdgeCaseServiceClient(s, connEdgeCaseServiceClient{conn}, opts...)
+}
+
+// connEdgeCaseServiceClient is the EdgeCaseServiceClient of
+// ForwardToEdgeCaseServiceConn.
+type connEdgeCaseServiceClient struct {
+	conn grpc.ClientConnInterface
+}
+
+func (c connEdgeCaseServiceClient) AllScalarTypes(ctx context.Context, req *testdata.AllScalarTypesRequest, opts ...grpc.CallOption) (*testdata.AllScalarTypesResponse, error) {
+	resp := new(testdata.AllScalarTypesResponse)
+	if err := c.conn.Invoke(ctx, "/testdata.EdgeCaseService/AllScalarTypes", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connEdgeCaseServiceClient) DeepNesting(ctx context.Context, req *testdata.DeepNestingRequest, opts ...grpc.CallOption) (*testdata.DeepNestingResponse, error) {
+	resp := new(testdata.DeepNestingResponse)
+	if err := c.conn.Invoke(ctx, "/testdata.EdgeCaseService/DeepNesting", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connEdgeCaseServiceClient) EnumFields(ctx context.Context, req *testdata.EnumFieldsRequest, opts ...grpc.CallOption) (*testdata.EnumFieldsResponse, error) {
+	resp := new(testdata.EnumFieldsResponse)
+	if err := c.conn.Invoke(ctx, "/testdata.EdgeCaseService/EnumFields", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connEdgeCaseServiceClient) MapVariants(ctx context.Context, req *testdata.MapVariantsRequest, opts ...grpc.CallOption) (*testdata.MapVariantsResponse, error) {
+	resp := new(testdata.MapVariantsResponse)
+	if err := c.conn.Invoke(ctx, "/testdata.EdgeCaseService/MapVariants", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connEdgeCaseServiceClient) MultipleOneofs(ctx context.Context, req *testdata.MultipleOneofsRequest, opts ...grpc.CallOption) (*testdata.MultipleOneofsResponse, error) {
+	resp := new(testdata.MultipleOneofsResponse)
+	if err := c.conn.Invoke(ctx, "/testdata.EdgeCaseService/MultipleOneofs", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connEdgeCaseServiceClient) NoArguments(ctx context.Context, req *emptypb.Empty, opts ...grpc.CallOption) (*testdata.NoArgumentsResponse, error) {
+	resp := new(testdata.NoArgumentsResponse)
+	if err := c.conn.Invoke(ctx, "/testdata.EdgeCaseService/NoArguments", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connEdgeCaseServiceClient) NumericValidation(ctx context.Context, req *testdata.NumericValidationRequest, opts ...grpc.CallOption) (*testdata.NumericValidationResponse, error) {
+	resp := new(testdata.NumericValidationResponse)
+	if err := c.conn.Invoke(ctx, "/testdata.EdgeCaseService/NumericValidation", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connEdgeCaseServiceClient) OneofRecursive(ctx context.Context, req *testdata.OneofRecursiveRequest, opts ...grpc.CallOption) (*testdata.OneofRecursiveResponse, error) {
+	resp := new(testdata.OneofRecursiveResponse)
+	if err := c.conn.Invoke(ctx, "/testdata.EdgeCaseService/OneofRecursive", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connEdgeCaseServiceClient) RecursiveTree(ctx context.Context, req *testdata.RecursiveTreeRequest, opts ...grpc.CallOption) (*testdata.RecursiveTreeResponse, error) {
+	resp := new(testdata.RecursiveTreeResponse)
+	if err := c.conn.Invoke(ctx, "/testdata.EdgeCaseService/RecursiveTree", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connEdgeCaseServiceClient) RepeatedMessages(ctx context.Context, req *testdata.RepeatedMessagesRequest, opts ...grpc.CallOption) (*testdata.RepeatedMessagesResponse, error) {
+	resp := new(testdata.RepeatedMessagesResponse)
+	if err := c.conn.Invoke(ctx, "/testdata.EdgeCaseService/RepeatedMessages", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
@@ -0,0 +1,293 @@
+// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
+// source: testdata/edge_cases.proto
+
+package testdatamcp
+
+import (
+	context "context"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	sync "sync"
+)
+
+// EdgeCaseServiceServerMock is a EdgeCaseServiceServer for testing MCP wiring and
+// interceptors without a backend. A method calls its <Method>Func when set,
+// and otherwise returns <Method>Err or <Method>Response, defaulting to an empty
+// response. Streaming methods send <Method>Responses instead. <Method>Calls
+// returns the requests a method got.
+type EdgeCaseServiceServerMock struct {
+	DeepNestingFunc           func(ctx context.Context, req *testdata.DeepNestingRequest) (*testdata.DeepNestingResponse, error)
+	DeepNestingResponse       *testdata.DeepNestingResponse
+	DeepNestingErr            error
+	AllScalarTypesFunc        func(ctx context.Context, req *testdata.AllScalarTypesRequest) (*testdata.AllScalarTypesResponse, error)
+	AllScalarTypesResponse    *testdata.AllScalarTypesResponse
+	AllScalarTypesErr         error
+	RepeatedMessagesFunc      func(ctx context.Context, req *testdata.RepeatedMessagesRequest) (*testdata.RepeatedMessagesResponse, error)
+	RepeatedMessagesResponse  *testdata.RepeatedMessagesResponse
+	RepeatedMessagesErr       error
+	MapVariantsFunc           func(ctx context.Context, req *testdata.MapVariantsRequest) (*testdata.MapVariantsResponse, error)
+	MapVariantsResponse       *testdata.MapVariantsResponse
+	MapVariantsErr            error
+	EnumFieldsFunc            func(ctx context.Context, req *testdata.EnumFieldsRequest) (*testdata.EnumFieldsResponse, error)
+	EnumFieldsResponse        *testdata.EnumFieldsResponse
+	EnumFieldsErr             error
+	MultipleOneofsFunc        func(ctx context.Context, req *testdata.MultipleOneofsRequest) (*testdata.MultipleOneofsResponse, error)
+	MultipleOneofsResponse    *testdata.MultipleOneofsResponse
+	MultipleOneofsErr         error
+	NumericValidationFunc     func(ctx context.Context, req *testdata.NumericValidationRequest) (*testdata.NumericValidationResponse, error)
+	NumericValidationResponse *testdata.NumericValidationResponse
+	NumericValidationErr      error
+	RecursiveTreeFunc         func(ctx context.Context, req *testdata.RecursiveTreeRequest) (*testdata.RecursiveTreeResponse, error)
+	RecursiveTreeResponse     *testdata.RecursiveTreeResponse
+	RecursiveTreeErr          error
+	OneofRecursiveFunc        func(ctx context.Context, req *testdata.OneofRecursiveRequest) (*testdata.OneofRecursiveResponse, error)
+	OneofRecursiveResponse    *testdata.OneofRecursiveResponse
+	OneofRecursiveErr         error
+	NoArgumentsFunc           func(ctx context.Context, req *emptypb.Empty) (*testdata.NoArgumentsResponse, error)
+	NoArgumentsResponse       *testdata.NoArgumentsResponse
+	NoArgumentsErr            error
+
+	mu                     sync.Mutex
+	callsDeepNesting       []*testdata.DeepNestingRequest
+	callsAllScalarTypes    []*testdata.AllScalarTypesRequest
+	callsRepeatedMessages  []*testdata.RepeatedMessagesRequest
+	callsMapVariants       []*testdata.MapVariantsRequest
+	callsEnumFields        []*testdata.EnumFieldsRequest
+	callsMultipleOneofs    []*testdata.MultipleOneofsRequest
+	callsNumericValidation []*testdata.NumericValidationRequest
+	callsRecursiveTree     []*testdata.RecursiveTreeRequest
+	callsOneofRecursive    []*testdata.OneofRecursiveRequest
+	callsNoArguments       []*emptypb.Empty
+}
+
+var _ EdgeCaseServiceServer = (*EdgeCaseServiceServerMock)(nil)
+
+func (m *EdgeCaseServiceServerMock) DeepNesting(ctx context.Context, req *testdata.DeepNestingRequest) (*testdata.DeepNestingResponse, error) {
+	m.mu.Lock()
+	m.callsDeepNesting = append(m.callsDeepNesting, req)
+	m.mu.Unlock()
+	if m.DeepNestingFunc != nil {
+		return m.DeepNestingFunc(ctx, req)
+	}
+	if m.DeepNestingErr != nil {
+		return nil, m.DeepNestingErr
+	}
+	if m.DeepNestingResponse != nil {
+		return m.DeepNestingResponse, nil
+	}
+	return &testdata.DeepNestingResponse{}, nil
+}
+
+// DeepNestingCalls returns the requests DeepNesting was called with, in order.
+func (m *EdgeCaseServiceServerMock) DeepNestingCalls() []*testdata.DeepNestingRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.DeepNestingRequest(nil), m.callsDeepNesting...)
+}
+
+func (m *EdgeCaseServiceServerMock) AllScalarTypes(ctx context.Context, req *testdata.AllScalarTypesRequest) (*testdata.AllScalarTypesResponse, error) {
+	m.mu.Lock()
+	m.callsAllScalarTypes = append(m.callsAllScalarTypes, req)
+	m.mu.Unlock()
+	if m.AllScalarTypesFunc != nil {
+		return m.AllScalarTypesFunc(ctx, req)
+	}
+	if m.AllScalarTypesErr != nil {
+		return nil, m.AllScalarTypesErr
+	}
+	if m.AllScalarTypesResponse != nil {
+		return m.AllScalarTypesResponse, nil
+	}
+	return &testdata.AllScalarTypesResponse{}, nil
+}
+
+// AllScalarTypesCalls returns the requests AllScalarTypes was called with, in order.
+func (m *EdgeCaseServiceServerMock) AllScalarTypesCalls() []*testdata.AllScalarTypesRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.AllScalarTypesRequest(nil), m.callsAllScalarTypes...)
+}
+
+func (m *EdgeCaseServiceServerMock) RepeatedMessages(ctx context.Context, req *testdata.RepeatedMessagesRequest) (*testdata.RepeatedMessagesResponse, error) {
+	m.mu.Lock()
+	m.callsRepeatedMessages = append(m.callsRepeatedMessages, req)
+	m.mu.Unlock()
+	if m.RepeatedMessagesFunc != nil {
+		return m.RepeatedMessagesFunc(ctx, req)
+	}
+	if m.RepeatedMessagesErr != nil {
+		return nil, m.RepeatedMessagesErr
+	}
+	if m.RepeatedMessagesResponse != nil {
+		return m.RepeatedMessagesResponse, nil
+	}
+	return &testdata.RepeatedMessagesResponse{}, nil
+}
+
+// RepeatedMessagesCalls returns the requests RepeatedMessages was called with, in order.
+func (m *EdgeCaseServiceServerMock) RepeatedMessagesCalls() []*testdata.RepeatedMessagesRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.RepeatedMessagesRequest(nil), m.callsRepeatedMessages...)
+}
+
+func (m *EdgeCaseServiceServerMock) MapVariants(ctx context.Context, req *testdata.MapVariantsRequest) (*testdata.MapVariantsResponse, error) {
+	m.mu.Lock()
+	m.callsMapVariants = append(m.callsMapVariants, req)
+	m.mu.Unlock()
+	if m.MapVariantsFunc != nil {
+		return m.MapVariantsFunc(ctx, req)
+	}
+	if m.MapVariantsErr != nil {
+		return nil, m.MapVariantsErr
+	}
+	if m.MapVariantsResponse != nil {
+		return m.MapVariantsResponse, nil
+	}
+	return &testdata.MapVariantsResponse{}, nil
+}
+
+// MapVariantsCalls returns the requests MapVariants was called with, in order.
+func (m *EdgeCaseServiceServerMock) MapVariantsCalls() []*testdata.MapVariantsRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.MapVariantsRequest(nil), m.callsMapVariants...)
+}
+
+func (m *EdgeCaseServiceServerMock) EnumFields(ctx context.Context, req *testdata.EnumFieldsRequest) (*testdata.EnumFieldsResponse, error) {
+	m.mu.Lock()
+	m.callsEnumFields = append(m.callsEnumFields, req)
+	m.mu.Unlock()
+	if m.EnumFieldsFunc != nil {
+		return m.EnumFieldsFunc(ctx, req)
+	}
+	if m.EnumFieldsErr != nil {
+		return nil, m.EnumFieldsErr
+	}
+	if m.EnumFieldsResponse != nil {
+		return m.EnumFieldsResponse, nil
+	}
+	return &testdata.EnumFieldsResponse{}, nil
+}
+
+// EnumFieldsCalls returns the requests EnumFields was called with, in order.
+func (m *EdgeCaseServiceServerMock) EnumFieldsCalls() []*testdata.EnumFieldsRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.EnumFieldsRequest(nil), m.callsEnumFields...)
+}
+
+func (m *EdgeCaseServiceServerMock) MultipleOneofs(ctx context.Context, req *testdata.MultipleOneofsRequest) (*testdata.MultipleOneofsResponse, error) {
+	m.mu.Lock()
+	m.callsMultipleOneofs = append(m.callsMultipleOneofs, req)
+	m.mu.Unlock()
+	if m.MultipleOneofsFunc != nil {
+		return m.MultipleOneofsFunc(ctx, req)
+	}
+	if m.MultipleOneofsErr != nil {
+		return nil, m.MultipleOneofsErr
+	}
+	if m.MultipleOneofsResponse != nil {
+		return m.MultipleOneofsResponse, nil
+	}
+	return &testdata.MultipleOneofsResponse{}, nil
+}
+
+// MultipleOneofsCalls returns the requests MultipleOneofs was called with, in order.
+func (m *EdgeCaseServiceServerMock) MultipleOneofsCalls() []*testdata.MultipleOneofsRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.MultipleOneofsRequest(nil), m.callsMultipleOneofs...)
+}
+
+func (m *EdgeCaseServiceServerMock) NumericValidation(ctx context.Context, req *testdata.NumericValidationRequest) (*testdata.NumericValidationResponse, error) {
+	m.mu.Lock()
+	m.callsNumericValidation = append(m.callsNumericValidation, req)
+	m.mu.Unlock()
+	if m.NumericValidationFunc != nil {
+		return m.NumericValidationFunc(ctx, req)
+	}
+	if m.NumericValidationErr != nil {
+		return nil, m.NumericValidationErr
+	}
+	if m.NumericValidationResponse != nil {
+		return m.NumericValidationResponse, nil
+	}
+	return &testdata.NumericValidationResponse{}, nil
+}
+
+// NumericValidationCalls returns the requests NumericValidation was called with, in order.
+func (m *EdgeCaseServiceServerMock) NumericValidationCalls() []*testdata.NumericValidationRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.NumericValidationRequest(nil), m.callsNumericValidation...)
+}
+
+func (m *EdgeCaseServiceServerMock) RecursiveTree(ctx context.Context, req *testdata.RecursiveTreeRequest) (*testdata.RecursiveTreeResponse, error) {
+	m.mu.Lock()
+	m.callsRecursiveTree = append(m.callsRecursiveTree, req)
+	m.mu.Unlock()
+	if m.RecursiveTreeFunc != nil {
+		return m.RecursiveTreeFunc(ctx, req)
+	}
+	if m.RecursiveTreeErr != nil {
+		return nil, m.RecursiveTreeErr
+	}
+	if m.RecursiveTreeResponse != nil {
+		return m.RecursiveTreeResponse, nil
+	}
+	return &testdata.RecursiveTreeResponse{}, nil
+}
+
+// RecursiveTreeCalls returns the requests RecursiveTree was called with, in order.
+func (m *EdgeCaseServiceServerMock) RecursiveTreeCalls() []*testdata.RecursiveTreeRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.RecursiveTreeRequest(nil), m.callsRecursiveTree...)
+}
+
+func (m *EdgeCaseServiceServerMock) OneofRecursive(ctx context.Context, req *testdata.OneofRecursiveRequest) (*testdata.OneofRecursiveResponse, error) {
+	m.mu.Lock()
+	m.callsOneofRecursive = append(m.callsOneofRecursive, req)
+	m.mu.Unlock()
+	if m.OneofRecursiveFunc != nil {
+		return m.OneofRecursiveFunc(ctx, req)
+	}
+	if m.OneofRecursiveErr != nil {
+		return nil, m.OneofRecursiveErr
+	}
+	if m.OneofRecursiveResponse != nil {
+		return m.OneofRecursiveResponse, nil
+	}
+	return &testdata.OneofRecursiveResponse{}, nil
+}
+
+// OneofRecursiveCalls returns the requests OneofRecursive was called with, in order.
+func (m *EdgeCaseServiceServerMock) OneofRecursiveCalls() []*testdata.OneofRecursiveRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.OneofRecursiveRequest(nil), m.callsOneofRecursive...)
+}
+
+func (m *EdgeCaseServiceServerMock) NoArguments(ctx context.Context, req *emptypb.Empty) (*testdata.NoArgumentsResponse, error) {
+	m.mu.Lock()
+	m.callsNoArguments = append(m.callsNoArguments, req)
+	m.mu.Unlock()
+	if m.NoArgumentsFunc != nil {
+		return m.NoArgumentsFunc(ctx, req)
+	}
+	if m.NoArgumentsErr != nil {
+		return nil, m.NoArgumentsErr
+	}
+	if m.NoArgumentsResponse != nil {
+		return m.NoArgumentsResponse, nil
+	}
+	return &testdata.NoArgumentsResponse{}, nil
+}
+
+// NoArgumentsCalls returns the requests NoArguments was called with, in order.
+func (m *EdgeCaseServiceServerMock) NoArgumentsCalls() []*emptypb.Empty {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*emptypb.Empty(nil), m.callsNoArguments...)
+}
@@ -17,12 +17,66 @@ import (
 )
 
 var (
-	TestService_CreateItemTool            = runtime.Tool{Name: "testdata_TestService_CreateItem", Description: "CreateItem creates a new item\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x45, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x5c, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5c, 0x22, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x20, 0x53, 0x65, 0x74, 0x20, 0x5c, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x5c, 0x22, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x65, 0x74, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x57, 0x68, 0x69, 0x63, 0x68, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x5c, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5c, 0x22, 0x20, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x20, 0x69, 0x73, 0x20, 0x73, 0x65, 0x74, 0x2e, 0x22, 0x2c, 0x22, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0x3a, 0x5b, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x2c, 0x22, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x3d, 0x5c, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5c, 0x22, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x3d, 0x5c, 0x22, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5c, 0x22, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x77, 0x68, 0x69, 0x63, 0x68, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x61, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x22, 0x2c, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3a, 0x22, 0x62, 0x79, 0x74, 0x65, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3a, 0x22, 0x64, 0x61, 0x74, 0x65, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x2c, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
-	TestService_GetItemTool               = runtime.Tool{Name: "testdata_TestService_GetItem", Description: "GetItem retrieves an item by ID\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3a, 0x22, 0x64, 0x61, 0x74, 0x65, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x2c, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3a, 0x22, 0x64, 0x61, 0x74, 0x65, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
-	TestService_ProcessWellKnownTypesTool = runtime.Tool{Name: "testdata_TestService_ProcessWellKnownTypes", Description: "Test well-known types handling\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x61, 0x20, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2c, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x2c, 0x20, 0x61, 0x72, 0x72, 0x61, 0x79, 0x2c, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x29, 0x2e, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x2c, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x3a, 0x7b, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3a, 0x22, 0x64, 0x61, 0x74, 0x65, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
-	TestService_TestValidationTool        = runtime.Tool{Name: "testdata_TestService_TestValidation", Description: "Test protovalidate constraints\n", RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x22, 0x3a, 0x31, 0x35, 0x30, 0x2c, 0x22, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x22, 0x3a, 0x30, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x3a, 0x7b, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3a, 0x22, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x3a, 0x35, 0x30, 0x2c, 0x22, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x3a, 0x33, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3a, 0x22, 0x75, 0x75, 0x69, 0x64, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x3a, 0x7b, 0x22, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x22, 0x3a, 0x31, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x3a, 0x22, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x5d, 0x7b, 0x32, 0x2c, 0x31, 0x39, 0x7d, 0x24, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}}
+	TestService_CreateItemTool = runtime.Tool{
+		Name:            "testdata_TestService_CreateItem",
+		Description:     "CreateItem creates a new item\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"description":{"type":"string"},"item_type":{"description":"Exactly one of the \"item_type\" group. Set \"which\" to the chosen field name, then set only that field.","properties":{"which":{"description":"Which field of the \"item_type\" oneof is set.","enum":["product","service"],"type":"string"},"product":{"description":"The value when which=\"product\".","properties":{"price":{"type":"number"},"quantity":{"type":"integer"}},"required":[],"type":"object"},"service":{"description":"The value when which=\"service\".","properties":{"duration":{"type":"string"},"recurring":{"type":"boolean"}},"required":[],"type":"object"}},"required":["which"],"type":"object"},"labels":{"additionalProperties":{"type":"string"},"propertyNames":{"type":"string"},"type":"object"},"name":{"type":"string"},"tags":{"items":{"type":"string"},"type":"array"},"thumbnail":{"contentEncoding":"base64","format":"byte","type":"string"}},"required":["name"],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"created_at":{"format":"date-time","type":["string","null"]},"id":{"type":"string"}},"required":[],"type":"object"}`),
+	}
+	TestService_GetItemTool = runtime.Tool{
+		Name:            "testdata_TestService_GetItem",
+		Description:     "GetItem retrieves an item by ID\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"id":{"type":"string"}},"required":[],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"item":{"properties":{"created_at":{"format":"date-time","type":["string","null"]},"description":{"type":"string"},"id":{"type":"string"},"labels":{"additionalProperties":{"type":"string"},"propertyNames":{"type":"string"},"type":"object"},"name":{"type":"string"},"updated_at":{"format":"date-time","type":["string","null"]}},"required":[],"type":"object"}},"required":[],"type":"object"}`),
+	}
+	TestService_ProcessWellKnownTypesTool = runtime.Tool{
+		Name:            "testdata_TestService_ProcessWellKnownTypes",
+		Description:     "Test well-known types handling\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"config":{"description":"represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object). Arrays and objects JSON-encoded as a string are accepted too."},"metadata":{"additionalProperties":true,"type":"object"},"payload":{"properties":{"@type":{"type":"string"},"value":{}},"required":["@type"],"type":["object","null"]},"timestamp":{"format":"date-time","type":["string","null"]}},"required":[],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"message":{"type":"string"},"success":{"type":"boolean"}},"required":[],"type":"object"}`),
+	}
+	TestService_TestValidationTool = runtime.Tool{
+		Name:            "testdata_TestService_TestValidation",
+		Description:     "Test protovalidate constraints\n",
+		RawInputSchema:  json.RawMessage(`{"properties":{"age":{"maximum":150,"minimum":0,"type":"integer"},"email":{"format":"email","type":"string"},"name":{"maxLength":50,"minLength":3,"type":"string"},"resource_group_id":{"format":"uuid","type":"string"},"timestamp":{"minimum":1,"type":"string"},"username":{"pattern":"^[a-zA-Z][a-zA-Z0-9_]{2,19}$","type":"string"}},"required":[],"type":"object"}`),
+		RawOutputSchema: json.RawMessage(`{"properties":{"message":{"type":"string"},"success":{"type":"boolean"}},"required":[],"type":"object"}`),
+	}
 )
 
+// File_testdata_test_service_proto_GenerationInfo records the
+// generator version and options of the tools above, see
+// runtime.CheckGenerationInfo.
+var File_testdata_test_service_proto_GenerationInfo = runtime.GenerationInfo{
+	Source:  "testdata/test_service.proto",
+	Version: "devel",
+	Options: []string{
+		"deprecated_fields=keep",
+		"dry_run=false",
+		"exclude_deprecated_methods=false",
+		"exclude_fields=",
+		"flatten_nested=false",
+		"openai_strict=false",
+		"relative_times=false",
+		"resources=false",
+		"schema_draft=",
+		"schema_mappings=",
+		"schema_patches=",
+		"wrap_input=",
+	},
+	Cosmetic: []string{
+		"comment_directives=",
+		"comment_markdown=keep",
+		"dedupe_descriptions=false",
+		"examples_in_description=false",
+		"field_comments=none",
+		"max_field_description_bytes=0",
+		"max_tool_description_bytes=0",
+		"minify_schemas=false",
+		"shared_definitions=false",
+		"titles=false",
+	},
+}
+
 // TestServiceServer is compatible with the grpc-go server interface.
 type TestServiceServer interface {
 	CreateItem(ctx context.Context, req *testdata.CreateItemRequest) (*testdata.CreateItemResponse, error)
@@ -40,16 +94,49 @@ func RegisterTestServiceHandler(s runtime.MCPServer, srv TestServiceServer, opts
 	CreateItemTool := TestService_CreateItemTool
 	CreateItemTool = runtime.ApplyConfig(CreateItemTool, config)
 
-	s.AddTool(CreateItemTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	CreateItemHandler := runtime.ApplyMutatingHandlerConfig(CreateItemTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.CreateItemRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, CreateItemTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		// Rewrite oneof discriminated wrappers and recursion placeholders into the
@@ -58,6 +145,11 @@ func RegisterTestServiceHandler(s runtime.MCPServer, srv TestServiceServer, opts
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -67,7 +159,9 @@ func RegisterTestServiceHandler(s runtime.MCPServer, srv TestServiceServer, opts
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.CreateItem(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -79,19 +173,53 @@ func RegisterTestServiceHandler(s runtime.MCPServer, srv TestServiceServer, opts
 
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, CreateItemTool, CreateItemHandler)
 	GetItemTool := TestService_GetItemTool
 	GetItemTool = runtime.ApplyConfig(GetItemTool, config)
 
-	s.AddTool(GetItemTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	GetItemHandler := runtime.ApplyMutatingHandlerConfig(GetItemTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.GetItemRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, GetItemTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		// Rewrite oneof discriminated wrappers and recursion placeholders into the
@@ -100,6 +228,11 @@ func RegisterTestServiceHandler(s runtime.MCPServer, srv TestServiceServer, opts
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -109,7 +242,9 @@ func RegisterTestServiceHandler(s runtime.MCPServer, srv TestServiceServer, opts
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.GetItem(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -121,19 +256,53 @@ func RegisterTestServiceHandler(s runtime.MCPServer, srv TestServiceServer, opts
 
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, GetItemTool, GetItemHandler)
 	ProcessWellKnownTypesTool := TestService_ProcessWellKnownTypesTool
 	ProcessWellKnownTypesTool = runtime.ApplyConfig(ProcessWellKnownTypesTool, config)
 
-	s.AddTool(ProcessWellKnownTypesTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	ProcessWellKnownTypesHandler := runtime.ApplyMutatingHandlerConfig(ProcessWellKnownTypesTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.ProcessWellKnownTypesRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, ProcessWellKnownTypesTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		// Rewrite oneof discriminated wrappers and recursion placeholders into the
@@ -142,6 +311,11 @@ func RegisterTestServiceHandler(s runtime.MCPServer, srv TestServiceServer, opts
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -151,7 +325,9 @@ func RegisterTestServiceHandler(s runtime.MCPServer, srv TestServiceServer, opts
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.ProcessWellKnownTypes(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -163,19 +339,53 @@ func RegisterTestServiceHandler(s runtime.MCPServer, srv TestServiceServer, opts
 
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, ProcessWellKnownTypesTool, ProcessWellKnownTypesHandler)
 	TestValidationTool := TestService_TestValidationTool
 	TestValidationTool = runtime.ApplyConfig(TestValidationTool, config)
 
-	s.AddTool(TestValidationTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	TestValidationHandler := runtime.ApplyMutatingHandlerConfig(TestValidationTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.TestValidationRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, TestValidationTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		// Rewrite oneof discriminated wrappers and recursion placeholders into the
@@ -184,6 +394,11 @@ func RegisterTestServiceHandler(s runtime.MCPServer, srv TestServiceServer, opts
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -193,7 +408,9 @@ func RegisterTestServiceHandler(s runtime.MCPServer, srv TestServiceServer, opts
 			return nil, err
 		}
 
+		done := runtime.StartBackendCall(ctx)
 		resp, err := srv.TestValidation(ctx, &req)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -205,6 +422,7 @@ func RegisterTestServiceHandler(s runtime.MCPServer, srv TestServiceServer, opts
 
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, TestValidationTool, TestValidationHandler)
 }
 
 // TestServiceClient is compatible with the grpc-go client interface.
@@ -232,22 +450,60 @@ func ForwardToConnectTestServiceClient(s runtime.MCPServer, client ConnectTestSe
 	CreateItemTool := TestService_CreateItemTool
 	CreateItemTool = runtime.ApplyConfig(CreateItemTool, config)
 
-	s.AddTool(CreateItemTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	CreateItemHandler := runtime.ApplyMutatingHandlerConfig(CreateItemTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.CreateItemRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, CreateItemTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -257,7 +513,12 @@ func ForwardToConnectTestServiceClient(s runtime.MCPServer, client ConnectTestSe
 			return nil, err
 		}
 
-		resp, err := client.CreateItem(ctx, connect.NewRequest(&req))
+		creq := connect.NewRequest(&req)
+		runtime.SetOutgoingHeaders(ctx, creq.Header())
+		runtime.ApplyConnectHeaders(ctx, CreateItemTool.Name, config, creq.Header())
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.CreateItem(ctx, creq)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -268,25 +529,64 @@ func ForwardToConnectTestServiceClient(s runtime.MCPServer, client ConnectTestSe
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, CreateItemTool, CreateItemHandler)
 	GetItemTool := TestService_GetItemTool
 	GetItemTool = runtime.ApplyConfig(GetItemTool, config)
 
-	s.AddTool(GetItemTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	GetItemHandler := runtime.ApplyMutatingHandlerConfig(GetItemTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.GetItemRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, GetItemTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -296,7 +596,12 @@ func ForwardToConnectTestServiceClient(s runtime.MCPServer, client ConnectTestSe
 			return nil, err
 		}
 
-		resp, err := client.GetItem(ctx, connect.NewRequest(&req))
+		creq := connect.NewRequest(&req)
+		runtime.SetOutgoingHeaders(ctx, creq.Header())
+		runtime.ApplyConnectHeaders(ctx, GetItemTool.Name, config, creq.Header())
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.GetItem(ctx, creq)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -307,25 +612,64 @@ func ForwardToConnectTestServiceClient(s runtime.MCPServer, client ConnectTestSe
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, GetItemTool, GetItemHandler)
 	ProcessWellKnownTypesTool := TestService_ProcessWellKnownTypesTool
 	ProcessWellKnownTypesTool = runtime.ApplyConfig(ProcessWellKnownTypesTool, config)
 
-	s.AddTool(ProcessWellKnownTypesTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	ProcessWellKnownTypesHandler := runtime.ApplyMutatingHandlerConfig(ProcessWellKnownTypesTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.ProcessWellKnownTypesRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, ProcessWellKnownTypesTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -335,7 +679,12 @@ func ForwardToConnectTestServiceClient(s runtime.MCPServer, client ConnectTestSe
 			return nil, err
 		}
 
-		resp, err := client.ProcessWellKnownTypes(ctx, connect.NewRequest(&req))
+		creq := connect.NewRequest(&req)
+		runtime.SetOutgoingHeaders(ctx, creq.Header())
+		runtime.ApplyConnectHeaders(ctx, ProcessWellKnownTypesTool.Name, config, creq.Header())
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.ProcessWellKnownTypes(ctx, creq)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -346,25 +695,64 @@ func ForwardToConnectTestServiceClient(s runtime.MCPServer, client ConnectTestSe
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, ProcessWellKnownTypesTool, ProcessWellKnownTypesHandler)
 	TestValidationTool := TestService_TestValidationTool
 	TestValidationTool = runtime.ApplyConfig(TestValidationTool, config)
 
-	s.AddTool(TestValidationTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	TestValidationHandler := runtime.ApplyMutatingHandlerConfig(TestValidationTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.TestValidationRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, TestValidationTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -374,7 +762,12 @@ func ForwardToConnectTestServiceClient(s runtime.MCPServer, client ConnectTestSe
 			return nil, err
 		}
 
-		resp, err := client.TestValidation(ctx, connect.NewRequest(&req))
+		creq := connect.NewRequest(&req)
+		runtime.SetOutgoingHeaders(ctx, creq.Header())
+		runtime.ApplyConnectHeaders(ctx, TestValidationTool.Name, config, creq.Header())
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.TestValidation(ctx, creq)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -385,6 +778,72 @@ func ForwardToConnectTestServiceClient(s runtime.MCPServer, client ConnectTestSe
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, TestValidationTool, TestValidationHandler)
+}
+
+// ForwardToConnectTestServiceURL forwards MCP calls to the TestService at baseURL
+// with a connectrpc client. Select its wire protocol and codec with
+// runtime.WithConnectProtocol and runtime.WithConnectCodec.
+func ForwardToConnectTestServiceURL(s runtime.MCPServer, httpClient connect.HTTPClient, baseURL string, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	clientOpts := runtime.ConnectClientOptions(config)
+	ForwardToConnectTestServiceClient(s, connectTestServiceClient{
+		callCreateItem:            connect.NewClient[testdata.CreateItemRequest, testdata.CreateItemResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.TestService/CreateItem"), clientOpts...),
+		callGetItem:               connect.NewClient[testdata.GetItemRequest, testdata.GetItemResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.TestService/GetItem"), clientOpts...),
+		callProcessWellKnownTypes: connect.NewClient[testdata.ProcessWellKnownTypesRequest, testdata.ProcessWellKnownTypesResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.TestService/ProcessWellKnownTypes"), clientOpts...),
+		callTestValidation:        connect.NewClient[testdata.TestValidationRequest, testdata.TestValidationResponse](httpClient, runtime.ConnectProcedureURL(baseURL, "/testdata.TestService/TestValidation"), clientOpts...),
+	}, opts...)
+}
+
+// connectTestServiceClient is the ConnectTestServiceClient of
+// ForwardToConnectTestServiceURL.
+type connectTestServiceClient struct {
+	callCreateItem            *connect.Client[testdata.CreateItemRequest, testdata.CreateItemResponse]
+	callGetItem               *connect.Client[testdata.GetItemRequest, testdata.GetItemResponse]
+	callProcessWellKnownTypes *connect.Client[testdata.ProcessWellKnownTypesRequest, testdata.ProcessWellKnownTypesResponse]
+	callTestValidation        *connect.Client[testdata.TestValidationRequest, testdata.TestValidationResponse]
+}
+
+func (c connectTestServiceClient) CreateItem(ctx context.Context, req *connect.Request[testdata.CreateItemRequest]) (*connect.Response[testdata.CreateItemResponse], error) {
+	return c.callCreateItem.CallUnary(ctx, req)
+}
+
+func (c connectTestServiceClient) GetItem(ctx context.Context, req *connect.Request[testdata.GetItemRequest]) (*connect.Response[testdata.GetItemResponse], error) {
+	return c.callGetItem.CallUnary(ctx, req)
+}
+
+func (c connectTestServiceClient) ProcessWellKnownTypes(ctx context.Context, req *connect.Request[testdata.ProcessWellKnownTypesRequest]) (*connect.Response[testdata.ProcessWellKnownTypesResponse], error) {
+	return c.callProcessWellKnownTypes.CallUnary(ctx, req)
+}
+
+func (c connectTestServiceClient) TestValidation(ctx context.Context, req *connect.Request[testdata.TestValidationRequest]) (*connect.Response[testdata.TestValidationResponse], error) {
+	return c.callTestValidation.CallUnary(ctx, req)
+}
+
+// ConnectTestServiceHandler is compatible with the connectrpc-go handler interface.
+type ConnectTestServiceHandler interface {
+	CreateItem(ctx context.Context, req *connect.Request[testdata.CreateItemRequest]) (*connect.Response[testdata.CreateItemResponse], error)
+	GetItem(ctx context.Context, req *connect.Request[testdata.GetItemRequest]) (*connect.Response[testdata.GetItemResponse], error)
+	ProcessWellKnownTypes(ctx context.Context, req *connect.Request[testdata.ProcessWellKnownTypesRequest]) (*connect.Response[testdata.ProcessWellKnownTypesResponse], error)
+	TestValidation(ctx context.Context, req *connect.Request[testdata.TestValidationRequest]) (*connect.Response[testdata.TestValidationResponse], error)
+}
+
+// ForwardToConnectTestServiceHandler forwards MCP calls to a connectrpc handler
+// implementation in the same process by calling its methods directly, without
+// serializing requests. Handler interceptors do not run. Watched resources
+// need a stream, which only a mounted handler can serve: forward to it with
+// ForwardToConnectTestServiceURL and runtime.InProcessHTTPClient instead.
+func ForwardToConnectTestServiceHandler(s runtime.MCPServer, handler ConnectTestServiceHandler, opts ...runtime.Option) {
+	ForwardToConnectTestServiceClient(s, handlerTestServiceClient{handler}, opts...)
+}
+
+// handlerTestServiceClient is the ConnectTestServiceClient of
+// ForwardToConnectTestServiceHandler.
+type handlerTestServiceClient struct {
+	ConnectTestServiceHandler
 }
 
 // ForwardToTestServiceClient registers a gRPC client, to forward MCP calls to it.
@@ -396,22 +855,60 @@ func ForwardToTestServiceClient(s runtime.MCPServer, client TestServiceClient, o
 	CreateItemTool := TestService_CreateItemTool
 	CreateItemTool = runtime.ApplyConfig(CreateItemTool, config)
 
-	s.AddTool(CreateItemTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	CreateItemHandler := runtime.ApplyMutatingHandlerConfig(CreateItemTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.CreateItemRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, CreateItemTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -421,7 +918,9 @@ func ForwardToTestServiceClient(s runtime.MCPServer, client TestServiceClient, o
 			return nil, err
 		}
 
-		resp, err := client.CreateItem(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.CreateItem(ctx, &req, runtime.ApplyCallOptions(ctx, CreateItemTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -432,25 +931,64 @@ func ForwardToTestServiceClient(s runtime.MCPServer, client TestServiceClient, o
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, CreateItemTool, CreateItemHandler)
 	GetItemTool := TestService_GetItemTool
 	GetItemTool = runtime.ApplyConfig(GetItemTool, config)
 
-	s.AddTool(GetItemTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	GetItemHandler := runtime.ApplyMutatingHandlerConfig(GetItemTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.GetItemRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
+
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, GetItemTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -460,7 +998,9 @@ func ForwardToTestServiceClient(s runtime.MCPServer, client TestServiceClient, o
 			return nil, err
 		}
 
-		resp, err := client.GetItem(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.GetItem(ctx, &req, runtime.ApplyCallOptions(ctx, GetItemTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -471,25 +1011,64 @@ func ForwardToTestServiceClient(s runtime.MCPServer, client TestServiceClient, o
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, GetItemTool, GetItemHandler)
 	ProcessWellKnownTypesTool := TestService_ProcessWellKnownTypesTool
 	ProcessWellKnownTypesTool = runtime.ApplyConfig(ProcessWellKnownTypesTool, config)
 
-	s.AddTool(ProcessWellKnownTypesTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	ProcessWellKnownTypesHandler := runtime.ApplyMutatingHandlerConfig(ProcessWellKnownTypesTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.ProcessWellKnownTypesRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, ProcessWellKnownTypesTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -499,7 +1078,9 @@ func ForwardToTestServiceClient(s runtime.MCPServer, client TestServiceClient, o
 			return nil, err
 		}
 
-		resp, err := client.ProcessWellKnownTypes(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.ProcessWellKnownTypes(ctx, &req, runtime.ApplyCallOptions(ctx, ProcessWellKnownTypesTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -510,25 +1091,64 @@ func ForwardToTestServiceClient(s runtime.MCPServer, client TestServiceClient, o
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, ProcessWellKnownTypesTool, ProcessWellKnownTypesHandler)
 	TestValidationTool := TestService_TestValidationTool
 	TestValidationTool = runtime.ApplyConfig(TestValidationTool, config)
 
-	s.AddTool(TestValidationTool, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
+	TestValidationHandler := runtime.ApplyMutatingHandlerConfig(TestValidationTool.Name, config, func(ctx context.Context, request *runtime.CallToolRequest) (*runtime.CallToolResult, error) {
 		var req testdata.TestValidationRequest
 
+		// Stop the backend call when the client's _meta timeout runs out.
+		ctx, cancel := runtime.WithRequestTimeout(ctx, request.Meta)
+		defer cancel()
+
 		message := request.Arguments
+		if message == nil {
+			// A call may come without arguments; the steps below write to them.
+			message = map[string]any{}
+		}
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		// Fill the fields named in the "resources" argument from their resources.
+		if err := runtime.ExtractResourceInputs(ctx, req.ProtoReflect().Descriptor(), message, config.ResourceReader); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Move extra properties into ctx, converted to their typed values.
+		extraProperties := config.ExtraProperties
+		ctx, err := runtime.ExtractExtraProperties(ctx, message, extraProperties)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		if config.Elicitation {
+			// Ask the user for required arguments the model left out.
+			elicited, err := runtime.ElicitMissingArguments(ctx, TestValidationTool, message, extraProperties)
+			if err != nil {
+				return runtime.NewToolResultError(err.Error()), nil
 			}
+			message = elicited
+		}
+
+		// Strip the "headers" argument into outgoing metadata.
+		ctx, err = runtime.ExtractHeaders(ctx, req.ProtoReflect().Descriptor(), message, config.ForwardedHeaders)
+		if err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
+		// Fill (mcp.field).from_context fields from ctx; the model never sets them.
+		if err := runtime.PopulateFromContext(ctx, req.ProtoReflect().Descriptor(), message, config.ContextFields); err != nil {
+			return nil, err
 		}
 
 		if err := runtime.DecodeArguments(req.ProtoReflect().Descriptor(), message); err != nil {
 			return runtime.NewToolResultError(err.Error()), nil
 		}
 
+		// Clean up string values with the runtime.WithStringSanitizers sanitizers.
+		if err := runtime.SanitizeStrings(req.ProtoReflect().Descriptor(), message, config.StringSanitizers); err != nil {
+			return runtime.NewToolResultError(err.Error()), nil
+		}
+
 		marshaled, err := json.Marshal(message)
 		if err != nil {
 			return nil, err
@@ -538,7 +1158,9 @@ func ForwardToTestServiceClient(s runtime.MCPServer, client TestServiceClient, o
 			return nil, err
 		}
 
-		resp, err := client.TestValidation(ctx, &req)
+		done := runtime.StartBackendCall(ctx)
+		resp, err := client.TestValidation(ctx, &req, runtime.ApplyCallOptions(ctx, TestValidationTool.Name, config)...)
+		done(resp, err)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
@@ -549,4 +1171,50 @@ func ForwardToTestServiceClient(s runtime.MCPServer, client TestServiceClient, o
 		}
 		return runtime.NewToolResultJSON(structured), nil
 	})
+	runtime.AddTool(s, config, TestValidationTool, TestValidationHandler)
+}
+
+// ForwardToTestServiceConn forwards MCP calls to the TestService behind conn,
+// e.g. a *grpc.ClientConn with interceptors or a connection pool, without
+// building a typed gRPC client first.
+func ForwardToTestServiceConn(s runtime.MCPServer, conn grpc.ClientConnInterface, opts ...runtime.Option) {
+	ForwardToTestServiceClient(s, connTestServiceClient{conn}, opts...)
+}
+
+// connTestServiceClient is the TestServiceClient of
+// ForwardToTestServiceConn.
+type connTestServiceClient struct {
+	conn grpc.ClientConnInterface
+}
+
+func (c connTestServiceClient) CreateItem(ctx context.Context, req *testdata.CreateItemRequest, opts ...grpc.CallOption) (*testdata.CreateItemResponse, error) {
+	resp := new(testdata.CreateItemResponse)
+	if err := c.conn.Invoke(ctx, "/testdata.TestService/CreateItem", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connTestServiceClient) GetItem(ctx context.Context, req *testdata.GetItemRequest, opts ...grpc.CallOption) (*testdata.GetItemResponse, error) {
+	resp := new(testdata.GetItemResponse)
+	if err := c.conn.Invoke(ctx, "/testdata.TestService/GetItem", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connTestServiceClient) ProcessWellKnownTypes(ctx context.Context, req *testdata.ProcessWellKnownTypesRequest, opts ...grpc.CallOption) (*testdata.ProcessWellKnownTypesResponse, error) {
+	resp := new(testdata.ProcessWellKnownTypesResponse)
+	if err := c.conn.Invoke(ctx, "/testdata.TestService/ProcessWellKnownTypes", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c connTestServiceClient) TestValidation(ctx context.Context, req *testdata.TestValidationRequest, opts ...grpc.CallOption) (*testdata.TestValidationResponse, error) {
+	resp := new(testdata.TestValidationResponse)
+	if err := c.conn.Invoke(ctx, "/testdata.TestService/TestValidation", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
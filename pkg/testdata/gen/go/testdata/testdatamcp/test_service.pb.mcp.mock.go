@@ -0,0 +1,130 @@
+// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
+// source: testdata/test_service.proto
+
+package testdatamcp
+
+import (
+	context "context"
+	testdata "github.com/redpanda-data/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	sync "sync"
+)
+
+// TestServiceServerMock is a TestServiceServer for testing MCP wiring and
+// interceptors without a backend. A method calls its <Method>Func when set,
+// and otherwise returns <Method>Err or <Method>Response, defaulting to an empty
+// response. Streaming methods send <Method>Responses instead. <Method>Calls
+// returns the requests a method got.
+type TestServiceServerMock struct {
+	CreateItemFunc                func(ctx context.Context, req *testdata.CreateItemRequest) (*testdata.CreateItemResponse, error)
+	CreateItemResponse            *testdata.CreateItemResponse
+	CreateItemErr                 error
+	GetItemFunc                   func(ctx context.Context, req *testdata.GetItemRequest) (*testdata.GetItemResponse, error)
+	GetItemResponse               *testdata.GetItemResponse
+	GetItemErr                    error
+	ProcessWellKnownTypesFunc     func(ctx context.Context, req *testdata.ProcessWellKnownTypesRequest) (*testdata.ProcessWellKnownTypesResponse, error)
+	ProcessWellKnownTypesResponse *testdata.ProcessWellKnownTypesResponse
+	ProcessWellKnownTypesErr      error
+	TestValidationFunc            func(ctx context.Context, req *testdata.TestValidationRequest) (*testdata.TestValidationResponse, error)
+	TestValidationResponse        *testdata.TestValidationResponse
+	TestValidationErr             error
+
+	mu                         sync.Mutex
+	callsCreateItem            []*testdata.CreateItemRequest
+	callsGetItem               []*testdata.GetItemRequest
+	callsProcessWellKnownTypes []*testdata.ProcessWellKnownTypesRequest
+	callsTestValidation        []*testdata.TestValidationRequest
+}
+
+var _ TestServiceServer = (*TestServiceServerMock)(nil)
+
+func (m *TestServiceServerMock) CreateItem(ctx context.Context, req *testdata.CreateItemRequest) (*testdata.CreateItemResponse, error) {
+	m.mu.Lock()
+	m.callsCreateItem = append(m.callsCreateItem, req)
+	m.mu.Unlock()
+	if m.CreateItemFunc != nil {
+		return m.CreateItemFunc(ctx, req)
+	}
+	if m.CreateItemErr != nil {
+		return nil, m.CreateItemErr
+	}
+	if m.CreateItemResponse != nil {
+		return m.CreateItemResponse, nil
+	}
+	return &testdata.CreateItemResponse{}, nil
+}
+
+// CreateItemCalls returns the requests CreateItem was called with, in order.
+func (m *TestServiceServerMock) CreateItemCalls() []*testdata.CreateItemRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.CreateItemRequest(nil), m.callsCreateItem...)
+}
+
+func (m *TestServiceServerMock) GetItem(ctx context.Context, req *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+	m.mu.Lock()
+	m.callsGetItem = append(m.callsGetItem, req)
+	m.mu.Unlock()
+	if m.GetItemFunc != nil {
+		return m.GetItemFunc(ctx, req)
+	}
+	if m.GetItemErr != nil {
+		return nil, m.GetItemErr
+	}
+	if m.GetItemResponse != nil {
+		return m.GetItemResponse, nil
+	}
+	return &testdata.GetItemResponse{}, nil
+}
+
+// GetItemCalls returns the requests GetItem was called with, in order.
+func (m *TestServiceServerMock) GetItemCalls() []*testdata.GetItemRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.GetItemRequest(nil), m.callsGetItem...)
+}
+
+func (m *TestServiceServerMock) ProcessWellKnownTypes(ctx context.Context, req *testdata.ProcessWellKnownTypesRequest) (*testdata.ProcessWellKnownTypesResponse, error) {
+	m.mu.Lock()
+	m.callsProcessWellKnownTypes = append(m.callsProcessWellKnownTypes, req)
+	m.mu.Unlock()
+	if m.ProcessWellKnownTypesFunc != nil {
+		return m.ProcessWellKnownTypesFunc(ctx, req)
+	}
+	if m.ProcessWellKnownTypesErr != nil {
+		return nil, m.ProcessWellKnownTypesErr
+	}
+	if m.ProcessWellKnownTypesResponse != nil {
+		return m.ProcessWellKnownTypesResponse, nil
+	}
+	return &testdata.ProcessWellKnownTypesResponse{}, nil
+}
+
+// ProcessWellKnownTypesCalls returns the requests ProcessWellKnownTypes was called with, in order.
+func (m *TestServiceServerMock) ProcessWellKnownTypesCalls() []*testdata.ProcessWellKnownTypesRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.ProcessWellKnownTypesRequest(nil), m.callsProcessWellKnownTypes...)
+}
+
+func (m *TestServiceServerMock) TestValidation(ctx context.Context, req *testdata.TestValidationRequest) (*testdata.TestValidationResponse, error) {
+	m.mu.Lock()
+	m.callsTestValidation = append(m.callsTestValidation, req)
+	m.mu.Unlock()
+	if m.TestValidationFunc != nil {
+		return m.TestValidationFunc(ctx, req)
+	}
+	if m.TestValidationErr != nil {
+		return nil, m.TestValidationErr
+	}
+	if m.TestValidationResponse != nil {
+		return m.TestValidationResponse, nil
+	}
+	return &testdata.TestValidationResponse{}, nil
+}
+
+// TestValidationCalls returns the requests TestValidation was called with, in order.
+func (m *TestServiceServerMock) TestValidationCalls() []*testdata.TestValidationRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*testdata.TestValidationRequest(nil), m.callsTestValidation...)
+}